@@ -1,13 +1,23 @@
 package main
 
 import (
+	"fmt"
+	"os"
 	"sync"
 
 	grpcruntime "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime"
 	restruntime "github.com/cloud-barista/cb-spider/api-runtime/rest-runtime"
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
 )
 
 func main() {
+	// the credentials can not be used without the master key, so check it before the servers.
+	err := cim.LoadCredentialKeys()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
 	wg := new(sync.WaitGroup)
 
 	wg.Add(2)
@@ -10,14 +10,22 @@ package commonruntime
 
 import (
 	"fmt"
+	"io"
+	"net"
+	"regexp"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
+	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
+	imim "github.com/cloud-barista/cb-spider/cloud-info-manager/image-map-info-manager"
 	"github.com/cloud-barista/cb-store/config"
 	"github.com/sirupsen/logrus"
 	//	"strings"
@@ -30,12 +38,25 @@ const (
 	rsImage string = "image"
 	rsVPC   string = "vpc"
 	// rsSubnet = SUBNET:{VPC NameID} => cook in code
-	rsSG  string = "sg"
-	rsKey string = "keypair"
-	rsVM  string = "vm"
+	rsSG         string = "sg"
+	rsKey        string = "keypair"
+	rsVM         string = "vm"
+	rsNLB        string = "nlb"
+	rsPublicIP   string = "publicip"
+	rsVPCPeering string = "vpcpeering"
+	rsDNSZone    string = "dnszone"
+	// rsDNSRecord = {VM NameID} => {DNS Zone NameID} of the A record registered by StartVM
+	rsDNSRecord string = "dnsrecord"
+	rsBucket    string = "bucket"
+	// rsVPCCIDR = {VPC NameID} => "{IPv4 CIDR},{IPv6 CIDR}" of the VPC recorded by CreateVPC
+	rsVPCCIDR string = "vpccidr"
 )
 
 const rsSubnetPrefix string = "subnet:"
+
+// rsSubnetCIDRPrefix+{VPC NameID} = {Subnet NameID} => "{IPv4 CIDR},{IPv6 CIDR}" of the Subnet
+const rsSubnetCIDRPrefix string = "subnetcidr:"
+const rsNATGatewayPrefix string = "natgateway:"
 const sgDELIMITER string = "-delimiter-"
 
 // definition of RWLock for each Resource Ops
@@ -44,6 +65,29 @@ var vpcRWLock = new(sync.RWMutex)
 var sgRWLock = new(sync.RWMutex)
 var keyRWLock = new(sync.RWMutex)
 var vmRWLock = new(sync.RWMutex)
+var nlbRWLock = new(sync.RWMutex)
+var publicIPRWLock = new(sync.RWMutex)
+var vpcPeeringRWLock = new(sync.RWMutex)
+var dnsZoneRWLock = new(sync.RWMutex)
+var bucketRWLock = new(sync.RWMutex)
+
+// held from the check of fixed private IPs to the IID insert of the VM,
+// so two VMs can not pass the check with the same private IP.
+// the IPs are checked with the VMs of the same connection, so each connection has its own lock.
+var vmPrivateIPLockMap = map[string]*sync.Mutex{}
+var vmPrivateIPLockMapLock = new(sync.Mutex)
+
+func getVMPrivateIPLock(connectionName string) *sync.Mutex {
+	vmPrivateIPLockMapLock.Lock()
+	defer vmPrivateIPLockMapLock.Unlock()
+
+	lock, ok := vmPrivateIPLockMap[connectionName]
+	if !ok {
+		lock = new(sync.Mutex)
+		vmPrivateIPLockMap[connectionName] = lock
+	}
+	return lock
+}
 
 // definition of IIDManager RWLock
 var iidRWLock = new(iidm.IIDRWLOCK)
@@ -62,6 +106,41 @@ type AllResourceList struct {
 	}
 }
 
+//================ Credential Verify
+// (1) verify the credential with a read-only API call of the region's CSP
+// (2) register the credential
+// a credential with an invalid key is rejected with the CSP error.
+// no verification if regionName is empty.
+// driverName is required only if the provider has more than one driver.
+func RegisterCredential(crdInfo cim.CredentialInfo, regionName string, driverName string) (*cim.CredentialInfo, error) {
+	cblog.Info("call RegisterCredential()")
+
+	if regionName != "" {
+		err := ccm.VerifyCredentialInfo(crdInfo, regionName, driverName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, fmt.Errorf("Credential(%s) verification failed: %v", crdInfo.CredentialName, err)
+		}
+	}
+
+	return cim.RegisterCredentialInfo(crdInfo)
+}
+
+func VerifyCredential(credentialName string, regionName string, driverName string) error {
+	cblog.Info("call VerifyCredential()")
+
+	if regionName == "" {
+		return fmt.Errorf("RegionName is empty!")
+	}
+
+	err := ccm.VerifyCredential(credentialName, regionName, driverName)
+	if err != nil {
+		cblog.Error(err)
+		return fmt.Errorf("Credential(%s) verification failed: %v", credentialName, err)
+	}
+	return nil
+}
+
 //================ Image Handler
 // @todo
 // (1) check exist(NameID)
@@ -467,10 +546,89 @@ func GetOrgVMSpec(connectionName string, nameID string) (string, error) {
 	return info, nil
 }
 
+//================ RegionZone Handler
+// regions and zones of the CSP, which are available with the credential of the connection.
+func ListRegionZone(connectionName string) ([]*cres.RegionZoneInfo, error) {
+	cblog.Info("call ListRegionZone()")
+
+	handler, err := getRegionZoneHandler(connectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	infoList, err := handler.ListRegionZone()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if infoList == nil || len(infoList) <= 0 {
+		infoList = []*cres.RegionZoneInfo{}
+	}
+
+	return infoList, nil
+}
+
+func GetRegionZone(connectionName string, nameID string) (*cres.RegionZoneInfo, error) {
+	cblog.Info("call GetRegionZone()")
+
+	handler, err := getRegionZoneHandler(connectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := handler.GetRegionZone(nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// zones of the region of the connection
+func ListZone(connectionName string) ([]*cres.ZoneInfo, error) {
+	cblog.Info("call ListZone()")
+
+	handler, err := getRegionZoneHandler(connectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	infoList, err := handler.ListZone()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if infoList == nil || len(infoList) <= 0 {
+		infoList = []*cres.ZoneInfo{}
+	}
+
+	return infoList, nil
+}
+
+func getRegionZoneHandler(connectionName string) (cres.RegionZoneHandler, error) {
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateRegionZoneHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	return handler, nil
+}
+
 //================ VPC Handler
 // (1) check exist(NameID)
-// (2) create Resource
-// (3) insert IID
+// (2) check and allocate CIDRs
+// (3) create Resource
+// (4) insert IID
+// (5) record CIDRs
 func CreateVPC(connectionName string, rsType string, reqInfo cres.VPCReqInfo) (*cres.VPCInfo, error) {
 	cblog.Info("call CreateVPC()")
 
@@ -498,7 +656,14 @@ func CreateVPC(connectionName string, rsType string, reqInfo cres.VPCReqInfo) (*
 	if bool_ret == true {
 		return nil, fmt.Errorf(rsType + "-" + reqInfo.IId.NameId + " already exists!")
 	}
-	// (2) create Resource
+	// (2) check and allocate CIDRs
+	err = validateVPCCIDR(connectionName, &reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) create Resource
 	info, err := handler.CreateVPC(reqInfo)
 	if err != nil {
 		cblog.Error(err)
@@ -506,7 +671,7 @@ func CreateVPC(connectionName string, rsType string, reqInfo cres.VPCReqInfo) (*
 	}
 	info.IId.NameId = reqInfo.IId.NameId
 
-	// (3) insert IID
+	// (4) insert IID
 	// for VPC
 	iidInfo, err := iidRWLock.CreateIID(connectionName, rsType, info.IId)
 	if err != nil {
@@ -552,6 +717,12 @@ func CreateVPC(connectionName string, rsType string, reqInfo cres.VPCReqInfo) (*
 			return nil, err
 		}
 	}
+	// (5) record CIDRs for the validation of the next Subnets
+	err = insertVPCCIDR(connectionName, info)
+	if err != nil {
+		// recorded again with the CSP info at the next check
+		cblog.Error(err)
+	}
 
 	return &info, nil
 }
@@ -632,6 +803,11 @@ func ListVPC(connectionName string, rsType string) ([]*cres.VPCInfo, error) {
 
 				}
 				info.SubnetInfoList = subnetInfoList
+				err = getSetNATGatewayAndRouteNameId(connectionName, info)
+				if err != nil {
+					cblog.Error(err)
+					return nil, err
+				}
 
 				infoList2 = append(infoList2, info)
 				exist = true
@@ -696,26 +872,85 @@ func GetVPC(connectionName string, rsType string, nameID string) (*cres.VPCInfo,
 		}
 	}
 	info.SubnetInfoList = subnetInfoList
+	err = getSetNATGatewayAndRouteNameId(connectionName, &info)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
 
 	return &info, nil
 }
 
-//================ SecurityGroup Handler
-// (1) check exist(NameID)
-// (2) create Resource
-// (3) insert IID
-func CreateSecurity(connectionName string, rsType string, reqInfo cres.SecurityReqInfo) (*cres.SecurityInfo, error) {
-	cblog.Info("call CreateSecurity()")
+// set NameIds of NAT Gateways, Route Table Subnets and Route Targets
+// by NameId of VPC, only the NAT Gateways and Subnets created by user remain.
+func getSetNATGatewayAndRouteNameId(connectionName string, info *cres.VPCInfo) error {
+	natGatewayInfoList := []cres.NATGatewayInfo{}
+	for _, natGatewayInfo := range info.NATGatewayInfoList {
+		natIIDInfo, err := iidRWLock.GetIIDbySystemID(connectionName, rsNATGatewayPrefix+info.IId.NameId, natGatewayInfo.IId)
+		if err != nil {
+			return err
+		}
+		if natIIDInfo.IId.NameId == "" { // insert only this user created.
+			continue
+		}
+		natGatewayInfo.IId.NameId = natIIDInfo.IId.NameId
+		subnetIIDInfo, err := iidRWLock.GetIIDbySystemID(connectionName, rsSubnetPrefix+info.IId.NameId, natGatewayInfo.SubnetIID)
+		if err != nil {
+			return err
+		}
+		natGatewayInfo.SubnetIID.NameId = subnetIIDInfo.IId.NameId
+		natGatewayInfoList = append(natGatewayInfoList, natGatewayInfo)
+	}
+	info.NATGatewayInfoList = natGatewayInfoList
 
-	//+++++++++++++++++++++++++++++++++++++++++++
-	// set VPC SystemId
-	vpcIIDInfo, err := iidRWLock.GetIID(connectionName, rsVPC, reqInfo.VpcIID)
+	for i, routeTableInfo := range info.RouteTableInfoList {
+		subnetIIDs := []cres.IID{}
+		for _, subnetIID := range routeTableInfo.SubnetIIDs {
+			subnetIIDInfo, err := iidRWLock.GetIIDbySystemID(connectionName, rsSubnetPrefix+info.IId.NameId, subnetIID)
+			if err != nil {
+				return err
+			}
+			if subnetIIDInfo.IId.NameId != "" { // insert only this user created.
+				subnetIID.NameId = subnetIIDInfo.IId.NameId
+				subnetIIDs = append(subnetIIDs, subnetIID)
+			}
+		}
+		info.RouteTableInfoList[i].SubnetIIDs = subnetIIDs
+
+		for j, routeInfo := range routeTableInfo.RouteList {
+			var targetIIDInfo *iidm.IIDInfo
+			var err error
+			switch routeInfo.TargetType {
+			case cres.RouteNATGateway:
+				targetIIDInfo, err = iidRWLock.GetIIDbySystemID(connectionName, rsNATGatewayPrefix+info.IId.NameId, routeInfo.TargetIID)
+			case cres.RouteVPCPeering:
+				targetIIDInfo, err = iidRWLock.GetIIDbySystemID(connectionName, rsVPCPeering, routeInfo.TargetIID)
+			default:
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			routeTableInfo.RouteList[j].TargetIID.NameId = targetIIDInfo.IId.NameId
+		}
+	}
+
+	return nil
+}
+
+// (1) get VPC IID(NameId) and check exist(Subnet NameID)
+// (2) check and allocate CIDR with the Subnets of the VPC
+// (3) create Resource
+// (4) insert Subnet IID
+func AddSubnet(connectionName string, rsType string, vpcName string, reqInfo cres.SubnetInfo) (*cres.VPCInfo, error) {
+	cblog.Info("call AddSubnet()")
+
+	err := checkCapability(connectionName, "SUBNET_ADD_REMOVE",
+		func(capability idrv.DriverCapabilityInfo) bool { return capability.SUBNET_ADD_REMOVE })
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
-	reqInfo.VpcIID.SystemId = vpcIIDInfo.IId.SystemId
-	//+++++++++++++++++++++++++++++++++++++++++++
 
 	cldConn, err := ccm.GetCloudConnection(connectionName)
 	if err != nil {
@@ -723,138 +958,153 @@ func CreateSecurity(connectionName string, rsType string, reqInfo cres.SecurityR
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateSecurityHandler()
+	handler, err := cldConn.CreateVPCHandler()
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
 
-	sgRWLock.Lock()
-	defer sgRWLock.Unlock()
-	// (1) check exist(NameID)
-	bool_ret, err := iidRWLock.IsExistIID(connectionName, rsType, reqInfo.IId)
+	vpcRWLock.Lock()
+	defer vpcRWLock.Unlock()
+	// (1) get VPC IID(NameId) and check exist(Subnet NameID)
+	vpcIIDInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{vpcName, ""})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	bool_ret, err := iidRWLock.IsExistIID(connectionName, rsSubnetPrefix+vpcName, reqInfo.IId)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
-
 	if bool_ret == true {
-		return nil, fmt.Errorf(rsType + "-" + reqInfo.IId.NameId + " already exists!")
+		return nil, fmt.Errorf("subnet-" + reqInfo.IId.NameId + " already exists!")
 	}
 
-	// (2) create Resource
-	info, err := handler.CreateSecurity(reqInfo)
+	// (2) check and allocate CIDR with the Subnets of the VPC
+	vpcInfo, err := getVPCCIDRInfo(connectionName, handler, vpcIIDInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	err = validateSubnetCIDR(connectionName, vpcInfo, &reqInfo)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
 
-	// set VPC NameId
-	info.VpcIID.NameId = reqInfo.VpcIID.NameId
-	info.VpcIID.SystemId = reqInfo.VpcIID.SystemId
+	// (3) create Resource
+	info, err := handler.AddSubnet(vpcIIDInfo.IId, reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
 
-	// (3) insert IID
-	iidInfo, err := iidRWLock.CreateIID(connectionName, rsType, info.IId)
+	// (4) insert Subnet IID
+	// the CIDR is unique in the VPC after (2)
+	subnetIID := cres.IID{}
+	subnetCIDRInfo := cres.SubnetInfo{}
+	for _, subnetInfo := range info.SubnetInfoList {
+		if subnetInfo.IPv4_CIDR == reqInfo.IPv4_CIDR {
+			subnetIID = cres.IID{reqInfo.IId.NameId, subnetInfo.IId.SystemId}
+			subnetCIDRInfo = subnetInfo
+		}
+	}
+	if subnetIID.SystemId == "" {
+		return nil, fmt.Errorf("subnet-" + reqInfo.IId.NameId + " is not included in the VPC info of " + connectionName + "!")
+	}
+	_, err = iidRWLock.CreateIID(connectionName, rsSubnetPrefix+vpcName, subnetIID)
 	if err != nil {
 		cblog.Error(err)
 		// rollback
-		_, err2 := handler.DeleteSecurity(iidInfo.IId)
+		cblog.Info("<<ROLLBACK:TRY:Subnet-CSP>> " + subnetIID.SystemId)
+		_, err2 := handler.RemoveSubnet(vpcIIDInfo.IId, subnetIID)
 		if err2 != nil {
 			cblog.Error(err2)
 			return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
 		}
 		return nil, err
 	}
+	subnetCIDRInfo.IId = subnetIID
+	insertSubnetCIDR(connectionName, vpcName, subnetCIDRInfo)
 
-	// set ResourceInfo(IID.NameId)
-	// iidInfo.IId.NameID format => {VPC NameID} + sgDELIMITER + {SG NameID}
-	vpc_sg_nameid := strings.Split(info.IId.NameId, sgDELIMITER)
-	info.IId.NameId = vpc_sg_nameid[1]
+	info.IId.NameId = vpcName
+	err = setSubnetNameId(connectionName, &info)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	err = getSetNATGatewayAndRouteNameId(connectionName, &info)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
 
 	return &info, nil
 }
 
-// (1) get IID:list
-// (2) get CSP:list
-// (3) filtering CSP-list by IID-list
-func ListSecurity(connectionName string, rsType string) ([]*cres.SecurityInfo, error) {
-	cblog.Info("call ListSecurity()")
+// (1) get IID(NameId)
+// (2) delete Resource(SystemId)
+// (3) delete IID
+func RemoveSubnet(connectionName string, rsType string, vpcName string, subnetName string) (bool, error) {
+	cblog.Info("call RemoveSubnet()")
 
-	cldConn, err := ccm.GetCloudConnection(connectionName)
+	err := checkCapability(connectionName, "SUBNET_ADD_REMOVE",
+		func(capability idrv.DriverCapabilityInfo) bool { return capability.SUBNET_ADD_REMOVE })
 	if err != nil {
 		cblog.Error(err)
-		return nil, err
+		return false, err
 	}
 
-	handler, err := cldConn.CreateSecurityHandler()
+	cldConn, err := ccm.GetCloudConnection(connectionName)
 	if err != nil {
 		cblog.Error(err)
-		return nil, err
+		return false, err
 	}
 
-	sgRWLock.RLock()
-	defer sgRWLock.RUnlock()
-	// (1) get IID:list
-	iidInfoList, err := iidRWLock.ListIID(connectionName, rsType)
+	handler, err := cldConn.CreateVPCHandler()
 	if err != nil {
 		cblog.Error(err)
-		return nil, err
+		return false, err
 	}
 
-	var infoList []*cres.SecurityInfo
-	if iidInfoList == nil || len(iidInfoList) <= 0 {
-		infoList = []*cres.SecurityInfo{}
-		return infoList, nil
+	vpcRWLock.Lock()
+	defer vpcRWLock.Unlock()
+	// (1) get IID(NameId)
+	vpcIIDInfo, subnetIIDInfo, err := getVPCAndSubnetIID(connectionName, rsType, vpcName, subnetName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
 	}
 
-	// (2) get CSP:list
-	infoList, err = handler.ListSecurity()
+	// (2) delete Resource(SystemId)
+	result, err := handler.RemoveSubnet(vpcIIDInfo.IId, subnetIIDInfo.IId)
 	if err != nil {
 		cblog.Error(err)
-		return nil, err
+		return false, err
 	}
-	if infoList == nil { // if iidInfoList not null, then infoList has any list.
-		return nil, fmt.Errorf("<IID-CSP mismatch> " + rsType + " IID List has " + strconv.Itoa(len(iidInfoList)) + ", but " + connectionName + " Resource list has nothing!")
+	if result == false {
+		return result, nil
 	}
 
-	// (3) filtering CSP-list by IID-list
-	infoList2 := []*cres.SecurityInfo{}
-	for _, iidInfo := range iidInfoList {
-		exist := false
-		for _, info := range infoList {
-			if iidInfo.IId.SystemId == info.IId.SystemId {
-
-				// set ResourceInfo(IID.NameId)
-				// iidInfo.IId.NameID format => {VPC NameID} + sgDELIMITER + {SG NameID}
-				vpc_sg_nameid := strings.Split(iidInfo.IId.NameId, sgDELIMITER)
-				info.VpcIID.NameId = vpc_sg_nameid[0]
-				info.IId.NameId = vpc_sg_nameid[1]
-
-				// set VPC SystemId
-				vpcIIDInfo, err := iidRWLock.GetIID(connectionName, rsVPC, info.VpcIID)
-				if err != nil {
-					cblog.Error(err)
-					return nil, err
-				}
-				info.VpcIID.SystemId = vpcIIDInfo.IId.SystemId
-
-				infoList2 = append(infoList2, info)
-				exist = true
-			}
-		}
-		if exist == false {
-			return nil, fmt.Errorf("<IID-CSP mismatch> " + rsType + "-" + iidInfo.IId.NameId + ":" + iidInfo.IId.SystemId + " exsits. but " + connectionName + " does not have!")
-		}
+	// (3) delete IID
+	_, err = iidRWLock.DeleteIID(connectionName, rsSubnetPrefix+vpcName, subnetIIDInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
 	}
+	deleteSubnetCIDR(connectionName, vpcName, subnetName)
 
-	return infoList2, nil
+	return result, nil
 }
 
-// (1) get IID(NameId)
-// (2) get resource(SystemId)
-// (3) set ResourceInfo(IID.NameId)
-func GetSecurity(connectionName string, rsType string, nameID string) (*cres.SecurityInfo, error) {
-	cblog.Info("call GetSecurity()")
+//================ NAT Gateway and Route Handler
+// (1) get VPC and Subnet IID(NameId)
+// (2) check exist(NameID)
+// (3) create Resource
+// (4) insert IID
+func AddNATGateway(connectionName string, rsType string, vpcName string, reqInfo cres.NATGatewayReqInfo) (*cres.VPCInfo, error) {
+	cblog.Info("call AddNATGateway()")
 
 	cldConn, err := ccm.GetCloudConnection(connectionName)
 	if err != nil {
@@ -862,168 +1112,139 @@ func GetSecurity(connectionName string, rsType string, nameID string) (*cres.Sec
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateSecurityHandler()
+	handler, err := cldConn.CreateVPCHandler()
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
 
-	sgRWLock.RLock()
-	defer sgRWLock.RUnlock()
-	// (1) get IID(NameId)
-	// SG NameID format => {VPC NameID} + sgDELIMITER + {SG NameID}
-	iidInfo, err := iidRWLock.FindIID(connectionName, rsType, nameID)
+	vpcRWLock.Lock()
+	defer vpcRWLock.Unlock()
+	// (1) get VPC and Subnet IID(NameId)
+	vpcIIDInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{vpcName, ""})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
-
-	// (2) get resource(SystemId)
-	info, err := handler.GetSecurity(iidInfo.IId)
+	subnetIIDInfo, err := iidRWLock.GetIID(connectionName, rsSubnetPrefix+vpcName, reqInfo.SubnetIID)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
+	reqInfo.SubnetIID = subnetIIDInfo.IId
 
-	// (3) set ResourceInfo(IID.NameId)
-	// set ResourceInfo(IID.NameId)
-	// iidInfo.IId.NameID format => {VPC NameID} + sgDELIMITER + {SG NameID}
-	vpc_sg_nameid := strings.Split(iidInfo.IId.NameId, sgDELIMITER)
-	info.VpcIID.NameId = vpc_sg_nameid[0]
-	info.IId.NameId = vpc_sg_nameid[1]
-
-	// set VPC SystemId
-	vpcIIDInfo, err := iidRWLock.GetIID(connectionName, rsVPC, info.VpcIID)
+	// (2) check exist(NameID)
+	bool_ret, err := iidRWLock.IsExistIID(connectionName, rsNATGatewayPrefix+vpcName, reqInfo.IId)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
-	info.VpcIID.SystemId = vpcIIDInfo.IId.SystemId
-
-	return &info, nil
-}
-
-//================ KeyPair Handler
-// (1) check exist(NameID)
-// (2) create Resource
-// (3) insert IID
-func CreateKey(connectionName string, rsType string, reqInfo cres.KeyPairReqInfo) (*cres.KeyPairInfo, error) {
-	cblog.Info("call CreateKey()")
-
-	cldConn, err := ccm.GetCloudConnection(connectionName)
-	if err != nil {
-		cblog.Error(err)
-		return nil, err
+	if bool_ret == true {
+		return nil, fmt.Errorf("natgateway-" + reqInfo.IId.NameId + " already exists!")
 	}
 
-	handler, err := cldConn.CreateKeyPairHandler()
+	// (3) create Resource
+	info, err := handler.AddNATGateway(vpcIIDInfo.IId, reqInfo)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
 
-	keyRWLock.Lock()
-	defer keyRWLock.Unlock()
-	// (1) check exist(NameID)
-	bool_ret, err := iidRWLock.IsExistIID(connectionName, rsType, reqInfo.IId)
+	// (4) insert IID
+	// key-value structure: /{ConnectionName}/rsNATGatewayPrefix+{VPC-NameId}/{NATGateway-IId}
+	natIID := cres.IID{}
+	for _, natGatewayInfo := range info.NATGatewayInfoList {
+		if natGatewayInfo.IId.NameId == reqInfo.IId.NameId {
+			natIID = cres.IID{reqInfo.IId.NameId, natGatewayInfo.IId.SystemId}
+		}
+	}
+	if natIID.SystemId == "" {
+		return nil, fmt.Errorf("natgateway-" + reqInfo.IId.NameId + " is not included in the VPC info of " + connectionName + "!")
+	}
+	_, err = iidRWLock.CreateIID(connectionName, rsNATGatewayPrefix+vpcName, natIID)
 	if err != nil {
 		cblog.Error(err)
+		// rollback
+		cblog.Info("<<ROLLBACK:TRY:NATGateway-CSP>> " + natIID.SystemId)
+		_, err2 := handler.RemoveNATGateway(vpcIIDInfo.IId, natIID)
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
+		}
 		return nil, err
 	}
 
-	if bool_ret == true {
-		return nil, fmt.Errorf(reqInfo.IId.NameId + " already exists!")
-	}
-
-	// (2) create Resource
-	info, err := handler.CreateKey(reqInfo)
+	info.IId.NameId = vpcName
+	err = setSubnetNameId(connectionName, &info)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
-
-	// (3) insert IID
-	iidInfo, err := iidRWLock.CreateIID(connectionName, rsType, info.IId)
+	err = getSetNATGatewayAndRouteNameId(connectionName, &info)
 	if err != nil {
 		cblog.Error(err)
-		// rollback
-		_, err2 := handler.DeleteKey(iidInfo.IId)
-		if err2 != nil {
-			cblog.Error(err2)
-			return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
-		}
 		return nil, err
 	}
 
 	return &info, nil
 }
 
-// (1) get IID:list
-// (2) get CSP:list
-// (3) filtering CSP-list by IID-list
-func ListKey(connectionName string, rsType string) ([]*cres.KeyPairInfo, error) {
-	cblog.Info("call ListKey()")
+// (1) get IID(NameId)
+// (2) delete Resource(SystemId)
+// (3) delete IID
+func RemoveNATGateway(connectionName string, rsType string, vpcName string, natGatewayName string) (bool, error) {
+	cblog.Info("call RemoveNATGateway()")
 
 	cldConn, err := ccm.GetCloudConnection(connectionName)
 	if err != nil {
 		cblog.Error(err)
-		return nil, err
+		return false, err
 	}
 
-	handler, err := cldConn.CreateKeyPairHandler()
+	handler, err := cldConn.CreateVPCHandler()
 	if err != nil {
 		cblog.Error(err)
-		return nil, err
+		return false, err
 	}
 
-	keyRWLock.RLock()
-	defer keyRWLock.RUnlock()
-	// (1) get IID:list
-	iidInfoList, err := iidRWLock.ListIID(connectionName, rsType)
+	vpcRWLock.Lock()
+	defer vpcRWLock.Unlock()
+	// (1) get IID(NameId)
+	vpcIIDInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{vpcName, ""})
 	if err != nil {
 		cblog.Error(err)
-		return nil, err
+		return false, err
 	}
-
-	var infoList []*cres.KeyPairInfo
-	if iidInfoList == nil || len(iidInfoList) <= 0 {
-		infoList = []*cres.KeyPairInfo{}
-		return infoList, nil
+	natIIDInfo, err := iidRWLock.GetIID(connectionName, rsNATGatewayPrefix+vpcName, cres.IID{natGatewayName, ""})
+	if err != nil {
+		cblog.Error(err)
+		return false, err
 	}
 
-	// (2) get CSP:list
-	infoList, err = handler.ListKey()
+	// (2) delete Resource(SystemId)
+	result, err := handler.RemoveNATGateway(vpcIIDInfo.IId, natIIDInfo.IId)
 	if err != nil {
 		cblog.Error(err)
-		return nil, err
+		return false, err
 	}
-	if infoList == nil { // if iidInfoList not null, then infoList has any list.
-		return nil, fmt.Errorf("<IID-CSP mismatch> " + rsType + " IID List has " + strconv.Itoa(len(iidInfoList)) + ", but " + connectionName + " Resource list has nothing!")
+	if result == false {
+		return result, nil
 	}
 
-	// (3) filtering CSP-list by IID-list
-	infoList2 := []*cres.KeyPairInfo{}
-	for _, iidInfo := range iidInfoList {
-		exist := false
-		for _, info := range infoList {
-			if iidInfo.IId.SystemId == info.IId.SystemId {
-				infoList2 = append(infoList2, info)
-				exist = true
-			}
-		}
-		if exist == false {
-			return nil, fmt.Errorf("<IID-CSP mismatch> " + rsType + "-" + iidInfo.IId.NameId + ":" + iidInfo.IId.SystemId + " exsits. but " + connectionName + " does not have!")
-		}
+	// (3) delete IID
+	_, err = iidRWLock.DeleteIID(connectionName, rsNATGatewayPrefix+vpcName, natIIDInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
 	}
 
-	return infoList2, nil
+	return result, nil
 }
 
-// (1) get IID(NameId)
-// (2) get resource(SystemId)
-// (3) set ResourceInfo(IID.NameId)
-func GetKey(connectionName string, rsType string, nameID string) (*cres.KeyPairInfo, error) {
-	cblog.Info("call GetKey()")
+// (1) get VPC, Subnet and Route Target IID(NameId)
+// (2) add Route into the Route Table of the Subnet
+func AddRoute(connectionName string, rsType string, vpcName string, subnetName string, routeInfo cres.RouteInfo) (*cres.VPCInfo, error) {
+	cblog.Info("call AddRoute()")
 
 	cldConn, err := ccm.GetCloudConnection(connectionName)
 	if err != nil {
@@ -1031,96 +1252,146 @@ func GetKey(connectionName string, rsType string, nameID string) (*cres.KeyPairI
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateKeyPairHandler()
+	handler, err := cldConn.CreateVPCHandler()
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
 
-	keyRWLock.RLock()
-	defer keyRWLock.RUnlock()
-	// (1) get IID(NameId)
-	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{nameID, ""})
+	vpcRWLock.Lock()
+	defer vpcRWLock.Unlock()
+	// (1) get VPC, Subnet and Route Target IID(NameId)
+	vpcIIDInfo, subnetIIDInfo, err := getVPCAndSubnetIID(connectionName, rsType, vpcName, subnetName)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
+	switch routeInfo.TargetType {
+	case cres.RouteNATGateway:
+		targetIIDInfo, err := iidRWLock.GetIID(connectionName, rsNATGatewayPrefix+vpcName, routeInfo.TargetIID)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		routeInfo.TargetIID = targetIIDInfo.IId
+	case cres.RouteVPCPeering:
+		targetIIDInfo, err := iidRWLock.GetIID(connectionName, rsVPCPeering, routeInfo.TargetIID)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		routeInfo.TargetIID = targetIIDInfo.IId
+	}
 
-	// (2) get resource(SystemId)
-	info, err := handler.GetKey(iidInfo.IId)
+	// (2) add Route into the Route Table of the Subnet
+	info, err := handler.AddRoute(vpcIIDInfo.IId, subnetIIDInfo.IId, routeInfo)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
 
-	// (3) set ResourceInfo(IID.NameId)
-	info.IId.NameId = iidInfo.IId.NameId
+	info.IId.NameId = vpcName
+	err = setSubnetNameId(connectionName, &info)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	err = getSetNATGatewayAndRouteNameId(connectionName, &info)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
 
 	return &info, nil
 }
 
-func getSetSystemId(ConnectionName string, reqInfo *cres.VMReqInfo) error {
+// (1) get VPC and Subnet IID(NameId)
+// (2) remove Route from the Route Table of the Subnet
+func RemoveRoute(connectionName string, rsType string, vpcName string, subnetName string, routeInfo cres.RouteInfo) (bool, error) {
+	cblog.Info("call RemoveRoute()")
 
-	// set Image SystemId
-	// @todo before Image Handling by powerkim
-	reqInfo.ImageIID.SystemId = reqInfo.ImageIID.NameId
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
 
-	// set VPC SystemId
-	if reqInfo.VpcIID.NameId != "" {
-		IIdInfo, err := iidRWLock.GetIID(ConnectionName, rsVPC, reqInfo.VpcIID)
-		if err != nil {
-			cblog.Error(err)
-			return err
-		}
-		reqInfo.VpcIID.SystemId = IIdInfo.IId.SystemId
+	handler, err := cldConn.CreateVPCHandler()
+	if err != nil {
+		cblog.Error(err)
+		return false, err
 	}
 
-	// set Subnet SystemId
-	if reqInfo.SubnetIID.NameId != "" {
-		IIdInfo, err := iidRWLock.GetIID(ConnectionName, rsSubnetPrefix+reqInfo.VpcIID.NameId, reqInfo.SubnetIID)
-		if err != nil {
-			cblog.Error(err)
-			return err
-		}
-		reqInfo.SubnetIID.SystemId = IIdInfo.IId.SystemId
+	vpcRWLock.Lock()
+	defer vpcRWLock.Unlock()
+	// (1) get VPC and Subnet IID(NameId)
+	vpcIIDInfo, subnetIIDInfo, err := getVPCAndSubnetIID(connectionName, rsType, vpcName, subnetName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
 	}
 
-	// set SecurityGroups SystemId
-	for i, sgIID := range reqInfo.SecurityGroupIIDs {
-		IIdInfo, err := iidRWLock.GetIID(ConnectionName, rsSG, sgIID)
-		if err != nil {
-			cblog.Error(err)
-			return err
-		}
-		reqInfo.SecurityGroupIIDs[i].SystemId = IIdInfo.IId.SystemId
+	// (2) remove Route from the Route Table of the Subnet
+	result, err := handler.RemoveRoute(vpcIIDInfo.IId, subnetIIDInfo.IId, routeInfo)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
 	}
 
-	// set KeyPair SystemId
-	if reqInfo.KeyPairIID.NameId != "" {
-		IIdInfo, err := iidRWLock.GetIID(ConnectionName, rsKey, reqInfo.KeyPairIID)
+	return result, nil
+}
+
+func getVPCAndSubnetIID(connectionName string, rsType string, vpcName string, subnetName string) (*iidm.IIDInfo, *iidm.IIDInfo, error) {
+	vpcIIDInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{vpcName, ""})
+	if err != nil {
+		return nil, nil, err
+	}
+	subnetIIDInfo, err := iidRWLock.GetIID(connectionName, rsSubnetPrefix+vpcName, cres.IID{subnetName, ""})
+	if err != nil {
+		return nil, nil, err
+	}
+	return vpcIIDInfo, subnetIIDInfo, nil
+}
+
+// set NameId for SubnetInfo List, only the Subnets created by user remain.
+func setSubnetNameId(connectionName string, info *cres.VPCInfo) error {
+	subnetInfoList := []cres.SubnetInfo{}
+	for _, subnetInfo := range info.SubnetInfoList {
+		subnetIIDInfo, err := iidRWLock.GetIIDbySystemID(connectionName, rsSubnetPrefix+info.IId.NameId, subnetInfo.IId)
 		if err != nil {
-			cblog.Error(err)
 			return err
 		}
-		reqInfo.KeyPairIID.SystemId = IIdInfo.IId.SystemId
+		if subnetIIDInfo.IId.NameId != "" { // insert only this user created.
+			subnetInfo.IId.NameId = subnetIIDInfo.IId.NameId
+			subnetInfoList = append(subnetInfoList, subnetInfo)
+		}
 	}
-
+	info.SubnetInfoList = subnetInfoList
 	return nil
 }
 
-//================ VM Handler
+//================ SecurityGroup Handler
 // (1) check exist(NameID)
 // (2) create Resource
 // (3) insert IID
-func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo) (*cres.VMInfo, error) {
-	cblog.Info("call StartVM()")
+func CreateSecurity(connectionName string, rsType string, reqInfo cres.SecurityReqInfo) (*cres.SecurityInfo, error) {
+	cblog.Info("call CreateSecurity()")
 
-	// get & set SystemId
-	err := getSetSystemId(connectionName, &reqInfo)
+	err := validateSecurityRuleCIDR(connectionName, reqInfo.SecurityRules)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	//+++++++++++++++++++++++++++++++++++++++++++
+	// set VPC SystemId
+	vpcIIDInfo, err := iidRWLock.GetIID(connectionName, rsVPC, reqInfo.VpcIID)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
+	reqInfo.VpcIID.SystemId = vpcIIDInfo.IId.SystemId
+	//+++++++++++++++++++++++++++++++++++++++++++
 
 	cldConn, err := ccm.GetCloudConnection(connectionName)
 	if err != nil {
@@ -1128,14 +1399,14 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo) (*cre
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateVMHandler()
+	handler, err := cldConn.CreateSecurityHandler()
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
 
-	// vmRWLock.Lock() @todo undo this until supporting async call. by powerkim, 2020.05.10
-	// defer vmRWLock.Unlock() @todo undo this until supporting async call. by powerkim, 2020.05.10
+	sgRWLock.Lock()
+	defer sgRWLock.Unlock()
 	// (1) check exist(NameID)
 	bool_ret, err := iidRWLock.IsExistIID(connectionName, rsType, reqInfo.IId)
 	if err != nil {
@@ -1148,20 +1419,22 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo) (*cre
 	}
 
 	// (2) create Resource
-	info, err := handler.StartVM(reqInfo)
+	info, err := handler.CreateSecurity(reqInfo)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
-	// set NameId for info by reqInfo
-	setNameId(connectionName, &info, &reqInfo)
+
+	// set VPC NameId
+	info.VpcIID.NameId = reqInfo.VpcIID.NameId
+	info.VpcIID.SystemId = reqInfo.VpcIID.SystemId
 
 	// (3) insert IID
 	iidInfo, err := iidRWLock.CreateIID(connectionName, rsType, info.IId)
 	if err != nil {
 		cblog.Error(err)
 		// rollback
-		_, err2 := handler.TerminateVM(iidInfo.IId) // @todo check validation
+		_, err2 := handler.DeleteSecurity(iidInfo.IId)
 		if err2 != nil {
 			cblog.Error(err2)
 			return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
@@ -1169,7 +1442,1127 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo) (*cre
 		return nil, err
 	}
 
-	// set sg NameId from VPCNameId-SecurityGroupNameId
+	// set ResourceInfo(IID.NameId)
+	// iidInfo.IId.NameID format => {VPC NameID} + sgDELIMITER + {SG NameID}
+	vpc_sg_nameid := strings.Split(info.IId.NameId, sgDELIMITER)
+	info.IId.NameId = vpc_sg_nameid[1]
+
+	return &info, nil
+}
+
+// (1) get IID:list
+// (2) get CSP:list
+// (3) filtering CSP-list by IID-list
+func ListSecurity(connectionName string, rsType string) ([]*cres.SecurityInfo, error) {
+	cblog.Info("call ListSecurity()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateSecurityHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	sgRWLock.RLock()
+	defer sgRWLock.RUnlock()
+	// (1) get IID:list
+	iidInfoList, err := iidRWLock.ListIID(connectionName, rsType)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var infoList []*cres.SecurityInfo
+	if iidInfoList == nil || len(iidInfoList) <= 0 {
+		infoList = []*cres.SecurityInfo{}
+		return infoList, nil
+	}
+
+	// (2) get CSP:list
+	infoList, err = handler.ListSecurity()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if infoList == nil { // if iidInfoList not null, then infoList has any list.
+		return nil, fmt.Errorf("<IID-CSP mismatch> " + rsType + " IID List has " + strconv.Itoa(len(iidInfoList)) + ", but " + connectionName + " Resource list has nothing!")
+	}
+
+	// (3) filtering CSP-list by IID-list
+	infoList2 := []*cres.SecurityInfo{}
+	for _, iidInfo := range iidInfoList {
+		exist := false
+		for _, info := range infoList {
+			if iidInfo.IId.SystemId == info.IId.SystemId {
+
+				// set ResourceInfo(IID.NameId)
+				// iidInfo.IId.NameID format => {VPC NameID} + sgDELIMITER + {SG NameID}
+				vpc_sg_nameid := strings.Split(iidInfo.IId.NameId, sgDELIMITER)
+				info.VpcIID.NameId = vpc_sg_nameid[0]
+				info.IId.NameId = vpc_sg_nameid[1]
+
+				// set VPC SystemId
+				vpcIIDInfo, err := iidRWLock.GetIID(connectionName, rsVPC, info.VpcIID)
+				if err != nil {
+					cblog.Error(err)
+					return nil, err
+				}
+				info.VpcIID.SystemId = vpcIIDInfo.IId.SystemId
+
+				infoList2 = append(infoList2, info)
+				exist = true
+			}
+		}
+		if exist == false {
+			return nil, fmt.Errorf("<IID-CSP mismatch> " + rsType + "-" + iidInfo.IId.NameId + ":" + iidInfo.IId.SystemId + " exsits. but " + connectionName + " does not have!")
+		}
+	}
+
+	return infoList2, nil
+}
+
+// (1) get IID(NameId)
+// (2) get resource(SystemId)
+// (3) set ResourceInfo(IID.NameId)
+func GetSecurity(connectionName string, rsType string, nameID string) (*cres.SecurityInfo, error) {
+	cblog.Info("call GetSecurity()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateSecurityHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	sgRWLock.RLock()
+	defer sgRWLock.RUnlock()
+	// (1) get IID(NameId)
+	// SG NameID format => {VPC NameID} + sgDELIMITER + {SG NameID}
+	iidInfo, err := iidRWLock.FindIID(connectionName, rsType, nameID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) get resource(SystemId)
+	info, err := handler.GetSecurity(iidInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) set ResourceInfo(IID.NameId)
+	// set ResourceInfo(IID.NameId)
+	// iidInfo.IId.NameID format => {VPC NameID} + sgDELIMITER + {SG NameID}
+	vpc_sg_nameid := strings.Split(iidInfo.IId.NameId, sgDELIMITER)
+	info.VpcIID.NameId = vpc_sg_nameid[0]
+	info.IId.NameId = vpc_sg_nameid[1]
+
+	// set VPC SystemId
+	vpcIIDInfo, err := iidRWLock.GetIID(connectionName, rsVPC, info.VpcIID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info.VpcIID.SystemId = vpcIIDInfo.IId.SystemId
+
+	return &info, nil
+}
+
+//================ KeyPair Handler
+// (1) check exist(NameID)
+// (2) create Resource
+// (3) insert IID
+func CreateKey(connectionName string, rsType string, reqInfo cres.KeyPairReqInfo) (*cres.KeyPairInfo, error) {
+	cblog.Info("call CreateKey()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateKeyPairHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	keyRWLock.Lock()
+	defer keyRWLock.Unlock()
+	// (1) check exist(NameID)
+	bool_ret, err := iidRWLock.IsExistIID(connectionName, rsType, reqInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if bool_ret == true {
+		return nil, fmt.Errorf(reqInfo.IId.NameId + " already exists!")
+	}
+
+	// (2) create Resource
+	info, err := handler.CreateKey(reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) insert IID
+	iidInfo, err := iidRWLock.CreateIID(connectionName, rsType, info.IId)
+	if err != nil {
+		cblog.Error(err)
+		// rollback
+		_, err2 := handler.DeleteKey(iidInfo.IId)
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
+		}
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// (1) get IID:list
+// (2) get CSP:list
+// (3) filtering CSP-list by IID-list
+func ListKey(connectionName string, rsType string) ([]*cres.KeyPairInfo, error) {
+	cblog.Info("call ListKey()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateKeyPairHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	keyRWLock.RLock()
+	defer keyRWLock.RUnlock()
+	// (1) get IID:list
+	iidInfoList, err := iidRWLock.ListIID(connectionName, rsType)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var infoList []*cres.KeyPairInfo
+	if iidInfoList == nil || len(iidInfoList) <= 0 {
+		infoList = []*cres.KeyPairInfo{}
+		return infoList, nil
+	}
+
+	// (2) get CSP:list
+	infoList, err = handler.ListKey()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if infoList == nil { // if iidInfoList not null, then infoList has any list.
+		return nil, fmt.Errorf("<IID-CSP mismatch> " + rsType + " IID List has " + strconv.Itoa(len(iidInfoList)) + ", but " + connectionName + " Resource list has nothing!")
+	}
+
+	// (3) filtering CSP-list by IID-list
+	infoList2 := []*cres.KeyPairInfo{}
+	for _, iidInfo := range iidInfoList {
+		exist := false
+		for _, info := range infoList {
+			if iidInfo.IId.SystemId == info.IId.SystemId {
+				infoList2 = append(infoList2, info)
+				exist = true
+			}
+		}
+		if exist == false {
+			return nil, fmt.Errorf("<IID-CSP mismatch> " + rsType + "-" + iidInfo.IId.NameId + ":" + iidInfo.IId.SystemId + " exsits. but " + connectionName + " does not have!")
+		}
+	}
+
+	return infoList2, nil
+}
+
+// (1) get IID(NameId)
+// (2) get resource(SystemId)
+// (3) set ResourceInfo(IID.NameId)
+func GetKey(connectionName string, rsType string, nameID string) (*cres.KeyPairInfo, error) {
+	cblog.Info("call GetKey()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateKeyPairHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	keyRWLock.RLock()
+	defer keyRWLock.RUnlock()
+	// (1) get IID(NameId)
+	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{nameID, ""})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) get resource(SystemId)
+	info, err := handler.GetKey(iidInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) set ResourceInfo(IID.NameId)
+	info.IId.NameId = iidInfo.IId.NameId
+
+	return &info, nil
+}
+
+// check the VM purchase option before calling the driver
+func checkPurchaseOption(option cres.VMPurchaseOption) error {
+	switch option.PurchaseType {
+	case "", cres.OnDemand, cres.Preemptible:
+		if option.MaxPrice != "" {
+			return fmt.Errorf("MaxPrice is only available with %s PurchaseType!", cres.Spot)
+		}
+	case cres.Spot:
+		if option.MaxPrice != "" {
+			price, err := strconv.ParseFloat(option.MaxPrice, 64)
+			if err != nil || price <= 0 {
+				return fmt.Errorf("MaxPrice(%s) is not a positive number!", option.MaxPrice)
+			}
+		}
+	default:
+		return fmt.Errorf("%s PurchaseType is not supported! (OnDemand | Spot | Preemptible)", option.PurchaseType)
+	}
+	return nil
+}
+
+// Image NameId can be a logical image name(ex: ubuntu-20.04) registered in the image map.
+// If it is mapped on the region of the connection, the CSP image ID is set as SystemId.
+// If not, the Image NameId is used as the CSP image ID as before.
+func getSetImageMapSystemId(ConnectionName string, reqInfo *cres.VMReqInfo) error {
+	if reqInfo.ImageIID.NameId == "" {
+		return nil
+	}
+
+	connInfo, err := ccim.GetConnectionConfig(ConnectionName)
+	if err != nil {
+		return err
+	}
+
+	imgMapInfoList, err := imim.ListImageMapByImageName(reqInfo.ImageIID.NameId)
+	if err != nil {
+		return err
+	}
+
+	for _, imgMapInfo := range imgMapInfoList {
+		if imgMapInfo.RegionName == connInfo.RegionName {
+			cblog.Info("image map: " + imgMapInfo.ImageName + "@" + imgMapInfo.RegionName + " => " + imgMapInfo.CSPImageId)
+			reqInfo.ImageIID.SystemId = imgMapInfo.CSPImageId
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func getSetSystemId(ConnectionName string, reqInfo *cres.VMReqInfo) error {
+
+	// set Image SystemId
+	// @todo before Image Handling by powerkim
+	// SystemId is already set when the image name is resolved by the image map.
+	if reqInfo.ImageIID.SystemId == "" {
+		reqInfo.ImageIID.SystemId = reqInfo.ImageIID.NameId
+	}
+
+	// set VPC SystemId
+	if reqInfo.VpcIID.NameId != "" {
+		IIdInfo, err := iidRWLock.GetIID(ConnectionName, rsVPC, reqInfo.VpcIID)
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		reqInfo.VpcIID.SystemId = IIdInfo.IId.SystemId
+	}
+
+	// set Subnet SystemId
+	if reqInfo.SubnetIID.NameId != "" {
+		IIdInfo, err := iidRWLock.GetIID(ConnectionName, rsSubnetPrefix+reqInfo.VpcIID.NameId, reqInfo.SubnetIID)
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		reqInfo.SubnetIID.SystemId = IIdInfo.IId.SystemId
+	}
+
+	// set SecurityGroups SystemId
+	for i, sgIID := range reqInfo.SecurityGroupIIDs {
+		IIdInfo, err := iidRWLock.GetIID(ConnectionName, rsSG, sgIID)
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		reqInfo.SecurityGroupIIDs[i].SystemId = IIdInfo.IId.SystemId
+	}
+
+	// set SystemIds of secondary NICs
+	for i, nicReqInfo := range reqInfo.NetworkInterfaceList {
+		IIdInfo, err := iidRWLock.GetIID(ConnectionName, rsSubnetPrefix+reqInfo.VpcIID.NameId, nicReqInfo.SubnetIID)
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		reqInfo.NetworkInterfaceList[i].SubnetIID.SystemId = IIdInfo.IId.SystemId
+
+		for j, sgIID := range nicReqInfo.SecurityGroupIIDs {
+			IIdInfo, err := iidRWLock.GetIID(ConnectionName, rsSG, sgIID)
+			if err != nil {
+				cblog.Error(err)
+				return err
+			}
+			reqInfo.NetworkInterfaceList[i].SecurityGroupIIDs[j].SystemId = IIdInfo.IId.SystemId
+		}
+	}
+
+	// set KeyPair SystemId
+	if reqInfo.KeyPairIID.NameId != "" {
+		IIdInfo, err := iidRWLock.GetIID(ConnectionName, rsKey, reqInfo.KeyPairIID)
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		reqInfo.KeyPairIID.SystemId = IIdInfo.IId.SystemId
+	}
+
+	// set PublicIP SystemId
+	if reqInfo.PublicIPIID.NameId != "" {
+		IIdInfo, err := iidRWLock.GetIID(ConnectionName, rsPublicIP, reqInfo.PublicIPIID)
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		reqInfo.PublicIPIID.SystemId = IIdInfo.IId.SystemId
+	}
+
+	// set DNS Zone SystemId
+	if reqInfo.DNSZoneIID.NameId != "" {
+		IIdInfo, err := iidRWLock.GetIID(ConnectionName, rsDNSZone, reqInfo.DNSZoneIID)
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		reqInfo.DNSZoneIID.SystemId = IIdInfo.IId.SystemId
+	}
+
+	return nil
+}
+
+// check the reserved PublicIP is not associated with other VM before creating a VM
+func checkPublicIPAvailable(handler cres.PublicIPHandler, publicIPIID cres.IID) error {
+	info, err := handler.GetPublicIP(publicIPIID)
+	if err != nil {
+		return err
+	}
+	if info.Status != cres.PublicIPAvailable {
+		return fmt.Errorf(rsPublicIP + "-" + publicIPIID.NameId + " is " + string(info.Status) + ", not " + string(cres.PublicIPAvailable) + "!")
+	}
+	return nil
+}
+
+// check secondary NICs and the driver capability before creating a VM
+// returns idrv.NotSupportedError if the driver of the connection does not support the feature.
+func checkCapability(connectionName string, feature string, supported func(idrv.DriverCapabilityInfo) bool) error {
+	capability, err := ccm.GetDriverCapabilityByConnectionName(connectionName)
+	if err != nil {
+		return err
+	}
+	if !supported(capability) {
+		return &idrv.NotSupportedError{Driver: connectionName, Feature: feature}
+	}
+	return nil
+}
+
+func hasFixedPrivateIP(reqInfo cres.VMReqInfo) bool {
+	if reqInfo.PrivateIP != "" {
+		return true
+	}
+	for _, nicReqInfo := range reqInfo.NetworkInterfaceList {
+		if nicReqInfo.PrivateIP != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func checkNetworkInterfaceList(connectionName string, reqInfo cres.VMReqInfo) error {
+	if len(reqInfo.NetworkInterfaceList) == 0 {
+		return nil
+	}
+
+	err := checkCapability(connectionName, "VM_MULTI_NIC",
+		func(capability idrv.DriverCapabilityInfo) bool { return capability.VM_MULTI_NIC })
+	if err != nil {
+		return err
+	}
+
+	for i, nicReqInfo := range reqInfo.NetworkInterfaceList {
+		if nicReqInfo.SubnetIID.NameId == "" {
+			return fmt.Errorf("NetworkInterface[%d]: Subnet is empty!", i+1)
+		}
+		if nicReqInfo.PrivateIP != "" && net.ParseIP(nicReqInfo.PrivateIP).To4() == nil {
+			return fmt.Errorf("NetworkInterface[%d]: PrivateIP(%s) is not an IPv4 address!", i+1, nicReqInfo.PrivateIP)
+		}
+	}
+	return nil
+}
+
+//================ VM Handler
+// (1) check exist(NameID)
+// (2) create Resource
+// (3) insert IID
+// (4) associate the reserved PublicIP if requested
+// (5) register the VM NameId into the private DNS zone if requested
+func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo) (*cres.VMInfo, error) {
+	cblog.Info("call StartVM()")
+
+	err := checkPurchaseOption(reqInfo.PurchaseOption)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	err = checkNetworkInterfaceList(connectionName, reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// resolve the logical image name with the image map
+	err = getSetImageMapSystemId(connectionName, &reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// get & set SystemId
+	err = getSetSystemId(connectionName, &reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// check fixed private IPs with Subnet CIDRs and other VMs
+	if hasFixedPrivateIP(reqInfo) {
+		vmPrivateIPLock := getVMPrivateIPLock(connectionName)
+		vmPrivateIPLock.Lock()
+		defer vmPrivateIPLock.Unlock()
+	}
+	err = validateVMPrivateIP(connectionName, reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateVMHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var publicIPHandler cres.PublicIPHandler
+	if reqInfo.PublicIPIID.NameId != "" {
+		publicIPHandler, err = cldConn.CreatePublicIPHandler()
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+
+		publicIPRWLock.Lock()
+		defer publicIPRWLock.Unlock()
+
+		err = checkPublicIPAvailable(publicIPHandler, reqInfo.PublicIPIID)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
+	var dnsHandler cres.DNSHandler
+	if reqInfo.DNSZoneIID.NameId != "" {
+		dnsHandler, err = cldConn.CreateDNSHandler()
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+
+		dnsZoneRWLock.RLock()
+		defer dnsZoneRWLock.RUnlock()
+	}
+
+	// vmRWLock.Lock() @todo undo this until supporting async call. by powerkim, 2020.05.10
+	// defer vmRWLock.Unlock() @todo undo this until supporting async call. by powerkim, 2020.05.10
+	// (1) check exist(NameID)
+	bool_ret, err := iidRWLock.IsExistIID(connectionName, rsType, reqInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if bool_ret == true {
+		return nil, fmt.Errorf(rsType + "-" + reqInfo.IId.NameId + " already exists!")
+	}
+
+	// (2) create Resource
+	info, err := handler.StartVM(reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	// set NameId for info by reqInfo
+	setNameId(connectionName, &info, &reqInfo)
+
+	// (3) insert IID
+	iidInfo, err := iidRWLock.CreateIID(connectionName, rsType, info.IId)
+	if err != nil {
+		cblog.Error(err)
+		// rollback
+		_, err2 := handler.TerminateVM(iidInfo.IId) // @todo check validation
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
+		}
+		return nil, err
+	}
+
+	// (4) associate the reserved PublicIP
+	if publicIPHandler != nil {
+		publicIPInfo, err := publicIPHandler.AssociatePublicIP(reqInfo.PublicIPIID, info.IId)
+		if err != nil {
+			cblog.Error(err)
+			// rollback
+			_, err2 := handler.TerminateVM(info.IId)
+			if err2 != nil {
+				cblog.Error(err2)
+				return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
+			}
+			_, err3 := iidRWLock.DeleteIID(connectionName, rsType, iidInfo.IId)
+			if err3 != nil {
+				cblog.Error(err3)
+				return nil, fmt.Errorf(err.Error() + ", " + err3.Error())
+			}
+			return nil, err
+		}
+		info.PublicIP = publicIPInfo.PublicIP
+	}
+
+	// (5) register the VM NameId into the private DNS zone
+	if dnsHandler != nil {
+		err = registerVMDNSRecord(connectionName, dnsHandler, reqInfo.DNSZoneIID, info)
+		if err != nil {
+			cblog.Error(err)
+			// rollback
+			_, err2 := handler.TerminateVM(info.IId)
+			if err2 != nil {
+				cblog.Error(err2)
+				return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
+			}
+			_, err3 := iidRWLock.DeleteIID(connectionName, rsType, iidInfo.IId)
+			if err3 != nil {
+				cblog.Error(err3)
+				return nil, fmt.Errorf(err.Error() + ", " + err3.Error())
+			}
+			return nil, err
+		}
+	}
+
+	// set sg NameId from VPCNameId-SecurityGroupNameId
+	// IID.NameID format => {VPC NameID} + sgDELIMITER + {SG NameID}
+	for i, sgIID := range info.SecurityGroupIIds {
+		vpc_sg_nameid := strings.Split(sgIID.NameId, sgDELIMITER)
+		info.SecurityGroupIIds[i].NameId = vpc_sg_nameid[1]
+	}
+
+	return &info, nil
+}
+
+func setNameId(ConnectionName string, vmInfo *cres.VMInfo, reqInfo *cres.VMReqInfo) error {
+
+	// set Image SystemId
+	// @todo before Image Handling by powerkim
+	if reqInfo.ImageIID.NameId != "" {
+		vmInfo.ImageIId.NameId = reqInfo.ImageIID.NameId
+	}
+
+	// set VPC SystemId
+	if reqInfo.VpcIID.NameId != "" {
+		vmInfo.VpcIID.NameId = reqInfo.VpcIID.NameId
+	}
+
+	if reqInfo.SubnetIID.NameId != "" {
+		// set Subnet SystemId
+		vmInfo.SubnetIID.NameId = reqInfo.SubnetIID.NameId
+	}
+
+	vmInfo.SecurityGroupIIds = reqInfo.SecurityGroupIIDs
+
+	// set SecurityGroups SystemId
+	for i, sgIID := range reqInfo.SecurityGroupIIDs {
+		IIdInfo, err := iidRWLock.GetIIDbySystemID(ConnectionName, rsSG, sgIID)
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		reqInfo.SecurityGroupIIDs[i].NameId = IIdInfo.IId.NameId
+	}
+
+	if reqInfo.KeyPairIID.NameId != "" {
+		// set KeyPair SystemId
+		vmInfo.KeyPairIId.NameId = reqInfo.KeyPairIID.NameId
+	}
+
+	return getSetNICNameId(ConnectionName, vmInfo)
+}
+
+// set NameIds of Subnets and SecurityGroups of all NICs.
+// SecurityGroup NameId is set without the VPC NameId prefix.
+func getSetNICNameId(ConnectionName string, vmInfo *cres.VMInfo) error {
+	for i, nicInfo := range vmInfo.NetworkInterfaceList {
+		if nicInfo.SubnetIID.SystemId != "" {
+			IIdInfo, err := iidRWLock.GetIIDbySystemID(ConnectionName, rsSubnetPrefix+vmInfo.VpcIID.NameId, nicInfo.SubnetIID)
+			if err != nil {
+				cblog.Error(err)
+				return err
+			}
+			vmInfo.NetworkInterfaceList[i].SubnetIID.NameId = IIdInfo.IId.NameId
+		}
+
+		sgIIDs := make([]cres.IID, len(nicInfo.SecurityGroupIIds))
+		for j, sgIID := range nicInfo.SecurityGroupIIds {
+			IIdInfo, err := iidRWLock.GetIIDbySystemID(ConnectionName, rsSG, sgIID)
+			if err != nil {
+				cblog.Error(err)
+				return err
+			}
+			// IID.NameID format => {VPC NameID} + sgDELIMITER + {SG NameID}
+			vpc_sg_nameid := strings.Split(IIdInfo.IId.NameId, sgDELIMITER)
+			sgIIDs[j] = cres.IID{vpc_sg_nameid[len(vpc_sg_nameid)-1], sgIID.SystemId}
+		}
+		vmInfo.NetworkInterfaceList[i].SecurityGroupIIds = sgIIDs
+	}
+	return nil
+}
+
+// limits of the VM group creation
+const (
+	maxVMGroupCount       int = 100
+	defaultVMGroupWorkers int = 5
+	maxVMGroupWorkers     int = 20
+)
+
+// VMGroupReqInfo: VMReqInfo is a template for all VMs of the group.
+// The Name of each VM is made by NamePattern and the index, ex) "web-%02d" => web-01, web-02, ...
+type VMGroupReqInfo struct {
+	NamePattern       string
+	Count             int
+	StartIndex        int  // default: 1
+	WorkerCount       int  // number of concurrent creations, default: 5
+	RollbackOnFailure bool // terminate all created VMs if any VM is failed
+	VMReqInfo         cres.VMReqInfo
+}
+
+type VMGroupResult struct {
+	Name       string
+	VMInfo     *cres.VMInfo `json:",omitempty"`
+	Error      string       `json:",omitempty"`
+	RolledBack bool
+}
+
+// counted after the rollback,
+// SucceededCount: VMs left running, FailedCount: VMs failed to start or to roll back,
+// RolledBackCount: VMs started and then terminated by the rollback.
+type VMGroupInfo struct {
+	RequestedCount  int
+	SucceededCount  int
+	FailedCount     int
+	RolledBackCount int
+	Results         []*VMGroupResult
+}
+
+var vmGroupNamePattern = regexp.MustCompile(`%0?[0-9]*d`)
+
+// make the VM names of a group with NamePattern
+func getVMGroupNames(reqInfo VMGroupReqInfo) ([]string, error) {
+	if reqInfo.Count <= 0 || reqInfo.Count > maxVMGroupCount {
+		return nil, fmt.Errorf("Count(%d) must be between 1 and %d!", reqInfo.Count, maxVMGroupCount)
+	}
+	// only one index verb is allowed, ex) "web-%d", "web-%03d"
+	if len(vmGroupNamePattern.FindAllString(reqInfo.NamePattern, -1)) != 1 ||
+		strings.Count(reqInfo.NamePattern, "%") != 1 {
+		return nil, fmt.Errorf("NamePattern(%s) must have one index verb like %%d or %%02d!", reqInfo.NamePattern)
+	}
+
+	startIndex := reqInfo.StartIndex
+	if startIndex <= 0 {
+		startIndex = 1
+	}
+
+	nameList := []string{}
+	for i := 0; i < reqInfo.Count; i++ {
+		nameList = append(nameList, fmt.Sprintf(reqInfo.NamePattern, startIndex+i))
+	}
+	return nameList, nil
+}
+
+// a reserved PublicIP and a fixed PrivateIP can be used by only one VM,
+// so they can not be set in the template of the VMs.
+func checkVMGroupTemplate(template cres.VMReqInfo, count int) error {
+	if count <= 1 {
+		return nil
+	}
+	if template.PublicIPIID.NameId != "" {
+		return fmt.Errorf("a reserved PublicIP can not be shared by " + strconv.Itoa(count) + " VMs!")
+	}
+	if template.PrivateIP != "" {
+		return fmt.Errorf("a fixed PrivateIP can not be shared by " + strconv.Itoa(count) + " VMs!")
+	}
+	for i, nicReqInfo := range template.NetworkInterfaceList {
+		if nicReqInfo.PrivateIP != "" {
+			return fmt.Errorf("NetworkInterface[%d]: a fixed PrivateIP can not be shared by %d VMs!", i+1, count)
+		}
+	}
+	return nil
+}
+
+// copy the template with the name of each VM.
+// SecurityGroupIIDs and NetworkInterfaceList are copied, because StartVM() sets the SystemIds into them.
+func cloneVMReqInfo(template cres.VMReqInfo, name string) cres.VMReqInfo {
+	reqInfo := template
+	reqInfo.IId = cres.IID{name, ""}
+	reqInfo.ImageIID.SystemId = ""
+	reqInfo.SecurityGroupIIDs = make([]cres.IID, len(template.SecurityGroupIIDs))
+	copy(reqInfo.SecurityGroupIIDs, template.SecurityGroupIIDs)
+	reqInfo.NetworkInterfaceList = make([]cres.NetworkInterfaceReqInfo, len(template.NetworkInterfaceList))
+	for i, nicReqInfo := range template.NetworkInterfaceList {
+		nicReqInfo.SecurityGroupIIDs = append([]cres.IID{}, nicReqInfo.SecurityGroupIIDs...)
+		reqInfo.NetworkInterfaceList[i] = nicReqInfo
+	}
+	return reqInfo
+}
+
+// (1) make & check the VM names
+// (2) start VMs with a bounded worker pool
+// (3) rollback the created VMs if requested and any VM is failed
+func StartVMGroup(connectionName string, rsType string, reqInfo VMGroupReqInfo) (*VMGroupInfo, error) {
+	cblog.Info("call StartVMGroup()")
+
+	// (1) make & check the VM names
+	nameList, err := getVMGroupNames(reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	err = checkPurchaseOption(reqInfo.VMReqInfo.PurchaseOption)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	err = checkVMGroupTemplate(reqInfo.VMReqInfo, len(nameList))
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	for _, name := range nameList {
+		bool_ret, err := iidRWLock.IsExistIID(connectionName, rsType, cres.IID{name, ""})
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		if bool_ret == true {
+			return nil, fmt.Errorf(rsType + "-" + name + " already exists!")
+		}
+	}
+
+	// (2) start VMs with a bounded worker pool
+	workerCount := reqInfo.WorkerCount
+	if workerCount <= 0 {
+		workerCount = defaultVMGroupWorkers
+	}
+	if workerCount > maxVMGroupWorkers {
+		workerCount = maxVMGroupWorkers
+	}
+	if workerCount > len(nameList) {
+		workerCount = len(nameList)
+	}
+
+	results := make([]*VMGroupResult, len(nameList))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workerCount; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				result := VMGroupResult{Name: nameList[idx]}
+				info, err := StartVM(connectionName, rsType, cloneVMReqInfo(reqInfo.VMReqInfo, nameList[idx]))
+				if err != nil {
+					result.Error = err.Error()
+				} else {
+					result.VMInfo = info
+				}
+				results[idx] = &result
+			}
+		}()
+	}
+	for idx := range nameList {
+		jobs <- idx
+	}
+	close(jobs)
+	wg.Wait()
+
+	failed := false
+	for _, result := range results {
+		if result.Error != "" {
+			failed = true
+			break
+		}
+	}
+
+	// (3) rollback the created VMs if requested and any VM is failed
+	if failed && reqInfo.RollbackOnFailure {
+		for _, result := range results {
+			if result.Error != "" {
+				continue
+			}
+			_, _, err := DeleteResource(connectionName, rsType, result.Name, "false")
+			if err != nil {
+				cblog.Error(err)
+				result.Error = "rollback failed: " + err.Error()
+				continue
+			}
+			result.RolledBack = true
+		}
+	}
+
+	groupInfo := VMGroupInfo{RequestedCount: len(nameList), Results: results}
+	for _, result := range results {
+		switch {
+		case result.RolledBack:
+			groupInfo.RolledBackCount++
+		case result.Error == "":
+			groupInfo.SucceededCount++
+		default:
+			groupInfo.FailedCount++
+		}
+	}
+
+	return &groupInfo, nil
+}
+
+// (1) get IID:list
+// (2) get CSP:list
+// (3) filtering CSP-list by IID-list
+func ListVM(connectionName string, rsType string) ([]*cres.VMInfo, error) {
+	cblog.Info("call ListVM()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateVMHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	vmRWLock.RLock()
+	defer vmRWLock.RUnlock()
+	// (1) get IID:list
+	iidInfoList, err := iidRWLock.ListIID(connectionName, rsType)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var infoList []*cres.VMInfo
+	if iidInfoList == nil || len(iidInfoList) <= 0 {
+		infoList = []*cres.VMInfo{}
+		return infoList, nil
+	}
+
+	// (2) get CSP:list
+	infoList, err = handler.ListVM()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if infoList == nil { // if iidInfoList not null, then infoList has any list.
+		return nil, fmt.Errorf("<IID-CSP mismatch> " + rsType + " IID List has " + strconv.Itoa(len(iidInfoList)) + ", but " + connectionName + " Resource list has nothing!")
+	}
+
+	// (3) filtering CSP-list by IID-list
+	infoList2 := []*cres.VMInfo{}
+	for _, iidInfo := range iidInfoList {
+		exist := false
+		for _, info := range infoList {
+			if iidInfo.IId.SystemId == info.IId.SystemId {
+
+				//+++++++++++++++++++++++++++++++++++++++++++
+				// set ResourceInfo(IID.NameId)
+				// set VPC NameId
+				IIdInfo, err := iidRWLock.GetIIDbySystemID(connectionName, rsType, info.IId)
+				if err != nil {
+					cblog.Error(err)
+					return nil, err
+				}
+				info.IId.NameId = IIdInfo.IId.NameId
+				//+++++++++++++++++++++++++++++++++++++++++++
+				err = getSetNameId(connectionName, info)
+				if err != nil {
+					cblog.Error(err)
+					return nil, err
+				}
+
+				// set sg NameId from VPCNameId-SecurityGroupNameId
+				// IID.NameID format => {VPC NameID} + sgDELIMITER + {SG NameID}
+				for i, sgIID := range info.SecurityGroupIIds {
+					vpc_sg_nameid := strings.Split(sgIID.NameId, sgDELIMITER)
+					info.SecurityGroupIIds[i].NameId = vpc_sg_nameid[1]
+				}
+
+				infoList2 = append(infoList2, info)
+				exist = true
+			}
+		}
+		if exist == false {
+			return nil, fmt.Errorf("<IID-CSP mismatch> " + rsType + "-" + iidInfo.IId.NameId + ":" + iidInfo.IId.SystemId + " exsits. but " + connectionName + " does not have!")
+		}
+	}
+
+	return infoList2, nil
+}
+
+func getSetNameId(ConnectionName string, vmInfo *cres.VMInfo) error {
+
+	// set Image NameId
+	// @todo before Image Handling by powerkim
+	//vmInfo.ImageIId.NameId = vmInfo.ImageIId.SystemId
+
+	if vmInfo.VpcIID.SystemId != "" {
+		// set VPC NameId
+		IIdInfo, err := iidRWLock.GetIIDbySystemID(ConnectionName, rsVPC, vmInfo.VpcIID)
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		vmInfo.VpcIID.NameId = IIdInfo.IId.NameId
+	}
+
+	if vmInfo.SubnetIID.SystemId != "" {
+		// set Subnet NameId
+		IIdInfo, err := iidRWLock.GetIIDbySystemID(ConnectionName, rsSubnetPrefix+vmInfo.VpcIID.NameId, vmInfo.SubnetIID)
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		vmInfo.SubnetIID.NameId = IIdInfo.IId.NameId
+	}
+
+	// set SecurityGroups NameId
+	for i, sgIID := range vmInfo.SecurityGroupIIds {
+		IIdInfo, err := iidRWLock.GetIIDbySystemID(ConnectionName, rsSG, sgIID)
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		vmInfo.SecurityGroupIIds[i].NameId = IIdInfo.IId.NameId
+	}
+
+	if vmInfo.KeyPairIId.SystemId != "" {
+		// set KeyPair NameId
+		IIdInfo, err := iidRWLock.GetIIDbySystemID(ConnectionName, rsKey, vmInfo.KeyPairIId)
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		vmInfo.KeyPairIId.NameId = IIdInfo.IId.NameId
+	}
+
+	return getSetNICNameId(ConnectionName, vmInfo)
+}
+
+// (1) get IID(NameId)
+// (2) get resource(SystemId)
+// (3) set ResourceInfo(IID.NameId)
+func GetVM(connectionName string, rsType string, nameID string) (*cres.VMInfo, error) {
+	cblog.Info("call GetVM()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateVMHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	vmRWLock.RLock()
+	defer vmRWLock.RUnlock()
+	// (1) get IID(NameId)
+	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{nameID, ""})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) get resource(SystemId)
+	info, err := handler.GetVM(iidInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) set ResourceInfo(IID.NameId)
+	info.IId.NameId = iidInfo.IId.NameId
+
+	err = getSetNameId(connectionName, &info)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// set sg NameId from VPCNameId-SecurityGroupNameId
 	// IID.NameID format => {VPC NameID} + sgDELIMITER + {SG NameID}
 	for i, sgIID := range info.SecurityGroupIIds {
 		vpc_sg_nameid := strings.Split(sgIID.NameId, sgDELIMITER)
@@ -1179,49 +2572,1414 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo) (*cre
 	return &info, nil
 }
 
-func setNameId(ConnectionName string, vmInfo *cres.VMInfo, reqInfo *cres.VMReqInfo) error {
+// (1) get IID:list
+// (2) get CSP:VMStatuslist
+// (3) filtering CSP-VMStatuslist by IID-list
+func ListVMStatus(connectionName string, rsType string) ([]*cres.VMStatusInfo, error) {
+	cblog.Info("call ListVMStatus()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateVMHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	vmRWLock.RLock()
+	defer vmRWLock.RUnlock()
+	// (1) get IID:list
+	iidInfoList, err := iidRWLock.ListIID(connectionName, rsType)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var infoList []*cres.VMStatusInfo
+	if iidInfoList == nil || len(iidInfoList) <= 0 {
+		infoList = []*cres.VMStatusInfo{}
+		return infoList, nil
+	}
+
+	// (2) get CSP:VMStatuslist
+	infoList, err = handler.ListVMStatus()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if infoList == nil { // if iidInfoList not null, then infoList has any list.
+		return nil, fmt.Errorf("<IID-CSP mismatch> " + rsType + " IID List has " + strconv.Itoa(len(iidInfoList)) + ", but " + connectionName + " Resource list has nothing!")
+	}
+
+	// (3) filtering CSP-VMStatuslist by IID-list
+	infoList2 := []*cres.VMStatusInfo{}
+	for _, iidInfo := range iidInfoList {
+		exist := false
+		for _, info := range infoList {
+			if iidInfo.IId.SystemId == info.IId.SystemId {
+				info.IId.NameId = iidInfo.IId.NameId
+				infoList2 = append(infoList2, info)
+				exist = true
+			}
+		}
+		if exist == false {
+			return nil, fmt.Errorf("<IID-CSP mismatch> " + rsType + "-" + iidInfo.IId.NameId + ":" + iidInfo.IId.SystemId + " exsits. but " + connectionName + " does not have!")
+		}
+	}
+
+	return infoList2, nil
+}
+
+// (1) get IID(NameId)
+// (2) get CSP:VMStatus(SystemId)
+func GetVMStatus(connectionName string, rsType string, nameID string) (cres.VMStatus, error) {
+	cblog.Info("call GetVMStatus()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return "", err
+	}
+
+	handler, err := cldConn.CreateVMHandler()
+	if err != nil {
+		cblog.Error(err)
+		return "", err
+	}
+
+	vmRWLock.RLock()
+	defer vmRWLock.RUnlock()
+	// (1) get IID(NameId)
+	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{nameID, ""})
+	if err != nil {
+		cblog.Error(err)
+		return "", err
+	}
+
+	// (2) get CSP:VMStatus(SystemId)
+	info, err := handler.GetVMStatus(iidInfo.IId) // type of info => string
+	if err != nil {
+		cblog.Error(err)
+		return "", err
+	}
+
+	return info, nil
+}
+
+// (1) get IID(NameId)
+// (2) get CSP:VM Console Output(SystemId)
+func GetVMConsoleOutput(connectionName string, rsType string, nameID string) (string, error) {
+	cblog.Info("call GetVMConsoleOutput()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return "", err
+	}
+
+	handler, err := cldConn.CreateVMHandler()
+	if err != nil {
+		cblog.Error(err)
+		return "", err
+	}
+
+	vmRWLock.RLock()
+	defer vmRWLock.RUnlock()
+	// (1) get IID(NameId)
+	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{nameID, ""})
+	if err != nil {
+		cblog.Error(err)
+		return "", err
+	}
+
+	// (2) get CSP:VM Console Output(SystemId)
+	output, err := handler.GetVMConsoleOutput(iidInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return "", err
+	}
+
+	return output, nil
+}
+
+// (1) get IID(NameId)
+// (2) control CSP:VM(SystemId)
+func ControlVM(connectionName string, rsType string, nameID string, action string) (cres.VMStatus, error) {
+	cblog.Info("call ControlVM()")
+
+	switch strings.ToLower(action) {
+	case "suspend", "resume":
+		err := checkCapability(connectionName, "VM_SUSPEND_RESUME",
+			func(capability idrv.DriverCapabilityInfo) bool { return capability.VM_SUSPEND_RESUME })
+		if err != nil {
+			cblog.Error(err)
+			return "", err
+		}
+	case "reboot":
+		err := checkCapability(connectionName, "VM_REBOOT",
+			func(capability idrv.DriverCapabilityInfo) bool { return capability.VM_REBOOT })
+		if err != nil {
+			cblog.Error(err)
+			return "", err
+		}
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return "", err
+	}
+
+	handler, err := cldConn.CreateVMHandler()
+	if err != nil {
+		cblog.Error(err)
+		return "", err
+	}
+
+	vmRWLock.RLock()
+	defer vmRWLock.RUnlock()
+	// (1) get IID(NameId)
+	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{nameID, ""})
+	if err != nil {
+		cblog.Error(err)
+		return "", err
+	}
+
+	// (2) control CSP:VM(SystemId)
+	vmIID := iidInfo.IId
+
+	var info cres.VMStatus
+
+	switch strings.ToLower(action) {
+	case "suspend":
+		info, err = handler.SuspendVM(vmIID)
+	case "resume":
+		info, err = handler.ResumeVM(vmIID)
+	case "reboot":
+		info, err = handler.RebootVM(vmIID)
+	default:
+		return "", fmt.Errorf(action + " is not a valid action!!")
+
+	}
+	if err != nil {
+		cblog.Error(err)
+		return "", err
+	}
+
+	return info, nil
+}
+
+//================ NLB Handler
+func getSetNLBSystemId(ConnectionName string, reqInfo *cres.NLBReqInfo) error {
+
+	// set VPC SystemId
+	if reqInfo.VpcIID.NameId != "" {
+		IIdInfo, err := iidRWLock.GetIID(ConnectionName, rsVPC, reqInfo.VpcIID)
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		reqInfo.VpcIID.SystemId = IIdInfo.IId.SystemId
+	}
+
+	// set VMs SystemId
+	return getSetVMsSystemId(ConnectionName, reqInfo.VMGroup.VMs)
+}
+
+func getSetVMsSystemId(ConnectionName string, vmIIDs []cres.IID) error {
+	for i, vmIID := range vmIIDs {
+		IIdInfo, err := iidRWLock.GetIID(ConnectionName, rsVM, vmIID)
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		vmIIDs[i].SystemId = IIdInfo.IId.SystemId
+	}
+	return nil
+}
+
+func getSetVMsNameId(ConnectionName string, vmIIDs []cres.IID) error {
+	for i, vmIID := range vmIIDs {
+		IIdInfo, err := iidRWLock.GetIIDbySystemID(ConnectionName, rsVM, vmIID)
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		vmIIDs[i].NameId = IIdInfo.IId.NameId
+	}
+	return nil
+}
+
+func getSetNLBNameId(ConnectionName string, nlbInfo *cres.NLBInfo) error {
+
+	if nlbInfo.VpcIID.SystemId != "" {
+		// set VPC NameId
+		IIdInfo, err := iidRWLock.GetIIDbySystemID(ConnectionName, rsVPC, nlbInfo.VpcIID)
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		nlbInfo.VpcIID.NameId = IIdInfo.IId.NameId
+	}
+
+	// set VMs NameId
+	return getSetVMsNameId(ConnectionName, nlbInfo.VMGroup.VMs)
+}
+
+// (1) check exist(NameID)
+// (2) create Resource
+// (3) insert IID
+func CreateNLB(connectionName string, rsType string, reqInfo cres.NLBReqInfo) (*cres.NLBInfo, error) {
+	cblog.Info("call CreateNLB()")
+
+	// get & set SystemId
+	err := getSetNLBSystemId(connectionName, &reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateNLBHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	nlbRWLock.Lock()
+	defer nlbRWLock.Unlock()
+	// (1) check exist(NameID)
+	bool_ret, err := iidRWLock.IsExistIID(connectionName, rsType, reqInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if bool_ret == true {
+		return nil, fmt.Errorf(rsType + "-" + reqInfo.IId.NameId + " already exists!")
+	}
+
+	// (2) create Resource
+	info, err := handler.CreateNLB(reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) insert IID
+	iidInfo, err := iidRWLock.CreateIID(connectionName, rsType, cres.IID{reqInfo.IId.NameId, info.IId.SystemId})
+	if err != nil {
+		cblog.Error(err)
+		// rollback
+		_, err2 := handler.DeleteNLB(info.IId)
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
+		}
+		return nil, err
+	}
+
+	// set ResourceInfo(IID.NameId)
+	info.IId.NameId = iidInfo.IId.NameId
+	err = getSetNLBNameId(connectionName, &info)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// (1) get IID:list
+// (2) get CSP:list
+// (3) filtering CSP-list by IID-list
+func ListNLB(connectionName string, rsType string) ([]*cres.NLBInfo, error) {
+	cblog.Info("call ListNLB()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateNLBHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	nlbRWLock.RLock()
+	defer nlbRWLock.RUnlock()
+	// (1) get IID:list
+	iidInfoList, err := iidRWLock.ListIID(connectionName, rsType)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var infoList []*cres.NLBInfo
+	if iidInfoList == nil || len(iidInfoList) <= 0 {
+		infoList = []*cres.NLBInfo{}
+		return infoList, nil
+	}
+
+	// (2) get CSP:list
+	infoList, err = handler.ListNLB()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if infoList == nil { // if iidInfoList not null, then infoList has any list.
+		return nil, fmt.Errorf("<IID-CSP mismatch> " + rsType + " IID List has " + strconv.Itoa(len(iidInfoList)) + ", but " + connectionName + " Resource list has nothing!")
+	}
+
+	// (3) filtering CSP-list by IID-list
+	infoList2 := []*cres.NLBInfo{}
+	for _, iidInfo := range iidInfoList {
+		exist := false
+		for _, info := range infoList {
+			if iidInfo.IId.SystemId == info.IId.SystemId {
+				// set ResourceInfo(IID.NameId)
+				info.IId.NameId = iidInfo.IId.NameId
+				err = getSetNLBNameId(connectionName, info)
+				if err != nil {
+					cblog.Error(err)
+					return nil, err
+				}
+				infoList2 = append(infoList2, info)
+				exist = true
+			}
+		}
+		if exist == false {
+			return nil, fmt.Errorf("<IID-CSP mismatch> " + rsType + "-" + iidInfo.IId.NameId + ":" + iidInfo.IId.SystemId + " exsits. but " + connectionName + " does not have!")
+		}
+	}
+
+	return infoList2, nil
+}
+
+// (1) get IID(NameId)
+// (2) get resource(SystemId)
+// (3) set ResourceInfo(IID.NameId)
+func GetNLB(connectionName string, rsType string, nameID string) (*cres.NLBInfo, error) {
+	cblog.Info("call GetNLB()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateNLBHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	nlbRWLock.RLock()
+	defer nlbRWLock.RUnlock()
+	// (1) get IID(NameId)
+	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{nameID, ""})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) get resource(SystemId)
+	info, err := handler.GetNLB(iidInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) set ResourceInfo(IID.NameId)
+	info.IId.NameId = iidInfo.IId.NameId
+	err = getSetNLBNameId(connectionName, &info)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// (1) get IID(NameId)
+// (2) get VMs SystemId
+// (3) add VMs into CSP:NLB(SystemId)
+func AddNLBVMs(connectionName string, rsType string, nlbName string, vmIIDs []cres.IID) (*cres.NLBVMGroupInfo, error) {
+	cblog.Info("call AddNLBVMs()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateNLBHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	nlbRWLock.Lock()
+	defer nlbRWLock.Unlock()
+	// (1) get IID(NameId)
+	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{nlbName, ""})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) get VMs SystemId
+	err = getSetVMsSystemId(connectionName, vmIIDs)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) add VMs into CSP:NLB(SystemId)
+	info, err := handler.AddVMs(iidInfo.IId, vmIIDs)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	err = getSetVMsNameId(connectionName, info.VMs)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// (1) get IID(NameId)
+// (2) get VMs SystemId
+// (3) remove VMs from CSP:NLB(SystemId)
+func RemoveNLBVMs(connectionName string, rsType string, nlbName string, vmIIDs []cres.IID) (bool, error) {
+	cblog.Info("call RemoveNLBVMs()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	handler, err := cldConn.CreateNLBHandler()
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	nlbRWLock.Lock()
+	defer nlbRWLock.Unlock()
+	// (1) get IID(NameId)
+	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{nlbName, ""})
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	// (2) get VMs SystemId
+	err = getSetVMsSystemId(connectionName, vmIIDs)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	// (3) remove VMs from CSP:NLB(SystemId)
+	result, err := handler.RemoveVMs(iidInfo.IId, vmIIDs)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	return result, nil
+}
+
+// (1) get IID(NameId)
+// (2) get CSP:health of VMs(SystemId)
+// (3) set VMs NameId
+func GetNLBVMGroupHealthInfo(connectionName string, rsType string, nlbName string) (*cres.HealthInfo, error) {
+	cblog.Info("call GetNLBVMGroupHealthInfo()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateNLBHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	nlbRWLock.RLock()
+	defer nlbRWLock.RUnlock()
+	// (1) get IID(NameId)
+	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{nlbName, ""})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) get CSP:health of VMs(SystemId)
+	info, err := handler.GetVMGroupHealthInfo(iidInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) set VMs NameId
+	for i, vmHealth := range info.AllVMs {
+		IIdInfo, err := iidRWLock.GetIIDbySystemID(connectionName, rsVM, vmHealth.VMIID)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		info.AllVMs[i].VMIID.NameId = IIdInfo.IId.NameId
+	}
+
+	return &info, nil
+}
+
+// (1) get IID(NameId)
+// (2) change CSP:NLB Listener(SystemId)
+func ChangeNLBListener(connectionName string, rsType string, nlbName string, listener cres.ListenerInfo) (*cres.ListenerInfo, error) {
+	cblog.Info("call ChangeNLBListener()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateNLBHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	nlbRWLock.Lock()
+	defer nlbRWLock.Unlock()
+	// (1) get IID(NameId)
+	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{nlbName, ""})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) change CSP:NLB Listener(SystemId)
+	info, err := handler.ChangeListener(iidInfo.IId, listener)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// (1) get IID(NameId)
+// (2) change CSP:NLB HealthChecker(SystemId)
+func ChangeNLBHealthChecker(connectionName string, rsType string, nlbName string, healthChecker cres.HealthCheckerInfo) (*cres.HealthCheckerInfo, error) {
+	cblog.Info("call ChangeNLBHealthChecker()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateNLBHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	nlbRWLock.Lock()
+	defer nlbRWLock.Unlock()
+	// (1) get IID(NameId)
+	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{nlbName, ""})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) change CSP:NLB HealthChecker(SystemId)
+	info, err := handler.ChangeHealthCheckerInfo(iidInfo.IId, healthChecker)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+//================ PublicIP Handler
+func getSetPublicIPNameId(ConnectionName string, publicIPInfo *cres.PublicIPInfo) error {
+
+	if publicIPInfo.AssociatedVMIID.SystemId != "" {
+		// set VM NameId
+		IIdInfo, err := iidRWLock.GetIIDbySystemID(ConnectionName, rsVM, publicIPInfo.AssociatedVMIID)
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		publicIPInfo.AssociatedVMIID.NameId = IIdInfo.IId.NameId
+	}
+
+	return nil
+}
+
+// (1) check exist(NameID)
+// (2) create Resource
+// (3) insert IID
+func AllocatePublicIP(connectionName string, rsType string, reqInfo cres.PublicIPReqInfo) (*cres.PublicIPInfo, error) {
+	cblog.Info("call AllocatePublicIP()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreatePublicIPHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	publicIPRWLock.Lock()
+	defer publicIPRWLock.Unlock()
+	// (1) check exist(NameID)
+	bool_ret, err := iidRWLock.IsExistIID(connectionName, rsType, reqInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if bool_ret == true {
+		return nil, fmt.Errorf(rsType + "-" + reqInfo.IId.NameId + " already exists!")
+	}
+
+	// (2) create Resource
+	info, err := handler.AllocatePublicIP(reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) insert IID
+	iidInfo, err := iidRWLock.CreateIID(connectionName, rsType, cres.IID{reqInfo.IId.NameId, info.IId.SystemId})
+	if err != nil {
+		cblog.Error(err)
+		// rollback
+		_, err2 := handler.ReleasePublicIP(info.IId)
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
+		}
+		return nil, err
+	}
+
+	// set ResourceInfo(IID.NameId)
+	info.IId.NameId = iidInfo.IId.NameId
+
+	return &info, nil
+}
+
+// (1) get IID:list
+// (2) get CSP:list
+// (3) filtering CSP-list by IID-list
+func ListPublicIP(connectionName string, rsType string) ([]*cres.PublicIPInfo, error) {
+	cblog.Info("call ListPublicIP()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreatePublicIPHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	publicIPRWLock.RLock()
+	defer publicIPRWLock.RUnlock()
+	// (1) get IID:list
+	iidInfoList, err := iidRWLock.ListIID(connectionName, rsType)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var infoList []*cres.PublicIPInfo
+	if iidInfoList == nil || len(iidInfoList) <= 0 {
+		infoList = []*cres.PublicIPInfo{}
+		return infoList, nil
+	}
+
+	// (2) get CSP:list
+	infoList, err = handler.ListPublicIP()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if infoList == nil { // if iidInfoList not null, then infoList has any list.
+		return nil, fmt.Errorf("<IID-CSP mismatch> " + rsType + " IID List has " + strconv.Itoa(len(iidInfoList)) + ", but " + connectionName + " Resource list has nothing!")
+	}
+
+	// (3) filtering CSP-list by IID-list
+	infoList2 := []*cres.PublicIPInfo{}
+	for _, iidInfo := range iidInfoList {
+		exist := false
+		for _, info := range infoList {
+			if iidInfo.IId.SystemId == info.IId.SystemId {
+				// set ResourceInfo(IID.NameId)
+				info.IId.NameId = iidInfo.IId.NameId
+				err = getSetPublicIPNameId(connectionName, info)
+				if err != nil {
+					cblog.Error(err)
+					return nil, err
+				}
+				infoList2 = append(infoList2, info)
+				exist = true
+			}
+		}
+		if exist == false {
+			return nil, fmt.Errorf("<IID-CSP mismatch> " + rsType + "-" + iidInfo.IId.NameId + ":" + iidInfo.IId.SystemId + " exsits. but " + connectionName + " does not have!")
+		}
+	}
+
+	return infoList2, nil
+}
+
+// (1) get IID(NameId)
+// (2) get resource(SystemId)
+// (3) set ResourceInfo(IID.NameId)
+func GetPublicIP(connectionName string, rsType string, nameID string) (*cres.PublicIPInfo, error) {
+	cblog.Info("call GetPublicIP()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreatePublicIPHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	publicIPRWLock.RLock()
+	defer publicIPRWLock.RUnlock()
+	// (1) get IID(NameId)
+	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{nameID, ""})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) get resource(SystemId)
+	info, err := handler.GetPublicIP(iidInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) set ResourceInfo(IID.NameId)
+	info.IId.NameId = iidInfo.IId.NameId
+	err = getSetPublicIPNameId(connectionName, &info)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// (1) get IID(NameId) of PublicIP and VM
+// (2) associate CSP:PublicIP(SystemId) with CSP:VM(SystemId)
+// (3) set ResourceInfo(IID.NameId)
+func AssociatePublicIP(connectionName string, rsType string, nameID string, vmName string) (*cres.PublicIPInfo, error) {
+	cblog.Info("call AssociatePublicIP()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreatePublicIPHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	publicIPRWLock.Lock()
+	defer publicIPRWLock.Unlock()
+	// (1) get IID(NameId) of PublicIP and VM
+	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{nameID, ""})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	vmIIDInfo, err := iidRWLock.GetIID(connectionName, rsVM, cres.IID{vmName, ""})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) associate CSP:PublicIP(SystemId) with CSP:VM(SystemId)
+	info, err := handler.AssociatePublicIP(iidInfo.IId, vmIIDInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) set ResourceInfo(IID.NameId)
+	info.IId.NameId = iidInfo.IId.NameId
+	info.AssociatedVMIID.NameId = vmIIDInfo.IId.NameId
+
+	return &info, nil
+}
+
+// (1) get IID(NameId)
+// (2) disassociate CSP:PublicIP(SystemId) from its VM
+func DisassociatePublicIP(connectionName string, rsType string, nameID string) (bool, error) {
+	cblog.Info("call DisassociatePublicIP()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	handler, err := cldConn.CreatePublicIPHandler()
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	publicIPRWLock.Lock()
+	defer publicIPRWLock.Unlock()
+	// (1) get IID(NameId)
+	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{nameID, ""})
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	// (2) disassociate CSP:PublicIP(SystemId) from its VM
+	result, err := handler.DisassociatePublicIP(iidInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	return result, nil
+}
+
+//================ DNS Handler
+
+func getSetDNSZoneNameId(ConnectionName string, zoneInfo *cres.DNSZoneInfo) error {
+
+	if zoneInfo.VpcIID.SystemId != "" {
+		// set VPC NameId
+		IIdInfo, err := iidRWLock.GetIIDbySystemID(ConnectionName, rsVPC, zoneInfo.VpcIID)
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		zoneInfo.VpcIID.NameId = IIdInfo.IId.NameId
+	}
+
+	return nil
+}
+
+// (1) check exist(NameID)
+// (2) get VPC IID(NameId) for getting SystemId
+// (3) create Resource
+// (4) insert IID
+func CreateDNSZone(connectionName string, rsType string, reqInfo cres.DNSZoneReqInfo) (*cres.DNSZoneInfo, error) {
+	cblog.Info("call CreateDNSZone()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateDNSHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	dnsZoneRWLock.Lock()
+	defer dnsZoneRWLock.Unlock()
+	// (1) check exist(NameID)
+	bool_ret, err := iidRWLock.IsExistIID(connectionName, rsType, reqInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if bool_ret == true {
+		return nil, fmt.Errorf(rsType + "-" + reqInfo.IId.NameId + " already exists!")
+	}
+
+	// (2) get VPC IID(NameId) for getting SystemId
+	vpcIIDInfo, err := iidRWLock.GetIID(connectionName, rsVPC, reqInfo.VpcIID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	reqInfo.VpcIID.SystemId = vpcIIDInfo.IId.SystemId
+
+	// (3) create Resource
+	info, err := handler.CreateZone(reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (4) insert IID
+	iidInfo, err := iidRWLock.CreateIID(connectionName, rsType, cres.IID{reqInfo.IId.NameId, info.IId.SystemId})
+	if err != nil {
+		cblog.Error(err)
+		// rollback
+		_, err2 := handler.DeleteZone(info.IId)
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
+		}
+		return nil, err
+	}
+
+	// set ResourceInfo(IID.NameId)
+	info.IId.NameId = iidInfo.IId.NameId
+	info.VpcIID.NameId = vpcIIDInfo.IId.NameId
+
+	return &info, nil
+}
+
+// (1) get IID:list
+// (2) get CSP:list
+// (3) filtering CSP-list by IID-list
+func ListDNSZone(connectionName string, rsType string) ([]*cres.DNSZoneInfo, error) {
+	cblog.Info("call ListDNSZone()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateDNSHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	dnsZoneRWLock.RLock()
+	defer dnsZoneRWLock.RUnlock()
+	// (1) get IID:list
+	iidInfoList, err := iidRWLock.ListIID(connectionName, rsType)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var infoList []*cres.DNSZoneInfo
+	if iidInfoList == nil || len(iidInfoList) <= 0 {
+		infoList = []*cres.DNSZoneInfo{}
+		return infoList, nil
+	}
+
+	// (2) get CSP:list
+	infoList, err = handler.ListZone()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if infoList == nil { // if iidInfoList not null, then infoList has any list.
+		return nil, fmt.Errorf("<IID-CSP mismatch> " + rsType + " IID List has " + strconv.Itoa(len(iidInfoList)) + ", but " + connectionName + " Resource list has nothing!")
+	}
+
+	// (3) filtering CSP-list by IID-list
+	infoList2 := []*cres.DNSZoneInfo{}
+	for _, iidInfo := range iidInfoList {
+		exist := false
+		for _, info := range infoList {
+			if iidInfo.IId.SystemId == info.IId.SystemId {
+				// set ResourceInfo(IID.NameId)
+				info.IId.NameId = iidInfo.IId.NameId
+				err = getSetDNSZoneNameId(connectionName, info)
+				if err != nil {
+					cblog.Error(err)
+					return nil, err
+				}
+				infoList2 = append(infoList2, info)
+				exist = true
+			}
+		}
+		if exist == false {
+			return nil, fmt.Errorf("<IID-CSP mismatch> " + rsType + "-" + iidInfo.IId.NameId + ":" + iidInfo.IId.SystemId + " exsits. but " + connectionName + " does not have!")
+		}
+	}
+
+	return infoList2, nil
+}
+
+// (1) get IID(NameId)
+// (2) get resource(SystemId)
+// (3) set ResourceInfo(IID.NameId)
+func GetDNSZone(connectionName string, rsType string, nameID string) (*cres.DNSZoneInfo, error) {
+	cblog.Info("call GetDNSZone()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateDNSHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	dnsZoneRWLock.RLock()
+	defer dnsZoneRWLock.RUnlock()
+	// (1) get IID(NameId)
+	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{nameID, ""})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) get resource(SystemId)
+	info, err := handler.GetZone(iidInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) set ResourceInfo(IID.NameId)
+	info.IId.NameId = iidInfo.IId.NameId
+	err = getSetDNSZoneNameId(connectionName, &info)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// (1) get IID(NameId)
+// (2) add the record into CSP:DNSZone(SystemId)
+// (3) set ResourceInfo(IID.NameId)
+func AddDNSRecord(connectionName string, rsType string, zoneName string, recordInfo cres.DNSRecordInfo) (*cres.DNSZoneInfo, error) {
+	cblog.Info("call AddDNSRecord()")
 
-	// set Image SystemId
-	// @todo before Image Handling by powerkim
-	if reqInfo.ImageIID.NameId != "" {
-		vmInfo.ImageIId.NameId = reqInfo.ImageIID.NameId
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
 	}
 
-	// set VPC SystemId
-	if reqInfo.VpcIID.NameId != "" {
-		vmInfo.VpcIID.NameId = reqInfo.VpcIID.NameId
+	handler, err := cldConn.CreateDNSHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
 	}
 
-	if reqInfo.SubnetIID.NameId != "" {
-		// set Subnet SystemId
-		vmInfo.SubnetIID.NameId = reqInfo.SubnetIID.NameId
+	dnsZoneRWLock.Lock()
+	defer dnsZoneRWLock.Unlock()
+	// (1) get IID(NameId)
+	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{zoneName, ""})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
 	}
 
-	vmInfo.SecurityGroupIIds = reqInfo.SecurityGroupIIDs
+	// (2) add the record into CSP:DNSZone(SystemId)
+	info, err := handler.AddRecord(iidInfo.IId, recordInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
 
-	// set SecurityGroups SystemId
-	for i, sgIID := range reqInfo.SecurityGroupIIDs {
-		IIdInfo, err := iidRWLock.GetIIDbySystemID(ConnectionName, rsSG, sgIID)
+	// (3) set ResourceInfo(IID.NameId)
+	info.IId.NameId = iidInfo.IId.NameId
+	err = getSetDNSZoneNameId(connectionName, &info)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// (1) get IID(NameId)
+// (2) update the record of CSP:DNSZone(SystemId)
+// (3) set ResourceInfo(IID.NameId)
+func UpdateDNSRecord(connectionName string, rsType string, zoneName string, recordInfo cres.DNSRecordInfo) (*cres.DNSZoneInfo, error) {
+	cblog.Info("call UpdateDNSRecord()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateDNSHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	dnsZoneRWLock.Lock()
+	defer dnsZoneRWLock.Unlock()
+	// (1) get IID(NameId)
+	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{zoneName, ""})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) update the record of CSP:DNSZone(SystemId)
+	info, err := handler.UpdateRecord(iidInfo.IId, recordInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) set ResourceInfo(IID.NameId)
+	info.IId.NameId = iidInfo.IId.NameId
+	err = getSetDNSZoneNameId(connectionName, &info)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// (1) get IID(NameId)
+// (2) remove the record from CSP:DNSZone(SystemId)
+func RemoveDNSRecord(connectionName string, rsType string, zoneName string, recordName string, recordType cres.DNSRecordType) (bool, error) {
+	cblog.Info("call RemoveDNSRecord()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	handler, err := cldConn.CreateDNSHandler()
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	dnsZoneRWLock.Lock()
+	defer dnsZoneRWLock.Unlock()
+	// (1) get IID(NameId)
+	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{zoneName, ""})
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	// (2) remove the record from CSP:DNSZone(SystemId)
+	result, err := handler.RemoveRecord(iidInfo.IId, recordName, recordType)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	return result, nil
+}
+
+// register the VM NameId as an A record of the Private IP in the private DNS zone.
+// caller must hold dnsZoneRWLock.
+// (1) add the A record into CSP:DNSZone(SystemId)
+// (2) insert IID of the registration: {VM NameId} => {DNS Zone NameId}
+func registerVMDNSRecord(connectionName string, handler cres.DNSHandler, zoneIID cres.IID, vmInfo cres.VMInfo) error {
+	if vmInfo.PrivateIP == "" {
+		return fmt.Errorf(rsVM + "-" + vmInfo.IId.NameId + " has no Private IP to register into " + rsDNSZone + "-" + zoneIID.NameId + "!")
+	}
+
+	// (1) add the A record into CSP:DNSZone(SystemId)
+	recordInfo := cres.DNSRecordInfo{
+		Name:   vmInfo.IId.NameId,
+		Type:   cres.DNSRecordA,
+		TTL:    cres.DNSDefaultTTL,
+		Values: []string{vmInfo.PrivateIP},
+	}
+	_, err := handler.AddRecord(zoneIID, recordInfo)
+	if err != nil {
+		return err
+	}
+
+	// (2) insert IID of the registration
+	_, err = iidRWLock.CreateIID(connectionName, rsDNSRecord, cres.IID{vmInfo.IId.NameId, zoneIID.NameId})
+	if err != nil {
+		// rollback
+		_, err2 := handler.RemoveRecord(zoneIID, recordInfo.Name, recordInfo.Type)
+		if err2 != nil {
+			return fmt.Errorf(err.Error() + ", " + err2.Error())
+		}
+		return err
+	}
+
+	return nil
+}
+
+// deregister the A record of the VM registered by StartVM.
+// the VM is already terminated, so errors are only logged.
+// (1) get IID of the registration
+// (2) remove the A record from CSP:DNSZone(SystemId)
+// (3) delete IID of the registration
+func deregisterVMDNSRecord(connectionName string, cldConn icon.CloudConnection, vmName string) {
+	// (1) get IID of the registration
+	bool_ret, err := iidRWLock.IsExistIID(connectionName, rsDNSRecord, cres.IID{vmName, ""})
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	if bool_ret == false {
+		return
+	}
+	recordIIDInfo, err := iidRWLock.GetIID(connectionName, rsDNSRecord, cres.IID{vmName, ""})
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+
+	dnsZoneRWLock.RLock()
+	defer dnsZoneRWLock.RUnlock()
+
+	// (2) remove the A record from CSP:DNSZone(SystemId)
+	zoneIIDInfo, err := iidRWLock.GetIID(connectionName, rsDNSZone, cres.IID{recordIIDInfo.IId.SystemId, ""})
+	if err != nil {
+		cblog.Error(err)
+	} else {
+		handler, err := cldConn.CreateDNSHandler()
+		if err != nil {
+			cblog.Error(err)
+			return
+		}
+		_, err = handler.RemoveRecord(zoneIIDInfo.IId, vmName, cres.DNSRecordA)
 		if err != nil {
 			cblog.Error(err)
+		}
+	}
+
+	// (3) delete IID of the registration
+	_, err = iidRWLock.DeleteIID(connectionName, rsDNSRecord, recordIIDInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+	}
+}
+
+// delete IIDs of the VM registrations in the deleted DNS zone.
+func deleteDNSRecordIIDs(connectionName string, zoneName string) error {
+	recordIIDInfoList, err := iidRWLock.ListIID(connectionName, rsDNSRecord)
+	if err != nil {
+		return err
+	}
+	for _, recordIIDInfo := range recordIIDInfoList {
+		if recordIIDInfo.IId.SystemId != zoneName {
+			continue
+		}
+		_, err := iidRWLock.DeleteIID(connectionName, rsDNSRecord, recordIIDInfo.IId)
+		if err != nil {
 			return err
 		}
-		reqInfo.SecurityGroupIIDs[i].NameId = IIdInfo.IId.NameId
+	}
+	return nil
+}
+
+//================ Bucket Handler
+
+// (1) check exist(NameID)
+// (2) create Resource
+// (3) insert IID
+func CreateBucket(connectionName string, rsType string, reqInfo cres.BucketReqInfo) (*cres.BucketInfo, error) {
+	cblog.Info("call CreateBucket()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateBucketHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	bucketRWLock.Lock()
+	defer bucketRWLock.Unlock()
+	// (1) check exist(NameID)
+	bool_ret, err := iidRWLock.IsExistIID(connectionName, rsType, reqInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if bool_ret == true {
+		return nil, fmt.Errorf(rsType + "-" + reqInfo.IId.NameId + " already exists!")
+	}
+
+	// (2) create Resource
+	info, err := handler.CreateBucket(reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
 	}
 
-	if reqInfo.KeyPairIID.NameId != "" {
-		// set KeyPair SystemId
-		vmInfo.KeyPairIId.NameId = reqInfo.KeyPairIID.NameId
+	// (3) insert IID
+	iidInfo, err := iidRWLock.CreateIID(connectionName, rsType, cres.IID{reqInfo.IId.NameId, info.IId.SystemId})
+	if err != nil {
+		cblog.Error(err)
+		// rollback
+		_, err2 := handler.DeleteBucket(info.IId)
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
+		}
+		return nil, err
 	}
 
-	return nil
+	// set ResourceInfo(IID.NameId)
+	info.IId.NameId = iidInfo.IId.NameId
+
+	return &info, nil
 }
 
 // (1) get IID:list
 // (2) get CSP:list
 // (3) filtering CSP-list by IID-list
-func ListVM(connectionName string, rsType string) ([]*cres.VMInfo, error) {
-	cblog.Info("call ListVM()")
+func ListBucket(connectionName string, rsType string) ([]*cres.BucketInfo, error) {
+	cblog.Info("call ListBucket()")
 
 	cldConn, err := ccm.GetCloudConnection(connectionName)
 	if err != nil {
@@ -1229,14 +3987,14 @@ func ListVM(connectionName string, rsType string) ([]*cres.VMInfo, error) {
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateVMHandler()
+	handler, err := cldConn.CreateBucketHandler()
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
 
-	vmRWLock.RLock()
-	defer vmRWLock.RUnlock()
+	bucketRWLock.RLock()
+	defer bucketRWLock.RUnlock()
 	// (1) get IID:list
 	iidInfoList, err := iidRWLock.ListIID(connectionName, rsType)
 	if err != nil {
@@ -1244,14 +4002,14 @@ func ListVM(connectionName string, rsType string) ([]*cres.VMInfo, error) {
 		return nil, err
 	}
 
-	var infoList []*cres.VMInfo
+	var infoList []*cres.BucketInfo
 	if iidInfoList == nil || len(iidInfoList) <= 0 {
-		infoList = []*cres.VMInfo{}
+		infoList = []*cres.BucketInfo{}
 		return infoList, nil
 	}
 
 	// (2) get CSP:list
-	infoList, err = handler.ListVM()
+	infoList, err = handler.ListBucket()
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -1261,35 +4019,13 @@ func ListVM(connectionName string, rsType string) ([]*cres.VMInfo, error) {
 	}
 
 	// (3) filtering CSP-list by IID-list
-	infoList2 := []*cres.VMInfo{}
+	infoList2 := []*cres.BucketInfo{}
 	for _, iidInfo := range iidInfoList {
 		exist := false
 		for _, info := range infoList {
 			if iidInfo.IId.SystemId == info.IId.SystemId {
-
-				//+++++++++++++++++++++++++++++++++++++++++++
 				// set ResourceInfo(IID.NameId)
-				// set VPC NameId
-				IIdInfo, err := iidRWLock.GetIIDbySystemID(connectionName, rsType, info.IId)
-				if err != nil {
-					cblog.Error(err)
-					return nil, err
-				}
-				info.IId.NameId = IIdInfo.IId.NameId
-				//+++++++++++++++++++++++++++++++++++++++++++
-				err = getSetNameId(connectionName, info)
-				if err != nil {
-					cblog.Error(err)
-					return nil, err
-				}
-
-				// set sg NameId from VPCNameId-SecurityGroupNameId
-				// IID.NameID format => {VPC NameID} + sgDELIMITER + {SG NameID}
-				for i, sgIID := range info.SecurityGroupIIds {
-					vpc_sg_nameid := strings.Split(sgIID.NameId, sgDELIMITER)
-					info.SecurityGroupIIds[i].NameId = vpc_sg_nameid[1]
-				}
-
+				info.IId.NameId = iidInfo.IId.NameId
 				infoList2 = append(infoList2, info)
 				exist = true
 			}
@@ -1302,60 +4038,363 @@ func ListVM(connectionName string, rsType string) ([]*cres.VMInfo, error) {
 	return infoList2, nil
 }
 
-func getSetNameId(ConnectionName string, vmInfo *cres.VMInfo) error {
+// (1) get IID(NameId)
+// (2) get resource(SystemId)
+// (3) set ResourceInfo(IID.NameId)
+func GetBucket(connectionName string, rsType string, nameID string) (*cres.BucketInfo, error) {
+	cblog.Info("call GetBucket()")
 
-	// set Image NameId
-	// @todo before Image Handling by powerkim
-	//vmInfo.ImageIId.NameId = vmInfo.ImageIId.SystemId
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
 
-	if vmInfo.VpcIID.SystemId != "" {
+	handler, err := cldConn.CreateBucketHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	bucketRWLock.RLock()
+	defer bucketRWLock.RUnlock()
+	// (1) get IID(NameId)
+	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{nameID, ""})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) get resource(SystemId)
+	info, err := handler.GetBucket(iidInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) set ResourceInfo(IID.NameId)
+	info.IId.NameId = iidInfo.IId.NameId
+
+	return &info, nil
+}
+
+// get the bucket handler and the bucket IID for object operations.
+// caller must hold bucketRWLock.
+func getBucketHandlerIID(connectionName string, rsType string, bucketName string) (cres.BucketHandler, cres.IID, error) {
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		return nil, cres.IID{}, err
+	}
+
+	handler, err := cldConn.CreateBucketHandler()
+	if err != nil {
+		return nil, cres.IID{}, err
+	}
+
+	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{bucketName, ""})
+	if err != nil {
+		return nil, cres.IID{}, err
+	}
+
+	return handler, iidInfo.IId, nil
+}
+
+// (1) get IID(NameId)
+// (2) list objects of CSP:Bucket(SystemId)
+func ListObject(connectionName string, rsType string, bucketName string, prefix string) ([]*cres.ObjectInfo, error) {
+	cblog.Info("call ListObject()")
+
+	bucketRWLock.RLock()
+	defer bucketRWLock.RUnlock()
+	// (1) get IID(NameId)
+	handler, bucketIID, err := getBucketHandlerIID(connectionName, rsType, bucketName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) list objects of CSP:Bucket(SystemId)
+	infoList, err := handler.ListObject(bucketIID, prefix)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if infoList == nil {
+		infoList = []*cres.ObjectInfo{}
+	}
+
+	return infoList, nil
+}
+
+// (1) get IID(NameId)
+// (2) stream the reader into CSP:Bucket(SystemId)
+func PutObject(connectionName string, rsType string, bucketName string, objectName string, reader io.Reader, size int64, contentType string) (*cres.ObjectInfo, error) {
+	cblog.Info("call PutObject()")
+
+	if objectName == "" {
+		return nil, fmt.Errorf("object name of " + rsType + "-" + bucketName + " is empty!")
+	}
+
+	bucketRWLock.RLock()
+	defer bucketRWLock.RUnlock()
+	// (1) get IID(NameId)
+	handler, bucketIID, err := getBucketHandlerIID(connectionName, rsType, bucketName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (2) stream the reader into CSP:Bucket(SystemId)
+	info, err := handler.PutObject(bucketIID, objectName, reader, size, contentType)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// (1) get IID(NameId)
+// (2) open the object of CSP:Bucket(SystemId)
+// caller must close the returned reader.
+func GetObject(connectionName string, rsType string, bucketName string, objectName string) (io.ReadCloser, *cres.ObjectInfo, error) {
+	cblog.Info("call GetObject()")
+
+	bucketRWLock.RLock()
+	defer bucketRWLock.RUnlock()
+	// (1) get IID(NameId)
+	handler, bucketIID, err := getBucketHandlerIID(connectionName, rsType, bucketName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, nil, err
+	}
+
+	// (2) open the object of CSP:Bucket(SystemId)
+	reader, info, err := handler.GetObject(bucketIID, objectName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, nil, err
+	}
+
+	return reader, &info, nil
+}
+
+// (1) get IID(NameId)
+// (2) delete the object of CSP:Bucket(SystemId)
+func DeleteObject(connectionName string, rsType string, bucketName string, objectName string) (bool, error) {
+	cblog.Info("call DeleteObject()")
+
+	bucketRWLock.RLock()
+	defer bucketRWLock.RUnlock()
+	// (1) get IID(NameId)
+	handler, bucketIID, err := getBucketHandlerIID(connectionName, rsType, bucketName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	// (2) delete the object of CSP:Bucket(SystemId)
+	result, err := handler.DeleteObject(bucketIID, objectName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	return result, nil
+}
+
+// max expiration of presigned URLs, AWS S3 limit.
+const maxPresignedURLExpires = 7 * 24 * time.Hour
+
+// (1) check the method and the expiration
+// (2) get IID(NameId)
+// (3) get the presigned URL of the object in CSP:Bucket(SystemId)
+func GetPresignedURL(connectionName string, rsType string, bucketName string, objectName string, method string, expires time.Duration) (string, error) {
+	cblog.Info("call GetPresignedURL()")
+
+	// (1) check the method and the expiration
+	urlMethod := cres.PresignedURLMethod(strings.ToUpper(method))
+	if urlMethod != cres.PresignedGet && urlMethod != cres.PresignedPut {
+		return "", fmt.Errorf(method + " is not supported method of presigned URL! (GET, PUT)")
+	}
+	if expires <= 0 || expires > maxPresignedURLExpires {
+		return "", fmt.Errorf("expiration of presigned URL must be in (0s, " + maxPresignedURLExpires.String() + "]!")
+	}
+	if objectName == "" {
+		return "", fmt.Errorf("object name of " + rsType + "-" + bucketName + " is empty!")
+	}
+
+	bucketRWLock.RLock()
+	defer bucketRWLock.RUnlock()
+	// (2) get IID(NameId)
+	handler, bucketIID, err := getBucketHandlerIID(connectionName, rsType, bucketName)
+	if err != nil {
+		cblog.Error(err)
+		return "", err
+	}
+
+	// (3) get the presigned URL of the object in CSP:Bucket(SystemId)
+	presignedURL, err := handler.GetPresignedURL(bucketIID, objectName, urlMethod, expires)
+	if err != nil {
+		cblog.Error(err)
+		return "", err
+	}
+
+	return presignedURL, nil
+}
+
+//================ VPC Peering Handler
+func getSetVPCPeeringNameId(ConnectionName string, peeringInfo *cres.VPCPeeringInfo) error {
+
+	for _, vpcIID := range []*cres.IID{&peeringInfo.RequesterVPCIID, &peeringInfo.AccepterVPCIID} {
+		if vpcIID.SystemId == "" {
+			continue
+		}
 		// set VPC NameId
-		IIdInfo, err := iidRWLock.GetIIDbySystemID(ConnectionName, rsVPC, vmInfo.VpcIID)
+		IIdInfo, err := iidRWLock.GetIIDbySystemID(ConnectionName, rsVPC, *vpcIID)
 		if err != nil {
 			cblog.Error(err)
 			return err
 		}
-		vmInfo.VpcIID.NameId = IIdInfo.IId.NameId
+		vpcIID.NameId = IIdInfo.IId.NameId
 	}
 
-	if vmInfo.SubnetIID.SystemId != "" {
-		// set Subnet NameId
-		IIdInfo, err := iidRWLock.GetIIDbySystemID(ConnectionName, rsSubnetPrefix+vmInfo.VpcIID.NameId, vmInfo.SubnetIID)
-		if err != nil {
-			cblog.Error(err)
-			return err
+	return nil
+}
+
+// CIDR list of a VPC and its Subnets
+func getVPCCIDRList(vpcInfo cres.VPCInfo) []string {
+	cidrList := []string{}
+	if vpcInfo.IPv4_CIDR != "" {
+		cidrList = append(cidrList, vpcInfo.IPv4_CIDR)
+	}
+	for _, subnetInfo := range vpcInfo.SubnetInfoList {
+		if subnetInfo.IPv4_CIDR != "" {
+			cidrList = append(cidrList, subnetInfo.IPv4_CIDR)
 		}
-		vmInfo.SubnetIID.NameId = IIdInfo.IId.NameId
 	}
+	return cidrList
+}
 
-	// set SecurityGroups NameId
-	for i, sgIID := range vmInfo.SecurityGroupIIds {
-		IIdInfo, err := iidRWLock.GetIIDbySystemID(ConnectionName, rsSG, sgIID)
+// peered VPCs can not route to each other when their CIDRs overlap.
+func checkCIDROverlap(cidrList1 []string, cidrList2 []string) error {
+	for _, cidr1 := range cidrList1 {
+		_, net1, err := net.ParseCIDR(cidr1)
 		if err != nil {
-			cblog.Error(err)
 			return err
 		}
-		vmInfo.SecurityGroupIIds[i].NameId = IIdInfo.IId.NameId
+		for _, cidr2 := range cidrList2 {
+			_, net2, err := net.ParseCIDR(cidr2)
+			if err != nil {
+				return err
+			}
+			if net1.Contains(net2.IP) || net2.Contains(net1.IP) {
+				return fmt.Errorf(cidr1 + " overlaps with " + cidr2 + "!")
+			}
+		}
+	}
+	return nil
+}
+
+// (1) check exist(NameID)
+// (2) get IID(NameId) of VPCs and check CIDR overlap
+// (3) create Resource
+// (4) insert IID
+func RequestVPCPeering(connectionName string, rsType string, reqInfo cres.VPCPeeringReqInfo) (*cres.VPCPeeringInfo, error) {
+	cblog.Info("call RequestVPCPeering()")
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateVPCHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	vpcRWLock.RLock()
+	defer vpcRWLock.RUnlock()
+	vpcPeeringRWLock.Lock()
+	defer vpcPeeringRWLock.Unlock()
+	// (1) check exist(NameID)
+	bool_ret, err := iidRWLock.IsExistIID(connectionName, rsType, reqInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if bool_ret == true {
+		return nil, fmt.Errorf(rsType + "-" + reqInfo.IId.NameId + " already exists!")
+	}
+
+	// (2) get IID(NameId) of VPCs and check CIDR overlap
+	if reqInfo.RequesterVPCIID.NameId == reqInfo.AccepterVPCIID.NameId {
+		return nil, fmt.Errorf(rsVPC + "-" + reqInfo.RequesterVPCIID.NameId + " can not be peered with itself!")
+	}
+	requesterIIDInfo, err := iidRWLock.GetIID(connectionName, rsVPC, reqInfo.RequesterVPCIID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	accepterIIDInfo, err := iidRWLock.GetIID(connectionName, rsVPC, reqInfo.AccepterVPCIID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	requesterVPCInfo, err := getVPCCIDRInfo(connectionName, handler, requesterIIDInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	accepterVPCInfo, err := getVPCCIDRInfo(connectionName, handler, accepterIIDInfo.IId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	err = checkCIDROverlap(getVPCCIDRList(requesterVPCInfo), getVPCCIDRList(accepterVPCInfo))
+	if err != nil {
+		cblog.Error(err)
+		return nil, fmt.Errorf(rsVPC + "-" + reqInfo.RequesterVPCIID.NameId + " and " + rsVPC + "-" + reqInfo.AccepterVPCIID.NameId + " can not be peered: " + err.Error())
 	}
+	reqInfo.RequesterVPCIID = requesterIIDInfo.IId
+	reqInfo.AccepterVPCIID = accepterIIDInfo.IId
 
-	if vmInfo.KeyPairIId.SystemId != "" {
-		// set KeyPair NameId
-		IIdInfo, err := iidRWLock.GetIIDbySystemID(ConnectionName, rsKey, vmInfo.KeyPairIId)
-		if err != nil {
-			cblog.Error(err)
-			return err
+	// (3) create Resource
+	info, err := handler.RequestVPCPeering(reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (4) insert IID
+	iidInfo, err := iidRWLock.CreateIID(connectionName, rsType, cres.IID{reqInfo.IId.NameId, info.IId.SystemId})
+	if err != nil {
+		cblog.Error(err)
+		// rollback
+		_, err2 := handler.DeleteVPCPeering(info.IId)
+		if err2 != nil {
+			cblog.Error(err2)
+			return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
 		}
-		vmInfo.KeyPairIId.NameId = IIdInfo.IId.NameId
+		return nil, err
 	}
 
-	return nil
+	// set ResourceInfo(IID.NameId)
+	info.IId.NameId = iidInfo.IId.NameId
+	info.RequesterVPCIID.NameId = requesterIIDInfo.IId.NameId
+	info.AccepterVPCIID.NameId = accepterIIDInfo.IId.NameId
+
+	return &info, nil
 }
 
 // (1) get IID(NameId)
-// (2) get resource(SystemId)
+// (2) accept CSP:VPCPeering(SystemId)
 // (3) set ResourceInfo(IID.NameId)
-func GetVM(connectionName string, rsType string, nameID string) (*cres.VMInfo, error) {
-	cblog.Info("call GetVM()")
+func AcceptVPCPeering(connectionName string, rsType string, nameID string) (*cres.VPCPeeringInfo, error) {
+	cblog.Info("call AcceptVPCPeering()")
 
 	cldConn, err := ccm.GetCloudConnection(connectionName)
 	if err != nil {
@@ -1363,14 +4402,14 @@ func GetVM(connectionName string, rsType string, nameID string) (*cres.VMInfo, e
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateVMHandler()
+	handler, err := cldConn.CreateVPCHandler()
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
 
-	vmRWLock.RLock()
-	defer vmRWLock.RUnlock()
+	vpcPeeringRWLock.Lock()
+	defer vpcPeeringRWLock.Unlock()
 	// (1) get IID(NameId)
 	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{nameID, ""})
 	if err != nil {
@@ -1378,8 +4417,8 @@ func GetVM(connectionName string, rsType string, nameID string) (*cres.VMInfo, e
 		return nil, err
 	}
 
-	// (2) get resource(SystemId)
-	info, err := handler.GetVM(iidInfo.IId)
+	// (2) accept CSP:VPCPeering(SystemId)
+	info, err := handler.AcceptVPCPeering(iidInfo.IId)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -1387,28 +4426,20 @@ func GetVM(connectionName string, rsType string, nameID string) (*cres.VMInfo, e
 
 	// (3) set ResourceInfo(IID.NameId)
 	info.IId.NameId = iidInfo.IId.NameId
-
-	err = getSetNameId(connectionName, &info)
+	err = getSetVPCPeeringNameId(connectionName, &info)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
 
-	// set sg NameId from VPCNameId-SecurityGroupNameId
-	// IID.NameID format => {VPC NameID} + sgDELIMITER + {SG NameID}
-	for i, sgIID := range info.SecurityGroupIIds {
-		vpc_sg_nameid := strings.Split(sgIID.NameId, sgDELIMITER)
-		info.SecurityGroupIIds[i].NameId = vpc_sg_nameid[1]
-	}
-
 	return &info, nil
 }
 
 // (1) get IID:list
-// (2) get CSP:VMStatuslist
-// (3) filtering CSP-VMStatuslist by IID-list
-func ListVMStatus(connectionName string, rsType string) ([]*cres.VMStatusInfo, error) {
-	cblog.Info("call ListVMStatus()")
+// (2) get CSP:list
+// (3) filtering CSP-list by IID-list
+func ListVPCPeering(connectionName string, rsType string) ([]*cres.VPCPeeringInfo, error) {
+	cblog.Info("call ListVPCPeering()")
 
 	cldConn, err := ccm.GetCloudConnection(connectionName)
 	if err != nil {
@@ -1416,14 +4447,14 @@ func ListVMStatus(connectionName string, rsType string) ([]*cres.VMStatusInfo, e
 		return nil, err
 	}
 
-	handler, err := cldConn.CreateVMHandler()
+	handler, err := cldConn.CreateVPCHandler()
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
 
-	vmRWLock.RLock()
-	defer vmRWLock.RUnlock()
+	vpcPeeringRWLock.RLock()
+	defer vpcPeeringRWLock.RUnlock()
 	// (1) get IID:list
 	iidInfoList, err := iidRWLock.ListIID(connectionName, rsType)
 	if err != nil {
@@ -1431,14 +4462,14 @@ func ListVMStatus(connectionName string, rsType string) ([]*cres.VMStatusInfo, e
 		return nil, err
 	}
 
-	var infoList []*cres.VMStatusInfo
+	var infoList []*cres.VPCPeeringInfo
 	if iidInfoList == nil || len(iidInfoList) <= 0 {
-		infoList = []*cres.VMStatusInfo{}
+		infoList = []*cres.VPCPeeringInfo{}
 		return infoList, nil
 	}
 
-	// (2) get CSP:VMStatuslist
-	infoList, err = handler.ListVMStatus()
+	// (2) get CSP:list
+	infoList, err = handler.ListVPCPeering()
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -1447,13 +4478,19 @@ func ListVMStatus(connectionName string, rsType string) ([]*cres.VMStatusInfo, e
 		return nil, fmt.Errorf("<IID-CSP mismatch> " + rsType + " IID List has " + strconv.Itoa(len(iidInfoList)) + ", but " + connectionName + " Resource list has nothing!")
 	}
 
-	// (3) filtering CSP-VMStatuslist by IID-list
-	infoList2 := []*cres.VMStatusInfo{}
+	// (3) filtering CSP-list by IID-list
+	infoList2 := []*cres.VPCPeeringInfo{}
 	for _, iidInfo := range iidInfoList {
 		exist := false
 		for _, info := range infoList {
 			if iidInfo.IId.SystemId == info.IId.SystemId {
+				// set ResourceInfo(IID.NameId)
 				info.IId.NameId = iidInfo.IId.NameId
+				err = getSetVPCPeeringNameId(connectionName, info)
+				if err != nil {
+					cblog.Error(err)
+					return nil, err
+				}
 				infoList2 = append(infoList2, info)
 				exist = true
 			}
@@ -1467,89 +4504,48 @@ func ListVMStatus(connectionName string, rsType string) ([]*cres.VMStatusInfo, e
 }
 
 // (1) get IID(NameId)
-// (2) get CSP:VMStatus(SystemId)
-func GetVMStatus(connectionName string, rsType string, nameID string) (cres.VMStatus, error) {
-	cblog.Info("call GetVMStatus()")
+// (2) get resource(SystemId)
+// (3) set ResourceInfo(IID.NameId)
+func GetVPCPeering(connectionName string, rsType string, nameID string) (*cres.VPCPeeringInfo, error) {
+	cblog.Info("call GetVPCPeering()")
 
 	cldConn, err := ccm.GetCloudConnection(connectionName)
 	if err != nil {
 		cblog.Error(err)
-		return "", err
+		return nil, err
 	}
 
-	handler, err := cldConn.CreateVMHandler()
+	handler, err := cldConn.CreateVPCHandler()
 	if err != nil {
 		cblog.Error(err)
-		return "", err
+		return nil, err
 	}
 
-	vmRWLock.RLock()
-	defer vmRWLock.RUnlock()
+	vpcPeeringRWLock.RLock()
+	defer vpcPeeringRWLock.RUnlock()
 	// (1) get IID(NameId)
 	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{nameID, ""})
 	if err != nil {
 		cblog.Error(err)
-		return "", err
-	}
-
-	// (2) get CSP:VMStatus(SystemId)
-	info, err := handler.GetVMStatus(iidInfo.IId) // type of info => string
-	if err != nil {
-		cblog.Error(err)
-		return "", err
-	}
-
-	return info, nil
-}
-
-// (1) get IID(NameId)
-// (2) control CSP:VM(SystemId)
-func ControlVM(connectionName string, rsType string, nameID string, action string) (cres.VMStatus, error) {
-	cblog.Info("call ControlVM()")
-
-	cldConn, err := ccm.GetCloudConnection(connectionName)
-	if err != nil {
-		cblog.Error(err)
-		return "", err
-	}
-
-	handler, err := cldConn.CreateVMHandler()
-	if err != nil {
-		cblog.Error(err)
-		return "", err
+		return nil, err
 	}
 
-	vmRWLock.RLock()
-	defer vmRWLock.RUnlock()
-	// (1) get IID(NameId)
-	iidInfo, err := iidRWLock.GetIID(connectionName, rsType, cres.IID{nameID, ""})
+	// (2) get resource(SystemId)
+	info, err := handler.GetVPCPeering(iidInfo.IId)
 	if err != nil {
 		cblog.Error(err)
-		return "", err
+		return nil, err
 	}
 
-	// (2) control CSP:VM(SystemId)
-	vmIID := iidInfo.IId
-
-	var info cres.VMStatus
-
-	switch strings.ToLower(action) {
-	case "suspend":
-		info, err = handler.SuspendVM(vmIID)
-	case "resume":
-		info, err = handler.ResumeVM(vmIID)
-	case "reboot":
-		info, err = handler.RebootVM(vmIID)
-	default:
-		return "", fmt.Errorf(action + " is not a valid action!!")
-
-	}
+	// (3) set ResourceInfo(IID.NameId)
+	info.IId.NameId = iidInfo.IId.NameId
+	err = getSetVPCPeeringNameId(connectionName, &info)
 	if err != nil {
 		cblog.Error(err)
-		return "", err
+		return nil, err
 	}
 
-	return info, nil
+	return &info, nil
 }
 
 // list all Resources for management
@@ -1576,6 +4572,16 @@ func ListAllResource(connectionName string, rsType string) (AllResourceList, err
 		handler, err = cldConn.CreateKeyPairHandler()
 	case rsVM:
 		handler, err = cldConn.CreateVMHandler()
+	case rsNLB:
+		handler, err = cldConn.CreateNLBHandler()
+	case rsPublicIP:
+		handler, err = cldConn.CreatePublicIPHandler()
+	case rsVPCPeering:
+		handler, err = cldConn.CreateVPCHandler()
+	case rsDNSZone:
+		handler, err = cldConn.CreateDNSHandler()
+	case rsBucket:
+		handler, err = cldConn.CreateBucketHandler()
 	default:
 		return AllResourceList{}, fmt.Errorf(rsType + " is not supported Resource!!")
 	}
@@ -1598,6 +4604,21 @@ func ListAllResource(connectionName string, rsType string) (AllResourceList, err
 	case rsVM:
 		vmRWLock.RLock()
 		defer vmRWLock.RUnlock()
+	case rsNLB:
+		nlbRWLock.RLock()
+		defer nlbRWLock.RUnlock()
+	case rsPublicIP:
+		publicIPRWLock.RLock()
+		defer publicIPRWLock.RUnlock()
+	case rsVPCPeering:
+		vpcPeeringRWLock.RLock()
+		defer vpcPeeringRWLock.RUnlock()
+	case rsDNSZone:
+		dnsZoneRWLock.RLock()
+		defer dnsZoneRWLock.RUnlock()
+	case rsBucket:
+		bucketRWLock.RLock()
+		defer bucketRWLock.RUnlock()
 	default:
 		return AllResourceList{}, fmt.Errorf(rsType + " is not supported Resource!!")
 	}
@@ -1663,6 +4684,61 @@ func ListAllResource(connectionName string, rsType string) (AllResourceList, err
 				iidCSPList = append(iidCSPList, &info.IId)
 			}
 		}
+	case rsNLB:
+		infoList, err := handler.(cres.NLBHandler).ListNLB()
+		if err != nil {
+			cblog.Error(err)
+			return AllResourceList{}, err
+		}
+		if infoList != nil {
+			for _, info := range infoList {
+				iidCSPList = append(iidCSPList, &info.IId)
+			}
+		}
+	case rsPublicIP:
+		infoList, err := handler.(cres.PublicIPHandler).ListPublicIP()
+		if err != nil {
+			cblog.Error(err)
+			return AllResourceList{}, err
+		}
+		if infoList != nil {
+			for _, info := range infoList {
+				iidCSPList = append(iidCSPList, &info.IId)
+			}
+		}
+	case rsVPCPeering:
+		infoList, err := handler.(cres.VPCHandler).ListVPCPeering()
+		if err != nil {
+			cblog.Error(err)
+			return AllResourceList{}, err
+		}
+		if infoList != nil {
+			for _, info := range infoList {
+				iidCSPList = append(iidCSPList, &info.IId)
+			}
+		}
+	case rsDNSZone:
+		infoList, err := handler.(cres.DNSHandler).ListZone()
+		if err != nil {
+			cblog.Error(err)
+			return AllResourceList{}, err
+		}
+		if infoList != nil {
+			for _, info := range infoList {
+				iidCSPList = append(iidCSPList, &info.IId)
+			}
+		}
+	case rsBucket:
+		infoList, err := handler.(cres.BucketHandler).ListBucket()
+		if err != nil {
+			cblog.Error(err)
+			return AllResourceList{}, err
+		}
+		if infoList != nil {
+			for _, info := range infoList {
+				iidCSPList = append(iidCSPList, &info.IId)
+			}
+		}
 	default:
 		return AllResourceList{}, fmt.Errorf(rsType + " is not supported Resource!!")
 	}
@@ -1781,6 +4857,16 @@ func DeleteResource(connectionName string, rsType string, nameID string, force s
 		handler, err = cldConn.CreateKeyPairHandler()
 	case rsVM:
 		handler, err = cldConn.CreateVMHandler()
+	case rsNLB:
+		handler, err = cldConn.CreateNLBHandler()
+	case rsPublicIP:
+		handler, err = cldConn.CreatePublicIPHandler()
+	case rsVPCPeering:
+		handler, err = cldConn.CreateVPCHandler()
+	case rsDNSZone:
+		handler, err = cldConn.CreateDNSHandler()
+	case rsBucket:
+		handler, err = cldConn.CreateBucketHandler()
 	default:
 		return false, "", fmt.Errorf(rsType + " is not supported Resource!!")
 	}
@@ -1802,6 +4888,21 @@ func DeleteResource(connectionName string, rsType string, nameID string, force s
 	case rsVM:
 		vmRWLock.Lock()
 		defer vmRWLock.Unlock()
+	case rsNLB:
+		nlbRWLock.Lock()
+		defer nlbRWLock.Unlock()
+	case rsPublicIP:
+		publicIPRWLock.Lock()
+		defer publicIPRWLock.Unlock()
+	case rsVPCPeering:
+		vpcPeeringRWLock.Lock()
+		defer vpcPeeringRWLock.Unlock()
+	case rsDNSZone:
+		dnsZoneRWLock.Lock()
+		defer dnsZoneRWLock.Unlock()
+	case rsBucket:
+		bucketRWLock.Lock()
+		defer bucketRWLock.Unlock()
 	default:
 		return false, "", fmt.Errorf(rsType + " is not supported Resource!!")
 	}
@@ -1851,6 +4952,46 @@ func DeleteResource(connectionName string, rsType string, nameID string, force s
 				return false, "", err
 			}
 		}
+	case rsNLB:
+		result, err = handler.(cres.NLBHandler).DeleteNLB(iidInfo.IId)
+		if err != nil {
+			cblog.Error(err)
+			if force != "true" {
+				return false, "", err
+			}
+		}
+	case rsPublicIP:
+		result, err = handler.(cres.PublicIPHandler).ReleasePublicIP(iidInfo.IId)
+		if err != nil {
+			cblog.Error(err)
+			if force != "true" {
+				return false, "", err
+			}
+		}
+	case rsVPCPeering:
+		result, err = handler.(cres.VPCHandler).DeleteVPCPeering(iidInfo.IId)
+		if err != nil {
+			cblog.Error(err)
+			if force != "true" {
+				return false, "", err
+			}
+		}
+	case rsDNSZone:
+		result, err = handler.(cres.DNSHandler).DeleteZone(iidInfo.IId)
+		if err != nil {
+			cblog.Error(err)
+			if force != "true" {
+				return false, "", err
+			}
+		}
+	case rsBucket:
+		result, err = handler.(cres.BucketHandler).DeleteBucket(iidInfo.IId)
+		if err != nil {
+			cblog.Error(err)
+			if force != "true" {
+				return false, "", err
+			}
+		}
 	case rsVM:
 		vmStatus, err = handler.(cres.VMHandler).TerminateVM(iidInfo.IId)
 		if err != nil {
@@ -1882,6 +5023,7 @@ func DeleteResource(connectionName string, rsType string, nameID string, force s
 
 	// if VPC
 	if rsType == rsVPC {
+		deleteVPCCIDR(connectionName, iidInfo.IId.NameId)
 		// for Subnet list
 		// key-value structure: /{ConnectionName}/rsSubnetPrefix+{VPC-NameId}/{Subnet-IId}
 		subnetInfoList, err2 := iidRWLock.ListIID(connectionName, rsSubnetPrefix+iidInfo.IId.NameId)
@@ -1901,9 +5043,40 @@ func DeleteResource(connectionName string, rsType string, nameID string, force s
 				}
 			}
 		}
+		// for NAT Gateway list
+		// key-value structure: /{ConnectionName}/rsNATGatewayPrefix+{VPC-NameId}/{NATGateway-IId}
+		natIIDInfoList, err2 := iidRWLock.ListIID(connectionName, rsNATGatewayPrefix+iidInfo.IId.NameId)
+		if err2 != nil {
+			cblog.Error(err2)
+			if force != "true" {
+				return false, "", err2
+			}
+		}
+		for _, natIIDInfo := range natIIDInfoList {
+			_, err := iidRWLock.DeleteIID(connectionName, rsNATGatewayPrefix+iidInfo.IId.NameId, natIIDInfo.IId)
+			if err != nil {
+				cblog.Error(err)
+				if force != "true" {
+					return false, "", err
+				}
+			}
+		}
+	}
+
+	// if DNS Zone
+	if rsType == rsDNSZone {
+		err = deleteDNSRecordIIDs(connectionName, iidInfo.IId.NameId)
+		if err != nil {
+			cblog.Error(err)
+			if force != "true" {
+				return false, "", err
+			}
+		}
 	}
 
 	if rsType == rsVM {
+		// deregister the A record registered by StartVM
+		deregisterVMDNSRecord(connectionName, cldConn, iidInfo.IId.NameId)
 		return result, vmStatus, nil
 	} else {
 		return result, "", nil
@@ -1931,6 +5104,16 @@ func DeleteCSPResource(connectionName string, rsType string, systemID string) (b
 		handler, err = cldConn.CreateKeyPairHandler()
 	case rsVM:
 		handler, err = cldConn.CreateVMHandler()
+	case rsNLB:
+		handler, err = cldConn.CreateNLBHandler()
+	case rsPublicIP:
+		handler, err = cldConn.CreatePublicIPHandler()
+	case rsVPCPeering:
+		handler, err = cldConn.CreateVPCHandler()
+	case rsDNSZone:
+		handler, err = cldConn.CreateDNSHandler()
+	case rsBucket:
+		handler, err = cldConn.CreateBucketHandler()
 	default:
 		return false, "", fmt.Errorf(rsType + " is not supported Resource!!")
 	}
@@ -1963,6 +5146,36 @@ func DeleteCSPResource(connectionName string, rsType string, systemID string) (b
 			cblog.Error(err)
 			return false, "", err
 		}
+	case rsNLB:
+		result, err = handler.(cres.NLBHandler).DeleteNLB(iid)
+		if err != nil {
+			cblog.Error(err)
+			return false, "", err
+		}
+	case rsPublicIP:
+		result, err = handler.(cres.PublicIPHandler).ReleasePublicIP(iid)
+		if err != nil {
+			cblog.Error(err)
+			return false, "", err
+		}
+	case rsVPCPeering:
+		result, err = handler.(cres.VPCHandler).DeleteVPCPeering(iid)
+		if err != nil {
+			cblog.Error(err)
+			return false, "", err
+		}
+	case rsDNSZone:
+		result, err = handler.(cres.DNSHandler).DeleteZone(iid)
+		if err != nil {
+			cblog.Error(err)
+			return false, "", err
+		}
+	case rsBucket:
+		result, err = handler.(cres.BucketHandler).DeleteBucket(iid)
+		if err != nil {
+			cblog.Error(err)
+			return false, "", err
+		}
 	case rsVM:
 		vmStatus, err = handler.(cres.VMHandler).TerminateVM(iid)
 		if err != nil {
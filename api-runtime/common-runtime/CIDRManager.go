@@ -0,0 +1,646 @@
+// Cloud Control Manager's Common Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// CIDR planning and validation of VPC, Subnets and Security Rules.
+//
+// by CB-Spider Team, 2020.10.
+
+package commonruntime
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
+)
+
+// CIDR rule of a CSP
+type cidrRule struct {
+	vpcPrefixMin     int      // largest VPC, ex) 16 => /16
+	vpcPrefixMax     int      // smallest VPC, ex) 28 => /28
+	subnetPrefixMin  int      // largest Subnet
+	subnetPrefixMax  int      // smallest Subnet
+	reservedCIDRList []string // CIDRs which can not be used in a VPC
+}
+
+// reserved ranges of all CSPs: this network, loopback, link-local, multicast and class E.
+var commonReservedCIDRList = []string{"0.0.0.0/8", "127.0.0.0/8", "169.254.0.0/16", "224.0.0.0/4", "240.0.0.0/4"}
+
+// key: upper case of ProviderName
+var cidrRuleMap = map[string]cidrRule{
+	"AWS":     {16, 28, 16, 28, commonReservedCIDRList},
+	"AZURE":   {8, 29, 8, 29, append([]string{"168.63.129.16/32"}, commonReservedCIDRList...)},
+	"GCP":     {8, 29, 8, 29, commonReservedCIDRList},
+	"ALIBABA": {8, 28, 16, 29, append([]string{"100.64.0.0/10"}, commonReservedCIDRList...)},
+}
+
+var defaultCIDRRule = cidrRule{0, 32, 0, 32, commonReservedCIDRList}
+
+// A Subnet CIDR with only a prefix length(ex: "/24") is allocated automatically
+// with the next free range of the VPC.
+func getAutoAllocPrefix(cidr string) (int, bool) {
+	if !strings.HasPrefix(cidr, "/") {
+		return 0, false
+	}
+	prefixLen, err := strconv.Atoi(strings.TrimPrefix(cidr, "/"))
+	if err != nil {
+		return 0, false
+	}
+	return prefixLen, true
+}
+
+func getCIDRRule(connectionName string) (cidrRule, error) {
+	connInfo, err := ccim.GetConnectionConfig(connectionName)
+	if err != nil {
+		return cidrRule{}, err
+	}
+	if rule, ok := cidrRuleMap[strings.ToUpper(connInfo.ProviderName)]; ok {
+		return rule, nil
+	}
+	return defaultCIDRRule, nil
+}
+
+// (1) check VPC CIDR
+// (2) check Subnet CIDRs given by user
+// (3) allocate Subnet CIDRs in auto-allocate mode
+// (4) check IPv6 CIDRs of a dual-stack VPC
+func validateVPCCIDR(connectionName string, reqInfo *cres.VPCReqInfo) error {
+	rule, err := getCIDRRule(connectionName)
+	if err != nil {
+		return err
+	}
+
+	// (1) check VPC CIDR
+	var vpcNet *net.IPNet
+	if reqInfo.IPv4_CIDR != "" {
+		vpcNet, err = parseIPv4CIDR(reqInfo.IPv4_CIDR)
+		if err != nil {
+			return err
+		}
+		err = checkCIDRRule(vpcNet, rule.vpcPrefixMin, rule.vpcPrefixMax, rule.reservedCIDRList)
+		if err != nil {
+			return fmt.Errorf("VPC " + err.Error())
+		}
+		if capability, err := ccm.GetDriverCapabilityByConnectionName(connectionName); err == nil && !capability.VPC_CIDR {
+			cblog.Warn(connectionName + ": the driver does not support VPC CIDR, so " + reqInfo.IPv4_CIDR + " is ignored.")
+		}
+	}
+
+	// (2) check Subnet CIDRs given by user
+	usedNetList := []*net.IPNet{}
+	for i, subnetInfo := range reqInfo.SubnetInfoList {
+		if _, ok := getAutoAllocPrefix(subnetInfo.IPv4_CIDR); ok {
+			continue
+		}
+		subnetNet, err := checkSubnetCIDR(rule, vpcNet, usedNetList, subnetInfo)
+		if err != nil {
+			return err
+		}
+		reqInfo.SubnetInfoList[i].IPv4_CIDR = subnetNet.String()
+		usedNetList = append(usedNetList, subnetNet)
+	}
+
+	// (3) allocate Subnet CIDRs in auto-allocate mode
+	for i, subnetInfo := range reqInfo.SubnetInfoList {
+		if _, ok := getAutoAllocPrefix(subnetInfo.IPv4_CIDR); !ok {
+			continue
+		}
+		subnetNet, err := allocSubnetCIDR(rule, vpcNet, usedNetList, subnetInfo)
+		if err != nil {
+			return err
+		}
+		reqInfo.SubnetInfoList[i].IPv4_CIDR = subnetNet.String()
+		usedNetList = append(usedNetList, subnetNet)
+	}
+
+	// (4) check IPv6 CIDRs of a dual-stack VPC
+	return validateIPv6CIDR(connectionName, reqInfo.IPv6_CIDR, nil, reqInfo.SubnetInfoList)
+}
+
+// check or allocate CIDR of a Subnet added into an existing VPC.
+func validateSubnetCIDR(connectionName string, vpcInfo cres.VPCInfo, subnetInfo *cres.SubnetInfo) error {
+	rule, err := getCIDRRule(connectionName)
+	if err != nil {
+		return err
+	}
+
+	// some CSPs(ex: GCP) have no VPC CIDR.
+	vpcNet, _ := parseIPv4CIDR(vpcInfo.IPv4_CIDR)
+
+	usedNetList := []*net.IPNet{}
+	for _, existInfo := range vpcInfo.SubnetInfoList {
+		existNet, err := parseIPv4CIDR(existInfo.IPv4_CIDR)
+		if err != nil {
+			cblog.Error(err)
+			continue
+		}
+		usedNetList = append(usedNetList, existNet)
+	}
+
+	var subnetNet *net.IPNet
+	if _, ok := getAutoAllocPrefix(subnetInfo.IPv4_CIDR); ok {
+		subnetNet, err = allocSubnetCIDR(rule, vpcNet, usedNetList, *subnetInfo)
+	} else {
+		subnetNet, err = checkSubnetCIDR(rule, vpcNet, usedNetList, *subnetInfo)
+	}
+	if err != nil {
+		return err
+	}
+	subnetInfo.IPv4_CIDR = subnetNet.String()
+
+	return validateIPv6CIDR(connectionName, vpcInfo.IPv6_CIDR, vpcInfo.SubnetInfoList, []cres.SubnetInfo{*subnetInfo})
+}
+
+// IPv6 CIDRs are assigned by CSPs("auto") or given by user.
+// Subnet IPv6 CIDRs may be empty, then drivers assign the next /64 of the VPC IPv6 CIDR.
+// (1) check driver capability
+// (2) check VPC IPv6 CIDR
+// (3) check Subnet IPv6 CIDRs given by user
+func validateIPv6CIDR(connectionName string, vpcIPv6CIDR string, existSubnetList []cres.SubnetInfo, newSubnetList []cres.SubnetInfo) error {
+	if vpcIPv6CIDR == "" {
+		for _, subnetInfo := range newSubnetList {
+			if subnetInfo.IPv6_CIDR != "" {
+				return fmt.Errorf("Subnet " + subnetInfo.IId.NameId + ": IPv6 CIDR needs a dual-stack VPC with IPv6_CIDR!")
+			}
+		}
+		return nil
+	}
+
+	// (1) check driver capability
+	err := checkCapability(connectionName, "VPC_IPv6_CIDR",
+		func(capability idrv.DriverCapabilityInfo) bool { return capability.VPC_IPv6_CIDR })
+	if err != nil {
+		return err
+	}
+
+	// (2) check VPC IPv6 CIDR
+	var vpcNet *net.IPNet
+	if vpcIPv6CIDR != cres.IPv6AutoCIDR {
+		vpcNet, err = parseIPv6CIDR(vpcIPv6CIDR)
+		if err != nil {
+			return fmt.Errorf("VPC " + err.Error())
+		}
+	}
+
+	// (3) check Subnet IPv6 CIDRs given by user
+	usedNetList := []*net.IPNet{}
+	for _, existInfo := range existSubnetList {
+		if existNet, err := parseIPv6CIDR(existInfo.IPv6_CIDR); err == nil {
+			usedNetList = append(usedNetList, existNet)
+		}
+	}
+	for _, subnetInfo := range newSubnetList {
+		if subnetInfo.IPv6_CIDR == "" || subnetInfo.IPv6_CIDR == cres.IPv6AutoCIDR {
+			continue
+		}
+		subnetNet, err := parseIPv6CIDR(subnetInfo.IPv6_CIDR)
+		if err != nil {
+			return fmt.Errorf("Subnet " + subnetInfo.IId.NameId + ": " + err.Error())
+		}
+		if prefixLen, _ := subnetNet.Mask.Size(); prefixLen != 64 {
+			return fmt.Errorf("Subnet " + subnetInfo.IId.NameId + ": IPv6 CIDR " + subnetNet.String() + " must be a /64!")
+		}
+		if vpcNet != nil && !containsCIDR(vpcNet, subnetNet) {
+			return fmt.Errorf("Subnet " + subnetInfo.IId.NameId + " IPv6 CIDR " + subnetNet.String() + " is out of VPC IPv6 CIDR " + vpcNet.String() + "!")
+		}
+		for _, usedNet := range usedNetList {
+			if overlapCIDR(usedNet, subnetNet) {
+				return fmt.Errorf("Subnet " + subnetInfo.IId.NameId + " IPv6 CIDR " + subnetNet.String() + " overlaps with Subnet IPv6 CIDR " + usedNet.String() + "!")
+			}
+		}
+		usedNetList = append(usedNetList, subnetNet)
+	}
+
+	return nil
+}
+
+// CIDR of a Security Rule: "" means 0.0.0.0/0, IPv6 CIDR needs the driver capability.
+func validateSecurityRuleCIDR(connectionName string, ruleList *[]cres.SecurityRuleInfo) error {
+	if ruleList == nil {
+		return nil
+	}
+	for _, rule := range *ruleList {
+		if rule.CIDR == "" {
+			continue
+		}
+		ip, _, err := net.ParseCIDR(rule.CIDR)
+		if err != nil {
+			return fmt.Errorf("Security Rule: " + rule.CIDR + " is not a valid CIDR!")
+		}
+		if ip.To4() != nil {
+			continue
+		}
+		err = checkCapability(connectionName, "SECURITY_IPv6_RULE",
+			func(capability idrv.DriverCapabilityInfo) bool { return capability.SECURITY_IPv6_RULE })
+		if err != nil {
+			cblog.Error("Security Rule CIDR " + rule.CIDR + " is IPv6.")
+			return err
+		}
+	}
+	return nil
+}
+
+// (1) check CSP rule
+// (2) check containment in the VPC
+// (3) check overlap with other Subnets
+func checkSubnetCIDR(rule cidrRule, vpcNet *net.IPNet, usedNetList []*net.IPNet, subnetInfo cres.SubnetInfo) (*net.IPNet, error) {
+	subnetNet, err := parseIPv4CIDR(subnetInfo.IPv4_CIDR)
+	if err != nil {
+		return nil, fmt.Errorf("Subnet " + subnetInfo.IId.NameId + ": " + err.Error())
+	}
+
+	// (1) check CSP rule
+	err = checkCIDRRule(subnetNet, rule.subnetPrefixMin, rule.subnetPrefixMax, rule.reservedCIDRList)
+	if err != nil {
+		return nil, fmt.Errorf("Subnet " + subnetInfo.IId.NameId + " " + err.Error())
+	}
+
+	// (2) check containment in the VPC
+	if vpcNet != nil && !containsCIDR(vpcNet, subnetNet) {
+		return nil, fmt.Errorf("Subnet " + subnetInfo.IId.NameId + " CIDR " + subnetNet.String() + " is out of VPC CIDR " + vpcNet.String() + "!")
+	}
+
+	// (3) check overlap with other Subnets
+	for _, usedNet := range usedNetList {
+		if overlapCIDR(usedNet, subnetNet) {
+			return nil, fmt.Errorf("Subnet " + subnetInfo.IId.NameId + " CIDR " + subnetNet.String() + " overlaps with Subnet CIDR " + usedNet.String() + "!")
+		}
+	}
+
+	return subnetNet, nil
+}
+
+// the first free range of the prefix length in the VPC CIDR
+func allocSubnetCIDR(rule cidrRule, vpcNet *net.IPNet, usedNetList []*net.IPNet, subnetInfo cres.SubnetInfo) (*net.IPNet, error) {
+	prefixLen, _ := getAutoAllocPrefix(subnetInfo.IPv4_CIDR)
+	if vpcNet == nil {
+		return nil, fmt.Errorf("Subnet " + subnetInfo.IId.NameId + ": auto-allocated CIDR needs a VPC CIDR!")
+	}
+	vpcPrefixLen, _ := vpcNet.Mask.Size()
+	if prefixLen < vpcPrefixLen || prefixLen > 32 {
+		return nil, fmt.Errorf("Subnet " + subnetInfo.IId.NameId + ": /" + strconv.Itoa(prefixLen) + " can not be allocated in VPC CIDR " + vpcNet.String() + "!")
+	}
+	if prefixLen < rule.subnetPrefixMin || prefixLen > rule.subnetPrefixMax {
+		return nil, fmt.Errorf("Subnet " + subnetInfo.IId.NameId + ": prefix length must be between /" + strconv.Itoa(rule.subnetPrefixMin) + " and /" + strconv.Itoa(rule.subnetPrefixMax) + "!")
+	}
+
+	size := uint64(1) << uint(32-prefixLen)
+	first, last := ipv4Range(vpcNet)
+	for cur := first; cur+size-1 <= last; {
+		candidateNet := &net.IPNet{IP: uint32ToIPv4(uint32(cur)), Mask: net.CIDRMask(prefixLen, 32)}
+		next := uint64(0)
+		for _, usedNet := range usedNetList {
+			if overlapCIDR(usedNet, candidateNet) {
+				_, usedLast := ipv4Range(usedNet)
+				if usedLast+1 > next {
+					next = usedLast + 1
+				}
+			}
+		}
+		if next == 0 && checkReservedCIDR(candidateNet, rule.reservedCIDRList) == nil {
+			return candidateNet, nil
+		}
+		if next < cur+size {
+			next = cur + size
+		}
+		// align to the prefix length
+		cur = (next + size - 1) / size * size
+	}
+
+	return nil, fmt.Errorf("Subnet " + subnetInfo.IId.NameId + ": no free /" + strconv.Itoa(prefixLen) + " range in VPC CIDR " + vpcNet.String() + "!")
+}
+
+// CIDRs of the VPCs and Subnets are recorded in the IID store when they are created,
+// so the CIDR validation does not call the CSP and is not blocked by a CSP failure.
+// A VPC without the record(ex: created by an old version) is recorded with the CSP info at the first check.
+// The callers must hold vpcRWLock.
+
+// the VPC record is inserted after the Subnet records,
+// so a VPC record means that the Subnet records are complete.
+func insertVPCCIDR(connectionName string, vpcInfo cres.VPCInfo) error {
+	for _, subnetInfo := range vpcInfo.SubnetInfoList {
+		err := putCIDRIID(connectionName, rsSubnetCIDRPrefix+vpcInfo.IId.NameId,
+			cres.IID{subnetInfo.IId.NameId, encodeCIDR(subnetInfo.IPv4_CIDR, subnetInfo.IPv6_CIDR)})
+		if err != nil {
+			return err
+		}
+	}
+	return putCIDRIID(connectionName, rsVPCCIDR, cres.IID{vpcInfo.IId.NameId, encodeCIDR(vpcInfo.IPv4_CIDR, vpcInfo.IPv6_CIDR)})
+}
+
+// if the Subnet record fails, the VPC record is deleted to be recorded again with the CSP info.
+func insertSubnetCIDR(connectionName string, vpcName string, subnetInfo cres.SubnetInfo) {
+	err := putCIDRIID(connectionName, rsSubnetCIDRPrefix+vpcName,
+		cres.IID{subnetInfo.IId.NameId, encodeCIDR(subnetInfo.IPv4_CIDR, subnetInfo.IPv6_CIDR)})
+	if err != nil {
+		cblog.Error(err)
+		iidRWLock.DeleteIID(connectionName, rsVPCCIDR, cres.IID{vpcName, ""})
+	}
+}
+
+func deleteSubnetCIDR(connectionName string, vpcName string, subnetName string) {
+	_, err := iidRWLock.DeleteIID(connectionName, rsSubnetCIDRPrefix+vpcName, cres.IID{subnetName, ""})
+	if err != nil {
+		cblog.Error(err)
+		iidRWLock.DeleteIID(connectionName, rsVPCCIDR, cres.IID{vpcName, ""})
+	}
+}
+
+func deleteVPCCIDR(connectionName string, vpcName string) {
+	// the VPC record first, so the Subnet records left by a failure are not used.
+	if _, err := iidRWLock.DeleteIID(connectionName, rsVPCCIDR, cres.IID{vpcName, ""}); err != nil {
+		cblog.Error(err)
+	}
+	subnetIIDInfoList, err := iidRWLock.ListIID(connectionName, rsSubnetCIDRPrefix+vpcName)
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	for _, subnetIIDInfo := range subnetIIDInfoList {
+		if _, err := iidRWLock.DeleteIID(connectionName, rsSubnetCIDRPrefix+vpcName, subnetIIDInfo.IId); err != nil {
+			cblog.Error(err)
+		}
+	}
+}
+
+// CIDRs of the VPC and its Subnets with NameIds.
+// vpcIID must have the SystemId for a VPC without the record.
+func getVPCCIDRInfo(connectionName string, handler cres.VPCHandler, vpcIID cres.IID) (cres.VPCInfo, error) {
+	bool_ret, err := iidRWLock.IsExistIID(connectionName, rsVPCCIDR, vpcIID)
+	if err != nil {
+		return cres.VPCInfo{}, err
+	}
+	if bool_ret == false {
+		return recordVPCCIDR(connectionName, handler, vpcIID)
+	}
+
+	vpcCIDRIIDInfo, err := iidRWLock.GetIID(connectionName, rsVPCCIDR, cres.IID{vpcIID.NameId, ""})
+	if err != nil {
+		return cres.VPCInfo{}, err
+	}
+	vpcInfo := cres.VPCInfo{IId: vpcIID}
+	vpcInfo.IPv4_CIDR, vpcInfo.IPv6_CIDR = decodeCIDR(vpcCIDRIIDInfo.IId.SystemId)
+
+	subnetIIDInfoList, err := iidRWLock.ListIID(connectionName, rsSubnetCIDRPrefix+vpcIID.NameId)
+	if err != nil {
+		return cres.VPCInfo{}, err
+	}
+	for _, subnetIIDInfo := range subnetIIDInfoList {
+		subnetInfo := cres.SubnetInfo{IId: cres.IID{NameId: subnetIIDInfo.IId.NameId}}
+		subnetInfo.IPv4_CIDR, subnetInfo.IPv6_CIDR = decodeCIDR(subnetIIDInfo.IId.SystemId)
+		vpcInfo.SubnetInfoList = append(vpcInfo.SubnetInfoList, subnetInfo)
+	}
+	return vpcInfo, nil
+}
+
+// record the CIDRs of a VPC with the CSP info.
+// the Subnets which are not created by Spider are checked, but not recorded.
+func recordVPCCIDR(connectionName string, handler cres.VPCHandler, vpcIID cres.IID) (cres.VPCInfo, error) {
+	vpcInfo, err := handler.GetVPC(vpcIID)
+	if err != nil {
+		return cres.VPCInfo{}, err
+	}
+	vpcInfo.IId = vpcIID
+
+	recordInfo := vpcInfo
+	recordInfo.SubnetInfoList = nil
+	for i, subnetInfo := range vpcInfo.SubnetInfoList {
+		subnetIIDInfo, err := iidRWLock.GetIIDbySystemID(connectionName, rsSubnetPrefix+vpcIID.NameId, subnetInfo.IId)
+		if err != nil {
+			return cres.VPCInfo{}, err
+		}
+		if subnetIIDInfo.IId.NameId == "" {
+			continue
+		}
+		vpcInfo.SubnetInfoList[i].IId.NameId = subnetIIDInfo.IId.NameId
+		recordInfo.SubnetInfoList = append(recordInfo.SubnetInfoList, vpcInfo.SubnetInfoList[i])
+	}
+
+	// the check goes on without the record, it is recorded again at the next check.
+	err = insertVPCCIDR(connectionName, recordInfo)
+	if err != nil {
+		cblog.Error(err)
+	}
+	return vpcInfo, nil
+}
+
+func putCIDRIID(connectionName string, rsType string, iid cres.IID) error {
+	bool_ret, err := iidRWLock.IsExistIID(connectionName, rsType, iid)
+	if err != nil {
+		return err
+	}
+	if bool_ret == true {
+		_, err = iidRWLock.UpdateIID(connectionName, rsType, iid)
+	} else {
+		_, err = iidRWLock.CreateIID(connectionName, rsType, iid)
+	}
+	return err
+}
+
+func encodeCIDR(ipv4CIDR string, ipv6CIDR string) string {
+	return ipv4CIDR + "," + ipv6CIDR
+}
+
+func decodeCIDR(value string) (string, string) {
+	cidrList := strings.SplitN(value, ",", 2)
+	if len(cidrList) < 2 {
+		return cidrList[0], ""
+	}
+	return cidrList[0], cidrList[1]
+}
+
+// fixed private IP of a VM NIC
+type vmFixedIP struct {
+	subnetIID cres.IID
+	ip        string
+}
+
+// A fixed private IP of a VM NIC must be a host address of the Subnet CIDR,
+// and must not be used by other VMs of Spider in the Subnet.
+// SystemIds of reqInfo must be set before calling,
+// and the lock of getVMPrivateIPLock(connectionName) must be held until the IID of the VM is inserted.
+// (1) collect fixed IPs of the primary and secondary NICs
+// (2) check fixed IPs with Subnet CIDRs
+// (3) check fixed IPs used by other VMs
+func validateVMPrivateIP(connectionName string, reqInfo cres.VMReqInfo) error {
+	// (1) collect fixed IPs of the primary and secondary NICs
+	fixedIPList := []vmFixedIP{}
+	if reqInfo.PrivateIP != "" {
+		fixedIPList = append(fixedIPList, vmFixedIP{reqInfo.SubnetIID, reqInfo.PrivateIP})
+	}
+	for _, nicReqInfo := range reqInfo.NetworkInterfaceList {
+		if nicReqInfo.PrivateIP != "" {
+			fixedIPList = append(fixedIPList, vmFixedIP{nicReqInfo.SubnetIID, nicReqInfo.PrivateIP})
+		}
+	}
+	if len(fixedIPList) == 0 {
+		return nil
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		return err
+	}
+
+	// (2) check fixed IPs with Subnet CIDRs
+	vpcHandler, err := cldConn.CreateVPCHandler()
+	if err != nil {
+		return err
+	}
+	vpcRWLock.RLock()
+	vpcInfo, err := vpcHandler.GetVPC(reqInfo.VpcIID)
+	vpcRWLock.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	reqIPMap := map[string]bool{}
+	for _, fixedIP := range fixedIPList {
+		ip := net.ParseIP(fixedIP.ip)
+		if ip == nil || ip.To4() == nil {
+			return fmt.Errorf("PrivateIP " + fixedIP.ip + " is not an IPv4 address!")
+		}
+		var subnetNet *net.IPNet
+		for _, subnetInfo := range vpcInfo.SubnetInfoList {
+			if subnetInfo.IId.SystemId == fixedIP.subnetIID.SystemId {
+				_, subnetNet, err = net.ParseCIDR(subnetInfo.IPv4_CIDR)
+				if err != nil {
+					return err
+				}
+				break
+			}
+		}
+		if subnetNet == nil {
+			return fmt.Errorf("Subnet " + fixedIP.subnetIID.NameId + " does not exist in VPC " + reqInfo.VpcIID.NameId + "!")
+		}
+		// the network and broadcast addresses can not be used.
+		first, last := ipv4Range(subnetNet)
+		n := uint64(binary.BigEndian.Uint32(ip.To4()))
+		if !subnetNet.Contains(ip) || n == first || n == last {
+			return fmt.Errorf("PrivateIP " + fixedIP.ip + " is not a host address of Subnet " + fixedIP.subnetIID.NameId + "(" + subnetNet.String() + ")!")
+		}
+		if reqIPMap[ip.String()] {
+			return fmt.Errorf("PrivateIP " + fixedIP.ip + " is requested more than once!")
+		}
+		reqIPMap[ip.String()] = true
+	}
+
+	// (3) check fixed IPs used by other VMs
+	iidInfoList, err := iidRWLock.ListIID(connectionName, rsVM)
+	if err != nil {
+		return err
+	}
+	if len(iidInfoList) == 0 {
+		return nil
+	}
+	spiderVMMap := map[string]string{} // SystemId => NameId
+	for _, iidInfo := range iidInfoList {
+		spiderVMMap[iidInfo.IId.SystemId] = iidInfo.IId.NameId
+	}
+
+	vmHandler, err := cldConn.CreateVMHandler()
+	if err != nil {
+		return err
+	}
+	vmInfoList, err := vmHandler.ListVM()
+	if err != nil {
+		return err
+	}
+	for _, vmInfo := range vmInfoList {
+		vmName, ok := spiderVMMap[vmInfo.IId.SystemId]
+		if !ok {
+			continue
+		}
+		usedIPList := []vmFixedIP{{vmInfo.SubnetIID, vmInfo.PrivateIP}}
+		for _, nicInfo := range vmInfo.NetworkInterfaceList {
+			usedIPList = append(usedIPList, vmFixedIP{nicInfo.SubnetIID, nicInfo.PrivateIP})
+		}
+		for _, usedIP := range usedIPList {
+			for _, fixedIP := range fixedIPList {
+				if usedIP.subnetIID.SystemId == fixedIP.subnetIID.SystemId && usedIP.ip == fixedIP.ip {
+					return fmt.Errorf("PrivateIP " + fixedIP.ip + " is already used by VM " + vmName + "!")
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func parseIPv4CIDR(cidr string) (*net.IPNet, error) {
+	ip, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf(cidr + " is not a valid CIDR!")
+	}
+	if ip.To4() == nil {
+		return nil, fmt.Errorf(cidr + " is not an IPv4 CIDR!")
+	}
+	if !ip.Equal(ipNet.IP) {
+		return nil, fmt.Errorf(cidr + " has host bits, use " + ipNet.String() + "!")
+	}
+	return ipNet, nil
+}
+
+func parseIPv6CIDR(cidr string) (*net.IPNet, error) {
+	ip, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf(cidr + " is not a valid CIDR!")
+	}
+	if ip.To4() != nil {
+		return nil, fmt.Errorf(cidr + " is not an IPv6 CIDR!")
+	}
+	if !ip.Equal(ipNet.IP) {
+		return nil, fmt.Errorf(cidr + " has host bits, use " + ipNet.String() + "!")
+	}
+	return ipNet, nil
+}
+
+func checkCIDRRule(ipNet *net.IPNet, prefixMin int, prefixMax int, reservedCIDRList []string) error {
+	prefixLen, _ := ipNet.Mask.Size()
+	if prefixLen < prefixMin || prefixLen > prefixMax {
+		return fmt.Errorf("CIDR " + ipNet.String() + ": prefix length must be between /" + strconv.Itoa(prefixMin) + " and /" + strconv.Itoa(prefixMax) + "!")
+	}
+	return checkReservedCIDR(ipNet, reservedCIDRList)
+}
+
+func checkReservedCIDR(ipNet *net.IPNet, reservedCIDRList []string) error {
+	for _, reservedCIDR := range reservedCIDRList {
+		_, reservedNet, _ := net.ParseCIDR(reservedCIDR)
+		if overlapCIDR(reservedNet, ipNet) {
+			return fmt.Errorf("CIDR " + ipNet.String() + " overlaps with the reserved range " + reservedCIDR + "!")
+		}
+	}
+	return nil
+}
+
+func containsCIDR(outer *net.IPNet, inner *net.IPNet) bool {
+	outerPrefixLen, _ := outer.Mask.Size()
+	innerPrefixLen, _ := inner.Mask.Size()
+	return outerPrefixLen <= innerPrefixLen && outer.Contains(inner.IP)
+}
+
+func overlapCIDR(net1 *net.IPNet, net2 *net.IPNet) bool {
+	return net1.Contains(net2.IP) || net2.Contains(net1.IP)
+}
+
+// first and last address of an IPv4 CIDR as integers
+func ipv4Range(ipNet *net.IPNet) (uint64, uint64) {
+	prefixLen, _ := ipNet.Mask.Size()
+	first := uint64(binary.BigEndian.Uint32(ipNet.IP.To4()))
+	return first, first + (uint64(1) << uint(32-prefixLen)) - 1
+}
+
+func uint32ToIPv4(n uint32) net.IP {
+	ip := make(net.IP, 4)
+	binary.BigEndian.PutUint32(ip, n)
+	return ip
+}
@@ -0,0 +1,596 @@
+// Cloud Info Manager's Common Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package commonruntime
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
+	im "github.com/cloud-barista/cb-spider/cloud-info-manager"
+	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
+	dim "github.com/cloud-barista/cb-spider/cloud-info-manager/driver-info-manager"
+	imim "github.com/cloud-barista/cb-spider/cloud-info-manager/image-map-info-manager"
+	rim "github.com/cloud-barista/cb-spider/cloud-info-manager/region-info-manager"
+	icbs "github.com/cloud-barista/cb-store/interfaces"
+)
+
+// locks the dependency check and the change of the connection configs.
+var cimLock = new(sync.Mutex)
+
+// returned when a CIM object is used by other objects.
+// Dependents: "{kind}/{name}", ex) "connectionconfig/aws-ohio-config", "imagemap/ubuntu"
+// IIDs: the resources of the connection configs, which remain in the CSP if their IIDs are deleted.
+//       "{ResourceType}/{NameId}" of a connection config, ex) "vm/vm-01"
+//       "connectionconfig/{ConfigName}/{ResourceType}/{NameId}" of the dependent connection configs
+type DependentError struct {
+	Kind       string
+	Name       string
+	Dependents []string
+	IIDs       []string
+}
+
+func (e *DependentError) Error() string {
+	if len(e.IIDs) == 0 {
+		return fmt.Sprintf("%s(%s) is used by [%s]. Delete them first, or use the cascade option!",
+			e.Kind, e.Name, strings.Join(e.Dependents, ", "))
+	}
+	if len(e.Dependents) == 0 {
+		return fmt.Sprintf("%s(%s) has the resources [%s], which remain in the CSP if their IIDs are deleted. "+
+			"Delete the resources first, or use the cascade and orphan options!",
+			e.Kind, e.Name, strings.Join(e.IIDs, ", "))
+	}
+	return fmt.Sprintf("%s(%s) is used by [%s], and the resources [%s] remain in the CSP if their IIDs are deleted. "+
+		"Delete the resources first, or use the cascade and orphan options!",
+		e.Kind, e.Name, strings.Join(e.Dependents, ", "), strings.Join(e.IIDs, ", "))
+}
+
+func IsDependentError(err error) bool {
+	_, ok := err.(*DependentError)
+	return ok
+}
+
+// The admin APIs, ex) the online rotation of the credential master key,
+// need the token of CBSPIDER_ADMIN_TOKEN in the Spider-Admin-Token header(REST) or metadata(gRPC).
+// They are disabled if CBSPIDER_ADMIN_TOKEN is not set.
+const (
+	AdminTokenEnv    string = "CBSPIDER_ADMIN_TOKEN"
+	AdminTokenHeader string = "Spider-Admin-Token"
+)
+
+// returned when the admin token of a request is not valid.
+type AdminTokenError struct {
+	Message string
+}
+
+func (e *AdminTokenError) Error() string {
+	return e.Message
+}
+
+func IsAdminTokenError(err error) bool {
+	_, ok := err.(*AdminTokenError)
+	return ok
+}
+
+func checkAdminToken(token string) error {
+	adminToken := os.Getenv(AdminTokenEnv)
+	if adminToken == "" {
+		return &AdminTokenError{AdminTokenEnv + " is not set, so the admin API is disabled. Use utils/credential-key instead!"}
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) != 1 {
+		return &AdminTokenError{AdminTokenHeader + " is not valid!"}
+	}
+	return nil
+}
+
+// reload the master keys from the env and the key file,
+// and re-encrypt all credentials with the current key.
+// returns the current KeyID and the number of rotated values.
+func RotateCredentialKey(adminToken string) (string, int, error) {
+	cblog.Info("call RotateCredentialKey()")
+
+	err := checkAdminToken(adminToken)
+	if err != nil {
+		cblog.Error(err)
+		return "", 0, err
+	}
+
+	err = cim.LoadCredentialKeys()
+	if err != nil {
+		return "", 0, err
+	}
+
+	count, err := cim.RotateCredentialKey()
+	if err != nil {
+		return "", 0, err
+	}
+
+	keyID, err := cim.CurrentCredentialKeyID()
+	if err != nil {
+		return "", 0, err
+	}
+	return keyID, count, nil
+}
+
+//================ ConnectionConfig Create
+// (1) check the driver, credential and region of the config
+// (2) create the config
+func CreateConnectionConfig(configInfo ccim.ConnectionConfigInfo) (*ccim.ConnectionConfigInfo, error) {
+	cblog.Info("call CreateConnectionConfig()")
+
+	cimLock.Lock()
+	defer cimLock.Unlock()
+
+	err := checkConnectionConfigReference(configInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return ccim.CreateConnectionConfigInfo(configInfo)
+}
+
+//================ ConnectionConfig Update
+// (1) check the driver, credential and region of the new config
+// (2) update the config with the version check
+// A cloud connection is made for each call with the current config,
+// so the next call uses the updated config.
+func UpdateConnectionConfig(configInfo ccim.ConnectionConfigInfo) (*ccim.ConnectionConfigInfo, error) {
+	cblog.Info("call UpdateConnectionConfig()")
+
+	cimLock.Lock()
+	defer cimLock.Unlock()
+
+	err := checkConnectionConfigReference(configInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return ccim.UpdateConnectionConfig(configInfo)
+}
+
+// the non-empty names of the patch are replaced, and the others are kept.
+func PatchConnectionConfig(patchInfo ccim.ConnectionConfigInfo) (*ccim.ConnectionConfigInfo, error) {
+	cblog.Info("call PatchConnectionConfig()")
+
+	oldInfo, err := ccim.GetConnectionConfig(patchInfo.ConfigName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// a change after this Get() is rejected by the version check of the update.
+	return UpdateConnectionConfig(ccim.MergeConnectionConfig(*oldInfo, patchInfo))
+}
+
+// the driver, credential and region should exist and have the same provider as the config.
+func checkConnectionConfigReference(configInfo ccim.ConnectionConfigInfo) error {
+	providerName := configInfo.ProviderName
+
+	drvInfo, err := dim.GetCloudDriver(configInfo.DriverName)
+	if err != nil {
+		return fmt.Errorf("Driver(%s) of ConnectionConfig(%s): %v", configInfo.DriverName, configInfo.ConfigName, err)
+	}
+	if !strings.EqualFold(drvInfo.ProviderName, providerName) {
+		return fmt.Errorf("Driver(%s) is for %s, not %s!", drvInfo.DriverName, drvInfo.ProviderName, providerName)
+	}
+
+	crdInfo, err := cim.GetCredential(configInfo.CredentialName)
+	if err != nil {
+		return fmt.Errorf("Credential(%s) of ConnectionConfig(%s): %v", configInfo.CredentialName, configInfo.ConfigName, err)
+	}
+	if !strings.EqualFold(crdInfo.ProviderName, providerName) {
+		return fmt.Errorf("Credential(%s) is for %s, not %s!", crdInfo.CredentialName, crdInfo.ProviderName, providerName)
+	}
+
+	rgnInfo, err := rim.GetRegion(configInfo.RegionName)
+	if err != nil {
+		return fmt.Errorf("Region(%s) of ConnectionConfig(%s): %v", configInfo.RegionName, configInfo.ConfigName, err)
+	}
+	if !strings.EqualFold(rgnInfo.ProviderName, providerName) {
+		return fmt.Errorf("Region(%s) is for %s, not %s!", rgnInfo.RegionName, rgnInfo.ProviderName, providerName)
+	}
+	return nil
+}
+
+//================ Delete with the dependency check
+// cascade: "true" => the dependents are deleted together.
+// orphan: "true" => the IIDs of the connection configs are deleted with the cascade.
+// The cascade does not delete the resources of the CSP, so a connection config with IIDs
+// is deleted only with the orphan option, and its resources remain in the CSP.
+
+// the dependents are the connection configs with the driver.
+func UnRegisterCloudDriver(driverName string, cascade string, orphan string) (bool, error) {
+	cblog.Info("call UnRegisterCloudDriver()")
+
+	cimLock.Lock()
+	defer cimLock.Unlock()
+
+	configNameList, err := listConnectionConfigName(func(configInfo *ccim.ConnectionConfigInfo) bool {
+		return configInfo.DriverName == driverName
+	})
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	err = checkConfigDependent("Driver", driverName, configNameList, nil, cascade, orphan)
+	if err != nil {
+		return false, err
+	}
+
+	err = deleteConnectionConfigList(configNameList)
+	if err != nil {
+		return false, err
+	}
+	return dim.UnRegisterCloudDriver(driverName)
+}
+
+// the dependents are the connection configs with the credential.
+func UnRegisterCredential(credentialName string, cascade string, orphan string) (bool, error) {
+	cblog.Info("call UnRegisterCredential()")
+
+	cimLock.Lock()
+	defer cimLock.Unlock()
+
+	configNameList, err := listConnectionConfigName(func(configInfo *ccim.ConnectionConfigInfo) bool {
+		return configInfo.CredentialName == credentialName
+	})
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	err = checkConfigDependent("Credential", credentialName, configNameList, nil, cascade, orphan)
+	if err != nil {
+		return false, err
+	}
+
+	err = deleteConnectionConfigList(configNameList)
+	if err != nil {
+		return false, err
+	}
+	return cim.UnRegisterCredential(credentialName)
+}
+
+// the dependents are the connection configs and the image maps with the region.
+func UnRegisterRegion(regionName string, cascade string, orphan string) (bool, error) {
+	cblog.Info("call UnRegisterRegion()")
+
+	cimLock.Lock()
+	defer cimLock.Unlock()
+
+	configNameList, err := listConnectionConfigName(func(configInfo *ccim.ConnectionConfigInfo) bool {
+		return configInfo.RegionName == regionName
+	})
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	imgMapInfoList, err := imim.ListImageMap()
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	var imageNameList []string
+	for _, imgMapInfo := range imgMapInfoList {
+		if imgMapInfo.RegionName == regionName {
+			imageNameList = append(imageNameList, imgMapInfo.ImageName)
+		}
+	}
+
+	err = checkConfigDependent("Region", regionName, configNameList, dependentList("imagemap", imageNameList), cascade, orphan)
+	if err != nil {
+		return false, err
+	}
+
+	err = deleteConnectionConfigList(configNameList)
+	if err != nil {
+		return false, err
+	}
+	for _, imageName := range imageNameList {
+		_, err := imim.UnRegisterImageMap(imageName, regionName)
+		if err != nil {
+			cblog.Error(err)
+			return false, err
+		}
+	}
+	return rim.UnRegisterRegion(regionName)
+}
+
+// the dependents are the IIDs of the connection config.
+func DeleteConnectionConfig(configName string, cascade string, orphan string) (bool, error) {
+	cblog.Info("call DeleteConnectionConfig()")
+
+	cimLock.Lock()
+	defer cimLock.Unlock()
+
+	iidList, err := listResourceIID(configName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	err = checkDependent("ConnectionConfig", configName, nil, iidList, cascade, orphan)
+	if err != nil {
+		return false, err
+	}
+
+	return deleteConnectionConfig(configName)
+}
+
+// the IIDs of the resources which are created with the connection config.
+func ListConnectionConfigDependent(configName string) ([]*iidm.IIDInfo, error) {
+	cblog.Info("call ListConnectionConfigDependent()")
+
+	_, err := ccim.GetConnectionConfig(configName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return listResourceIIDInfo(configName)
+}
+
+//================ Driver Capability
+// the capability of the registered driver, the handlers and features which are not supported
+// by the driver are rejected with idrv.NotSupportedError.
+func GetCloudDriverCapability(driverName string) (*idrv.DriverCapabilityInfo, error) {
+	cblog.Info("call GetCloudDriverCapability()")
+
+	capability, err := ccm.GetDriverCapability(driverName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	return &capability, nil
+}
+
+// the capability of the driver of the connection config.
+func GetConnectionConfigCapability(configName string) (*idrv.DriverCapabilityInfo, error) {
+	cblog.Info("call GetConnectionConfigCapability()")
+
+	capability, err := ccm.GetDriverCapabilityByConnectionName(configName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	return &capability, nil
+}
+
+//================ Region Sync
+// (1) list the regions and zones of the CSP with the connection
+// (2) register the new ones, and update the Region and Zone keys of the registered ones
+// The RegionName is "{provider}-{zone}" for the provider with the Zone key, ex) "aws-us-east-2a",
+// or "{provider}-{region}", ex) "azure-koreacentral", like utils/import-info.
+// keyValueInfoList: the other keys of the new regions, ex) ResourceGroup of Azure
+// The unavailable regions and zones are skipped, the registered ones are not deleted.
+func SyncRegion(connectionName string, keyValueInfoList []icbs.KeyValue) ([]*rim.RegionInfo, error) {
+	cblog.Info("call SyncRegion()")
+
+	configInfo, err := ccim.GetConnectionConfig(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	providerName := configInfo.ProviderName
+
+	regionKey, zoneKey, err := ccm.GetRegionZoneKeyNameByConnectionName(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	regionZoneInfoList, err := ListRegionZone(connectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	rgnInfoList, err := rim.ListRegion()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	rgnInfoMap := make(map[string]*rim.RegionInfo)
+	for _, rgnInfo := range rgnInfoList {
+		rgnInfoMap[rgnInfo.RegionName] = rgnInfo
+	}
+
+	syncedList := []*rim.RegionInfo{}
+	for _, regionZoneInfo := range regionZoneInfoList {
+		if regionZoneInfo.Status == cres.RegionZoneUnavailable {
+			continue
+		}
+
+		regionKeyValue := icbs.KeyValue{Key: regionKey, Value: regionZoneInfo.Name}
+		if zoneKey == "" {
+			rgnInfo, err := syncRegionInfo(rgnInfoMap, providerName, regionZoneInfo.Name,
+				[]icbs.KeyValue{regionKeyValue}, keyValueInfoList)
+			if err != nil {
+				return nil, err
+			}
+			if rgnInfo != nil {
+				syncedList = append(syncedList, rgnInfo)
+			}
+			continue
+		}
+
+		for _, zoneInfo := range regionZoneInfo.ZoneList {
+			if zoneInfo.Status == cres.RegionZoneUnavailable {
+				continue
+			}
+			rgnInfo, err := syncRegionInfo(rgnInfoMap, providerName, zoneInfo.Name,
+				[]icbs.KeyValue{regionKeyValue, {Key: zoneKey, Value: zoneInfo.Name}}, keyValueInfoList)
+			if err != nil {
+				return nil, err
+			}
+			if rgnInfo != nil {
+				syncedList = append(syncedList, rgnInfo)
+			}
+		}
+	}
+
+	return syncedList, nil
+}
+
+// returns nil if the registered region has the same keys.
+func syncRegionInfo(rgnInfoMap map[string]*rim.RegionInfo, providerName string, name string,
+	regionZoneKeyValueList []icbs.KeyValue, keyValueInfoList []icbs.KeyValue) (*rim.RegionInfo, error) {
+
+	regionName := strings.ToLower(providerName) + "-" + name
+
+	oldInfo, ok := rgnInfoMap[regionName]
+	if !ok {
+		return rim.RegisterRegion(regionName, providerName,
+			im.MergeKeyValueList(keyValueInfoList, regionZoneKeyValueList))
+	}
+
+	if !strings.EqualFold(oldInfo.ProviderName, providerName) {
+		return nil, fmt.Errorf("Region(%s) is already registered for %s, not %s!", regionName, oldInfo.ProviderName, providerName)
+	}
+
+	mergedList := im.MergeKeyValueList(oldInfo.KeyValueInfoList, regionZoneKeyValueList)
+	if reflect.DeepEqual(mergedList, oldInfo.KeyValueInfoList) {
+		return nil, nil
+	}
+
+	// a change after ListRegion() is rejected by the version check.
+	return rim.UpdateRegion(rim.RegionInfo{RegionName: regionName, ProviderName: oldInfo.ProviderName,
+		KeyValueInfoList: mergedList, Version: oldInfo.Version})
+}
+
+//----------------
+
+func listConnectionConfigName(match func(*ccim.ConnectionConfigInfo) bool) ([]string, error) {
+	configInfoList, err := ccim.ListConnectionConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	var configNameList []string
+	for _, configInfo := range configInfoList {
+		if match(configInfo) {
+			configNameList = append(configNameList, configInfo.ConfigName)
+		}
+	}
+	return configNameList, nil
+}
+
+// "{kind}/{name}" list
+func dependentList(kind string, nameList []string) []string {
+	var dependents []string
+	for _, name := range nameList {
+		dependents = append(dependents, kind+"/"+name)
+	}
+	return dependents
+}
+
+// IIDs of the resources created with the connection config.
+// the records for the validation, ex) CIDRs of the VPCs, are not resources.
+func listResourceIIDInfo(configName string) ([]*iidm.IIDInfo, error) {
+	iidInfoList, err := iidRWLock.ListAllIID(configName)
+	if err != nil {
+		return nil, err
+	}
+	resourceIIDInfoList := []*iidm.IIDInfo{}
+	for _, iidInfo := range iidInfoList {
+		if iidInfo.ResourceType == rsVPCCIDR || strings.HasPrefix(iidInfo.ResourceType, rsSubnetCIDRPrefix) {
+			continue
+		}
+		resourceIIDInfoList = append(resourceIIDInfoList, iidInfo)
+	}
+	return resourceIIDInfoList, nil
+}
+
+// "{ResourceType}/{NameId}" list of the resources created with the connection config.
+func listResourceIID(configName string) ([]string, error) {
+	iidInfoList, err := listResourceIIDInfo(configName)
+	if err != nil {
+		return nil, err
+	}
+	var iidList []string
+	for _, iidInfo := range iidInfoList {
+		iidList = append(iidList, iidInfo.ResourceType+"/"+iidInfo.IId.NameId)
+	}
+	return iidList, nil
+}
+
+// check the dependent connection configs with their IIDs, and the other dependents.
+func checkConfigDependent(kind string, name string, configNameList []string, dependents []string, cascade string, orphan string) error {
+	var iidList []string
+	for _, configName := range configNameList {
+		configIIDList, err := listResourceIID(configName)
+		if err != nil {
+			cblog.Error(err)
+			return err
+		}
+		iidList = append(iidList, dependentList("connectionconfig/"+configName, configIIDList)...)
+	}
+	return checkDependent(kind, name, append(dependentList("connectionconfig", configNameList), dependents...), iidList, cascade, orphan)
+}
+
+// returns a DependentError
+// (1) without the cascade, if the object has dependents or IIDs.
+// (2) without the orphan, if the object has IIDs, because the cascade leaves their resources in the CSP.
+func checkDependent(kind string, name string, dependents []string, iidList []string, cascade string, orphan string) error {
+	if len(dependents) == 0 && len(iidList) == 0 {
+		return nil
+	}
+	if cascade != "true" || (len(iidList) > 0 && orphan != "true") {
+		err := &DependentError{Kind: kind, Name: name, Dependents: dependents, IIDs: iidList}
+		cblog.Error(err)
+		return err
+	}
+	cblog.Infof("cascade delete of %s(%s): %s", kind, name, strings.Join(dependents, ", "))
+	if len(iidList) > 0 {
+		cblog.Warnf("the resources of %s(%s) remain in the CSP: %s", kind, name, strings.Join(iidList, ", "))
+	}
+	return nil
+}
+
+// the caller should hold the cimLock.
+func deleteConnectionConfigList(configNameList []string) error {
+	for _, configName := range configNameList {
+		_, err := deleteConnectionConfig(configName)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// delete the IIDs and the connection config.
+// the caller should hold the cimLock.
+func deleteConnectionConfig(configName string) (bool, error) {
+	count, err := iidRWLock.DeleteAllIID(configName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	if count > 0 {
+		cblog.Infof("%d IIDs of ConnectionConfig(%s) are deleted, the resources of the CSP are not deleted.", count, configName)
+	}
+
+	result, err := ccim.DeleteConnectionConfig(configName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	return result, nil
+}
@@ -0,0 +1,324 @@
+// Common Runtime Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package commonruntime
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+func checkCIDRError(t *testing.T, err error, errMsg string) bool {
+	t.Helper()
+	if errMsg != "" {
+		if err == nil || !strings.Contains(err.Error(), errMsg) {
+			t.Errorf("error should contain '%s': %v", errMsg, err)
+		}
+		return false
+	}
+	if err != nil {
+		t.Error(err)
+		return false
+	}
+	return true
+}
+
+func subnetCIDRList(cidrList ...string) []cres.SubnetInfo {
+	subnetInfoList := []cres.SubnetInfo{}
+	for i, cidr := range cidrList {
+		subnetInfoList = append(subnetInfoList, cres.SubnetInfo{IId: cres.IID{NameId: "subnet-" + string(rune('a'+i))}, IPv4_CIDR: cidr})
+	}
+	return subnetInfoList
+}
+
+func TestValidateVPCCIDR(t *testing.T) {
+	connectionName := setupMockConnection(t)
+
+	dualStack := func(vpcIPv6CIDR string, subnetIPv6CIDRList ...string) cres.VPCReqInfo {
+		reqInfo := cres.VPCReqInfo{IPv4_CIDR: "10.1.0.0/16", IPv6_CIDR: vpcIPv6CIDR}
+		for i, ipv6CIDR := range subnetIPv6CIDRList {
+			reqInfo.SubnetInfoList = append(reqInfo.SubnetInfoList, cres.SubnetInfo{
+				IId:       cres.IID{NameId: "subnet-" + string(rune('a'+i))},
+				IPv4_CIDR: "/24",
+				IPv6_CIDR: ipv6CIDR,
+			})
+		}
+		return reqInfo
+	}
+
+	testList := []struct {
+		name     string
+		reqInfo  cres.VPCReqInfo
+		expected []string // Subnet CIDRs after the allocation
+		errMsg   string
+	}{
+		{"valid", cres.VPCReqInfo{IPv4_CIDR: "10.1.0.0/16", SubnetInfoList: subnetCIDRList("10.1.1.0/24", "10.1.2.0/24")},
+			[]string{"10.1.1.0/24", "10.1.2.0/24"}, ""},
+		{"invalid VPC CIDR", cres.VPCReqInfo{IPv4_CIDR: "10.1.0.0/33"}, nil, "is not a valid CIDR"},
+		{"VPC host bits", cres.VPCReqInfo{IPv4_CIDR: "10.1.0.1/16"}, nil, "has host bits"},
+		{"IPv6 as VPC CIDR", cres.VPCReqInfo{IPv4_CIDR: "2001:db8::/56"}, nil, "is not an IPv4 CIDR"},
+		{"reserved VPC CIDR", cres.VPCReqInfo{IPv4_CIDR: "127.0.0.0/16"}, nil, "overlaps with the reserved range"},
+		{"invalid Subnet CIDR", cres.VPCReqInfo{IPv4_CIDR: "10.1.0.0/16", SubnetInfoList: subnetCIDRList("10.1.1.0")},
+			nil, "is not a valid CIDR"},
+		{"Subnet out of VPC", cres.VPCReqInfo{IPv4_CIDR: "10.1.0.0/16", SubnetInfoList: subnetCIDRList("10.2.1.0/24")},
+			nil, "is out of VPC CIDR"},
+		{"Subnet larger than VPC", cres.VPCReqInfo{IPv4_CIDR: "10.1.0.0/16", SubnetInfoList: subnetCIDRList("10.0.0.0/8")},
+			nil, "is out of VPC CIDR"},
+		{"Subnets overlap", cres.VPCReqInfo{IPv4_CIDR: "10.1.0.0/16", SubnetInfoList: subnetCIDRList("10.1.0.0/24", "10.1.0.128/25")},
+			nil, "overlaps with Subnet CIDR"},
+		{"auto-allocate", cres.VPCReqInfo{IPv4_CIDR: "10.1.0.0/16", SubnetInfoList: subnetCIDRList("10.1.0.0/24", "/24", "/25")},
+			[]string{"10.1.0.0/24", "10.1.1.0/24", "10.1.2.0/25"}, ""},
+		{"auto-allocate after given", cres.VPCReqInfo{IPv4_CIDR: "10.1.0.0/16", SubnetInfoList: subnetCIDRList("/24", "10.1.0.0/24")},
+			[]string{"10.1.1.0/24", "10.1.0.0/24"}, ""},
+		{"auto-allocate exhausted", cres.VPCReqInfo{IPv4_CIDR: "10.1.0.0/24", SubnetInfoList: subnetCIDRList("/25", "/25", "/25")},
+			nil, "no free /25 range"},
+		{"auto-allocate larger than VPC", cres.VPCReqInfo{IPv4_CIDR: "10.1.0.0/24", SubnetInfoList: subnetCIDRList("/16")},
+			nil, "can not be allocated"},
+		{"auto-allocate without VPC CIDR", cres.VPCReqInfo{SubnetInfoList: subnetCIDRList("/24")},
+			nil, "needs a VPC CIDR"},
+
+		// IPv6
+		{"dual-stack", dualStack("2001:db8::/56", "2001:db8:0:1::/64", "2001:db8:0:2::/64"),
+			[]string{"10.1.0.0/24", "10.1.1.0/24"}, ""},
+		{"auto VPC IPv6", dualStack(cres.IPv6AutoCIDR, "", cres.IPv6AutoCIDR),
+			[]string{"10.1.0.0/24", "10.1.1.0/24"}, ""},
+		{"invalid VPC IPv6", dualStack("2001:db8::/129"), nil, "is not a valid CIDR"},
+		{"IPv4 as VPC IPv6", dualStack("10.2.0.0/16"), nil, "is not an IPv6 CIDR"},
+		{"VPC IPv6 host bits", dualStack("2001:db8::1/56"), nil, "has host bits"},
+		{"Subnet IPv6 not /64", dualStack("2001:db8::/56", "2001:db8::/60"), nil, "must be a /64"},
+		{"Subnet IPv6 out of VPC", dualStack("2001:db8::/56", "2001:db8:1::/64"), nil, "is out of VPC IPv6 CIDR"},
+		{"Subnet IPv6 overlap", dualStack("2001:db8::/56", "2001:db8:0:1::/64", "2001:db8:0:1::/64"),
+			nil, "overlaps with Subnet IPv6 CIDR"},
+		{"Subnet IPv6 without dual-stack", dualStack("", "2001:db8:0:1::/64"), nil, "needs a dual-stack VPC"},
+	}
+
+	for _, tc := range testList {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateVPCCIDR(connectionName, &tc.reqInfo)
+			if !checkCIDRError(t, err, tc.errMsg) {
+				return
+			}
+			for i, subnetInfo := range tc.reqInfo.SubnetInfoList {
+				if subnetInfo.IPv4_CIDR != tc.expected[i] {
+					t.Errorf("Subnet %s: %s, expected: %s", subnetInfo.IId.NameId, subnetInfo.IPv4_CIDR, tc.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestValidateSubnetCIDR(t *testing.T) {
+	connectionName := setupMockConnection(t)
+
+	vpcInfo := cres.VPCInfo{
+		IPv4_CIDR: "10.0.0.0/16",
+		IPv6_CIDR: "2001:db8::/56",
+		SubnetInfoList: []cres.SubnetInfo{
+			{IId: cres.IID{NameId: "subnet-01"}, IPv4_CIDR: "10.0.0.0/24", IPv6_CIDR: "2001:db8::/64"},
+			{IId: cres.IID{NameId: "subnet-02"}, IPv4_CIDR: "10.0.2.0/24"},
+		},
+	}
+	noVPCCIDR := cres.VPCInfo{SubnetInfoList: vpcInfo.SubnetInfoList}
+
+	testList := []struct {
+		name       string
+		vpcInfo    cres.VPCInfo
+		subnetInfo cres.SubnetInfo
+		expected   string
+		errMsg     string
+	}{
+		{"valid", vpcInfo, cres.SubnetInfo{IPv4_CIDR: "10.0.3.0/24"}, "10.0.3.0/24", ""},
+		{"overlap", vpcInfo, cres.SubnetInfo{IPv4_CIDR: "10.0.2.128/25"}, "", "overlaps with Subnet CIDR 10.0.2.0/24"},
+		{"containing a Subnet", vpcInfo, cres.SubnetInfo{IPv4_CIDR: "10.0.0.0/22"}, "", "overlaps with Subnet CIDR"},
+		{"out of VPC", vpcInfo, cres.SubnetInfo{IPv4_CIDR: "10.1.0.0/24"}, "", "is out of VPC CIDR"},
+		{"invalid", vpcInfo, cres.SubnetInfo{IPv4_CIDR: "10.0.3.0/24/24"}, "", "is not a valid CIDR"},
+		{"auto-allocate", vpcInfo, cres.SubnetInfo{IPv4_CIDR: "/24"}, "10.0.1.0/24", ""},
+		{"auto-allocate aligned", vpcInfo, cres.SubnetInfo{IPv4_CIDR: "/23"}, "10.0.4.0/23", ""},
+		{"no VPC CIDR", noVPCCIDR, cres.SubnetInfo{IPv4_CIDR: "172.16.0.0/24"}, "172.16.0.0/24", ""},
+		{"no VPC CIDR overlap", noVPCCIDR, cres.SubnetInfo{IPv4_CIDR: "10.0.0.0/25"}, "", "overlaps with Subnet CIDR"},
+		{"no VPC CIDR auto-allocate", noVPCCIDR, cres.SubnetInfo{IPv4_CIDR: "/24"}, "", "needs a VPC CIDR"},
+
+		// IPv6
+		{"IPv6", vpcInfo, cres.SubnetInfo{IPv4_CIDR: "10.0.3.0/24", IPv6_CIDR: "2001:db8:0:1::/64"}, "10.0.3.0/24", ""},
+		{"IPv6 overlap", vpcInfo, cres.SubnetInfo{IPv4_CIDR: "10.0.3.0/24", IPv6_CIDR: "2001:db8::/64"},
+			"", "overlaps with Subnet IPv6 CIDR"},
+		{"IPv6 out of VPC", vpcInfo, cres.SubnetInfo{IPv4_CIDR: "10.0.3.0/24", IPv6_CIDR: "2001:db8:1::/64"},
+			"", "is out of VPC IPv6 CIDR"},
+		{"IPv6 without dual-stack", noVPCCIDR, cres.SubnetInfo{IPv4_CIDR: "172.16.0.0/24", IPv6_CIDR: "2001:db8:0:1::/64"},
+			"", "needs a dual-stack VPC"},
+	}
+
+	for _, tc := range testList {
+		t.Run(tc.name, func(t *testing.T) {
+			subnetInfo := tc.subnetInfo
+			subnetInfo.IId = cres.IID{NameId: "subnet-new"}
+			err := validateSubnetCIDR(connectionName, tc.vpcInfo, &subnetInfo)
+			if !checkCIDRError(t, err, tc.errMsg) {
+				return
+			}
+			if subnetInfo.IPv4_CIDR != tc.expected {
+				t.Errorf("Subnet CIDR: %s, expected: %s", subnetInfo.IPv4_CIDR, tc.expected)
+			}
+		})
+	}
+}
+
+func TestAllocSubnetCIDR(t *testing.T) {
+	parseList := func(cidrList ...string) []*net.IPNet {
+		netList := []*net.IPNet{}
+		for _, cidr := range cidrList {
+			_, ipNet, _ := net.ParseCIDR(cidr)
+			netList = append(netList, ipNet)
+		}
+		return netList
+	}
+
+	testList := []struct {
+		name     string
+		rule     cidrRule
+		vpcCIDR  string
+		usedList []*net.IPNet
+		prefix   string
+		expected string
+		errMsg   string
+	}{
+		{"first", cidrRuleMap["AWS"], "10.0.0.0/16", nil, "/24", "10.0.0.0/24", ""},
+		{"gap", cidrRuleMap["AWS"], "10.0.0.0/16", parseList("10.0.0.0/24", "10.0.2.0/24"), "/24", "10.0.1.0/24", ""},
+		{"next half", cidrRuleMap["AWS"], "10.0.0.0/16", parseList("10.0.0.0/25"), "/25", "10.0.0.128/25", ""},
+		{"aligned", cidrRuleMap["AWS"], "10.0.0.0/16", parseList("10.0.0.0/26"), "/24", "10.0.1.0/24", ""},
+		{"used larger", cidrRuleMap["AWS"], "10.0.0.0/16", parseList("10.0.0.0/20"), "/28", "10.0.16.0/28", ""},
+		{"reserved", cidrRuleMap["AZURE"], "168.63.128.0/22", parseList("168.63.128.0/24"), "/24", "168.63.130.0/24", ""},
+		{"exhausted", cidrRuleMap["AWS"], "10.0.0.0/24", parseList("10.0.0.0/25", "10.0.0.128/25"), "/26", "", "no free /26 range"},
+		{"exhausted by fragments", cidrRuleMap["AWS"], "10.0.0.0/24", parseList("10.0.0.64/26", "10.0.0.192/26"), "/25", "", "no free /25 range"},
+		{"VPC size", defaultCIDRRule, "10.0.0.0/24", nil, "/24", "10.0.0.0/24", ""},
+		{"larger than VPC", defaultCIDRRule, "10.0.0.0/24", nil, "/23", "", "can not be allocated"},
+		{"over /32", defaultCIDRRule, "10.0.0.0/24", nil, "/33", "", "can not be allocated"},
+		{"CSP rule", cidrRuleMap["AWS"], "10.0.0.0/16", nil, "/29", "", "prefix length must be between /16 and /28"},
+		{"no VPC CIDR", cidrRuleMap["AWS"], "", nil, "/24", "", "needs a VPC CIDR"},
+	}
+
+	for _, tc := range testList {
+		t.Run(tc.name, func(t *testing.T) {
+			var vpcNet *net.IPNet
+			if tc.vpcCIDR != "" {
+				_, vpcNet, _ = net.ParseCIDR(tc.vpcCIDR)
+			}
+			subnetNet, err := allocSubnetCIDR(tc.rule, vpcNet, tc.usedList, cres.SubnetInfo{IId: cres.IID{NameId: "subnet-new"}, IPv4_CIDR: tc.prefix})
+			if !checkCIDRError(t, err, tc.errMsg) {
+				return
+			}
+			if subnetNet.String() != tc.expected {
+				t.Errorf("allocated: %s, expected: %s", subnetNet, tc.expected)
+			}
+		})
+	}
+}
+
+func TestValidateSecurityRuleCIDR(t *testing.T) {
+	connectionName := setupMockConnection(t)
+
+	testList := []struct {
+		name   string
+		cidr   string
+		errMsg string
+	}{
+		{"empty", "", ""},
+		{"any", "0.0.0.0/0", ""},
+		{"host", "10.0.0.1/32", ""},
+		{"host bits", "10.0.0.1/24", ""},
+		{"invalid", "10.0.0.0/33", "is not a valid CIDR"},
+		{"no prefix", "10.0.0.1", "is not a valid CIDR"},
+		// the mock driver does not support IPv6 rules.
+		{"IPv6", "::/0", "SECURITY_IPv6_RULE"},
+	}
+
+	for _, tc := range testList {
+		t.Run(tc.name, func(t *testing.T) {
+			ruleList := []cres.SecurityRuleInfo{{Direction: "inbound", IPProtocol: "tcp", FromPort: "22", ToPort: "22", CIDR: tc.cidr}}
+			checkCIDRError(t, validateSecurityRuleCIDR(connectionName, &ruleList), tc.errMsg)
+		})
+	}
+
+	if err := validateSecurityRuleCIDR(connectionName, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// CIDRs are checked with the records of the IID store, not with the CSP.
+func TestVPCCIDRRecord(t *testing.T) {
+	connectionName := setupMockConnection(t)
+	vpcIID := cres.IID{NameId: "vpc-01"}
+
+	checkRecord := func(handler cres.VPCHandler, expected ...string) {
+		t.Helper()
+		vpcInfo, err := getVPCCIDRInfo(connectionName, handler, vpcIID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if vpcInfo.IPv4_CIDR != "10.0.0.0/16" {
+			t.Errorf("VPC CIDR: %s", vpcInfo.IPv4_CIDR)
+		}
+		cidrList := []string{}
+		for _, subnetInfo := range vpcInfo.SubnetInfoList {
+			cidrList = append(cidrList, subnetInfo.IId.NameId+":"+subnetInfo.IPv4_CIDR)
+		}
+		if strings.Join(cidrList, ",") != strings.Join(expected, ",") {
+			t.Errorf("Subnet CIDRs: %v, expected: %v", cidrList, expected)
+		}
+	}
+
+	// a nil handler fails if the CSP is called.
+	checkRecord(nil, "subnet-01:10.0.1.0/24")
+
+	if _, err := AddSubnet(connectionName, rsVPC, "vpc-01", cres.SubnetInfo{IId: cres.IID{NameId: "subnet-02"}, IPv4_CIDR: "/24"}); err != nil {
+		t.Fatal(err)
+	}
+	checkRecord(nil, "subnet-01:10.0.1.0/24", "subnet-02:10.0.0.0/24")
+
+	if _, err := RemoveSubnet(connectionName, rsVPC, "vpc-01", "subnet-01"); err != nil {
+		t.Fatal(err)
+	}
+	checkRecord(nil, "subnet-02:10.0.0.0/24")
+
+	// a VPC without the record is recorded with the CSP info.
+	if _, err := iidRWLock.DeleteIID(connectionName, rsVPCCIDR, vpcIID); err != nil {
+		t.Fatal(err)
+	}
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler, err := cldConn.CreateVPCHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vpcIIDInfo, err := iidRWLock.GetIID(connectionName, rsVPC, vpcIID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vpcIID = vpcIIDInfo.IId
+	checkRecord(handler, "subnet-02:10.0.0.0/24")
+	checkRecord(nil, "subnet-02:10.0.0.0/24")
+
+	if _, _, err := DeleteResource(connectionName, rsVPC, "vpc-01", "false"); err != nil {
+		t.Fatal(err)
+	}
+	for _, rsType := range []string{rsVPCCIDR, rsSubnetCIDRPrefix + "vpc-01"} {
+		iidInfoList, err := iidRWLock.ListIID(connectionName, rsType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(iidInfoList) != 0 {
+			t.Errorf("%d records of %s are left after the VPC is deleted.", len(iidInfoList), rsType)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Common Runtime Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package commonruntime
+
+import (
+	"strings"
+	"testing"
+
+	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
+	dim "github.com/cloud-barista/cb-spider/cloud-info-manager/driver-info-manager"
+	rim "github.com/cloud-barista/cb-spider/cloud-info-manager/region-info-manager"
+	icbs "github.com/cloud-barista/cb-store/interfaces"
+)
+
+func TestRotateCredentialKeyAdminToken(t *testing.T) {
+	testList := []struct {
+		name       string
+		adminToken string // env of the server
+		token      string // header of the request
+	}{
+		{"disabled", "", ""},
+		{"disabled with a token", "", "admin-token"},
+		{"no token", "admin-token", ""},
+		{"wrong token", "admin-token", "admin-token2"},
+	}
+	for _, tc := range testList {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(AdminTokenEnv, tc.adminToken)
+			_, _, err := RotateCredentialKey(tc.token)
+			if !IsAdminTokenError(err) {
+				t.Errorf("RotateCredentialKey() should be rejected: %v", err)
+			}
+		})
+	}
+
+	t.Setenv(AdminTokenEnv, "admin-token")
+	if err := checkAdminToken("admin-token"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestVerifyCredentialDriverName(t *testing.T) {
+	name := setupMockConnection(t)
+
+	if err := VerifyCredential(name, name, name); err != nil {
+		t.Fatal(err)
+	}
+
+	// a second driver of the provider
+	driverName2 := name + "-2"
+	if _, err := dim.RegisterCloudDriver(driverName2, "MOCK", "mock-driver-v1.0.so"); err != nil {
+		t.Fatal(err)
+	}
+	defer dim.UnRegisterCloudDriver(driverName2)
+
+	err := VerifyCredential(name, name, "")
+	if err == nil || !strings.Contains(err.Error(), "driver name is required") {
+		t.Errorf("the driver name should be required: %v", err)
+	}
+	if err := VerifyCredential(name, name, driverName2); err != nil {
+		t.Error(err)
+	}
+	if err := VerifyCredential(name, name, "none-driver"); err == nil {
+		t.Error("a driver that is not registered should be rejected.")
+	}
+}
+
+// the Docker driver does not support the VMSpecHandler,
+// so the credential is verified by IsConnected().
+func TestVerifyCredentialWithoutVMSpecHandler(t *testing.T) {
+	name := setupMockConnection(t)
+	dockerName := name + "-docker"
+
+	if _, err := dim.RegisterCloudDriver(dockerName, "DOCKER", "docker-driver-v1.0.so"); err != nil {
+		t.Fatal(err)
+	}
+	defer dim.UnRegisterCloudDriver(dockerName)
+	if _, err := cim.RegisterCredential(dockerName, "DOCKER", []icbs.KeyValue{
+		{Key: "Host", Value: "http://127.0.0.1:1004"},
+		{Key: "APIVersion", Value: "v1.38"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	defer cim.UnRegisterCredential(dockerName)
+	if _, err := rim.RegisterRegion(dockerName, "DOCKER", []icbs.KeyValue{{Key: "Region", Value: "default"}}); err != nil {
+		t.Fatal(err)
+	}
+	defer rim.UnRegisterRegion(dockerName)
+
+	if err := VerifyCredential(dockerName, dockerName, dockerName); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRegisterCredentialSecretReference(t *testing.T) {
+	name := setupMockConnection(t)
+	t.Setenv("CBSPIDER_SECRET_PROVIDERS", "env")
+	t.Setenv("CBSPIDER_SECRET_ENV_ALLOWLIST", "SECRET_TEST_*")
+	t.Setenv("SECRET_TEST_MOCK", name)
+
+	// the references of a request are not resolved by the verification.
+	crdInfo := cim.CredentialInfo{
+		CredentialName:   name + "-ref",
+		ProviderName:     "MOCK",
+		KeyValueInfoList: []icbs.KeyValue{{Key: "MockName", Value: "secret:env:SECRET_TEST_MOCK"}},
+	}
+	_, err := RegisterCredential(crdInfo, name, name)
+	if err == nil || !strings.Contains(err.Error(), "secret reference") {
+		t.Errorf("the secret reference should not be verified before the registration: %v", err)
+	}
+
+	// the registered references are resolved.
+	if _, err := RegisterCredential(crdInfo, "", ""); err != nil {
+		t.Fatal(err)
+	}
+	defer cim.UnRegisterCredential(crdInfo.CredentialName)
+	if err := VerifyCredential(crdInfo.CredentialName, name, name); err != nil {
+		t.Error(err)
+	}
+}
+
+// the cascade does not delete the IIDs of the connection configs without the orphan option.
+func TestDeleteConnectionConfigOrphan(t *testing.T) {
+	name := setupMockConnection(t)
+
+	checkDependentError := func(err error, iid string) {
+		t.Helper()
+		if !IsDependentError(err) {
+			t.Fatalf("a DependentError should be returned: %v", err)
+		}
+		iidList := err.(*DependentError).IIDs
+		if strings.Join(iidList, ",") != iid {
+			t.Errorf("IIDs: %v, expected: %s", iidList, iid)
+		}
+		if !strings.Contains(err.Error(), "orphan") {
+			t.Errorf("the orphan option should be guided: %v", err)
+		}
+		if _, err := ccim.GetConnectionConfig(name); err != nil {
+			t.Errorf("the connection config should not be deleted: %v", err)
+		}
+	}
+
+	// the CIDR records of the VPC are not listed.
+	_, err := DeleteConnectionConfig(name, "", "")
+	checkDependentError(err, "subnet:vpc-01/subnet-01,vpc/vpc-01")
+	_, err = DeleteConnectionConfig(name, "true", "")
+	checkDependentError(err, "subnet:vpc-01/subnet-01,vpc/vpc-01")
+	_, err = UnRegisterCredential(name, "true", "")
+	checkDependentError(err, "connectionconfig/"+name+"/subnet:vpc-01/subnet-01,connectionconfig/"+name+"/vpc/vpc-01")
+
+	if _, err := DeleteConnectionConfig(name, "true", "true"); err != nil {
+		t.Fatal(err)
+	}
+	iidInfoList, err := iidRWLock.ListAllIID(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(iidInfoList) != 0 {
+		t.Errorf("%d IIDs are left after the connection config is deleted.", len(iidInfoList))
+	}
+
+	// a connection config without IIDs is deleted by the cascade.
+	if _, err := ccim.CreateConnectionConfig(name, "MOCK", name, name, name); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := UnRegisterRegion(name, "true", ""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ccim.GetConnectionConfig(name); err == nil {
+		t.Error("the connection config should be deleted by the cascade.")
+	}
+}
@@ -0,0 +1,82 @@
+// Common Runtime Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.06.
+
+package commonruntime
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
+	dim "github.com/cloud-barista/cb-spider/cloud-info-manager/driver-info-manager"
+	rim "github.com/cloud-barista/cb-spider/cloud-info-manager/region-info-manager"
+	icbs "github.com/cloud-barista/cb-store/interfaces"
+)
+
+// register a mock connection config with a VPC(10.0.0.0/16) and a Subnet(10.0.1.0/24),
+// the names are unique for each call, because the meta store is shared by the test runs.
+func setupMockConnection(t *testing.T) string {
+	t.Helper()
+	t.Setenv("PLUGIN_SW", "OFF")
+
+	// a master key for the test credentials, the key file of the server is not required.
+	testKey, err := cim.GenerateCredentialKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("CBSPIDER_CREDENTIAL_KEY", testKey)
+	if err := cim.LoadCredentialKeys(); err != nil {
+		t.Fatal(err)
+	}
+
+	name := "mock-test-" + strconv.FormatInt(time.Now().UnixNano(), 36)
+	if _, err := dim.RegisterCloudDriver(name, "MOCK", "mock-driver-v1.0.so"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cim.RegisterCredential(name, "MOCK", []icbs.KeyValue{{Key: "MockName", Value: name}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rim.RegisterRegion(name, "MOCK", []icbs.KeyValue{{Key: "Region", Value: "default"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ccim.CreateConnectionConfig(name, "MOCK", name, name, name); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		ccim.DeleteConnectionConfig(name)
+		rim.UnRegisterRegion(name)
+		cim.UnRegisterCredential(name)
+		dim.UnRegisterCloudDriver(name)
+	})
+
+	vpcReqInfo := cres.VPCReqInfo{
+		IId:       cres.IID{NameId: "vpc-01"},
+		IPv4_CIDR: "10.0.0.0/16",
+		SubnetInfoList: []cres.SubnetInfo{
+			{IId: cres.IID{NameId: "subnet-01"}, IPv4_CIDR: "10.0.1.0/24"},
+		},
+	}
+	if _, err := CreateVPC(name, rsVPC, vpcReqInfo); err != nil {
+		t.Fatal(err)
+	}
+
+	return name
+}
+
+func mockVMReqInfo(name string) cres.VMReqInfo {
+	return cres.VMReqInfo{
+		IId:        cres.IID{NameId: name},
+		ImageIID:   cres.IID{NameId: "mock-vmimage-01"},
+		VpcIID:     cres.IID{NameId: "vpc-01"},
+		SubnetIID:  cres.IID{NameId: "subnet-01"},
+		VMSpecName: "mock-vmspec-01",
+	}
+}
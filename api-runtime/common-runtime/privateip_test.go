@@ -0,0 +1,85 @@
+// Common Runtime Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.06.
+
+package commonruntime
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// the VMs requesting the same fixed private IP at the same time:
+// only one VM is created, the others are rejected by Spider before calling the driver.
+func TestStartVMFixedPrivateIPConcurrent(t *testing.T) {
+	connectionName := setupMockConnection(t)
+
+	const ipCount, vmCount = 5, 4
+	errList := make([]error, ipCount*vmCount)
+	var wg sync.WaitGroup
+	for i := 0; i < ipCount; i++ {
+		for j := 0; j < vmCount; j++ {
+			wg.Add(1)
+			go func(i, j int) {
+				defer wg.Done()
+				reqInfo := mockVMReqInfo(fmt.Sprintf("vm-ip%d-%d", i, j))
+				reqInfo.PrivateIP = fmt.Sprintf("10.0.1.%d", 100+i)
+				_, errList[i*vmCount+j] = StartVM(connectionName, rsVM, reqInfo)
+			}(i, j)
+		}
+	}
+	wg.Wait()
+
+	for i := 0; i < ipCount; i++ {
+		succeeded := 0
+		for j := 0; j < vmCount; j++ {
+			err := errList[i*vmCount+j]
+			if err == nil {
+				succeeded++
+			} else if !strings.Contains(err.Error(), "is already used by VM") {
+				t.Errorf("10.0.1.%d: unexpected error: %v", 100+i, err)
+			}
+		}
+		if succeeded != 1 {
+			t.Errorf("10.0.1.%d: only 1 VM should be created, but %d VMs are created.", 100+i, succeeded)
+		}
+	}
+}
+
+// a VM with a fixed private IP does not wait for the VMs of other connections.
+func TestVMPrivateIPLockPerConnection(t *testing.T) {
+	connectionName1 := setupMockConnection(t)
+	connectionName2 := setupMockConnection(t)
+
+	if getVMPrivateIPLock(connectionName1) != getVMPrivateIPLock(connectionName1) {
+		t.Error("the same connection should share the lock.")
+	}
+
+	// connection1 is busy, ex) a CSP call of StartVM()
+	lock1 := getVMPrivateIPLock(connectionName1)
+	lock1.Lock()
+	defer lock1.Unlock()
+
+	done := make(chan error, 1)
+	go func() {
+		reqInfo := mockVMReqInfo("vm-lock-01")
+		reqInfo.PrivateIP = "10.0.1.100"
+		_, err := StartVM(connectionName2, rsVM, reqInfo)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Error(err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("StartVM() of connection2 waits for the lock of connection1.")
+	}
+}
@@ -0,0 +1,125 @@
+// Common Runtime Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.06.
+
+package commonruntime
+
+import (
+	"strings"
+	"testing"
+
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+func TestStartVMGroup(t *testing.T) {
+	connectionName := setupMockConnection(t)
+
+	groupInfo, err := StartVMGroup(connectionName, rsVM, VMGroupReqInfo{
+		NamePattern: "vm-group-%02d",
+		Count:       3,
+		VMReqInfo:   mockVMReqInfo(""),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if groupInfo.SucceededCount != 3 || groupInfo.FailedCount != 0 {
+		t.Errorf("3 VMs should be created: %+v", groupInfo)
+	}
+}
+
+// the counts are made after the rollback.
+func TestStartVMGroupRollback(t *testing.T) {
+	connectionName := setupMockConnection(t)
+
+	// only 2 host addresses for the secondary NICs, so the third VM fails.
+	subnetInfo := cres.SubnetInfo{IId: cres.IID{NameId: "subnet-small"}, IPv4_CIDR: "10.0.2.0/30"}
+	if _, err := AddSubnet(connectionName, rsVPC, "vpc-01", subnetInfo); err != nil {
+		t.Fatal(err)
+	}
+	reqInfo := mockVMReqInfo("")
+	reqInfo.NetworkInterfaceList = []cres.NetworkInterfaceReqInfo{{SubnetIID: subnetInfo.IId}}
+
+	groupInfo, err := StartVMGroup(connectionName, rsVM, VMGroupReqInfo{
+		NamePattern:       "vm-rollback-%02d",
+		Count:             3,
+		WorkerCount:       1,
+		RollbackOnFailure: true,
+		VMReqInfo:         reqInfo,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if groupInfo.SucceededCount != 0 || groupInfo.FailedCount != 1 || groupInfo.RolledBackCount != 2 {
+		t.Errorf("1 VM should fail and 2 VMs should be rolled back: %+v", groupInfo)
+	}
+
+	iidInfoList, err := iidRWLock.ListIID(connectionName, rsVM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(iidInfoList) != 0 {
+		t.Errorf("no VM should be left, but %d VMs exist.", len(iidInfoList))
+	}
+}
+
+func TestStartVMGroupFixedIP(t *testing.T) {
+	connectionName := setupMockConnection(t)
+
+	primaryIP := mockVMReqInfo("")
+	primaryIP.PrivateIP = "10.0.1.10"
+
+	nicIP := mockVMReqInfo("")
+	nicIP.NetworkInterfaceList = []cres.NetworkInterfaceReqInfo{
+		{SubnetIID: cres.IID{NameId: "subnet-01"}, PrivateIP: "10.0.1.11"},
+	}
+
+	publicIP := mockVMReqInfo("")
+	publicIP.PublicIPIID = cres.IID{NameId: "publicip-01"}
+
+	testList := []struct {
+		name      string
+		count     int
+		reqInfo   cres.VMReqInfo
+		expectErr bool
+	}{
+		{"primary PrivateIP", 2, primaryIP, true},
+		{"NIC PrivateIP", 2, nicIP, true},
+		{"reserved PublicIP", 2, publicIP, true},
+		{"primary PrivateIP of one VM", 1, primaryIP, false},
+	}
+
+	for _, tc := range testList {
+		t.Run(tc.name, func(t *testing.T) {
+			groupInfo, err := StartVMGroup(connectionName, rsVM, VMGroupReqInfo{
+				NamePattern: "vm-fixed-%02d",
+				Count:       tc.count,
+				VMReqInfo:   tc.reqInfo,
+			})
+			if tc.expectErr {
+				if err == nil || !strings.Contains(err.Error(), "can not be shared") {
+					t.Errorf("a group of %d VMs with a %s should be rejected: %+v, %v", tc.count, tc.name, groupInfo, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if groupInfo.SucceededCount != tc.count {
+				t.Errorf("%d VMs should be created: %+v", tc.count, groupInfo)
+			}
+		})
+	}
+
+	// no VM is created by the rejected groups
+	iidInfoList, err := iidRWLock.ListIID(connectionName, rsVM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(iidInfoList) != 1 {
+		t.Errorf("only 1 VM should exist, but %d VMs exist.", len(iidInfoList))
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 )
 
 // ===== [ Constants and Variables ] =====
@@ -18,10 +19,15 @@ import (
 // ===== [ Public Functions ] =====
 
 // ConvGrpcStatusErr - GRPC 상태 코드 에러로 변환
+//   - Driver가 지원하지 않는 Handler와 기능: Unimplemented
 func ConvGrpcStatusErr(err error, tag string, method string) error {
 	logger := logger.NewLogger()
 
 	if err != nil {
+		if idrv.IsNotSupported(err) {
+			logger.Error(tag, " error while calling ", method, " method: ", err)
+			return status.Errorf(codes.Unimplemented, "%s error while calling %s method: %v ", tag, method, err)
+		}
 		if errStatus, ok := status.FromError(err); ok {
 			logger.Error(tag, " error while calling ", method, " method: ", errStatus.Message())
 			return status.Errorf(errStatus.Code(), "%s error while calling %s method: %v ", tag, method, errStatus.Message())
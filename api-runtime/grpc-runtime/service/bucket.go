@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	gc "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/common"
+	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
+	pb "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/stub/cbspider"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// objectChunkSize - GetObject 스트림으로 전송하는 데이터 단위
+const objectChunkSize = 64 * 1024
+
+// ===== [ Types ] =====
+
+// ===== [ Implementations ] =====
+
+// CreateBucket - Bucket 생성
+func (s *CCMService) CreateBucket(ctx context.Context, req *pb.BucketCreateRequest) (*pb.BucketInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.CreateBucket()")
+
+	// Grpc RegInfo => Driver ReqInfo
+	reqInfo := cres.BucketReqInfo{
+		IId: cres.IID{NameId: req.GetItem().GetName(), SystemId: ""},
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.CreateBucket(req.ConnectionName, rsBucket, reqInfo)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.CreateBucket()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.BucketInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.CreateBucket()")
+	}
+
+	resp := &pb.BucketInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// ListBucket - Bucket 목록
+func (s *CCMService) ListBucket(ctx context.Context, req *pb.BucketAllQryRequest) (*pb.ListBucketInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.ListBucket()")
+
+	// Call common-runtime API
+	result, err := cmrt.ListBucket(req.ConnectionName, rsBucket)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListBucket()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj []*pb.BucketInfo
+	err = gc.CopySrcToDest(&result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListBucket()")
+	}
+
+	resp := &pb.ListBucketInfoResponse{Items: grpcObj}
+	return resp, nil
+}
+
+// GetBucket - Bucket 조회
+func (s *CCMService) GetBucket(ctx context.Context, req *pb.BucketQryRequest) (*pb.BucketInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.GetBucket()")
+
+	// Call common-runtime API
+	result, err := cmrt.GetBucket(req.ConnectionName, rsBucket, req.Name)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.GetBucket()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.BucketInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.GetBucket()")
+	}
+
+	resp := &pb.BucketInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// DeleteBucket - Bucket 삭제
+func (s *CCMService) DeleteBucket(ctx context.Context, req *pb.BucketQryRequest) (*pb.BooleanResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.DeleteBucket()")
+
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteResource(req.ConnectionName, rsBucket, req.Name, req.Force)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.DeleteBucket()")
+	}
+
+	resp := &pb.BooleanResponse{Result: result}
+	return resp, nil
+}
+
+// ListAllBucket - 관리 Bucket 목록
+func (s *CCMService) ListAllBucket(ctx context.Context, req *pb.BucketAllQryRequest) (*pb.AllResourceInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.ListAllBucket()")
+
+	// Call common-runtime API
+	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, rsBucket)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListAllBucket()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.AllResourceInfoResponse
+	err = gc.CopySrcToDest(&allResourceList, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListAllBucket()")
+	}
+
+	return &grpcObj, nil
+}
+
+// DeleteCSPBucket - CSP Bucket 삭제
+func (s *CCMService) DeleteCSPBucket(ctx context.Context, req *pb.CSPBucketQryRequest) (*pb.BooleanResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.DeleteCSPBucket()")
+
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteCSPResource(req.ConnectionName, rsBucket, req.Id)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.DeleteCSPBucket()")
+	}
+
+	resp := &pb.BooleanResponse{Result: result}
+	return resp, nil
+}
+
+// ListObject - Bucket의 Object 목록
+func (s *CCMService) ListObject(ctx context.Context, req *pb.ObjectListRequest) (*pb.ListObjectInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.ListObject()")
+
+	// Call common-runtime API
+	result, err := cmrt.ListObject(req.ConnectionName, rsBucket, req.Name, req.Prefix)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListObject()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj []*pb.ObjectInfo
+	err = gc.CopySrcToDest(&result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListObject()")
+	}
+
+	resp := &pb.ListObjectInfoResponse{Items: grpcObj}
+	return resp, nil
+}
+
+// PutObject - Bucket에 Object 저장 (Client Stream)
+func (s *CCMService) PutObject(stream pb.CCM_PutObjectServer) error {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.PutObject()")
+
+	// 첫 메시지에서 Object 정보 수신
+	first, err := stream.Recv()
+	if err != nil {
+		return gc.ConvGrpcStatusErr(err, "", "CCMService.PutObject()")
+	}
+
+	// 수신한 데이터를 파이프로 전달하여 드라이버로 스트리밍
+	pr, pw := io.Pipe()
+	go func() {
+		if _, err := pw.Write(first.Data); err != nil {
+			return
+		}
+		for {
+			req, err := stream.Recv()
+			if err == io.EOF {
+				pw.Close()
+				return
+			}
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+			if _, err := pw.Write(req.Data); err != nil {
+				return
+			}
+		}
+	}()
+
+	size := first.Size_
+	if size == 0 && len(first.Data) == 0 {
+		// 크기 정보가 없으면 알 수 없음(-1)으로 처리
+		size = -1
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.PutObject(first.ConnectionName, rsBucket, first.Name, first.ObjectName, pr, size, first.ContentType)
+	// 드라이버가 중간에 실패하면 수신 고루틴의 Write를 해제
+	pr.CloseWithError(io.ErrClosedPipe)
+	if err != nil {
+		return gc.ConvGrpcStatusErr(err, "", "CCMService.PutObject()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.ObjectInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return gc.ConvGrpcStatusErr(err, "", "CCMService.PutObject()")
+	}
+
+	return stream.SendAndClose(&pb.ObjectInfoResponse{Item: &grpcObj})
+}
+
+// GetObject - Bucket의 Object 조회 (Server Stream)
+func (s *CCMService) GetObject(req *pb.ObjectQryRequest, stream pb.CCM_GetObjectServer) error {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.GetObject()")
+
+	// Call common-runtime API
+	reader, result, err := cmrt.GetObject(req.ConnectionName, rsBucket, req.Name, req.ObjectName)
+	if err != nil {
+		return gc.ConvGrpcStatusErr(err, "", "CCMService.GetObject()")
+	}
+	defer reader.Close()
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.ObjectInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return gc.ConvGrpcStatusErr(err, "", "CCMService.GetObject()")
+	}
+
+	// 첫 메시지로 Object 정보 전송
+	err = stream.Send(&pb.ObjectDataResponse{Item: &grpcObj})
+	if err != nil {
+		return gc.ConvGrpcStatusErr(err, "", "CCMService.GetObject()")
+	}
+
+	buf := make([]byte, objectChunkSize)
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			if sendErr := stream.Send(&pb.ObjectDataResponse{Data: buf[:n]}); sendErr != nil {
+				return gc.ConvGrpcStatusErr(sendErr, "", "CCMService.GetObject()")
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return gc.ConvGrpcStatusErr(err, "", "CCMService.GetObject()")
+		}
+	}
+}
+
+// DeleteObject - Bucket의 Object 삭제
+func (s *CCMService) DeleteObject(ctx context.Context, req *pb.ObjectQryRequest) (*pb.BooleanResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.DeleteObject()")
+
+	// Call common-runtime API
+	result, err := cmrt.DeleteObject(req.ConnectionName, rsBucket, req.Name, req.ObjectName)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.DeleteObject()")
+	}
+
+	resp := &pb.BooleanResponse{Result: result}
+	return resp, nil
+}
+
+// GetPresignedURL - Object의 Presigned URL 조회
+func (s *CCMService) GetPresignedURL(ctx context.Context, req *pb.PresignedURLRequest) (*pb.StringResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.GetPresignedURL()")
+
+	expires := int64(3600)
+	if req.GetItem().GetExpires() != "" {
+		var err error
+		expires, err = strconv.ParseInt(req.GetItem().GetExpires(), 10, 64)
+		if err != nil {
+			return nil, gc.ConvGrpcStatusErr(fmt.Errorf("invalid Expires: %v", err), "", "CCMService.GetPresignedURL()")
+		}
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.GetPresignedURL(req.ConnectionName, rsBucket, req.Name, req.GetItem().GetObjectName(),
+		req.GetItem().GetMethod(), time.Duration(expires)*time.Second)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.GetPresignedURL()")
+	}
+
+	resp := &pb.StringResponse{Result: result}
+	return resp, nil
+}
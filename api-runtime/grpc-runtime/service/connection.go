@@ -7,6 +7,8 @@ import (
 	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
 	pb "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/stub/cbspider"
 
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+
 	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
 )
 
@@ -29,7 +31,7 @@ func (s *CIMService) CreateConnectionConfig(ctx context.Context, req *pb.Connect
 		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.CreateConnectionConfig()")
 	}
 
-	connInfo, err := ccim.CreateConnectionConfigInfo(cimObj)
+	connInfo, err := cmrt.CreateConnectionConfig(cimObj)
 	if err != nil {
 		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.CreateConnectionConfig()")
 	}
@@ -95,15 +97,117 @@ func (s *CIMService) DeleteConnectionConfig(ctx context.Context, req *pb.Connect
 
 	logger.Debug("calling CIMService.DeleteConnectionConfig()")
 
-	result, err := ccim.DeleteConnectionConfig(req.ConfigName)
+	result, err := cmrt.DeleteConnectionConfig(req.ConfigName, req.Cascade, req.Orphan)
 	if err != nil {
-		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.DeleteConnectionConfig()")
+		return nil, gc.ConvGrpcStatusErr(convCIMErr(err), "", "CIMService.DeleteConnectionConfig()")
 	}
 
 	resp := &pb.BooleanResponse{Result: result}
 	return resp, nil
 }
 
+// UpdateConnectionConfig - Connection Config 변경
+func (s *CIMService) UpdateConnectionConfig(ctx context.Context, req *pb.ConnectionConfigInfoRequest) (*pb.ConnectionConfigInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CIMService.UpdateConnectionConfig()")
+
+	// GRPC 메시지에서 CIM 객체로 복사
+	var cimObj ccim.ConnectionConfigInfo
+	err := gc.CopySrcToDest(&req.Item, &cimObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.UpdateConnectionConfig()")
+	}
+
+	cncInfo, err := cmrt.UpdateConnectionConfig(cimObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(convCIMErr(err), "", "CIMService.UpdateConnectionConfig()")
+	}
+
+	// CIM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.ConnectionConfigInfo
+	err = gc.CopySrcToDest(&cncInfo, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.UpdateConnectionConfig()")
+	}
+
+	resp := &pb.ConnectionConfigInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// PatchConnectionConfig - Connection Config 부분 변경 (비어있지 않은 이름만 교체)
+func (s *CIMService) PatchConnectionConfig(ctx context.Context, req *pb.ConnectionConfigInfoRequest) (*pb.ConnectionConfigInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CIMService.PatchConnectionConfig()")
+
+	// GRPC 메시지에서 CIM 객체로 복사
+	var cimObj ccim.ConnectionConfigInfo
+	err := gc.CopySrcToDest(&req.Item, &cimObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.PatchConnectionConfig()")
+	}
+
+	cncInfo, err := cmrt.PatchConnectionConfig(cimObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(convCIMErr(err), "", "CIMService.PatchConnectionConfig()")
+	}
+
+	// CIM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.ConnectionConfigInfo
+	err = gc.CopySrcToDest(&cncInfo, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.PatchConnectionConfig()")
+	}
+
+	resp := &pb.ConnectionConfigInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// ListConnectionConfigDependent - Connection Config로 생성된 자원의 IID 목록
+func (s *CIMService) ListConnectionConfigDependent(ctx context.Context, req *pb.ConnectionConfigQryRequest) (*pb.ListIIDInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CIMService.ListConnectionConfigDependent()")
+
+	infoList, err := cmrt.ListConnectionConfigDependent(req.ConfigName)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.ListConnectionConfigDependent()")
+	}
+
+	// IID 객체에서 GRPC 메시지로 복사
+	var grpcObj []*pb.IIDInfo
+	err = gc.CopySrcToDest(&infoList, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.ListConnectionConfigDependent()")
+	}
+
+	resp := &pb.ListIIDInfoResponse{Items: grpcObj}
+	return resp, nil
+}
+
+// GetConnectionConfigCapability - Connection Config의 Driver가 지원하는 Handler와 기능 조회
+func (s *CIMService) GetConnectionConfigCapability(ctx context.Context, req *pb.ConnectionConfigQryRequest) (*pb.DriverCapabilityInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CIMService.GetConnectionConfigCapability()")
+
+	capability, err := cmrt.GetConnectionConfigCapability(req.ConfigName)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.GetConnectionConfigCapability()")
+	}
+
+	// Driver 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.DriverCapabilityInfo
+	err = gc.CopySrcToDest(&capability, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.GetConnectionConfigCapability()")
+	}
+
+	resp := &pb.DriverCapabilityInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
 // ===== [ Private Functions ] =====
 
 // ===== [ Public Functions ] =====
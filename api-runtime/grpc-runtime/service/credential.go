@@ -3,10 +3,14 @@ package service
 import (
 	"context"
 
+	"google.golang.org/grpc/metadata"
+
 	gc "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/common"
 	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
 	pb "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/stub/cbspider"
 
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+
 	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
 )
 
@@ -29,7 +33,7 @@ func (s *CIMService) CreateCredential(ctx context.Context, req *pb.CredentialInf
 		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.CreateCredential()")
 	}
 
-	crdInfo, err := cim.RegisterCredentialInfo(cimObj)
+	crdInfo, err := cmrt.RegisterCredential(cimObj, req.VerifyRegionName, req.VerifyDriverName)
 	if err != nil {
 		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.CreateCredential()")
 	}
@@ -95,15 +99,112 @@ func (s *CIMService) DeleteCredential(ctx context.Context, req *pb.CredentialQry
 
 	logger.Debug("calling CIMService.DeleteCredential()")
 
-	result, err := cim.UnRegisterCredential(req.CredentialName)
+	result, err := cmrt.UnRegisterCredential(req.CredentialName, req.Cascade, req.Orphan)
 	if err != nil {
-		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.DeleteCredential()")
+		return nil, gc.ConvGrpcStatusErr(convCIMErr(err), "", "CIMService.DeleteCredential()")
 	}
 
 	resp := &pb.BooleanResponse{Result: result}
 	return resp, nil
 }
 
+// RotateCredentialKey - Credential Master Key 교체
+// Key 파일을 다시 읽고, 저장된 Credential 값을 현재 Master Key로 재암호화
+func (s *CIMService) RotateCredentialKey(ctx context.Context, req *pb.Empty) (*pb.CredentialKeyRotateResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CIMService.RotateCredentialKey()")
+
+	// 관리자 토큰은 metadata로 전달
+	adminToken := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if tokens := md.Get(cmrt.AdminTokenHeader); len(tokens) > 0 {
+			adminToken = tokens[0]
+		}
+	}
+
+	keyID, count, err := cmrt.RotateCredentialKey(adminToken)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(convCIMErr(err), "", "CIMService.RotateCredentialKey()")
+	}
+
+	resp := &pb.CredentialKeyRotateResponse{KeyId: keyID, RotatedCount: int32(count)}
+	return resp, nil
+}
+
+// VerifyCredential - Region의 CSP에 연결하여 Credential 검증
+func (s *CIMService) VerifyCredential(ctx context.Context, req *pb.CredentialVerifyRequest) (*pb.BooleanResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CIMService.VerifyCredential()")
+
+	err := cmrt.VerifyCredential(req.CredentialName, req.RegionName, req.DriverName)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.VerifyCredential()")
+	}
+
+	resp := &pb.BooleanResponse{Result: true}
+	return resp, nil
+}
+
+// UpdateCredential - Credential 변경 (전체 Key/Value 교체)
+func (s *CIMService) UpdateCredential(ctx context.Context, req *pb.CredentialInfoRequest) (*pb.CredentialInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CIMService.UpdateCredential()")
+
+	// GRPC 메시지에서 CIM 객체로 복사
+	var cimObj cim.CredentialInfo
+	err := gc.CopySrcToDest(&req.Item, &cimObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.UpdateCredential()")
+	}
+
+	crdInfo, err := cim.UpdateCredential(cimObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(convCIMErr(err), "", "CIMService.UpdateCredential()")
+	}
+
+	// CIM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.CredentialInfo
+	err = gc.CopySrcToDest(&crdInfo, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.UpdateCredential()")
+	}
+
+	resp := &pb.CredentialInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// PatchCredential - Credential 부분 변경 (요청한 Key/Value만 추가/교체)
+func (s *CIMService) PatchCredential(ctx context.Context, req *pb.CredentialInfoRequest) (*pb.CredentialInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CIMService.PatchCredential()")
+
+	// GRPC 메시지에서 CIM 객체로 복사
+	var cimObj cim.CredentialInfo
+	err := gc.CopySrcToDest(&req.Item, &cimObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.PatchCredential()")
+	}
+
+	crdInfo, err := cim.PatchCredential(cimObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(convCIMErr(err), "", "CIMService.PatchCredential()")
+	}
+
+	// CIM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.CredentialInfo
+	err = gc.CopySrcToDest(&crdInfo, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.PatchCredential()")
+	}
+
+	resp := &pb.CredentialInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
 // ===== [ Private Functions ] =====
 
 // ===== [ Public Functions ] =====
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+
+	gc "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/common"
+	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
+	pb "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/stub/cbspider"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// ===== [ Types ] =====
+
+// ===== [ Implementations ] =====
+
+// CreateDNSZone - Private DNS Zone 생성
+func (s *CCMService) CreateDNSZone(ctx context.Context, req *pb.DNSZoneCreateRequest) (*pb.DNSZoneInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.CreateDNSZone()")
+
+	// Grpc RegInfo => Driver ReqInfo
+	reqInfo := cres.DNSZoneReqInfo{
+		IId:        cres.IID{NameId: req.GetItem().GetName(), SystemId: ""},
+		DomainName: req.GetItem().GetDomainName(),
+		VpcIID:     cres.IID{NameId: req.GetItem().GetVpcName(), SystemId: ""},
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.CreateDNSZone(req.ConnectionName, rsDNSZone, reqInfo)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.CreateDNSZone()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.DNSZoneInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.CreateDNSZone()")
+	}
+
+	resp := &pb.DNSZoneInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// ListDNSZone - Private DNS Zone 목록
+func (s *CCMService) ListDNSZone(ctx context.Context, req *pb.DNSZoneAllQryRequest) (*pb.ListDNSZoneInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.ListDNSZone()")
+
+	// Call common-runtime API
+	result, err := cmrt.ListDNSZone(req.ConnectionName, rsDNSZone)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListDNSZone()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj []*pb.DNSZoneInfo
+	err = gc.CopySrcToDest(&result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListDNSZone()")
+	}
+
+	resp := &pb.ListDNSZoneInfoResponse{Items: grpcObj}
+	return resp, nil
+}
+
+// GetDNSZone - Private DNS Zone 조회
+func (s *CCMService) GetDNSZone(ctx context.Context, req *pb.DNSZoneQryRequest) (*pb.DNSZoneInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.GetDNSZone()")
+
+	// Call common-runtime API
+	result, err := cmrt.GetDNSZone(req.ConnectionName, rsDNSZone, req.Name)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.GetDNSZone()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.DNSZoneInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.GetDNSZone()")
+	}
+
+	resp := &pb.DNSZoneInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// DeleteDNSZone - Private DNS Zone 삭제
+func (s *CCMService) DeleteDNSZone(ctx context.Context, req *pb.DNSZoneQryRequest) (*pb.BooleanResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.DeleteDNSZone()")
+
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteResource(req.ConnectionName, rsDNSZone, req.Name, req.Force)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.DeleteDNSZone()")
+	}
+
+	resp := &pb.BooleanResponse{Result: result}
+	return resp, nil
+}
+
+// ListAllDNSZone - 관리 Private DNS Zone 목록
+func (s *CCMService) ListAllDNSZone(ctx context.Context, req *pb.DNSZoneAllQryRequest) (*pb.AllResourceInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.ListAllDNSZone()")
+
+	// Call common-runtime API
+	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, rsDNSZone)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListAllDNSZone()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.AllResourceInfoResponse
+	err = gc.CopySrcToDest(&allResourceList, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListAllDNSZone()")
+	}
+
+	return &grpcObj, nil
+}
+
+// DeleteCSPDNSZone - CSP Private DNS Zone 삭제
+func (s *CCMService) DeleteCSPDNSZone(ctx context.Context, req *pb.CSPDNSZoneQryRequest) (*pb.BooleanResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.DeleteCSPDNSZone()")
+
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteCSPResource(req.ConnectionName, rsDNSZone, req.Id)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.DeleteCSPDNSZone()")
+	}
+
+	resp := &pb.BooleanResponse{Result: result}
+	return resp, nil
+}
+
+// AddDNSRecord - Private DNS Zone에 레코드 추가
+func (s *CCMService) AddDNSRecord(ctx context.Context, req *pb.DNSRecordRequest) (*pb.DNSZoneInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.AddDNSRecord()")
+
+	// Call common-runtime API
+	result, err := cmrt.AddDNSRecord(req.ConnectionName, rsDNSZone, req.Name, convDNSRecordInfo(req.GetItem()))
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.AddDNSRecord()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.DNSZoneInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.AddDNSRecord()")
+	}
+
+	resp := &pb.DNSZoneInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// UpdateDNSRecord - Private DNS Zone의 레코드 변경
+func (s *CCMService) UpdateDNSRecord(ctx context.Context, req *pb.DNSRecordRequest) (*pb.DNSZoneInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.UpdateDNSRecord()")
+
+	// Call common-runtime API
+	result, err := cmrt.UpdateDNSRecord(req.ConnectionName, rsDNSZone, req.Name, convDNSRecordInfo(req.GetItem()))
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.UpdateDNSRecord()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.DNSZoneInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.UpdateDNSRecord()")
+	}
+
+	resp := &pb.DNSZoneInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// RemoveDNSRecord - Private DNS Zone의 레코드 삭제
+func (s *CCMService) RemoveDNSRecord(ctx context.Context, req *pb.DNSRecordRequest) (*pb.BooleanResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.RemoveDNSRecord()")
+
+	// Call common-runtime API
+	result, err := cmrt.RemoveDNSRecord(req.ConnectionName, rsDNSZone, req.Name, req.GetItem().GetName(), cres.DNSRecordType(req.GetItem().GetType()))
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.RemoveDNSRecord()")
+	}
+
+	resp := &pb.BooleanResponse{Result: result}
+	return resp, nil
+}
+
+// ===== [ Private Functions ] =====
+
+// convDNSRecordInfo - GRPC 레코드 정보를 드라이버 레코드 정보로 변환
+func convDNSRecordInfo(item *pb.DNSRecordInfo) cres.DNSRecordInfo {
+	return cres.DNSRecordInfo{
+		Name:   item.GetName(),
+		Type:   cres.DNSRecordType(item.GetType()),
+		TTL:    item.GetTtl(),
+		Values: item.GetValues(),
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
 	pb "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/stub/cbspider"
 
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+
 	dim "github.com/cloud-barista/cb-spider/cloud-info-manager/driver-info-manager"
 )
 
@@ -95,15 +97,66 @@ func (s *CIMService) DeleteCloudDriver(ctx context.Context, req *pb.CloudDriverQ
 
 	logger.Debug("calling CIMService.DeleteCloudDriver()")
 
-	result, err := dim.UnRegisterCloudDriver(req.DriverName)
+	result, err := cmrt.UnRegisterCloudDriver(req.DriverName, req.Cascade, req.Orphan)
 	if err != nil {
-		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.DeleteCloudDriver()")
+		return nil, gc.ConvGrpcStatusErr(convCIMErr(err), "", "CIMService.DeleteCloudDriver()")
 	}
 
 	resp := &pb.BooleanResponse{Result: result}
 	return resp, nil
 }
 
+// UpdateCloudDriver - Cloud Driver 변경
+func (s *CIMService) UpdateCloudDriver(ctx context.Context, req *pb.CloudDriverInfoRequest) (*pb.CloudDriverInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CIMService.UpdateCloudDriver()")
+
+	// GRPC 메시지에서 CIM 객체로 복사
+	var cimObj dim.CloudDriverInfo
+	err := gc.CopySrcToDest(&req.Item, &cimObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.UpdateCloudDriver()")
+	}
+
+	drvInfo, err := dim.UpdateCloudDriver(cimObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(convCIMErr(err), "", "CIMService.UpdateCloudDriver()")
+	}
+
+	// CIM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.CloudDriverInfo
+	err = gc.CopySrcToDest(&drvInfo, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.UpdateCloudDriver()")
+	}
+
+	resp := &pb.CloudDriverInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// GetCloudDriverCapability - Cloud Driver가 지원하는 Handler와 기능 조회
+func (s *CIMService) GetCloudDriverCapability(ctx context.Context, req *pb.CloudDriverQryRequest) (*pb.DriverCapabilityInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CIMService.GetCloudDriverCapability()")
+
+	capability, err := cmrt.GetCloudDriverCapability(req.DriverName)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.GetCloudDriverCapability()")
+	}
+
+	// Driver 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.DriverCapabilityInfo
+	err = gc.CopySrcToDest(&capability, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.GetCloudDriverCapability()")
+	}
+
+	resp := &pb.DriverCapabilityInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
 // ===== [ Private Functions ] =====
 
 // ===== [ Public Functions ] =====
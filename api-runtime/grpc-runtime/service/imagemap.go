@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+
+	gc "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/common"
+	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
+	pb "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/stub/cbspider"
+
+	imim "github.com/cloud-barista/cb-spider/cloud-info-manager/image-map-info-manager"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// ===== [ Types ] =====
+
+// ===== [ Implementations ] =====
+
+// CreateImageMap - Image Map 생성
+func (s *CIMService) CreateImageMap(ctx context.Context, req *pb.ImageMapInfoRequest) (*pb.ImageMapInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CIMService.CreateImageMap()")
+
+	// GRPC 메시지에서 CIM 객체로 복사
+	var cimObj imim.ImageMapInfo
+	err := gc.CopySrcToDest(&req.Item, &cimObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.CreateImageMap()")
+	}
+
+	imgMapInfo, err := imim.RegisterImageMapInfo(cimObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.CreateImageMap()")
+	}
+
+	// CIM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.ImageMapInfo
+	err = gc.CopySrcToDest(&imgMapInfo, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.CreateImageMap()")
+	}
+
+	resp := &pb.ImageMapInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// ImportImageMap - YAML 문서로 Image Map 일괄 생성
+func (s *CIMService) ImportImageMap(ctx context.Context, req *pb.ImageMapImportRequest) (*pb.ListImageMapInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CIMService.ImportImageMap()")
+
+	infoList, err := imim.ImportImageMap([]byte(req.YamlData))
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.ImportImageMap()")
+	}
+
+	// CIM 객체에서 GRPC 메시지로 복사
+	var grpcObj []*pb.ImageMapInfo
+	err = gc.CopySrcToDest(&infoList, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.ImportImageMap()")
+	}
+
+	resp := &pb.ListImageMapInfoResponse{Items: grpcObj}
+	return resp, nil
+}
+
+// ListImageMap - Image Map 목록 (ImageName 지정시 해당 이미지의 목록)
+func (s *CIMService) ListImageMap(ctx context.Context, req *pb.ImageMapAllQryRequest) (*pb.ListImageMapInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CIMService.ListImageMap()")
+
+	var infoList []*imim.ImageMapInfo
+	var err error
+	if req.ImageName == "" {
+		infoList, err = imim.ListImageMap()
+	} else {
+		infoList, err = imim.ListImageMapByImageName(req.ImageName)
+	}
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.ListImageMap()")
+	}
+
+	// CIM 객체에서 GRPC 메시지로 복사
+	var grpcObj []*pb.ImageMapInfo
+	err = gc.CopySrcToDest(&infoList, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.ListImageMap()")
+	}
+
+	resp := &pb.ListImageMapInfoResponse{Items: grpcObj}
+	return resp, nil
+}
+
+// GetImageMap - Image Map 조회
+func (s *CIMService) GetImageMap(ctx context.Context, req *pb.ImageMapQryRequest) (*pb.ImageMapInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CIMService.GetImageMap()")
+
+	imgMapInfo, err := imim.GetImageMap(req.ImageName, req.RegionName)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.GetImageMap()")
+	}
+
+	// CIM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.ImageMapInfo
+	err = gc.CopySrcToDest(&imgMapInfo, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.GetImageMap()")
+	}
+
+	resp := &pb.ImageMapInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// DeleteImageMap - Image Map 삭제
+func (s *CIMService) DeleteImageMap(ctx context.Context, req *pb.ImageMapQryRequest) (*pb.BooleanResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CIMService.DeleteImageMap()")
+
+	result, err := imim.UnRegisterImageMap(req.ImageName, req.RegionName)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.DeleteImageMap()")
+	}
+
+	resp := &pb.BooleanResponse{Result: result}
+	return resp, nil
+}
+
+// ===== [ Private Functions ] =====
+
+// ===== [ Public Functions ] =====
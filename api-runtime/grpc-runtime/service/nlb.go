@@ -0,0 +1,293 @@
+package service
+
+import (
+	"context"
+
+	gc "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/common"
+	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
+	pb "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/stub/cbspider"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// ===== [ Types ] =====
+
+// ===== [ Implementations ] =====
+
+// CreateNLB - NLB 생성
+func (s *CCMService) CreateNLB(ctx context.Context, req *pb.NLBCreateRequest) (*pb.NLBInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.CreateNLB()")
+
+	// Grpc RegInfo => Driver ReqInfo
+	reqInfo := cres.NLBReqInfo{
+		IId:    cres.IID{NameId: req.GetItem().GetName(), SystemId: ""},
+		VpcIID: cres.IID{NameId: req.GetItem().GetVpcName(), SystemId: ""},
+		Type:   req.GetItem().GetType(),
+		Listener: cres.ListenerInfo{
+			Protocol: req.GetItem().GetListener().GetProtocol(),
+			Port:     req.GetItem().GetListener().GetPort(),
+		},
+		VMGroup: cres.NLBVMGroupInfo{
+			Protocol: req.GetItem().GetVmGroup().GetProtocol(),
+			Port:     req.GetItem().GetVmGroup().GetPort(),
+			VMs:      convVMNames(req.GetItem().GetVmGroup().GetVms()),
+		},
+		HealthChecker: convHealthCheckerInfo(req.GetItem().GetHealthChecker()),
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.CreateNLB(req.ConnectionName, rsNLB, reqInfo)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.CreateNLB()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.NLBInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.CreateNLB()")
+	}
+
+	resp := &pb.NLBInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// ListNLB - NLB 목록
+func (s *CCMService) ListNLB(ctx context.Context, req *pb.NLBAllQryRequest) (*pb.ListNLBInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.ListNLB()")
+
+	// Call common-runtime API
+	result, err := cmrt.ListNLB(req.ConnectionName, rsNLB)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListNLB()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj []*pb.NLBInfo
+	err = gc.CopySrcToDest(&result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListNLB()")
+	}
+
+	resp := &pb.ListNLBInfoResponse{Items: grpcObj}
+	return resp, nil
+}
+
+// GetNLB - NLB 조회
+func (s *CCMService) GetNLB(ctx context.Context, req *pb.NLBQryRequest) (*pb.NLBInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.GetNLB()")
+
+	// Call common-runtime API
+	result, err := cmrt.GetNLB(req.ConnectionName, rsNLB, req.Name)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.GetNLB()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.NLBInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.GetNLB()")
+	}
+
+	resp := &pb.NLBInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// DeleteNLB - NLB 삭제
+func (s *CCMService) DeleteNLB(ctx context.Context, req *pb.NLBQryRequest) (*pb.BooleanResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.DeleteNLB()")
+
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteResource(req.ConnectionName, rsNLB, req.Name, req.Force)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.DeleteNLB()")
+	}
+
+	resp := &pb.BooleanResponse{Result: result}
+	return resp, nil
+}
+
+// ListAllNLB - 관리 NLB 목록
+func (s *CCMService) ListAllNLB(ctx context.Context, req *pb.NLBAllQryRequest) (*pb.AllResourceInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.ListAllNLB()")
+
+	// Call common-runtime API
+	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, rsNLB)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListAllNLB()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.AllResourceInfoResponse
+	err = gc.CopySrcToDest(&allResourceList, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListAllNLB()")
+	}
+
+	return &grpcObj, nil
+}
+
+// DeleteCSPNLB - CSP NLB 삭제
+func (s *CCMService) DeleteCSPNLB(ctx context.Context, req *pb.CSPNLBQryRequest) (*pb.BooleanResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.DeleteCSPNLB()")
+
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteCSPResource(req.ConnectionName, rsNLB, req.Id)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.DeleteCSPNLB()")
+	}
+
+	resp := &pb.BooleanResponse{Result: result}
+	return resp, nil
+}
+
+// AddNLBVMs - NLB VM 그룹에 VM 추가
+func (s *CCMService) AddNLBVMs(ctx context.Context, req *pb.NLBVMsRequest) (*pb.NLBVMGroupInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.AddNLBVMs()")
+
+	// Call common-runtime API
+	result, err := cmrt.AddNLBVMs(req.ConnectionName, rsNLB, req.Name, convVMNames(req.GetItem().GetVms()))
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.AddNLBVMs()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.NLBVMGroupInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.AddNLBVMs()")
+	}
+
+	resp := &pb.NLBVMGroupInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// RemoveNLBVMs - NLB VM 그룹에서 VM 제거
+func (s *CCMService) RemoveNLBVMs(ctx context.Context, req *pb.NLBVMsRequest) (*pb.BooleanResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.RemoveNLBVMs()")
+
+	// Call common-runtime API
+	result, err := cmrt.RemoveNLBVMs(req.ConnectionName, rsNLB, req.Name, convVMNames(req.GetItem().GetVms()))
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.RemoveNLBVMs()")
+	}
+
+	resp := &pb.BooleanResponse{Result: result}
+	return resp, nil
+}
+
+// GetNLBVMGroupHealthInfo - NLB VM 그룹 상태 조회
+func (s *CCMService) GetNLBVMGroupHealthInfo(ctx context.Context, req *pb.NLBQryRequest) (*pb.NLBHealthInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.GetNLBVMGroupHealthInfo()")
+
+	// Call common-runtime API
+	result, err := cmrt.GetNLBVMGroupHealthInfo(req.ConnectionName, rsNLB, req.Name)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.GetNLBVMGroupHealthInfo()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.NLBHealthInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.GetNLBVMGroupHealthInfo()")
+	}
+
+	resp := &pb.NLBHealthInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// ChangeNLBListener - NLB Listener 변경
+func (s *CCMService) ChangeNLBListener(ctx context.Context, req *pb.NLBListenerChangeRequest) (*pb.NLBListenerInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.ChangeNLBListener()")
+
+	listener := cres.ListenerInfo{
+		Protocol: req.GetItem().GetProtocol(),
+		Port:     req.GetItem().GetPort(),
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ChangeNLBListener(req.ConnectionName, rsNLB, req.Name, listener)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ChangeNLBListener()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.NLBListenerInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ChangeNLBListener()")
+	}
+
+	resp := &pb.NLBListenerInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// ChangeNLBHealthChecker - NLB HealthChecker 변경
+func (s *CCMService) ChangeNLBHealthChecker(ctx context.Context, req *pb.NLBHealthCheckerChangeRequest) (*pb.NLBHealthCheckerInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.ChangeNLBHealthChecker()")
+
+	// Call common-runtime API
+	result, err := cmrt.ChangeNLBHealthChecker(req.ConnectionName, rsNLB, req.Name, convHealthCheckerInfo(req.GetItem()))
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ChangeNLBHealthChecker()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.NLBHealthCheckerInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ChangeNLBHealthChecker()")
+	}
+
+	resp := &pb.NLBHealthCheckerInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// ===== [ Private Functions ] =====
+
+func convVMNames(vmNames []string) []cres.IID {
+	vmIIDs := []cres.IID{}
+	for _, vmName := range vmNames {
+		vmIIDs = append(vmIIDs, cres.IID{NameId: vmName, SystemId: ""})
+	}
+	return vmIIDs
+}
+
+func convHealthCheckerInfo(item *pb.NLBHealthCheckerCreateInfo) cres.HealthCheckerInfo {
+	return cres.HealthCheckerInfo{
+		Protocol:  item.GetProtocol(),
+		Port:      item.GetPort(),
+		Interval:  int(item.GetInterval()),
+		Timeout:   int(item.GetTimeout()),
+		Threshold: int(item.GetThreshold()),
+	}
+}
+
+// ===== [ Public Functions ] =====
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 
+	gc "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/common"
 	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
 	pb "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/stub/cbspider"
 
@@ -27,6 +28,28 @@ func (s *CIMService) ListCloudOS(ctx context.Context, req *pb.Empty) (*pb.ListCl
 	return resp, nil
 }
 
+// GetCloudOSSchema - Cloud OS의 Credential, Region Key 정의 조회
+func (s *CIMService) GetCloudOSSchema(ctx context.Context, req *pb.CloudOSQryRequest) (*pb.CloudOSSchemaResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CIMService.GetCloudOSSchema()")
+
+	schema, err := im.GetCloudOSSchema(req.CloudOsName)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.GetCloudOSSchema()")
+	}
+
+	// CIM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.CloudOSSchema
+	err = gc.CopySrcToDest(&schema, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.GetCloudOSSchema()")
+	}
+
+	resp := &pb.CloudOSSchemaResponse{Item: &grpcObj}
+	return resp, nil
+}
+
 // ===== [ Private Functions ] =====
 
 // ===== [ Public Functions ] =====
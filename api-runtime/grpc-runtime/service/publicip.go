@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+
+	gc "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/common"
+	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
+	pb "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/stub/cbspider"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// ===== [ Types ] =====
+
+// ===== [ Implementations ] =====
+
+// AllocatePublicIP - PublicIP 할당
+func (s *CCMService) AllocatePublicIP(ctx context.Context, req *pb.PublicIPAllocateRequest) (*pb.PublicIPInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.AllocatePublicIP()")
+
+	// Grpc RegInfo => Driver ReqInfo
+	reqInfo := cres.PublicIPReqInfo{
+		IId: cres.IID{NameId: req.GetItem().GetName(), SystemId: ""},
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.AllocatePublicIP(req.ConnectionName, rsPublicIP, reqInfo)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.AllocatePublicIP()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.PublicIPInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.AllocatePublicIP()")
+	}
+
+	resp := &pb.PublicIPInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// ListPublicIP - PublicIP 목록
+func (s *CCMService) ListPublicIP(ctx context.Context, req *pb.PublicIPAllQryRequest) (*pb.ListPublicIPInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.ListPublicIP()")
+
+	// Call common-runtime API
+	result, err := cmrt.ListPublicIP(req.ConnectionName, rsPublicIP)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListPublicIP()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj []*pb.PublicIPInfo
+	err = gc.CopySrcToDest(&result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListPublicIP()")
+	}
+
+	resp := &pb.ListPublicIPInfoResponse{Items: grpcObj}
+	return resp, nil
+}
+
+// GetPublicIP - PublicIP 조회
+func (s *CCMService) GetPublicIP(ctx context.Context, req *pb.PublicIPQryRequest) (*pb.PublicIPInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.GetPublicIP()")
+
+	// Call common-runtime API
+	result, err := cmrt.GetPublicIP(req.ConnectionName, rsPublicIP, req.Name)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.GetPublicIP()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.PublicIPInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.GetPublicIP()")
+	}
+
+	resp := &pb.PublicIPInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// ReleasePublicIP - PublicIP 반납
+func (s *CCMService) ReleasePublicIP(ctx context.Context, req *pb.PublicIPQryRequest) (*pb.BooleanResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.ReleasePublicIP()")
+
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteResource(req.ConnectionName, rsPublicIP, req.Name, req.Force)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ReleasePublicIP()")
+	}
+
+	resp := &pb.BooleanResponse{Result: result}
+	return resp, nil
+}
+
+// ListAllPublicIP - 관리 PublicIP 목록
+func (s *CCMService) ListAllPublicIP(ctx context.Context, req *pb.PublicIPAllQryRequest) (*pb.AllResourceInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.ListAllPublicIP()")
+
+	// Call common-runtime API
+	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, rsPublicIP)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListAllPublicIP()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.AllResourceInfoResponse
+	err = gc.CopySrcToDest(&allResourceList, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListAllPublicIP()")
+	}
+
+	return &grpcObj, nil
+}
+
+// ReleaseCSPPublicIP - CSP PublicIP 반납
+func (s *CCMService) ReleaseCSPPublicIP(ctx context.Context, req *pb.CSPPublicIPQryRequest) (*pb.BooleanResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.ReleaseCSPPublicIP()")
+
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteCSPResource(req.ConnectionName, rsPublicIP, req.Id)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ReleaseCSPPublicIP()")
+	}
+
+	resp := &pb.BooleanResponse{Result: result}
+	return resp, nil
+}
+
+// AssociatePublicIP - PublicIP를 VM에 연결
+func (s *CCMService) AssociatePublicIP(ctx context.Context, req *pb.PublicIPAssociateRequest) (*pb.PublicIPInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.AssociatePublicIP()")
+
+	// Call common-runtime API
+	result, err := cmrt.AssociatePublicIP(req.ConnectionName, rsPublicIP, req.Name, req.GetItem().GetVmName())
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.AssociatePublicIP()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.PublicIPInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.AssociatePublicIP()")
+	}
+
+	resp := &pb.PublicIPInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// DisassociatePublicIP - PublicIP를 VM에서 해제
+func (s *CCMService) DisassociatePublicIP(ctx context.Context, req *pb.PublicIPQryRequest) (*pb.BooleanResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.DisassociatePublicIP()")
+
+	// Call common-runtime API
+	result, err := cmrt.DisassociatePublicIP(req.ConnectionName, rsPublicIP, req.Name)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.DisassociatePublicIP()")
+	}
+
+	resp := &pb.BooleanResponse{Result: result}
+	return resp, nil
+}
@@ -7,7 +7,10 @@ import (
 	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
 	pb "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/stub/cbspider"
 
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+
 	rim "github.com/cloud-barista/cb-spider/cloud-info-manager/region-info-manager"
+	icbs "github.com/cloud-barista/cb-store/interfaces"
 )
 
 // ===== [ Constants and Variables ] =====
@@ -95,15 +98,102 @@ func (s *CIMService) DeleteRegion(ctx context.Context, req *pb.RegionQryRequest)
 
 	logger.Debug("calling CIMService.DeleteRegion()")
 
-	result, err := rim.UnRegisterRegion(req.RegionName)
+	result, err := cmrt.UnRegisterRegion(req.RegionName, req.Cascade, req.Orphan)
 	if err != nil {
-		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.DeleteRegion()")
+		return nil, gc.ConvGrpcStatusErr(convCIMErr(err), "", "CIMService.DeleteRegion()")
 	}
 
 	resp := &pb.BooleanResponse{Result: result}
 	return resp, nil
 }
 
+// UpdateRegion - Region 변경 (전체 Key/Value 교체)
+func (s *CIMService) UpdateRegion(ctx context.Context, req *pb.RegionInfoRequest) (*pb.RegionInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CIMService.UpdateRegion()")
+
+	// GRPC 메시지에서 CIM 객체로 복사
+	var cimObj rim.RegionInfo
+	err := gc.CopySrcToDest(&req.Item, &cimObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.UpdateRegion()")
+	}
+
+	rgnInfo, err := rim.UpdateRegion(cimObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(convCIMErr(err), "", "CIMService.UpdateRegion()")
+	}
+
+	// CIM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.RegionInfo
+	err = gc.CopySrcToDest(&rgnInfo, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.UpdateRegion()")
+	}
+
+	resp := &pb.RegionInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// PatchRegion - Region 부분 변경 (요청한 Key/Value만 추가/교체)
+func (s *CIMService) PatchRegion(ctx context.Context, req *pb.RegionInfoRequest) (*pb.RegionInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CIMService.PatchRegion()")
+
+	// GRPC 메시지에서 CIM 객체로 복사
+	var cimObj rim.RegionInfo
+	err := gc.CopySrcToDest(&req.Item, &cimObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.PatchRegion()")
+	}
+
+	rgnInfo, err := rim.PatchRegion(cimObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(convCIMErr(err), "", "CIMService.PatchRegion()")
+	}
+
+	// CIM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.RegionInfo
+	err = gc.CopySrcToDest(&rgnInfo, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.PatchRegion()")
+	}
+
+	resp := &pb.RegionInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// SyncRegion - CSP의 Region, Zone 목록으로 Region 등록 또는 변경
+func (s *CIMService) SyncRegion(ctx context.Context, req *pb.RegionSyncRequest) (*pb.ListRegionInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CIMService.SyncRegion()")
+
+	// GRPC 메시지에서 CIM 객체로 복사
+	var keyValueList []icbs.KeyValue
+	err := gc.CopySrcToDest(&req.KeyValueInfoList, &keyValueList)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.SyncRegion()")
+	}
+
+	infoList, err := cmrt.SyncRegion(req.ConnectionName, keyValueList)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(convCIMErr(err), "", "CIMService.SyncRegion()")
+	}
+
+	// CIM 객체에서 GRPC 메시지로 복사
+	var grpcObj []*pb.RegionInfo
+	err = gc.CopySrcToDest(&infoList, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CIMService.SyncRegion()")
+	}
+
+	resp := &pb.ListRegionInfoResponse{Items: grpcObj}
+	return resp, nil
+}
+
 // ===== [ Private Functions ] =====
 
 // ===== [ Public Functions ] =====
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+
+	gc "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/common"
+	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
+	pb "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/stub/cbspider"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// ===== [ Types ] =====
+
+// ===== [ Implementations ] =====
+
+// ListRegionZone - CSP의 Region, Zone 목록
+func (s *CCMService) ListRegionZone(ctx context.Context, req *pb.RegionZoneAllQryRequest) (*pb.ListRegionZoneInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.ListRegionZone()")
+
+	// Call common-runtime API
+	result, err := cmrt.ListRegionZone(req.ConnectionName)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListRegionZone()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj []*pb.RegionZoneInfo
+	err = gc.CopySrcToDest(&result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListRegionZone()")
+	}
+
+	resp := &pb.ListRegionZoneInfoResponse{Items: grpcObj}
+	return resp, nil
+}
+
+// GetRegionZone - CSP의 Region, Zone 조회
+func (s *CCMService) GetRegionZone(ctx context.Context, req *pb.RegionZoneQryRequest) (*pb.RegionZoneInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.GetRegionZone()")
+
+	// Call common-runtime API
+	result, err := cmrt.GetRegionZone(req.ConnectionName, req.Name)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.GetRegionZone()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.RegionZoneInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.GetRegionZone()")
+	}
+
+	resp := &pb.RegionZoneInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// ListZone - Connection의 Region에 있는 Zone 목록
+func (s *CCMService) ListZone(ctx context.Context, req *pb.RegionZoneAllQryRequest) (*pb.ListZoneInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.ListZone()")
+
+	// Call common-runtime API
+	result, err := cmrt.ListZone(req.ConnectionName)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListZone()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj []*pb.ZoneInfo
+	err = gc.CopySrcToDest(&result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListZone()")
+	}
+
+	resp := &pb.ListZoneInfoResponse{Items: grpcObj}
+	return resp, nil
+}
+
+// ===== [ Private Functions ] =====
+
+// ===== [ Public Functions ] =====
@@ -1,14 +1,27 @@
 package service
 
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	im "github.com/cloud-barista/cb-spider/cloud-info-manager"
+)
+
 // ===== [ Constants and Variables ] =====
 
 // define string of resource types
 const (
-	rsImage string = "image"
-	rsVPC   string = "vpc"
-	rsSG    string = "sg"
-	rsKey   string = "keypair"
-	rsVM    string = "vm"
+	rsImage      string = "image"
+	rsVPC        string = "vpc"
+	rsSG         string = "sg"
+	rsKey        string = "keypair"
+	rsVM         string = "vm"
+	rsNLB        string = "nlb"
+	rsPublicIP   string = "publicip"
+	rsVPCPeering string = "vpcpeering"
+	rsDNSZone    string = "dnszone"
+	rsBucket     string = "bucket"
 )
 
 const rsSubnetPrefix string = "subnet:"
@@ -32,4 +45,20 @@ type SSHService struct {
 
 // ===== [ Private Functions ] =====
 
+// convCIMErr - CIM 에러를 GRPC 상태 코드로 변환
+//   - Version 충돌: Aborted (클라이언트는 다시 조회 후 재시도)
+//   - 참조하는 객체가 있는 삭제: FailedPrecondition (참조 객체 삭제 후 재시도 또는 cascade 사용, IID가 있으면 orphan도 사용)
+func convCIMErr(err error) error {
+	if im.IsVersionConflict(err) {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	if cmrt.IsDependentError(err) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if cmrt.IsAdminTokenError(err) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return err
+}
+
 // ===== [ Public Functions ] =====
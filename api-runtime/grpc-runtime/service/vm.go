@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	gc "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/common"
 	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
@@ -17,35 +18,70 @@ import (
 
 // ===== [ Implementations ] =====
 
-// StartVM - VM 시작
-func (s *CCMService) StartVM(ctx context.Context, req *pb.VMCreateRequest) (*pb.VMInfoResponse, error) {
-	logger := logger.NewLogger()
-
-	logger.Debug("calling CCMService.StartVM()")
+// convVMReqInfo - GRPC 메시지를 CCM 객체로 변환
+func convVMReqInfo(item *pb.VMCreateInfo) cres.VMReqInfo {
+	if item == nil {
+		return cres.VMReqInfo{}
+	}
 
-	// Rest RegInfo => Driver ReqInfo
 	// (1) create SecurityGroup IID List
 	sgIIDList := []cres.IID{}
-	for _, sgName := range req.Item.SecurityGroupNames {
+	for _, sgName := range item.SecurityGroupNames {
 		// SG NameID format => {VPC NameID} + sgDELIMITER + {SG NameID}
 		// transform: SG NameID => {VPC NameID}-{SG NameID}
-		sgIID := cres.IID{NameId: req.Item.VpcName + sgDELIMITER + sgName, SystemId: ""}
+		sgIID := cres.IID{NameId: item.VpcName + sgDELIMITER + sgName, SystemId: ""}
 		sgIIDList = append(sgIIDList, sgIID)
 	}
-	// (2) create VMReqInfo with SecurityGroup IID List
-	reqInfo := cres.VMReqInfo{
-		IId:               cres.IID{NameId: req.Item.Name, SystemId: ""},
-		ImageIID:          cres.IID{NameId: req.Item.ImageName, SystemId: ""},
-		VpcIID:            cres.IID{NameId: req.Item.VpcName, SystemId: ""},
-		SubnetIID:         cres.IID{NameId: req.Item.SubnetName, SystemId: ""},
+	// (2) create secondary NIC List
+	nicReqInfoList := []cres.NetworkInterfaceReqInfo{}
+	for _, nic := range item.NetworkInterfaces {
+		nicSGIIDList := []cres.IID{}
+		for _, sgName := range nic.SecurityGroupNames {
+			nicSGIIDList = append(nicSGIIDList, cres.IID{NameId: item.VpcName + sgDELIMITER + sgName, SystemId: ""})
+		}
+		nicReqInfoList = append(nicReqInfoList, cres.NetworkInterfaceReqInfo{
+			SubnetIID:         cres.IID{NameId: nic.SubnetName, SystemId: ""},
+			SecurityGroupIIDs: nicSGIIDList,
+			PrivateIP:         nic.PrivateIp,
+			PublicIP:          nic.PublicIp,
+		})
+	}
+	// (3) create VMReqInfo with SecurityGroup IID List
+	return cres.VMReqInfo{
+		IId:               cres.IID{NameId: item.Name, SystemId: ""},
+		ImageIID:          cres.IID{NameId: item.ImageName, SystemId: ""},
+		VpcIID:            cres.IID{NameId: item.VpcName, SystemId: ""},
+		SubnetIID:         cres.IID{NameId: item.SubnetName, SystemId: ""},
 		SecurityGroupIIDs: sgIIDList,
 
-		VMSpecName: req.Item.VmSpecName,
-		KeyPairIID: cres.IID{NameId: req.Item.KeyPairName, SystemId: ""},
+		VMSpecName: item.VmSpecName,
+		KeyPairIID: cres.IID{NameId: item.KeyPairName, SystemId: ""},
+
+		VMUserId:     item.VmUserId,
+		VMUserPasswd: item.VmUserPasswd,
+
+		PurchaseOption: cres.VMPurchaseOption{
+			PurchaseType: cres.VMPurchaseType(item.PurchaseType),
+			MaxPrice:     item.MaxPrice,
+		},
 
-		VMUserId:     req.Item.VmUserId,
-		VMUserPasswd: req.Item.VmUserPasswd,
+		PrivateIP: item.PrivateIp,
+
+		PublicIPIID: cres.IID{NameId: item.PublicIpName, SystemId: ""},
+		DNSZoneIID:  cres.IID{NameId: item.DnsZoneName, SystemId: ""},
+
+		NetworkInterfaceList: nicReqInfoList,
 	}
+}
+
+// StartVM - VM 시작
+func (s *CCMService) StartVM(ctx context.Context, req *pb.VMCreateRequest) (*pb.VMInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.StartVM()")
+
+	// GRPC 메시지에서 CCM 객체로 변환
+	reqInfo := convVMReqInfo(req.Item)
 
 	// Call common-runtime API
 	result, err := cmrt.StartVM(req.ConnectionName, rsVM, reqInfo)
@@ -64,6 +100,43 @@ func (s *CCMService) StartVM(ctx context.Context, req *pb.VMCreateRequest) (*pb.
 	return resp, nil
 }
 
+// StartVMGroup - VM 그룹 시작
+func (s *CCMService) StartVMGroup(ctx context.Context, req *pb.VMGroupCreateRequest) (*pb.VMGroupInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.StartVMGroup()")
+
+	if req.Item == nil {
+		return nil, gc.ConvGrpcStatusErr(errors.New("ReqInfo is required"), "", "CCMService.StartVMGroup()")
+	}
+
+	// GRPC 메시지에서 CCM 객체로 변환
+	reqInfo := cmrt.VMGroupReqInfo{
+		NamePattern:       req.Item.NamePattern,
+		Count:             int(req.Item.Count),
+		StartIndex:        int(req.Item.StartIndex),
+		WorkerCount:       int(req.Item.WorkerCount),
+		RollbackOnFailure: req.Item.RollbackOnFailure,
+		VMReqInfo:         convVMReqInfo(req.Item.VmReqInfo),
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.StartVMGroup(req.ConnectionName, rsVM, reqInfo)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.StartVMGroup()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.VMGroupInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.StartVMGroup()")
+	}
+
+	resp := &pb.VMGroupInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
 // ControlVM - VM 제어
 func (s *CCMService) ControlVM(ctx context.Context, req *pb.VMActionRequest) (*pb.StatusResponse, error) {
 	logger := logger.NewLogger()
@@ -165,6 +238,22 @@ func (s *CCMService) GetVMStatus(ctx context.Context, req *pb.VMQryRequest) (*pb
 	return resp, nil
 }
 
+// GetVMConsoleOutput - VM 콘솔 출력 조회
+func (s *CCMService) GetVMConsoleOutput(ctx context.Context, req *pb.VMQryRequest) (*pb.VMConsoleOutputResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.GetVMConsoleOutput()")
+
+	// Call common-runtime API
+	result, err := cmrt.GetVMConsoleOutput(req.ConnectionName, rsVM, req.Name)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.GetVMConsoleOutput()")
+	}
+
+	resp := &pb.VMConsoleOutputResponse{ConsoleOutput: result}
+	return resp, nil
+}
+
 // TerminateVM - VM 삭제
 func (s *CCMService) TerminateVM(ctx context.Context, req *pb.VMQryRequest) (*pb.StatusResponse, error) {
 	logger := logger.NewLogger()
@@ -37,13 +37,14 @@ func (s *CCMService) CreateVPC(ctx context.Context, req *pb.VPCCreateRequest) (*
 	// (1) create SubnetInfo List
 	subnetInfoList := []cres.SubnetInfo{}
 	for _, info := range req.Item.SubnetInfoList {
-		subnetInfo := cres.SubnetInfo{IId: cres.IID{NameId: info.Name, SystemId: ""}, IPv4_CIDR: info.Ipv4Cidr}
+		subnetInfo := cres.SubnetInfo{IId: cres.IID{NameId: info.Name, SystemId: ""}, IPv4_CIDR: info.Ipv4Cidr, IPv6_CIDR: info.Ipv6Cidr, Private: info.Private}
 		subnetInfoList = append(subnetInfoList, subnetInfo)
 	}
 	// (2) create VPCReqInfo with SubnetInfo List
 	reqInfo := cres.VPCReqInfo{
 		IId:            cres.IID{NameId: req.Item.Name, SystemId: ""},
 		IPv4_CIDR:      req.Item.Ipv4Cidr,
+		IPv6_CIDR:      req.Item.Ipv6Cidr,
 		SubnetInfoList: subnetInfoList,
 	}
 
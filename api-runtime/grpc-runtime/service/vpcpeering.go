@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+
+	gc "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/common"
+	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
+	pb "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/stub/cbspider"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// ===== [ Types ] =====
+
+// ===== [ Implementations ] =====
+
+// RequestVPCPeering - VPC Peering 요청
+func (s *CCMService) RequestVPCPeering(ctx context.Context, req *pb.VPCPeeringCreateRequest) (*pb.VPCPeeringInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.RequestVPCPeering()")
+
+	// Grpc RegInfo => Driver ReqInfo
+	reqInfo := cres.VPCPeeringReqInfo{
+		IId:             cres.IID{NameId: req.GetItem().GetName(), SystemId: ""},
+		RequesterVPCIID: cres.IID{NameId: req.GetItem().GetRequesterVpcName(), SystemId: ""},
+		AccepterVPCIID:  cres.IID{NameId: req.GetItem().GetAccepterVpcName(), SystemId: ""},
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.RequestVPCPeering(req.ConnectionName, rsVPCPeering, reqInfo)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.RequestVPCPeering()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.VPCPeeringInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.RequestVPCPeering()")
+	}
+
+	resp := &pb.VPCPeeringInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// AcceptVPCPeering - VPC Peering 수락
+func (s *CCMService) AcceptVPCPeering(ctx context.Context, req *pb.VPCPeeringQryRequest) (*pb.VPCPeeringInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.AcceptVPCPeering()")
+
+	// Call common-runtime API
+	result, err := cmrt.AcceptVPCPeering(req.ConnectionName, rsVPCPeering, req.Name)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.AcceptVPCPeering()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.VPCPeeringInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.AcceptVPCPeering()")
+	}
+
+	resp := &pb.VPCPeeringInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// ListVPCPeering - VPC Peering 목록
+func (s *CCMService) ListVPCPeering(ctx context.Context, req *pb.VPCPeeringAllQryRequest) (*pb.ListVPCPeeringInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.ListVPCPeering()")
+
+	// Call common-runtime API
+	result, err := cmrt.ListVPCPeering(req.ConnectionName, rsVPCPeering)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListVPCPeering()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj []*pb.VPCPeeringInfo
+	err = gc.CopySrcToDest(&result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListVPCPeering()")
+	}
+
+	resp := &pb.ListVPCPeeringInfoResponse{Items: grpcObj}
+	return resp, nil
+}
+
+// GetVPCPeering - VPC Peering 조회
+func (s *CCMService) GetVPCPeering(ctx context.Context, req *pb.VPCPeeringQryRequest) (*pb.VPCPeeringInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.GetVPCPeering()")
+
+	// Call common-runtime API
+	result, err := cmrt.GetVPCPeering(req.ConnectionName, rsVPCPeering, req.Name)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.GetVPCPeering()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.VPCPeeringInfo
+	err = gc.CopySrcToDest(result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.GetVPCPeering()")
+	}
+
+	resp := &pb.VPCPeeringInfoResponse{Item: &grpcObj}
+	return resp, nil
+}
+
+// DeleteVPCPeering - VPC Peering 삭제
+func (s *CCMService) DeleteVPCPeering(ctx context.Context, req *pb.VPCPeeringQryRequest) (*pb.BooleanResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.DeleteVPCPeering()")
+
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteResource(req.ConnectionName, rsVPCPeering, req.Name, req.Force)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.DeleteVPCPeering()")
+	}
+
+	resp := &pb.BooleanResponse{Result: result}
+	return resp, nil
+}
+
+// ListAllVPCPeering - 관리 VPC Peering 목록
+func (s *CCMService) ListAllVPCPeering(ctx context.Context, req *pb.VPCPeeringAllQryRequest) (*pb.AllResourceInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.ListAllVPCPeering()")
+
+	// Call common-runtime API
+	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, rsVPCPeering)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListAllVPCPeering()")
+	}
+
+	// CCM 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.AllResourceInfoResponse
+	err = gc.CopySrcToDest(&allResourceList, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.ListAllVPCPeering()")
+	}
+
+	return &grpcObj, nil
+}
+
+// DeleteCSPVPCPeering - CSP VPC Peering 삭제
+func (s *CCMService) DeleteCSPVPCPeering(ctx context.Context, req *pb.CSPVPCPeeringQryRequest) (*pb.BooleanResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling CCMService.DeleteCSPVPCPeering()")
+
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteCSPResource(req.ConnectionName, rsVPCPeering, req.Id)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "CCMService.DeleteCSPVPCPeering()")
+	}
+
+	resp := &pb.BooleanResponse{Result: result}
+	return resp, nil
+}
+
+// ===== [ Private Functions ] =====
+
+// ===== [ Public Functions ] =====
@@ -44,6 +44,10 @@ type StatusInfo struct {
 	Status string // PENDING | RUNNING | SUSPENDING | SUSPENDED | REBOOTING | TERMINATING | TERMINATED
 }
 
+type ConsoleOutputInfo struct {
+	ConsoleOutput string
+}
+
 //ex) {"POST", "/driver", registerCloudDriver}
 type route struct {
 	method, path string
@@ -70,30 +74,54 @@ func RunServer() {
 	routes := []route{
 		//----------CloudOS
 		{"GET", "/cloudos", listCloudOS},
+		{"GET", "/cloudos/:CloudOSName/schema", getCloudOSSchema},
 
 		//----------CloudDriverInfo
 		{"POST", "/driver", registerCloudDriver},
 		{"GET", "/driver", listCloudDriver},
 		{"GET", "/driver/:DriverName", getCloudDriver},
 		{"DELETE", "/driver/:DriverName", unRegisterCloudDriver},
+		{"PUT", "/driver/:DriverName", updateCloudDriver},
+		{"GET", "/driver/:DriverName/capability", getCloudDriverCapability},
 
 		//----------CredentialInfo
 		{"POST", "/credential", registerCredential},
 		{"GET", "/credential", listCredential},
 		{"GET", "/credential/:CredentialName", getCredential},
 		{"DELETE", "/credential/:CredentialName", unRegisterCredential},
+		{"POST", "/credential/:CredentialName/verify", verifyCredential},
+		{"PUT", "/credential/:CredentialName", updateCredential},
+		{"PATCH", "/credential/:CredentialName", patchCredential},
+		//-- for credential master key
+		{"POST", "/credentialkey/rotate", rotateCredentialKey},
 
 		//----------RegionInfo
 		{"POST", "/region", registerRegion},
 		{"GET", "/region", listRegion},
 		{"GET", "/region/:RegionName", getRegion},
 		{"DELETE", "/region/:RegionName", unRegisterRegion},
+		{"PUT", "/region/:RegionName", updateRegion},
+		{"PATCH", "/region/:RegionName", patchRegion},
+		//-- register or update the regions and zones of the CSP
+		{"POST", "/region/sync", syncRegion},
 
 		//----------ConnectionConfigInfo
 		{"POST", "/connectionconfig", createConnectionConfig},
 		{"GET", "/connectionconfig", listConnectionConfig},
 		{"GET", "/connectionconfig/:ConfigName", getConnectionConfig},
 		{"DELETE", "/connectionconfig/:ConfigName", deleteConnectionConfig},
+		{"PUT", "/connectionconfig/:ConfigName", updateConnectionConfig},
+		{"PATCH", "/connectionconfig/:ConfigName", patchConnectionConfig},
+		{"GET", "/connectionconfig/:ConfigName/dependents", listConnectionConfigDependent},
+		{"GET", "/connectionconfig/:ConfigName/capability", getConnectionConfigCapability},
+
+		//----------ImageMapInfo
+		{"POST", "/imagemap", registerImageMap},
+		{"POST", "/imagemap/import", importImageMap},
+		{"GET", "/imagemap", listImageMap},
+		{"GET", "/imagemap/:ImageName", listImageMap},
+		{"GET", "/imagemap/:ImageName/:RegionName", getImageMap},
+		{"DELETE", "/imagemap/:ImageName/:RegionName", unRegisterImageMap},
 
 		//-------------------------------------------------------------------//
 
@@ -109,15 +137,36 @@ func RunServer() {
 		{"GET", "/vmorgspec", listOrgVMSpec},
 		{"GET", "/vmorgspec/:Name", getOrgVMSpec},
 
+		//----------RegionZone Handler
+		{"GET", "/regionzone", listRegionZone},
+		{"GET", "/regionzone/:Name", getRegionZone},
+		{"GET", "/zone", listZone},
+
 		//----------VPC Handler
 		{"POST", "/vpc", createVPC},
 		{"GET", "/vpc", listVPC},
 		{"GET", "/vpc/:Name", getVPC},
 		{"DELETE", "/vpc/:Name", deleteVPC},
+		{"POST", "/vpc/:Name/subnet", addSubnet},
+		{"DELETE", "/vpc/:Name/subnet/:SubnetName", removeSubnet},
+		{"POST", "/vpc/:Name/natgateway", addNATGateway},
+		{"DELETE", "/vpc/:Name/natgateway/:NATGatewayName", removeNATGateway},
+		{"POST", "/vpc/:Name/route", addRoute},
+		{"DELETE", "/vpc/:Name/route", removeRoute},
 		//-- for management
 		{"GET", "/allvpc", listAllVPC},
 		{"DELETE", "/cspvpc/:Id", deleteCSPVPC},
 
+		//----------VPC Peering Handler
+		{"POST", "/vpcpeering", requestVPCPeering},
+		{"GET", "/vpcpeering", listVPCPeering},
+		{"GET", "/vpcpeering/:Name", getVPCPeering},
+		{"DELETE", "/vpcpeering/:Name", deleteVPCPeering},
+		{"PUT", "/vpcpeering/:Name/accept", acceptVPCPeering},
+		//-- for management
+		{"GET", "/allvpcpeering", listAllVPCPeering},
+		{"DELETE", "/cspvpcpeering/:Id", deleteCSPVPCPeering},
+
 		//----------SecurityGroup Handler
 		{"POST", "/securitygroup", createSecurity},
 		{"GET", "/securitygroup", listSecurity},
@@ -141,18 +190,55 @@ func RunServer() {
 			{"GET", "/vnic", listVNic},
 			{"GET", "/vnic/:VNicId", getVNic},
 			{"DELETE", "/vnic/:VNicId", deleteVNic},
-
-			//----------PublicIP Handler
-			{"POST", "/publicip", createPublicIP},
-			{"GET", "/publicip", listPublicIP},
-			{"GET", "/publicip/:PublicIPId", getPublicIP},
-			{"DELETE", "/publicip/:PublicIPId", deletePublicIP},
 		*/
+
+		//----------PublicIP Handler
+		{"POST", "/publicip", allocatePublicIP},
+		{"GET", "/publicip", listPublicIP},
+		{"GET", "/publicip/:Name", getPublicIP},
+		{"DELETE", "/publicip/:Name", releasePublicIP},
+		//-- for association with a VM
+		{"POST", "/publicip/:Name/vm", associatePublicIP},
+		{"DELETE", "/publicip/:Name/vm", disassociatePublicIP},
+		//-- for management
+		{"GET", "/allpublicip", listAllPublicIP},
+		{"DELETE", "/csppublicip/:Id", releaseCSPPublicIP},
+
+		//----------DNS Handler
+		{"POST", "/dnszone", createDNSZone},
+		{"GET", "/dnszone", listDNSZone},
+		{"GET", "/dnszone/:Name", getDNSZone},
+		{"DELETE", "/dnszone/:Name", deleteDNSZone},
+		//-- for records in a zone
+		{"POST", "/dnszone/:Name/record", addDNSRecord},
+		{"PUT", "/dnszone/:Name/record", updateDNSRecord},
+		{"DELETE", "/dnszone/:Name/record", removeDNSRecord},
+		//-- for management
+		{"GET", "/alldnszone", listAllDNSZone},
+		{"DELETE", "/cspdnszone/:Id", deleteCSPDNSZone},
+
+		//----------Bucket Handler
+		{"POST", "/bucket", createBucket},
+		{"GET", "/bucket", listBucket},
+		{"GET", "/bucket/:Name", getBucket},
+		{"DELETE", "/bucket/:Name", deleteBucket},
+		//-- for objects in a bucket
+		{"GET", "/bucket/:Name/object", listObject},
+		{"PUT", "/bucket/:Name/object/*", putObject},
+		{"GET", "/bucket/:Name/object/*", getObject},
+		{"DELETE", "/bucket/:Name/object/*", deleteObject},
+		{"POST", "/bucket/:Name/presignedurl", getPresignedURL},
+		//-- for management
+		{"GET", "/allbucket", listAllBucket},
+		{"DELETE", "/cspbucket/:Id", deleteCSPBucket},
+
 		//----------VM Handler
 		{"POST", "/vm", startVM},
 		{"GET", "/vm", listVM},
 		{"GET", "/vm/:Name", getVM},
+		{"GET", "/vm/:Name/console", getVMConsoleOutput},
 		{"DELETE", "/vm/:Name", terminateVM},
+		{"POST", "/vmgroup", startVMGroup},
 		//-- for management
 		{"GET", "/allvm", listAllVM},
 		{"DELETE", "/cspvm/:Id", terminateCSPVM},
@@ -162,6 +248,22 @@ func RunServer() {
 
 		{"GET", "/controlvm/:Name", controlVM}, // suspend, resume, reboot
 
+		//----------NLB Handler
+		{"POST", "/nlb", createNLB},
+		{"GET", "/nlb", listNLB},
+		{"GET", "/nlb/:Name", getNLB},
+		{"DELETE", "/nlb/:Name", deleteNLB},
+		//-- for vm group
+		{"POST", "/nlb/:Name/vms", addNLBVMs},
+		{"DELETE", "/nlb/:Name/vms", removeNLBVMs},
+		{"GET", "/nlb/:Name/health", getNLBVMGroupHealthInfo},
+		//-- for listener and health checker
+		{"PUT", "/nlb/:Name/listener", changeNLBListener},
+		{"PUT", "/nlb/:Name/healthchecker", changeNLBHealthChecker},
+		//-- for management
+		{"GET", "/allnlb", listAllNLB},
+		{"DELETE", "/cspnlb/:Id", deleteCSPNLB},
+
 		//-------------------------------------------------------------------//
 		//----------SSH RUN
 		{"POST", "/sshrun", sshRun},
@@ -183,6 +285,7 @@ func RunServer() {
 		{"GET", "/adminweb/keypairmgmt/:ConnectConfig", aw.KeyPairMgmt},
 		{"GET", "/adminweb/vm/:ConnectConfig", aw.VM},
 		{"GET", "/adminweb/vmmgmt/:ConnectConfig", aw.VMMgmt},
+		{"GET", "/adminweb/vmconsole/:ConnectConfig/:VMName", aw.VMConsole},
 
 		{"GET", "/adminweb/vmimage/:ConnectConfig", aw.VMImage},		
 		{"GET", "/adminweb/vmspec/:ConnectConfig", aw.VMSpec},
@@ -215,6 +318,8 @@ func ApiServer(routes []route, strPort string) {
 			e.GET(route.path, route.function)
 		case "PUT":
 			e.PUT(route.path, route.function)
+		case "PATCH":
+			e.PATCH(route.path, route.function)
 		case "DELETE":
 			e.DELETE(route.path, route.function)
 
@@ -13,6 +13,7 @@ import (
 	"fmt"
 
 	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 
 	// REST API (echo)
@@ -22,6 +23,7 @@ import (
 
 	"strconv"
 	"strings"
+	"time"
 )
 
 // define string of resource types
@@ -29,14 +31,27 @@ const (
 	rsImage string = "image"
 	rsVPC   string = "vpc"
 	// rsSubnet = SUBNET:{VPC NameID} => cook in code
-	rsSG  string = "sg"
-	rsKey string = "keypair"
-	rsVM  string = "vm"
+	rsSG         string = "sg"
+	rsKey        string = "keypair"
+	rsVM         string = "vm"
+	rsNLB        string = "nlb"
+	rsPublicIP   string = "publicip"
+	rsVPCPeering string = "vpcpeering"
+	rsDNSZone    string = "dnszone"
+	rsBucket     string = "bucket"
 )
 
 const rsSubnetPrefix string = "subnet:"
 const sgDELIMITER string = "-delimiter-"
 
+// 501 Not Implemented for the handlers and features which the driver does not support.
+func ccmErrorStatus(err error) int {
+	if idrv.IsNotSupported(err) {
+		return http.StatusNotImplemented
+	}
+	return http.StatusInternalServerError
+}
+
 //================ Image Handler
 func createImage(c echo.Context) error {
 	cblog.Info("call createImage()")
@@ -59,7 +74,7 @@ func createImage(c echo.Context) error {
 	// Call common-runtime API
 	result, err := cmrt.CreateImage(req.ConnectionName, rsImage, reqInfo)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	return c.JSON(http.StatusOK, result)
@@ -79,7 +94,7 @@ func listImage(c echo.Context) error {
 	// Call common-runtime API
 	result, err := cmrt.ListImage(req.ConnectionName, rsImage)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	var jsonResult struct {
@@ -104,7 +119,7 @@ func getImage(c echo.Context) error {
 	// Call common-runtime API
 	result, err := cmrt.GetImage(req.ConnectionName, rsImage, c.Param("Name"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	return c.JSON(http.StatusOK, result)
@@ -124,7 +139,7 @@ func deleteImage(c echo.Context) error {
 	// Call common-runtime API
 	result, err := cmrt.DeleteImage(req.ConnectionName, rsImage, c.Param("Name"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	resultInfo := BooleanInfo{
@@ -149,7 +164,7 @@ func listVMSpec(c echo.Context) error {
 	// Call common-runtime API
 	result, err := cmrt.ListVMSpec(req.ConnectionName)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	var jsonResult struct {
@@ -173,7 +188,7 @@ func getVMSpec(c echo.Context) error {
 	// Call common-runtime API
 	result, err := cmrt.GetVMSpec(req.ConnectionName, c.Param("Name"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	return c.JSON(http.StatusOK, result)
@@ -193,7 +208,7 @@ func listOrgVMSpec(c echo.Context) error {
 	// Call common-runtime API
 	result, err := cmrt.ListOrgVMSpec(req.ConnectionName)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	return c.String(http.StatusOK, result)
@@ -213,12 +228,82 @@ func getOrgVMSpec(c echo.Context) error {
 	// Call common-runtime API
 	result, err := cmrt.GetOrgVMSpec(req.ConnectionName, c.Param("Name"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	return c.String(http.StatusOK, result)
 }
 
+//================ RegionZone Handler
+func listRegionZone(c echo.Context) error {
+	cblog.Info("call listRegionZone()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ListRegionZone(req.ConnectionName)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cres.RegionZoneInfo `json:"regionzone"`
+	}
+	jsonResult.Result = result
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+func getRegionZone(c echo.Context) error {
+	cblog.Info("call getRegionZone()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.GetRegionZone(req.ConnectionName, c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// zones of the region of the connection
+func listZone(c echo.Context) error {
+	cblog.Info("call listZone()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ListZone(req.ConnectionName)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cres.ZoneInfo `json:"zone"`
+	}
+	jsonResult.Result = result
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
 //================ VPC Handler
 func createVPC(c echo.Context) error {
 	cblog.Info("call createVPC()")
@@ -228,9 +313,12 @@ func createVPC(c echo.Context) error {
 		ReqInfo        struct {
 			Name           string
 			IPv4_CIDR      string
+			IPv6_CIDR      string // "": IPv4 only, "auto": assigned by the CSP, or IPv6 CIDR
 			SubnetInfoList []struct {
 				Name      string
-				IPv4_CIDR string
+				IPv4_CIDR string // only a prefix length(ex: "/24") is allocated automatically
+				IPv6_CIDR string // in a dual-stack VPC, "": next /64 of the VPC IPv6 CIDR
+				Private   bool
 			}
 		}
 	}
@@ -252,20 +340,21 @@ func createVPC(c echo.Context) error {
 	// (1) create SubnetInfo List
 	subnetInfoList := []cres.SubnetInfo{}
 	for _, info := range req.ReqInfo.SubnetInfoList {
-		subnetInfo := cres.SubnetInfo{IId: cres.IID{info.Name, ""}, IPv4_CIDR: info.IPv4_CIDR}
+		subnetInfo := cres.SubnetInfo{IId: cres.IID{info.Name, ""}, IPv4_CIDR: info.IPv4_CIDR, IPv6_CIDR: info.IPv6_CIDR, Private: info.Private}
 		subnetInfoList = append(subnetInfoList, subnetInfo)
 	}
 	// (2) create VPCReqInfo with SubnetInfo List
 	reqInfo := cres.VPCReqInfo{
 		IId:            cres.IID{req.ReqInfo.Name, ""},
 		IPv4_CIDR:      req.ReqInfo.IPv4_CIDR,
+		IPv6_CIDR:      req.ReqInfo.IPv6_CIDR,
 		SubnetInfoList: subnetInfoList,
 	}
 
 	// Call common-runtime API
 	result, err := cmrt.CreateVPC(req.ConnectionName, rsVPC, reqInfo)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	return c.JSON(http.StatusOK, result)
@@ -285,7 +374,7 @@ func listVPC(c echo.Context) error {
 	// Call common-runtime API
 	result, err := cmrt.ListVPC(req.ConnectionName, rsVPC)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	var jsonResult struct {
@@ -314,7 +403,7 @@ func listAllVPC(c echo.Context) error {
 	// Call common-runtime API
 	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, rsVPC)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	return c.JSON(http.StatusOK, &allResourceList)
@@ -334,7 +423,7 @@ func getVPC(c echo.Context) error {
 	// Call common-runtime API
 	result, err := cmrt.GetVPC(req.ConnectionName, rsVPC, c.Param("Name"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	return c.JSON(http.StatusOK, result)
@@ -357,7 +446,7 @@ func deleteVPC(c echo.Context) error {
 	// Call common-runtime API
 	result, _, err := cmrt.DeleteResource(req.ConnectionName, rsVPC, c.Param("Name"), c.QueryParam("force"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	resultInfo := BooleanInfo{
@@ -384,7 +473,7 @@ func deleteCSPVPC(c echo.Context) error {
 	// Call common-runtime API
 	result, _, err := cmrt.DeleteCSPResource(req.ConnectionName, rsVPC, c.Param("Id"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	resultInfo := BooleanInfo{
@@ -394,17 +483,17 @@ func deleteCSPVPC(c echo.Context) error {
 	return c.JSON(http.StatusOK, &resultInfo)
 }
 
-//================ SecurityGroup Handler
-func createSecurity(c echo.Context) error {
-	cblog.Info("call createSecurity()")
+// Subnet CIDR with only a prefix length(ex: "/24") is allocated with the next free range of the VPC.
+func addSubnet(c echo.Context) error {
+	cblog.Info("call addSubnet()")
 
 	var req struct {
 		ConnectionName string
 		ReqInfo        struct {
-			Name          string
-			VPCName       string
-			Direction     string
-			SecurityRules *[]cres.SecurityRuleInfo
+			Name      string
+			IPv4_CIDR string
+			IPv6_CIDR string
+			Private   bool
 		}
 	}
 
@@ -412,32 +501,25 @@ func createSecurity(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	// check the input Name to include the SecurityGroup Delimiter
-	if strings.HasPrefix(req.ReqInfo.Name, sgDELIMITER) {
-		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf(sgDELIMITER+" cannot be used in SecurityGroup name!!"))
-	}
-
 	// Rest RegInfo => Driver ReqInfo
-	reqInfo := cres.SecurityReqInfo{
-		// SG NameID format => {VPC NameID} + sgDELIMITER + {SG NameID}
-		// transform: SG NameID => {VPC NameID} + sgDELIMITER + {SG NameID}
-		IId:           cres.IID{req.ReqInfo.VPCName + sgDELIMITER + req.ReqInfo.Name, ""},
-		VpcIID:        cres.IID{req.ReqInfo.VPCName, ""},
-		Direction:     req.ReqInfo.Direction,
-		SecurityRules: req.ReqInfo.SecurityRules,
+	reqInfo := cres.SubnetInfo{
+		IId:       cres.IID{req.ReqInfo.Name, ""},
+		IPv4_CIDR: req.ReqInfo.IPv4_CIDR,
+		IPv6_CIDR: req.ReqInfo.IPv6_CIDR,
+		Private:   req.ReqInfo.Private,
 	}
 
 	// Call common-runtime API
-	result, err := cmrt.CreateSecurity(req.ConnectionName, rsSG, reqInfo)
+	result, err := cmrt.AddSubnet(req.ConnectionName, rsVPC, c.Param("Name"), reqInfo)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	return c.JSON(http.StatusOK, result)
 }
 
-func listSecurity(c echo.Context) error {
-	cblog.Info("call listSecurity()")
+func removeSubnet(c echo.Context) error {
+	cblog.Info("call removeSubnet()")
 
 	var req struct {
 		ConnectionName string
@@ -448,44 +530,51 @@ func listSecurity(c echo.Context) error {
 	}
 
 	// Call common-runtime API
-	result, err := cmrt.ListSecurity(req.ConnectionName, rsSG)
+	result, err := cmrt.RemoveSubnet(req.ConnectionName, rsVPC, c.Param("Name"), c.Param("SubnetName"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
-	var jsonResult struct {
-		Result []*cres.SecurityInfo `json:"securitygroup"`
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
 	}
-	jsonResult.Result = result
-	return c.JSON(http.StatusOK, &jsonResult)
+
+	return c.JSON(http.StatusOK, &resultInfo)
 }
 
-// list all SGs for management
-// (1) get args from REST Call
-// (2) get all SG List by common-runtime API
-// (3) return REST Json Format
-func listAllSecurity(c echo.Context) error {
-	cblog.Info("call listAllSecurity()")
+//================ NAT Gateway and Route Handler
+func addNATGateway(c echo.Context) error {
+	cblog.Info("call addNATGateway()")
 
 	var req struct {
 		ConnectionName string
+		ReqInfo        struct {
+			Name       string
+			SubnetName string
+		}
 	}
 
 	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
+	// Rest RegInfo => Driver ReqInfo
+	reqInfo := cres.NATGatewayReqInfo{
+		IId:       cres.IID{req.ReqInfo.Name, ""},
+		SubnetIID: cres.IID{req.ReqInfo.SubnetName, ""},
+	}
+
 	// Call common-runtime API
-	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, rsSG)
+	result, err := cmrt.AddNATGateway(req.ConnectionName, rsVPC, c.Param("Name"), reqInfo)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
-	return c.JSON(http.StatusOK, &allResourceList)
+	return c.JSON(http.StatusOK, result)
 }
 
-func getSecurity(c echo.Context) error {
-	cblog.Info("call getSecurity()")
+func removeNATGateway(c echo.Context) error {
+	cblog.Info("call removeNATGateway()")
 
 	var req struct {
 		ConnectionName string
@@ -496,49 +585,60 @@ func getSecurity(c echo.Context) error {
 	}
 
 	// Call common-runtime API
-	result, err := cmrt.GetSecurity(req.ConnectionName, rsSG, c.Param("Name"))
+	result, err := cmrt.RemoveNATGateway(req.ConnectionName, rsVPC, c.Param("Name"), c.Param("NATGatewayName"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
-	return c.JSON(http.StatusOK, result)
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
 }
 
-// (1) get args from REST Call
-// (2) call common-runtime API
-// (3) return REST Json Format
-func deleteSecurity(c echo.Context) error {
-	cblog.Info("call deleteSecurity()")
+func addRoute(c echo.Context) error {
+	cblog.Info("call addRoute()")
 
 	var req struct {
 		ConnectionName string
+		ReqInfo        struct {
+			SubnetName      string
+			DestinationCIDR string
+			TargetType      string // InternetGateway | NATGateway | VPCPeering
+			TargetName      string
+		}
 	}
 
 	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	// Call common-runtime API
-	result, _, err := cmrt.DeleteResource(req.ConnectionName, rsSG, c.Param("Name"), c.QueryParam("force"))
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	// Rest RegInfo => Driver ReqInfo
+	routeInfo := cres.RouteInfo{
+		DestinationCIDR: req.ReqInfo.DestinationCIDR,
+		TargetType:      cres.RouteTargetType(req.ReqInfo.TargetType),
+		TargetIID:       cres.IID{req.ReqInfo.TargetName, ""},
 	}
 
-	resultInfo := BooleanInfo{
-		Result: strconv.FormatBool(result),
+	// Call common-runtime API
+	result, err := cmrt.AddRoute(req.ConnectionName, rsVPC, c.Param("Name"), req.ReqInfo.SubnetName, routeInfo)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
-	return c.JSON(http.StatusOK, &resultInfo)
+	return c.JSON(http.StatusOK, result)
 }
 
-// (1) get args from REST Call
-// (2) call common-runtime API
-// (3) return REST Json Format
-func deleteCSPSecurity(c echo.Context) error {
-	cblog.Info("call deleteCSPSecurity()")
+func removeRoute(c echo.Context) error {
+	cblog.Info("call removeRoute()")
 
 	var req struct {
 		ConnectionName string
+		ReqInfo        struct {
+			SubnetName      string
+			DestinationCIDR string
+		}
 	}
 
 	if err := c.Bind(&req); err != nil {
@@ -546,9 +646,10 @@ func deleteCSPSecurity(c echo.Context) error {
 	}
 
 	// Call common-runtime API
-	result, _, err := cmrt.DeleteCSPResource(req.ConnectionName, rsSG, c.Param("Id"))
+	routeInfo := cres.RouteInfo{DestinationCIDR: req.ReqInfo.DestinationCIDR}
+	result, err := cmrt.RemoveRoute(req.ConnectionName, rsVPC, c.Param("Name"), req.ReqInfo.SubnetName, routeInfo)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	resultInfo := BooleanInfo{
@@ -558,14 +659,16 @@ func deleteCSPSecurity(c echo.Context) error {
 	return c.JSON(http.StatusOK, &resultInfo)
 }
 
-//================ KeyPair Handler
-func createKey(c echo.Context) error {
-	cblog.Info("call createKey()")
+//================ VPC Peering Handler
+func requestVPCPeering(c echo.Context) error {
+	cblog.Info("call requestVPCPeering()")
 
 	var req struct {
 		ConnectionName string
 		ReqInfo        struct {
-			Name string
+			Name             string
+			RequesterVPCName string
+			AccepterVPCName  string
 		}
 	}
 
@@ -574,21 +677,23 @@ func createKey(c echo.Context) error {
 	}
 
 	// Rest RegInfo => Driver ReqInfo
-	reqInfo := cres.KeyPairReqInfo{
-		IId: cres.IID{req.ReqInfo.Name, ""},
+	reqInfo := cres.VPCPeeringReqInfo{
+		IId:             cres.IID{req.ReqInfo.Name, ""},
+		RequesterVPCIID: cres.IID{req.ReqInfo.RequesterVPCName, ""},
+		AccepterVPCIID:  cres.IID{req.ReqInfo.AccepterVPCName, ""},
 	}
 
 	// Call common-runtime API
-	result, err := cmrt.CreateKey(req.ConnectionName, rsKey, reqInfo)
+	result, err := cmrt.RequestVPCPeering(req.ConnectionName, rsVPCPeering, reqInfo)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	return c.JSON(http.StatusOK, result)
 }
 
-func listKey(c echo.Context) error {
-	cblog.Info("call listKey()")
+func acceptVPCPeering(c echo.Context) error {
+	cblog.Info("call acceptVPCPeering()")
 
 	var req struct {
 		ConnectionName string
@@ -599,24 +704,42 @@ func listKey(c echo.Context) error {
 	}
 
 	// Call common-runtime API
-	result, err := cmrt.ListKey(req.ConnectionName, rsKey)
+	result, err := cmrt.AcceptVPCPeering(req.ConnectionName, rsVPCPeering, c.Param("Name"))
 	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func listVPCPeering(c echo.Context) error {
+	cblog.Info("call listVPCPeering()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
+	// Call common-runtime API
+	result, err := cmrt.ListVPCPeering(req.ConnectionName, rsVPCPeering)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
 	var jsonResult struct {
-		Result []*cres.KeyPairInfo `json:"keypair"`
+		Result []*cres.VPCPeeringInfo `json:"vpcpeering"`
 	}
 	jsonResult.Result = result
+
 	return c.JSON(http.StatusOK, &jsonResult)
 }
 
-// list all KeyPairs for management
-// (1) get args from REST Call
-// (2) get all KeyPair List by common-runtime API
-// (3) return REST Json Format
-func listAllKey(c echo.Context) error {
-	cblog.Info("call listAllKey()")
+// list all VPC Peerings for management
+func listAllVPCPeering(c echo.Context) error {
+	cblog.Info("call listAllVPCPeering()")
 
 	var req struct {
 		ConnectionName string
@@ -627,16 +750,16 @@ func listAllKey(c echo.Context) error {
 	}
 
 	// Call common-runtime API
-	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, rsKey)
+	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, rsVPCPeering)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	return c.JSON(http.StatusOK, &allResourceList)
 }
 
-func getKey(c echo.Context) error {
-	cblog.Info("call getKey()")
+func getVPCPeering(c echo.Context) error {
+	cblog.Info("call getVPCPeering()")
 
 	var req struct {
 		ConnectionName string
@@ -647,19 +770,16 @@ func getKey(c echo.Context) error {
 	}
 
 	// Call common-runtime API
-	result, err := cmrt.GetKey(req.ConnectionName, rsKey, c.Param("Name"))
+	result, err := cmrt.GetVPCPeering(req.ConnectionName, rsVPCPeering, c.Param("Name"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	return c.JSON(http.StatusOK, result)
 }
 
-// (1) get args from REST Call
-// (2) call common-runtime API
-// (3) return REST Json Format
-func deleteKey(c echo.Context) error {
-	cblog.Info("call deleteKey()")
+func deleteVPCPeering(c echo.Context) error {
+	cblog.Info("call deleteVPCPeering()")
 
 	var req struct {
 		ConnectionName string
@@ -670,9 +790,9 @@ func deleteKey(c echo.Context) error {
 	}
 
 	// Call common-runtime API
-	result, _, err := cmrt.DeleteResource(req.ConnectionName, rsKey, c.Param("Name"), c.QueryParam("force"))
+	result, _, err := cmrt.DeleteResource(req.ConnectionName, rsVPCPeering, c.Param("Name"), c.QueryParam("force"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	resultInfo := BooleanInfo{
@@ -682,11 +802,8 @@ func deleteKey(c echo.Context) error {
 	return c.JSON(http.StatusOK, &resultInfo)
 }
 
-// (1) get args from REST Call
-// (2) call common-runtime API
-// (3) return REST Json Format
-func deleteCSPKey(c echo.Context) error {
-	cblog.Info("call deleteCSPKey()")
+func deleteCSPVPCPeering(c echo.Context) error {
+	cblog.Info("call deleteCSPVPCPeering()")
 
 	var req struct {
 		ConnectionName string
@@ -697,9 +814,9 @@ func deleteCSPKey(c echo.Context) error {
 	}
 
 	// Call common-runtime API
-	result, _, err := cmrt.DeleteCSPResource(req.ConnectionName, rsKey, c.Param("Id"))
+	result, _, err := cmrt.DeleteCSPResource(req.ConnectionName, rsVPCPeering, c.Param("Id"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	resultInfo := BooleanInfo{
@@ -709,286 +826,1584 @@ func deleteCSPKey(c echo.Context) error {
 	return c.JSON(http.StatusOK, &resultInfo)
 }
 
-/****************************
-//================ VNic Handler
-func createVNic(c echo.Context) error {
-	cblog.Info("call createVNic()")
-
-        var req struct {
-                ConnectionName string
-                ReqInfo cres.VNicReqInfo
-        }
+//================ SecurityGroup Handler
+func createSecurity(c echo.Context) error {
+	cblog.Info("call createSecurity()")
 
-        if err := c.Bind(&req); err != nil {
-                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-        }
+	var req struct {
+		ConnectionName string
+		ReqInfo        struct {
+			Name          string
+			VPCName       string
+			Direction     string
+			SecurityRules *[]cres.SecurityRuleInfo
+		}
+	}
 
-        cldConn, err := ccm.GetCloudConnection(req.ConnectionName)
-	if err != nil {
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	handler, err := cldConn.CreateVNicHandler()
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	// check the input Name to include the SecurityGroup Delimiter
+	if strings.HasPrefix(req.ReqInfo.Name, sgDELIMITER) {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Errorf(sgDELIMITER+" cannot be used in SecurityGroup name!!"))
 	}
 
-	info, err := handler.CreateVNic(req.ReqInfo)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	// Rest RegInfo => Driver ReqInfo
+	reqInfo := cres.SecurityReqInfo{
+		// SG NameID format => {VPC NameID} + sgDELIMITER + {SG NameID}
+		// transform: SG NameID => {VPC NameID} + sgDELIMITER + {SG NameID}
+		IId:           cres.IID{req.ReqInfo.VPCName + sgDELIMITER + req.ReqInfo.Name, ""},
+		VpcIID:        cres.IID{req.ReqInfo.VPCName, ""},
+		Direction:     req.ReqInfo.Direction,
+		SecurityRules: req.ReqInfo.SecurityRules,
 	}
 
-	return c.JSON(http.StatusOK, &info)
-}
+	// Call common-runtime API
+	result, err := cmrt.CreateSecurity(req.ConnectionName, rsSG, reqInfo)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
 
-func listVNic(c echo.Context) error {
-	cblog.Info("call listVNic()")
+	return c.JSON(http.StatusOK, result)
+}
 
-        var req struct {
-                ConnectionName string
-        }
+func listSecurity(c echo.Context) error {
+	cblog.Info("call listSecurity()")
 
-        if err := c.Bind(&req); err != nil {
-                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-        }
+	var req struct {
+		ConnectionName string
+	}
 
-        cldConn, err := ccm.GetCloudConnection(req.ConnectionName)
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ListSecurity(req.ConnectionName, rsSG)
 	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cres.SecurityInfo `json:"securitygroup"`
+	}
+	jsonResult.Result = result
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+// list all SGs for management
+// (1) get args from REST Call
+// (2) get all SG List by common-runtime API
+// (3) return REST Json Format
+func listAllSecurity(c echo.Context) error {
+	cblog.Info("call listAllSecurity()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	handler, err := cldConn.CreateVNicHandler()
+	// Call common-runtime API
+	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, rsSG)
 	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &allResourceList)
+}
+
+func getSecurity(c echo.Context) error {
+	cblog.Info("call getSecurity()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	infoList, err := handler.ListVNic()
+	// Call common-runtime API
+	result, err := cmrt.GetSecurity(req.ConnectionName, rsSG, c.Param("Name"))
 	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// (1) get args from REST Call
+// (2) call common-runtime API
+// (3) return REST Json Format
+func deleteSecurity(c echo.Context) error {
+	cblog.Info("call deleteSecurity()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-        var jsonResult struct {
-                Result []*cres.VNicInfo `json:"vnic"`
-        }
-        if infoList == nil {
-                infoList = []*cres.VNicInfo{}
-        }
-        jsonResult.Result = infoList
-        return c.JSON(http.StatusOK, &jsonResult)
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteResource(req.ConnectionName, rsSG, c.Param("Name"), c.QueryParam("force"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
 }
 
-func getVNic(c echo.Context) error {
-	cblog.Info("call getVNic()")
+// (1) get args from REST Call
+// (2) call common-runtime API
+// (3) return REST Json Format
+func deleteCSPSecurity(c echo.Context) error {
+	cblog.Info("call deleteCSPSecurity()")
 
-        var req struct {
-                ConnectionName string
-        }
+	var req struct {
+		ConnectionName string
+	}
 
-        if err := c.Bind(&req); err != nil {
-                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-        }
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
 
-        cldConn, err := ccm.GetCloudConnection(req.ConnectionName)
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteCSPResource(req.ConnectionName, rsSG, c.Param("Id"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+//================ KeyPair Handler
+func createKey(c echo.Context) error {
+	cblog.Info("call createKey()")
+
+	var req struct {
+		ConnectionName string
+		ReqInfo        struct {
+			Name string
+		}
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Rest RegInfo => Driver ReqInfo
+	reqInfo := cres.KeyPairReqInfo{
+		IId: cres.IID{req.ReqInfo.Name, ""},
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.CreateKey(req.ConnectionName, rsKey, reqInfo)
 	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func listKey(c echo.Context) error {
+	cblog.Info("call listKey()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	handler, err := cldConn.CreateVNicHandler()
+	// Call common-runtime API
+	result, err := cmrt.ListKey(req.ConnectionName, rsKey)
 	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cres.KeyPairInfo `json:"keypair"`
+	}
+	jsonResult.Result = result
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+// list all KeyPairs for management
+// (1) get args from REST Call
+// (2) get all KeyPair List by common-runtime API
+// (3) return REST Json Format
+func listAllKey(c echo.Context) error {
+	cblog.Info("call listAllKey()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	info, err := handler.GetVNic(c.Param("VNicId"))
+	// Call common-runtime API
+	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, rsKey)
 	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &allResourceList)
+}
+
+func getKey(c echo.Context) error {
+	cblog.Info("call getKey()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	return c.JSON(http.StatusOK, &info)
+	// Call common-runtime API
+	result, err := cmrt.GetKey(req.ConnectionName, rsKey, c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
 }
 
-func deleteVNic(c echo.Context) error {
-	cblog.Info("call deleteVNic()")
+// (1) get args from REST Call
+// (2) call common-runtime API
+// (3) return REST Json Format
+func deleteKey(c echo.Context) error {
+	cblog.Info("call deleteKey()")
 
-        var req struct {
-                ConnectionName string
-        }
+	var req struct {
+		ConnectionName string
+	}
 
-        if err := c.Bind(&req); err != nil {
-                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-        }
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteResource(req.ConnectionName, rsKey, c.Param("Name"), c.QueryParam("force"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+// (1) get args from REST Call
+// (2) call common-runtime API
+// (3) return REST Json Format
+func deleteCSPKey(c echo.Context) error {
+	cblog.Info("call deleteCSPKey()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteCSPResource(req.ConnectionName, rsKey, c.Param("Id"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+/****************************
+//================ VNic Handler
+func createVNic(c echo.Context) error {
+	cblog.Info("call createVNic()")
+
+        var req struct {
+                ConnectionName string
+                ReqInfo cres.VNicReqInfo
+        }
+
+        if err := c.Bind(&req); err != nil {
+                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+        }
+
+        cldConn, err := ccm.GetCloudConnection(req.ConnectionName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	handler, err := cldConn.CreateVNicHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	info, err := handler.CreateVNic(req.ReqInfo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func listVNic(c echo.Context) error {
+	cblog.Info("call listVNic()")
+
+        var req struct {
+                ConnectionName string
+        }
+
+        if err := c.Bind(&req); err != nil {
+                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+        }
+
+        cldConn, err := ccm.GetCloudConnection(req.ConnectionName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	handler, err := cldConn.CreateVNicHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	infoList, err := handler.ListVNic()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+        var jsonResult struct {
+                Result []*cres.VNicInfo `json:"vnic"`
+        }
+        if infoList == nil {
+                infoList = []*cres.VNicInfo{}
+        }
+        jsonResult.Result = infoList
+        return c.JSON(http.StatusOK, &jsonResult)
+}
+
+func getVNic(c echo.Context) error {
+	cblog.Info("call getVNic()")
+
+        var req struct {
+                ConnectionName string
+        }
+
+        if err := c.Bind(&req); err != nil {
+                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+        }
+
+        cldConn, err := ccm.GetCloudConnection(req.ConnectionName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	handler, err := cldConn.CreateVNicHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	info, err := handler.GetVNic(c.Param("VNicId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func deleteVNic(c echo.Context) error {
+	cblog.Info("call deleteVNic()")
+
+        var req struct {
+                ConnectionName string
+        }
+
+        if err := c.Bind(&req); err != nil {
+                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+        }
+
+        cldConn, err := ccm.GetCloudConnection(req.ConnectionName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	handler, err := cldConn.CreateVNicHandler()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	result, err := handler.DeleteVNic(c.Param("VNicId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+        resultInfo := BooleanInfo{
+                Result: strconv.FormatBool(result),
+        }
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+****************************/
+
+//================ PublicIP Handler
+func allocatePublicIP(c echo.Context) error {
+	cblog.Info("call allocatePublicIP()")
+
+	var req struct {
+		ConnectionName string
+		ReqInfo        struct {
+			Name string
+		}
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Rest RegInfo => Driver ReqInfo
+	reqInfo := cres.PublicIPReqInfo{
+		IId: cres.IID{req.ReqInfo.Name, ""},
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.AllocatePublicIP(req.ConnectionName, rsPublicIP, reqInfo)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func listPublicIP(c echo.Context) error {
+	cblog.Info("call listPublicIP()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ListPublicIP(req.ConnectionName, rsPublicIP)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cres.PublicIPInfo `json:"publicip"`
+	}
+	jsonResult.Result = result
+
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+// list all PublicIPs for management
+func listAllPublicIP(c echo.Context) error {
+	cblog.Info("call listAllPublicIP()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, rsPublicIP)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &allResourceList)
+}
+
+func getPublicIP(c echo.Context) error {
+	cblog.Info("call getPublicIP()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.GetPublicIP(req.ConnectionName, rsPublicIP, c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func releasePublicIP(c echo.Context) error {
+	cblog.Info("call releasePublicIP()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteResource(req.ConnectionName, rsPublicIP, c.Param("Name"), c.QueryParam("force"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+func releaseCSPPublicIP(c echo.Context) error {
+	cblog.Info("call releaseCSPPublicIP()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteCSPResource(req.ConnectionName, rsPublicIP, c.Param("Id"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+func associatePublicIP(c echo.Context) error {
+	cblog.Info("call associatePublicIP()")
+
+	var req struct {
+		ConnectionName string
+		ReqInfo        struct {
+			VMName string
+		}
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.AssociatePublicIP(req.ConnectionName, rsPublicIP, c.Param("Name"), req.ReqInfo.VMName)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func disassociatePublicIP(c echo.Context) error {
+	cblog.Info("call disassociatePublicIP()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.DisassociatePublicIP(req.ConnectionName, rsPublicIP, c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+//================ DNS Handler
+func createDNSZone(c echo.Context) error {
+	cblog.Info("call createDNSZone()")
+
+	var req struct {
+		ConnectionName string
+		ReqInfo        struct {
+			Name       string
+			DomainName string
+			VPCName    string
+		}
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Rest RegInfo => Driver ReqInfo
+	reqInfo := cres.DNSZoneReqInfo{
+		IId:        cres.IID{req.ReqInfo.Name, ""},
+		DomainName: req.ReqInfo.DomainName,
+		VpcIID:     cres.IID{req.ReqInfo.VPCName, ""},
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.CreateDNSZone(req.ConnectionName, rsDNSZone, reqInfo)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func listDNSZone(c echo.Context) error {
+	cblog.Info("call listDNSZone()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ListDNSZone(req.ConnectionName, rsDNSZone)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cres.DNSZoneInfo `json:"dnszone"`
+	}
+	jsonResult.Result = result
+
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+// list all DNS Zones for management
+func listAllDNSZone(c echo.Context) error {
+	cblog.Info("call listAllDNSZone()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, rsDNSZone)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &allResourceList)
+}
+
+func getDNSZone(c echo.Context) error {
+	cblog.Info("call getDNSZone()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.GetDNSZone(req.ConnectionName, rsDNSZone, c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func deleteDNSZone(c echo.Context) error {
+	cblog.Info("call deleteDNSZone()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteResource(req.ConnectionName, rsDNSZone, c.Param("Name"), c.QueryParam("force"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+func deleteCSPDNSZone(c echo.Context) error {
+	cblog.Info("call deleteCSPDNSZone()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteCSPResource(req.ConnectionName, rsDNSZone, c.Param("Id"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+// REST DNS Record ReqInfo, used by addDNSRecord and updateDNSRecord
+type dnsRecordReqInfo struct {
+	Name   string
+	Type   string // A | CNAME
+	TTL    string // seconds, empty: 300
+	Values []string
+}
+
+// Rest RegInfo => Driver ReqInfo
+func convDNSRecordReqInfo(restReqInfo dnsRecordReqInfo) (cres.DNSRecordInfo, error) {
+	var ttl int64
+	if restReqInfo.TTL != "" {
+		var err error
+		ttl, err = strconv.ParseInt(restReqInfo.TTL, 10, 64)
+		if err != nil {
+			return cres.DNSRecordInfo{}, err
+		}
+	}
+	return cres.DNSRecordInfo{
+		Name:   restReqInfo.Name,
+		Type:   cres.DNSRecordType(restReqInfo.Type),
+		TTL:    ttl,
+		Values: restReqInfo.Values,
+	}, nil
+}
+
+func addDNSRecord(c echo.Context) error {
+	cblog.Info("call addDNSRecord()")
+
+	var req struct {
+		ConnectionName string
+		ReqInfo        dnsRecordReqInfo
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Rest RegInfo => Driver ReqInfo
+	recordInfo, err := convDNSRecordReqInfo(req.ReqInfo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.AddDNSRecord(req.ConnectionName, rsDNSZone, c.Param("Name"), recordInfo)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func updateDNSRecord(c echo.Context) error {
+	cblog.Info("call updateDNSRecord()")
+
+	var req struct {
+		ConnectionName string
+		ReqInfo        dnsRecordReqInfo
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Rest RegInfo => Driver ReqInfo
+	recordInfo, err := convDNSRecordReqInfo(req.ReqInfo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.UpdateDNSRecord(req.ConnectionName, rsDNSZone, c.Param("Name"), recordInfo)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func removeDNSRecord(c echo.Context) error {
+	cblog.Info("call removeDNSRecord()")
+
+	var req struct {
+		ConnectionName string
+		ReqInfo        struct {
+			Name string
+			Type string // A | CNAME
+		}
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.RemoveDNSRecord(req.ConnectionName, rsDNSZone, c.Param("Name"), req.ReqInfo.Name, cres.DNSRecordType(req.ReqInfo.Type))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+//================ Bucket Handler
+func createBucket(c echo.Context) error {
+	cblog.Info("call createBucket()")
+
+	var req struct {
+		ConnectionName string
+		ReqInfo        struct {
+			Name string
+		}
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Rest RegInfo => Driver ReqInfo
+	reqInfo := cres.BucketReqInfo{
+		IId: cres.IID{req.ReqInfo.Name, ""},
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.CreateBucket(req.ConnectionName, rsBucket, reqInfo)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func listBucket(c echo.Context) error {
+	cblog.Info("call listBucket()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ListBucket(req.ConnectionName, rsBucket)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cres.BucketInfo `json:"bucket"`
+	}
+	jsonResult.Result = result
+
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+// list all Buckets for management
+func listAllBucket(c echo.Context) error {
+	cblog.Info("call listAllBucket()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, rsBucket)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &allResourceList)
+}
+
+func getBucket(c echo.Context) error {
+	cblog.Info("call getBucket()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.GetBucket(req.ConnectionName, rsBucket, c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func deleteBucket(c echo.Context) error {
+	cblog.Info("call deleteBucket()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteResource(req.ConnectionName, rsBucket, c.Param("Name"), c.QueryParam("force"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+func deleteCSPBucket(c echo.Context) error {
+	cblog.Info("call deleteCSPBucket()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, _, err := cmrt.DeleteCSPResource(req.ConnectionName, rsBucket, c.Param("Id"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+func listObject(c echo.Context) error {
+	cblog.Info("call listObject()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ListObject(req.ConnectionName, rsBucket, c.Param("Name"), c.QueryParam("prefix"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cres.ObjectInfo `json:"object"`
+	}
+	jsonResult.Result = result
+
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+// The object data is the raw request body, so ConnectionName is given by the query parameter.
+// ex) curl -X PUT --data-binary @a.tgz "http://localhost:1024/spider/bucket/b01/object/dir/a.tgz?ConnectionName=aws-ohio-config"
+func putObject(c echo.Context) error {
+	cblog.Info("call putObject()")
+
+	httpReq := c.Request()
+	defer httpReq.Body.Close()
+
+	// Call common-runtime API, streaming the body to the CSP
+	result, err := cmrt.PutObject(c.QueryParam("ConnectionName"), rsBucket, c.Param("Name"), c.Param("*"),
+		httpReq.Body, httpReq.ContentLength, httpReq.Header.Get(echo.HeaderContentType))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// The object data is the raw response body.
+func getObject(c echo.Context) error {
+	cblog.Info("call getObject()")
+
+	// Call common-runtime API
+	reader, info, err := cmrt.GetObject(c.QueryParam("ConnectionName"), rsBucket, c.Param("Name"), c.Param("*"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+	defer reader.Close()
+
+	contentType := info.ContentType
+	if contentType == "" {
+		contentType = echo.MIMEOctetStream
+	}
+	if info.Size >= 0 {
+		c.Response().Header().Set(echo.HeaderContentLength, strconv.FormatInt(info.Size, 10))
+	}
+	if info.ETag != "" {
+		c.Response().Header().Set("ETag", info.ETag)
+	}
+
+	return c.Stream(http.StatusOK, contentType, reader)
+}
+
+func deleteObject(c echo.Context) error {
+	cblog.Info("call deleteObject()")
+
+	// Call common-runtime API
+	result, err := cmrt.DeleteObject(c.QueryParam("ConnectionName"), rsBucket, c.Param("Name"), c.Param("*"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+func getPresignedURL(c echo.Context) error {
+	cblog.Info("call getPresignedURL()")
+
+	var req struct {
+		ConnectionName string
+		ReqInfo        struct {
+			ObjectName string
+			Method     string // GET | PUT
+			Expires    string // seconds, empty: 3600
+		}
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	expires := int64(3600)
+	if req.ReqInfo.Expires != "" {
+		var err error
+		expires, err = strconv.ParseInt(req.ReqInfo.Expires, 10, 64)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.GetPresignedURL(req.ConnectionName, rsBucket, c.Param("Name"), req.ReqInfo.ObjectName,
+		req.ReqInfo.Method, time.Duration(expires)*time.Second)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	var jsonResult struct {
+		PresignedURL string
+	}
+	jsonResult.PresignedURL = result
+
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+//================ VM Handler
+// (1) check exist(NameID)
+// (2) create Resource
+// (3) insert IID
+// REST VM ReqInfo, used by startVM and startVMGroup
+type vmReqInfo struct {
+	Name               string
+	ImageName          string
+	VPCName            string
+	SubnetName         string
+	SecurityGroupNames []string
+	VMSpecName         string
+	KeyPairName        string
+
+	VMUserId     string
+	VMUserPasswd string
+
+	PurchaseType string // OnDemand(default) | Spot | Preemptible
+	MaxPrice     string // only for Spot, empty: on-demand price cap
+
+	PrivateIP string // optional, fixed private IP in the Subnet
+
+	PublicIPName string // optional, reserved PublicIP to associate at creation
+	DNSZoneName  string // optional, private DNS zone to register the VM Name as an A record
+
+	NetworkInterfaces []networkInterfaceReqInfo // optional, secondary NICs
+}
+
+type networkInterfaceReqInfo struct {
+	SubnetName         string
+	SecurityGroupNames []string // empty: SecurityGroupNames of the VM
+	PrivateIP          string   // optional, fixed private IP
+	PublicIP           bool
+}
+
+// Rest RegInfo => Driver ReqInfo
+func convVMReqInfo(restReqInfo vmReqInfo) cres.VMReqInfo {
+	// (1) create SecurityGroup IID List
+	sgIIDList := []cres.IID{}
+	for _, sgName := range restReqInfo.SecurityGroupNames {
+		// SG NameID format => {VPC NameID} + sgDELIMITER + {SG NameID}
+		// transform: SG NameID => {VPC NameID}-{SG NameID}
+		sgIID := cres.IID{restReqInfo.VPCName + sgDELIMITER + sgName, ""}
+		sgIIDList = append(sgIIDList, sgIID)
+	}
+	// (2) create secondary NIC List
+	nicReqInfoList := []cres.NetworkInterfaceReqInfo{}
+	for _, nic := range restReqInfo.NetworkInterfaces {
+		nicSGIIDList := []cres.IID{}
+		for _, sgName := range nic.SecurityGroupNames {
+			nicSGIIDList = append(nicSGIIDList, cres.IID{restReqInfo.VPCName + sgDELIMITER + sgName, ""})
+		}
+		nicReqInfoList = append(nicReqInfoList, cres.NetworkInterfaceReqInfo{
+			SubnetIID:         cres.IID{nic.SubnetName, ""},
+			SecurityGroupIIDs: nicSGIIDList,
+			PrivateIP:         nic.PrivateIP,
+			PublicIP:          nic.PublicIP,
+		})
+	}
+	// (3) create VMReqInfo with SecurityGroup IID List
+	return cres.VMReqInfo{
+		IId:               cres.IID{restReqInfo.Name, ""},
+		ImageIID:          cres.IID{restReqInfo.ImageName, ""},
+		VpcIID:            cres.IID{restReqInfo.VPCName, ""},
+		SubnetIID:         cres.IID{restReqInfo.SubnetName, ""},
+		SecurityGroupIIDs: sgIIDList,
+
+		VMSpecName: restReqInfo.VMSpecName,
+		KeyPairIID: cres.IID{restReqInfo.KeyPairName, ""},
+
+		VMUserId:     restReqInfo.VMUserId,
+		VMUserPasswd: restReqInfo.VMUserPasswd,
+
+		PurchaseOption: cres.VMPurchaseOption{
+			PurchaseType: cres.VMPurchaseType(restReqInfo.PurchaseType),
+			MaxPrice:     restReqInfo.MaxPrice,
+		},
+
+		PrivateIP: restReqInfo.PrivateIP,
+
+		PublicIPIID: cres.IID{restReqInfo.PublicIPName, ""},
+		DNSZoneIID:  cres.IID{restReqInfo.DNSZoneName, ""},
+
+		NetworkInterfaceList: nicReqInfoList,
+	}
+}
+
+func startVM(c echo.Context) error {
+	cblog.Info("call startVM()")
+
+	var req struct {
+		ConnectionName string
+		ReqInfo        vmReqInfo
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Rest RegInfo => Driver ReqInfo
+	reqInfo := convVMReqInfo(req.ReqInfo)
+
+	// Call common-runtime API
+	result, err := cmrt.StartVM(req.ConnectionName, rsVM, reqInfo)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func startVMGroup(c echo.Context) error {
+	cblog.Info("call startVMGroup()")
+
+	var req struct {
+		ConnectionName string
+		ReqInfo        struct {
+			NamePattern       string // ex) "web-%02d" => web-01, web-02, ...
+			Count             int
+			StartIndex        int  // default: 1
+			WorkerCount       int  // default: 5
+			RollbackOnFailure bool // default: false
+			VMReqInfo         vmReqInfo
+		}
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	reqInfo := cmrt.VMGroupReqInfo{
+		NamePattern:       req.ReqInfo.NamePattern,
+		Count:             req.ReqInfo.Count,
+		StartIndex:        req.ReqInfo.StartIndex,
+		WorkerCount:       req.ReqInfo.WorkerCount,
+		RollbackOnFailure: req.ReqInfo.RollbackOnFailure,
+		VMReqInfo:         convVMReqInfo(req.ReqInfo.VMReqInfo),
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.StartVMGroup(req.ConnectionName, rsVM, reqInfo)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	// return per-VM results even if some VMs are failed
+	if result.FailedCount > 0 {
+		return c.JSON(http.StatusInternalServerError, result)
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+func listVM(c echo.Context) error {
+	cblog.Info("call listVM()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ListVM(req.ConnectionName, rsVM)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cres.VMInfo `json:"vm"`
+	}
+	jsonResult.Result = result
+
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+// list all VMs for management
+// (1) get args from REST Call
+// (2) get all VM List by common-runtime API
+// (3) return REST Json Format
+func listAllVM(c echo.Context) error {
+	cblog.Info("call listAllVM()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, rsVM)
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &allResourceList)
+}
+
+func getVM(c echo.Context) error {
+	cblog.Info("call getVM()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.GetVM(req.ConnectionName, rsVM, c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// (1) get args from REST Call
+// (2) call common-runtime API
+// (3) return REST Json Format
+func terminateVM(c echo.Context) error {
+	cblog.Info("call terminateVM()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	_, result, err := cmrt.DeleteResource(req.ConnectionName, rsVM, c.Param("Name"), c.QueryParam("force"))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	resultInfo := StatusInfo{
+		Status: string(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+// (1) get args from REST Call
+// (2) call common-runtime API
+// (3) return REST Json Format
+func terminateCSPVM(c echo.Context) error {
+	cblog.Info("call terminateCSPVM()")
 
-        cldConn, err := ccm.GetCloudConnection(req.ConnectionName)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	var req struct {
+		ConnectionName string
 	}
 
-	handler, err := cldConn.CreateVNicHandler()
-	if err != nil {
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	result, err := handler.DeleteVNic(c.Param("VNicId"))
+	// Call common-runtime API
+	_, result, err := cmrt.DeleteCSPResource(req.ConnectionName, rsVM, c.Param("Id"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
-        resultInfo := BooleanInfo{
-                Result: strconv.FormatBool(result),
-        }
+	resultInfo := StatusInfo{
+		Status: string(result),
+	}
 
 	return c.JSON(http.StatusOK, &resultInfo)
 }
 
-//================ PublicIP Handler
-func createPublicIP(c echo.Context) error {
-	cblog.Info("call createPublicIP()")
-
-        var req struct {
-                ConnectionName string
-                ReqInfo cres.PublicIPReqInfo
-        }
+func listVMStatus(c echo.Context) error {
+	cblog.Info("call listVMStatus()")
 
-        if err := c.Bind(&req); err != nil {
-                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-        }
+	var req struct {
+		ConnectionName string
+	}
 
-        cldConn, err := ccm.GetCloudConnection(req.ConnectionName)
-	if err != nil {
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	handler, err := cldConn.CreatePublicIPHandler()
+	// Call common-runtime API
+	result, err := cmrt.ListVMStatus(req.ConnectionName, rsVM)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
-	info, err := handler.CreatePublicIP(req.ReqInfo)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	var jsonResult struct {
+		Result []*cres.VMStatusInfo `json:"vmstatus"`
 	}
+	jsonResult.Result = result
 
-	return c.JSON(http.StatusOK, &info)
+	return c.JSON(http.StatusOK, &jsonResult)
 }
 
-func listPublicIP(c echo.Context) error {
-	cblog.Info("call listPublicIP()")
-
-        var req struct {
-                ConnectionName string
-        }
+func getVMStatus(c echo.Context) error {
+	cblog.Info("call getVMStatus()")
 
-        if err := c.Bind(&req); err != nil {
-                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-        }
+	var req struct {
+		ConnectionName string
+	}
 
-        cldConn, err := ccm.GetCloudConnection(req.ConnectionName)
-	if err != nil {
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	handler, err := cldConn.CreatePublicIPHandler()
+	// Call common-runtime API
+	result, err := cmrt.GetVMStatus(req.ConnectionName, rsVM, c.Param("Name"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
-	infoList, err := handler.ListPublicIP()
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	resultInfo := StatusInfo{
+		Status: string(result),
 	}
 
-        var jsonResult struct {
-                Result []*cres.PublicIPInfo `json:"publicip"`
-        }
-        if infoList == nil {
-                infoList = []*cres.PublicIPInfo{}
-        }
-        jsonResult.Result = infoList
-        return c.JSON(http.StatusOK, &jsonResult)
+	return c.JSON(http.StatusOK, &resultInfo)
 }
 
-func getPublicIP(c echo.Context) error {
-	cblog.Info("call getPublicIP()")
-
-        var req struct {
-                ConnectionName string
-        }
+func getVMConsoleOutput(c echo.Context) error {
+	cblog.Info("call getVMConsoleOutput()")
 
-        if err := c.Bind(&req); err != nil {
-                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-        }
+	var req struct {
+		ConnectionName string
+	}
 
-        cldConn, err := ccm.GetCloudConnection(req.ConnectionName)
-	if err != nil {
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	handler, err := cldConn.CreatePublicIPHandler()
+	// Call common-runtime API
+	result, err := cmrt.GetVMConsoleOutput(req.ConnectionName, rsVM, c.Param("Name"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
-	info, err := handler.GetPublicIP(c.Param("PublicIPId"))
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	resultInfo := ConsoleOutputInfo{
+		ConsoleOutput: result,
 	}
 
-	return c.JSON(http.StatusOK, &info)
+	return c.JSON(http.StatusOK, &resultInfo)
 }
 
-func deletePublicIP(c echo.Context) error {
-	cblog.Info("call deletePublicIP()")
-
-        var req struct {
-                ConnectionName string
-        }
-
-        if err := c.Bind(&req); err != nil {
-                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-        }
+func controlVM(c echo.Context) error {
+	cblog.Info("call controlVM()")
 
-        cldConn, err := ccm.GetCloudConnection(req.ConnectionName)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	var req struct {
+		ConnectionName string
 	}
 
-	handler, err := cldConn.CreatePublicIPHandler()
-	if err != nil {
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	result, err := handler.DeletePublicIP(c.Param("PublicIPId"))
+	// Call common-runtime API
+	result, err := cmrt.ControlVM(req.ConnectionName, rsVM, c.Param("Name"), c.QueryParam("action"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
-        resultInfo := BooleanInfo{
-                Result: strconv.FormatBool(result),
-        }
+	resultInfo := StatusInfo{
+		Status: string(result),
+	}
 
 	return c.JSON(http.StatusOK, &resultInfo)
 }
-****************************/
 
-//================ VM Handler
-// (1) check exist(NameID)
-// (2) create Resource
-// (3) insert IID
-func startVM(c echo.Context) error {
-	cblog.Info("call startVM()")
+//================ NLB Handler
+type nlbListenerReq struct {
+	Protocol string // TCP|UDP
+	Port     string // 1-65535
+}
+
+type nlbHealthCheckerReq struct {
+	Protocol  string // TCP|HTTP
+	Port      string // Listener Port or 1-65535
+	Interval  int    // secs
+	Timeout   int    // secs
+	Threshold int    // num
+}
+
+func convVMNames(vmNames []string) []cres.IID {
+	vmIIDs := []cres.IID{}
+	for _, vmName := range vmNames {
+		vmIIDs = append(vmIIDs, cres.IID{vmName, ""})
+	}
+	return vmIIDs
+}
+
+func convHealthCheckerReq(restReq nlbHealthCheckerReq) cres.HealthCheckerInfo {
+	return cres.HealthCheckerInfo{
+		Protocol:  restReq.Protocol,
+		Port:      restReq.Port,
+		Interval:  restReq.Interval,
+		Timeout:   restReq.Timeout,
+		Threshold: restReq.Threshold,
+	}
+}
+
+func createNLB(c echo.Context) error {
+	cblog.Info("call createNLB()")
 
 	var req struct {
 		ConnectionName string
 		ReqInfo        struct {
-			Name               string
-			ImageName          string
-			VPCName            string
-			SubnetName         string
-			SecurityGroupNames []string
-			VMSpecName         string
-			KeyPairName        string
-
-			VMUserId     string
-			VMUserPasswd string
+			Name     string
+			VPCName  string
+			Type     string // PUBLIC(default) | INTERNAL
+			Listener nlbListenerReq
+			VMGroup  struct {
+				Protocol string // TCP|UDP
+				Port     string // 1-65535
+				VMs      []string
+			}
+			HealthChecker nlbHealthCheckerReq
 		}
 	}
 
@@ -997,40 +2412,33 @@ func startVM(c echo.Context) error {
 	}
 
 	// Rest RegInfo => Driver ReqInfo
-	// (1) create SecurityGroup IID List
-	sgIIDList := []cres.IID{}
-	for _, sgName := range req.ReqInfo.SecurityGroupNames {
-		// SG NameID format => {VPC NameID} + sgDELIMITER + {SG NameID}
-		// transform: SG NameID => {VPC NameID}-{SG NameID}
-		sgIID := cres.IID{req.ReqInfo.VPCName + sgDELIMITER + sgName, ""}
-		sgIIDList = append(sgIIDList, sgIID)
-	}
-	// (2) create VMReqInfo with SecurityGroup IID List
-	reqInfo := cres.VMReqInfo{
-		IId:               cres.IID{req.ReqInfo.Name, ""},
-		ImageIID:          cres.IID{req.ReqInfo.ImageName, ""},
-		VpcIID:            cres.IID{req.ReqInfo.VPCName, ""},
-		SubnetIID:         cres.IID{req.ReqInfo.SubnetName, ""},
-		SecurityGroupIIDs: sgIIDList,
-
-		VMSpecName: req.ReqInfo.VMSpecName,
-		KeyPairIID: cres.IID{req.ReqInfo.KeyPairName, ""},
-
-		VMUserId:     req.ReqInfo.VMUserId,
-		VMUserPasswd: req.ReqInfo.VMUserPasswd,
+	reqInfo := cres.NLBReqInfo{
+		IId:    cres.IID{req.ReqInfo.Name, ""},
+		VpcIID: cres.IID{req.ReqInfo.VPCName, ""},
+		Type:   req.ReqInfo.Type,
+		Listener: cres.ListenerInfo{
+			Protocol: req.ReqInfo.Listener.Protocol,
+			Port:     req.ReqInfo.Listener.Port,
+		},
+		VMGroup: cres.NLBVMGroupInfo{
+			Protocol: req.ReqInfo.VMGroup.Protocol,
+			Port:     req.ReqInfo.VMGroup.Port,
+			VMs:      convVMNames(req.ReqInfo.VMGroup.VMs),
+		},
+		HealthChecker: convHealthCheckerReq(req.ReqInfo.HealthChecker),
 	}
 
 	// Call common-runtime API
-	result, err := cmrt.StartVM(req.ConnectionName, rsVM, reqInfo)
+	result, err := cmrt.CreateNLB(req.ConnectionName, rsNLB, reqInfo)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	return c.JSON(http.StatusOK, result)
 }
 
-func listVM(c echo.Context) error {
-	cblog.Info("call listVM()")
+func listNLB(c echo.Context) error {
+	cblog.Info("call listNLB()")
 
 	var req struct {
 		ConnectionName string
@@ -1041,25 +2449,22 @@ func listVM(c echo.Context) error {
 	}
 
 	// Call common-runtime API
-	result, err := cmrt.ListVM(req.ConnectionName, rsVM)
+	result, err := cmrt.ListNLB(req.ConnectionName, rsNLB)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	var jsonResult struct {
-		Result []*cres.VMInfo `json:"vm"`
+		Result []*cres.NLBInfo `json:"nlb"`
 	}
 	jsonResult.Result = result
 
 	return c.JSON(http.StatusOK, &jsonResult)
 }
 
-// list all VMs for management
-// (1) get args from REST Call
-// (2) get all VM List by common-runtime API
-// (3) return REST Json Format
-func listAllVM(c echo.Context) error {
-	cblog.Info("call listAllVM()")
+// list all NLBs for management
+func listAllNLB(c echo.Context) error {
+	cblog.Info("call listAllNLB()")
 
 	var req struct {
 		ConnectionName string
@@ -1070,16 +2475,16 @@ func listAllVM(c echo.Context) error {
 	}
 
 	// Call common-runtime API
-	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, rsVM)
+	allResourceList, err := cmrt.ListAllResource(req.ConnectionName, rsNLB)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	return c.JSON(http.StatusOK, &allResourceList)
 }
 
-func getVM(c echo.Context) error {
-	cblog.Info("call getVM()")
+func getNLB(c echo.Context) error {
+	cblog.Info("call getNLB()")
 
 	var req struct {
 		ConnectionName string
@@ -1090,19 +2495,16 @@ func getVM(c echo.Context) error {
 	}
 
 	// Call common-runtime API
-	result, err := cmrt.GetVM(req.ConnectionName, rsVM, c.Param("Name"))
+	result, err := cmrt.GetNLB(req.ConnectionName, rsNLB, c.Param("Name"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
 	return c.JSON(http.StatusOK, result)
 }
 
-// (1) get args from REST Call
-// (2) call common-runtime API
-// (3) return REST Json Format
-func terminateVM(c echo.Context) error {
-	cblog.Info("call terminateVM()")
+func deleteNLB(c echo.Context) error {
+	cblog.Info("call deleteNLB()")
 
 	var req struct {
 		ConnectionName string
@@ -1113,23 +2515,20 @@ func terminateVM(c echo.Context) error {
 	}
 
 	// Call common-runtime API
-	_, result, err := cmrt.DeleteResource(req.ConnectionName, rsVM, c.Param("Name"), c.QueryParam("force"))
+	result, _, err := cmrt.DeleteResource(req.ConnectionName, rsNLB, c.Param("Name"), c.QueryParam("force"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
-	resultInfo := StatusInfo{
-		Status: string(result),
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
 	}
 
 	return c.JSON(http.StatusOK, &resultInfo)
 }
 
-// (1) get args from REST Call
-// (2) call common-runtime API
-// (3) return REST Json Format
-func terminateCSPVM(c echo.Context) error {
-	cblog.Info("call terminateCSPVM()")
+func deleteCSPNLB(c echo.Context) error {
+	cblog.Info("call deleteCSPNLB()")
 
 	var req struct {
 		ConnectionName string
@@ -1140,23 +2539,26 @@ func terminateCSPVM(c echo.Context) error {
 	}
 
 	// Call common-runtime API
-	_, result, err := cmrt.DeleteCSPResource(req.ConnectionName, rsVM, c.Param("Id"))
+	result, _, err := cmrt.DeleteCSPResource(req.ConnectionName, rsNLB, c.Param("Id"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
-	resultInfo := StatusInfo{
-		Status: string(result),
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
 	}
 
 	return c.JSON(http.StatusOK, &resultInfo)
 }
 
-func listVMStatus(c echo.Context) error {
-	cblog.Info("call listVMStatus()")
+func addNLBVMs(c echo.Context) error {
+	cblog.Info("call addNLBVMs()")
 
 	var req struct {
 		ConnectionName string
+		ReqInfo        struct {
+			VMs []string
+		}
 	}
 
 	if err := c.Bind(&req); err != nil {
@@ -1164,21 +2566,43 @@ func listVMStatus(c echo.Context) error {
 	}
 
 	// Call common-runtime API
-	result, err := cmrt.ListVMStatus(req.ConnectionName, rsVM)
+	result, err := cmrt.AddNLBVMs(req.ConnectionName, rsNLB, c.Param("Name"), convVMNames(req.ReqInfo.VMs))
 	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func removeNLBVMs(c echo.Context) error {
+	cblog.Info("call removeNLBVMs()")
+
+	var req struct {
+		ConnectionName string
+		ReqInfo        struct {
+			VMs []string
+		}
+	}
+
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	var jsonResult struct {
-		Result []*cres.VMStatusInfo `json:"vmstatus"`
+	// Call common-runtime API
+	result, err := cmrt.RemoveNLBVMs(req.ConnectionName, rsNLB, c.Param("Name"), convVMNames(req.ReqInfo.VMs))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
-	jsonResult.Result = result
 
-	return c.JSON(http.StatusOK, &jsonResult)
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
 }
 
-func getVMStatus(c echo.Context) error {
-	cblog.Info("call getVMStatus()")
+func getNLBVMGroupHealthInfo(c echo.Context) error {
+	cblog.Info("call getNLBVMGroupHealthInfo()")
 
 	var req struct {
 		ConnectionName string
@@ -1189,38 +2613,62 @@ func getVMStatus(c echo.Context) error {
 	}
 
 	// Call common-runtime API
-	result, err := cmrt.GetVMStatus(req.ConnectionName, rsVM, c.Param("Name"))
+	result, err := cmrt.GetNLBVMGroupHealthInfo(req.ConnectionName, rsNLB, c.Param("Name"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
-	resultInfo := StatusInfo{
-		Status: string(result),
+	var jsonResult struct {
+		Result *cres.HealthInfo `json:"healthinfo"`
 	}
+	jsonResult.Result = result
 
-	return c.JSON(http.StatusOK, &resultInfo)
+	return c.JSON(http.StatusOK, &jsonResult)
 }
 
-func controlVM(c echo.Context) error {
-	cblog.Info("call controlVM()")
+func changeNLBListener(c echo.Context) error {
+	cblog.Info("call changeNLBListener()")
 
 	var req struct {
 		ConnectionName string
+		ReqInfo        nlbListenerReq
 	}
 
 	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
+	listener := cres.ListenerInfo{
+		Protocol: req.ReqInfo.Protocol,
+		Port:     req.ReqInfo.Port,
+	}
+
 	// Call common-runtime API
-	result, err := cmrt.ControlVM(req.ConnectionName, rsVM, c.Param("Name"), c.QueryParam("action"))
+	result, err := cmrt.ChangeNLBListener(req.ConnectionName, rsNLB, c.Param("Name"), listener)
 	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func changeNLBHealthChecker(c echo.Context) error {
+	cblog.Info("call changeNLBHealthChecker()")
+
+	var req struct {
+		ConnectionName string
+		ReqInfo        nlbHealthCheckerReq
+	}
+
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	resultInfo := StatusInfo{
-		Status: string(result),
+	// Call common-runtime API
+	result, err := cmrt.ChangeNLBHealthChecker(req.ConnectionName, rsNLB, c.Param("Name"), convHealthCheckerReq(req.ReqInfo))
+	if err != nil {
+		return echo.NewHTTPError(ccmErrorStatus(err), err.Error())
 	}
 
-	return c.JSON(http.StatusOK, &resultInfo)
+	return c.JSON(http.StatusOK, result)
 }
@@ -9,13 +9,18 @@
 package restruntime
 
 import (
+	"io/ioutil"
 	"strconv"
 
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
 	im "github.com/cloud-barista/cb-spider/cloud-info-manager"
 	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
 	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
 	dim "github.com/cloud-barista/cb-spider/cloud-info-manager/driver-info-manager"
+	imim "github.com/cloud-barista/cb-spider/cloud-info-manager/image-map-info-manager"
 	rim "github.com/cloud-barista/cb-spider/cloud-info-manager/region-info-manager"
+	icbs "github.com/cloud-barista/cb-store/interfaces"
 
 	// REST API (echo)
 	"net/http"
@@ -36,6 +41,27 @@ func listCloudOS(c echo.Context) error {
 	return c.JSON(http.StatusOK, &jsonResult)
 }
 
+// credential and region keys of the CloudOS, for the registration forms.
+func getCloudOSSchema(c echo.Context) error {
+	cblog.Info("call getCloudOSSchema()")
+
+	schema, err := im.GetCloudOSSchema(c.Param("CloudOSName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, schema)
+}
+
+// 409 Conflict for an old Version, the client should get the info and retry,
+// and for a delete of the info with dependents.
+func cimErrorStatus(err error) int {
+	if im.IsVersionConflict(err) || cmrt.IsDependentError(err) {
+		return http.StatusConflict
+	}
+	return ccmErrorStatus(err)
+}
+
 //================ CloudDriver Handler
 func registerCloudDriver(c echo.Context) error {
 	cblog.Info("call registerCloudDriver()")
@@ -84,12 +110,43 @@ func getCloudDriver(c echo.Context) error {
 	return c.JSON(http.StatusOK, &cldinfo)
 }
 
+func getCloudDriverCapability(c echo.Context) error {
+	cblog.Info("call getCloudDriverCapability()")
+
+	capability, err := cmrt.GetCloudDriverCapability(c.Param("DriverName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, capability)
+}
+
+// the DriverName of the path is used, and the ProviderName can not be changed.
+func updateCloudDriver(c echo.Context) error {
+	cblog.Info("call updateCloudDriver()")
+
+	req := &dim.CloudDriverInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	req.DriverName = c.Param("DriverName")
+
+	cldinfo, err := dim.UpdateCloudDriver(*req)
+	if err != nil {
+		return echo.NewHTTPError(cimErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &cldinfo)
+}
+
 func unRegisterCloudDriver(c echo.Context) error {
 	cblog.Info("call unRegisterCloudDriver()")
 
-	result, err := dim.UnRegisterCloudDriver(c.Param("DriverName"))
+	// cascade=true: the connection configs with this driver are deleted together.
+	// orphan=true: the connection configs with IIDs are deleted too, their resources remain in the CSP.
+	result, err := cmrt.UnRegisterCloudDriver(c.Param("DriverName"), c.QueryParam("cascade"), c.QueryParam("orphan"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(cimErrorStatus(err), err.Error())
 	}
 
 	resultInfo := BooleanInfo{
@@ -103,12 +160,18 @@ func unRegisterCloudDriver(c echo.Context) error {
 func registerCredential(c echo.Context) error {
 	cblog.Info("call registerCredential()")
 
-	req := &cim.CredentialInfo{}
-	if err := c.Bind(req); err != nil {
+	// VerifyRegionName: optional, the credential is verified with this region before the registration.
+	// VerifyDriverName: required only if the provider has more than one driver.
+	var req struct {
+		cim.CredentialInfo
+		VerifyRegionName string
+		VerifyDriverName string
+	}
+	if err := c.Bind(&req); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	crdinfoList, err := cim.RegisterCredentialInfo(*req)
+	crdinfoList, err := cmrt.RegisterCredential(req.CredentialInfo, req.VerifyRegionName, req.VerifyDriverName)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
@@ -145,12 +208,75 @@ func getCredential(c echo.Context) error {
 	return c.JSON(http.StatusOK, &crdinfo)
 }
 
+// connect to the CSP with the credential and call a read-only API.
+func verifyCredential(c echo.Context) error {
+	cblog.Info("call verifyCredential()")
+
+	// DriverName: required only if the provider has more than one driver.
+	var req struct {
+		RegionName string
+		DriverName string
+	}
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	err := cmrt.VerifyCredential(c.Param("CredentialName"), req.RegionName, req.DriverName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(true),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+// all key/values are replaced.
+func updateCredential(c echo.Context) error {
+	cblog.Info("call updateCredential()")
+
+	req := &cim.CredentialInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	req.CredentialName = c.Param("CredentialName")
+
+	crdinfo, err := cim.UpdateCredential(*req)
+	if err != nil {
+		return echo.NewHTTPError(cimErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &crdinfo)
+}
+
+// only the key/values of the request are added or replaced.
+func patchCredential(c echo.Context) error {
+	cblog.Info("call patchCredential()")
+
+	req := &cim.CredentialInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	req.CredentialName = c.Param("CredentialName")
+
+	crdinfo, err := cim.PatchCredential(*req)
+	if err != nil {
+		return echo.NewHTTPError(cimErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &crdinfo)
+}
+
 func unRegisterCredential(c echo.Context) error {
 	cblog.Info("call unRegisterCredential()")
 
-	result, err := cim.UnRegisterCredential(c.Param("CredentialName"))
+	// cascade=true: the connection configs with this credential are deleted together.
+	// orphan=true: the connection configs with IIDs are deleted too, their resources remain in the CSP.
+	result, err := cmrt.UnRegisterCredential(c.Param("CredentialName"), c.QueryParam("cascade"), c.QueryParam("orphan"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(cimErrorStatus(err), err.Error())
 	}
 
 	resultInfo := BooleanInfo{
@@ -160,6 +286,31 @@ func unRegisterCredential(c echo.Context) error {
 	return c.JSON(http.StatusOK, &resultInfo)
 }
 
+// reload the master keys from the env and the key file,
+// and re-encrypt all credentials with the current key.
+// the previous keys must be in the key file until this returns.
+// the admin token is required, see cmrt.AdminTokenEnv.
+func rotateCredentialKey(c echo.Context) error {
+	cblog.Info("call rotateCredentialKey()")
+
+	keyID, count, err := cmrt.RotateCredentialKey(c.Request().Header.Get(cmrt.AdminTokenHeader))
+	if err != nil {
+		if cmrt.IsAdminTokenError(err) {
+			return echo.NewHTTPError(http.StatusForbidden, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	var jsonResult struct {
+		KeyID        string
+		RotatedCount int
+	}
+	jsonResult.KeyID = keyID
+	jsonResult.RotatedCount = count
+
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
 //================ Region Handler
 func registerRegion(c echo.Context) error {
 	cblog.Info("call registerRegion()")
@@ -206,12 +357,76 @@ func getRegion(c echo.Context) error {
 	return c.JSON(http.StatusOK, &crdinfo)
 }
 
+// all key/values are replaced.
+func updateRegion(c echo.Context) error {
+	cblog.Info("call updateRegion()")
+
+	req := &rim.RegionInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	req.RegionName = c.Param("RegionName")
+
+	rgninfo, err := rim.UpdateRegion(*req)
+	if err != nil {
+		return echo.NewHTTPError(cimErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &rgninfo)
+}
+
+// only the key/values of the request are added or replaced.
+func patchRegion(c echo.Context) error {
+	cblog.Info("call patchRegion()")
+
+	req := &rim.RegionInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	req.RegionName = c.Param("RegionName")
+
+	rgninfo, err := rim.PatchRegion(*req)
+	if err != nil {
+		return echo.NewHTTPError(cimErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &rgninfo)
+}
+
+// ConnectionName: the connection to list the regions and zones of the CSP
+// KeyValueInfoList: the other keys of the new regions, ex) ResourceGroup of Azure
+func syncRegion(c echo.Context) error {
+	cblog.Info("call syncRegion()")
+
+	var req struct {
+		ConnectionName   string
+		KeyValueInfoList []icbs.KeyValue
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	infoList, err := cmrt.SyncRegion(req.ConnectionName, req.KeyValueInfoList)
+	if err != nil {
+		return echo.NewHTTPError(cimErrorStatus(err), err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*rim.RegionInfo `json:"region"`
+	}
+	jsonResult.Result = infoList
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
 func unRegisterRegion(c echo.Context) error {
 	cblog.Info("call unRegisterRegion()")
 
-	result, err := rim.UnRegisterRegion(c.Param("RegionName"))
+	// cascade=true: the connection configs and the image maps with this region are deleted together.
+	// orphan=true: the connection configs with IIDs are deleted too, their resources remain in the CSP.
+	result, err := cmrt.UnRegisterRegion(c.Param("RegionName"), c.QueryParam("cascade"), c.QueryParam("orphan"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(cimErrorStatus(err), err.Error())
 	}
 
 	resultInfo := BooleanInfo{
@@ -230,7 +445,7 @@ func createConnectionConfig(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	crdinfoList, err := ccim.CreateConnectionConfigInfo(*req)
+	crdinfoList, err := cmrt.CreateConnectionConfig(*req)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
@@ -267,10 +482,168 @@ func getConnectionConfig(c echo.Context) error {
 	return c.JSON(http.StatusOK, &crdinfo)
 }
 
+// the driver, credential and region of the new config are checked.
+func updateConnectionConfig(c echo.Context) error {
+	cblog.Info("call updateConnectionConfig()")
+
+	req := &ccim.ConnectionConfigInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	req.ConfigName = c.Param("ConfigName")
+
+	cncinfo, err := cmrt.UpdateConnectionConfig(*req)
+	if err != nil {
+		return echo.NewHTTPError(cimErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &cncinfo)
+}
+
+// only the non-empty names of the request are replaced.
+func patchConnectionConfig(c echo.Context) error {
+	cblog.Info("call patchConnectionConfig()")
+
+	req := &ccim.ConnectionConfigInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	req.ConfigName = c.Param("ConfigName")
+
+	cncinfo, err := cmrt.PatchConnectionConfig(*req)
+	if err != nil {
+		return echo.NewHTTPError(cimErrorStatus(err), err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &cncinfo)
+}
+
 func deleteConnectionConfig(c echo.Context) error {
 	cblog.Info("call deleteConnectionConfig()")
 
-	result, err := ccim.DeleteConnectionConfig(c.Param("ConfigName"))
+	// cascade=true and orphan=true: the IIDs of this config are deleted together, but not the resources of the CSP.
+	result, err := cmrt.DeleteConnectionConfig(c.Param("ConfigName"), c.QueryParam("cascade"), c.QueryParam("orphan"))
+	if err != nil {
+		return echo.NewHTTPError(cimErrorStatus(err), err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+// IIDs of the resources which are created with the connection config.
+func listConnectionConfigDependent(c echo.Context) error {
+	cblog.Info("call listConnectionConfigDependent()")
+
+	infoList, err := cmrt.ListConnectionConfigDependent(c.Param("ConfigName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*iidm.IIDInfo `json:"dependent"`
+	}
+	if infoList == nil {
+		infoList = []*iidm.IIDInfo{}
+	}
+	jsonResult.Result = infoList
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+func getConnectionConfigCapability(c echo.Context) error {
+	cblog.Info("call getConnectionConfigCapability()")
+
+	capability, err := cmrt.GetConnectionConfigCapability(c.Param("ConfigName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, capability)
+}
+
+//================ ImageMap Handler
+func registerImageMap(c echo.Context) error {
+	cblog.Info("call registerImageMap()")
+
+	req := &imim.ImageMapInfo{}
+	if err := c.Bind(req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	info, err := imim.RegisterImageMapInfo(*req)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+// body: YAML document, refer to imim.ImageMapInfoList
+func importImageMap(c echo.Context) error {
+	cblog.Info("call importImageMap()")
+
+	yamlData, err := ioutil.ReadAll(c.Request().Body)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	infoList, err := imim.ImportImageMap(yamlData)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*imim.ImageMapInfo `json:"imagemap"`
+	}
+	if infoList == nil {
+		infoList = []*imim.ImageMapInfo{}
+	}
+	jsonResult.Result = infoList
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+func listImageMap(c echo.Context) error {
+	cblog.Info("call listImageMap()")
+
+	var infoList []*imim.ImageMapInfo
+	var err error
+	if c.Param("ImageName") == "" {
+		infoList, err = imim.ListImageMap()
+	} else {
+		infoList, err = imim.ListImageMapByImageName(c.Param("ImageName"))
+	}
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*imim.ImageMapInfo `json:"imagemap"`
+	}
+	if infoList == nil {
+		infoList = []*imim.ImageMapInfo{}
+	}
+	jsonResult.Result = infoList
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+func getImageMap(c echo.Context) error {
+	cblog.Info("call getImageMap()")
+
+	info, err := imim.GetImageMap(c.Param("ImageName"), c.Param("RegionName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &info)
+}
+
+func unRegisterImageMap(c echo.Context) error {
+	cblog.Info("call unRegisterImageMap()")
+
+	result, err := imim.UnRegisterImageMap(c.Param("ImageName"), c.Param("RegionName"))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
@@ -15,6 +15,7 @@ import (
 
 	"strconv"
 
+	"html"
 	"net/http"
 	"strings"
 	"github.com/labstack/echo"
@@ -766,6 +767,8 @@ func makeVMTRList_html(connConfig string, bgcolor string, height string, fontSiz
                     </td>
                     <td>
                             <font size=%s>$$VMNAME$$</font>
+                            <br>
+                            <a href="../vmconsole/$$CONNCONFIG$$/$$VMNAME$$" target="_blank"><font size=1>[console]</font></a>
                     </td>
                     <td>
                             <font size=%s>$$VMSTATUS$$</font>
@@ -821,6 +824,7 @@ func makeVMTRList_html(connConfig string, bgcolor string, height string, fontSiz
         for i, one := range infoList{
                 str := strings.ReplaceAll(strTR, "$$NUM$$", strconv.Itoa(i+1))
                 str = strings.ReplaceAll(str, "$$VMNAME$$", one.IId.NameId)
+                str = strings.ReplaceAll(str, "$$CONNCONFIG$$", connConfig)
 		status := vmStatus(connConfig, one.IId.NameId)
                 str = strings.ReplaceAll(str, "$$VMSTATUS$$", status)
                 str = strings.ReplaceAll(str, "$$LASTSTARTTIME$$", one.StartTime.Format("2006.01.02 15:04:05 Mon"))
@@ -973,6 +977,47 @@ func makeDeleteVMFunc_js() string {
         return strFunc
 }
 
+// show the console(serial) output of a VM in a new window
+func VMConsole(c echo.Context) error {
+        cblog.Info("call VMConsole()")
+
+        connConfig := c.Param("ConnectConfig")
+        vmName := c.Param("VMName")
+
+        // curl -sX GET http://localhost:1024/spider/vm/vm-01/console -H 'Content-Type: application/json' -d '{ "ConnectionName": "'${CONN_CONFIG}'"}'
+        resBody, err := getResource_with_Connection_JsonByte(connConfig, "vm", vmName + "/console")
+        if err != nil {
+                cblog.Error(err)
+                return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+        }
+        var info struct {
+                ConsoleOutput string
+                Message string `json:"message"` // error message of REST API
+        }
+        json.Unmarshal(resBody, &info)
+        if info.ConsoleOutput == "" {
+                info.ConsoleOutput = info.Message
+        }
+
+        htmlStr :=  `
+                <html>
+                <head>
+                    <meta http-equiv="Content-Type" content="text/html; charset=UTF-8" />
+                    <title>$$VMNAME$$ console</title>
+                </head>
+                <body>
+                    <label style="font-size:18px;color:#606262;">&nbsp;&nbsp;$$VMNAME$$ ($$CONNCONFIG$$)</label>
+                    <pre style="background-color:#000000;color:#DDDDDD;padding:10px;font-size:small;">$$CONSOLEOUTPUT$$</pre>
+                </body>
+                </html>
+                `
+        htmlStr = strings.ReplaceAll(htmlStr, "$$VMNAME$$", html.EscapeString(vmName))
+        htmlStr = strings.ReplaceAll(htmlStr, "$$CONNCONFIG$$", html.EscapeString(connConfig))
+        htmlStr = strings.ReplaceAll(htmlStr, "$$CONSOLEOUTPUT$$", html.EscapeString(info.ConsoleOutput))
+
+        return c.HTML(http.StatusOK, htmlStr)
+}
+
 func VM(c echo.Context) error {
         cblog.Info("call VM()")
 
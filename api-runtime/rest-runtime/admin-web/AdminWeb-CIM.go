@@ -145,6 +145,9 @@ func makeDeleteDriverFunc_js() string {
                                         xhr.open("DELETE", "$$SPIDER_SERVER$$/spider/driver/" + checkboxes[i].value, false);
                                         xhr.setRequestHeader('Content-Type', 'application/json');
                                         xhr.send(null);
+                                        if (xhr.status == 409) { // used by other objects
+                                                alert(JSON.parse(xhr.responseText).message);
+                                        }
                                 }
                         }
 			location.reload();
@@ -289,10 +292,27 @@ func makeOnchangeCredentialProviderFunc_js() string {
 		}
                 document.getElementById('2').value= credentialInfo
 
+		// keys of the CloudOS schema, the values are descriptions of the keys
+		var xhr = new XMLHttpRequest();
+		xhr.open("GET", "$$SPIDER_SERVER$$/spider/cloudos/" + providerName + "/schema", true);
+		xhr.onload = function() {
+			if (xhr.status != 200) {
+				return;
+			}
+			var schema = JSON.parse(xhr.responseText);
+			var keyValueList = [];
+			for (var i = 0; i < schema.Credential.length; i++) {
+				keyValueList.push({"Key": schema.Credential[i].Key, "Value": schema.Credential[i].Description});
+			}
+			document.getElementById('2').value= JSON.stringify(keyValueList);
+		};
+		xhr.send();
+
 		// for credential name
                 document.getElementById('3').value= providerName.toLowerCase() + "-credential-01";
               }
         `
+        strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", "http://" + cr.HostIPorName + cr.ServicePort) // cr.ServicePort = ":1024"
         return strFunc
 }
 
@@ -395,6 +415,9 @@ func makeDeleteCredentialFunc_js() string {
                                         xhr.open("DELETE", "$$SPIDER_SERVER$$/spider/credential/" + checkboxes[i].value, false);
                                         xhr.setRequestHeader('Content-Type', 'application/json');
                                         xhr.send(null);
+                                        if (xhr.status == 409) { // used by other objects
+                                                alert(JSON.parse(xhr.responseText).message);
+                                        }
                                 }
                         }
 			location.reload();
@@ -556,10 +579,27 @@ func makeOnchangeRegionProviderFunc_js() string {
         }
                 document.getElementById('2').value= regionInfo
 
+        // keys of the CloudOS schema, the values are descriptions of the keys
+        var xhr = new XMLHttpRequest();
+        xhr.open("GET", "$$SPIDER_SERVER$$/spider/cloudos/" + providerName + "/schema", true);
+        xhr.onload = function() {
+            if (xhr.status != 200) {
+                return;
+            }
+            var schema = JSON.parse(xhr.responseText);
+            var keyValueList = [];
+            for (var i = 0; i < schema.Region.length; i++) {
+                keyValueList.push({"Key": schema.Region[i].Key, "Value": schema.Region[i].Description});
+            }
+            document.getElementById('2').value= JSON.stringify(keyValueList);
+        };
+        xhr.send();
+
         // for region-zone name
                 document.getElementById('3').value= providerName.toLowerCase() + "-" + region + "-" + zone;
               }
         `
+        strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", "http://" + cr.HostIPorName + cr.ServicePort) // cr.ServicePort = ":1024"
         return strFunc
 }
 
@@ -664,6 +704,9 @@ func makeDeleteRegionFunc_js() string {
                                         xhr.open("DELETE", "$$SPIDER_SERVER$$/spider/region/" + checkboxes[i].value, false);
                                         xhr.setRequestHeader('Content-Type', 'application/json');
                                         xhr.send(null);
+                                        if (xhr.status == 409) { // used by other objects
+                                                alert(JSON.parse(xhr.responseText).message);
+                                        }
                                 }
                         }
 			location.reload();
@@ -1088,6 +1131,9 @@ func makeDeleteConnectionConfigFunc_js() string {
                                         xhr.open("DELETE", "$$SPIDER_SERVER$$/spider/connectionconfig/" + checkboxes[i].value, false);
                                         xhr.setRequestHeader('Content-Type', 'application/json');
                                         xhr.send(null);
+                                        if (xhr.status == 409) { // used by other objects
+                                                alert(JSON.parse(xhr.responseText).message);
+                                        }
                                 }
                         }
 			location.reload();
@@ -0,0 +1,10 @@
+RESTSERVER=localhost
+
+ # credential and region keys of each CloudOS, served by its driver
+for CLOUDOS in AWS AZURE GCP ALIBABA OPENSTACK CLOUDIT DOCKER
+do
+	curl -X GET http://$RESTSERVER:1024/spider/cloudos/$CLOUDOS/schema -H 'Content-Type: application/json' |json_pp
+done
+
+ # rejected: ClientSecret is required for AWS
+curl -X POST http://$RESTSERVER:1024/spider/credential -H 'Content-Type: application/json' -d '{"CredentialName":"aws-credential-invalid","ProviderName":"AWS", "KeyValueInfoList": [{"Key":"ClientId", "Value":"XXXXXX"}]}' |json_pp
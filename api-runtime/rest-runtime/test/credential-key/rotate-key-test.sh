@@ -0,0 +1,13 @@
+RESTSERVER=localhost
+
+ # 1. generate a new master key and put it on the first line of the key file
+ #    ($CBSPIDER_CREDENTIAL_KEY_FILE, default: $CBSPIDER_ROOT/conf/credential.key), keep the previous keys
+NEWKEY=`$CBSPIDER_ROOT/utils/credential-key/credential_key.sh genkey`
+KEYFILE=${CBSPIDER_CREDENTIAL_KEY_FILE:-$CBSPIDER_ROOT/conf/credential.key}
+(echo $NEWKEY; cat $KEYFILE) > $KEYFILE.new && mv $KEYFILE.new $KEYFILE && chmod 600 $KEYFILE
+
+ # 2. reload the key file and re-encrypt all credentials with the new key, without downtime
+ #    CBSPIDER_ADMIN_TOKEN must be the same as the env of the server
+curl -X POST http://$RESTSERVER:1024/spider/credentialkey/rotate -H 'Content-Type: application/json' -H "Spider-Admin-Token: $CBSPIDER_ADMIN_TOKEN" |json_pp
+
+ # 3. the previous keys can be removed from the key file after the rotation
@@ -0,0 +1,13 @@
+RESTSERVER=localhost
+
+ # the driver and the region of the provider must be registered, ex) ../connect-config/1.aws-conn-config.sh
+
+ # 1. verify a registered credential with a region, returns the CSP error if it is not valid
+curl -X POST http://$RESTSERVER:1024/spider/credential/aws-credential01/verify -H 'Content-Type: application/json' -d '{"RegionName":"aws-ohio"}' |json_pp
+
+ # 2. verify before the registration, the credential is not registered if it is not valid
+ #    secret references(secret:...) can be verified only after the registration, with 1.
+curl -X POST http://$RESTSERVER:1024/spider/credential -H 'Content-Type: application/json' -d '{"CredentialName":"aws-credential02","ProviderName":"AWS", "KeyValueInfoList": [{"Key":"ClientId", "Value":"XXXXXX"}, {"Key":"ClientSecret", "Value":"XXXXXX"}], "VerifyRegionName":"aws-ohio"}' |json_pp
+
+ # 3. the driver name is required if the provider has more than one driver
+curl -X POST http://$RESTSERVER:1024/spider/credential/aws-credential01/verify -H 'Content-Type: application/json' -d '{"RegionName":"aws-ohio", "DriverName":"aws-driver01"}' |json_pp
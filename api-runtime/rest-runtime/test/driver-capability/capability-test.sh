@@ -0,0 +1,13 @@
+RESTSERVER=localhost
+
+ # the driver and the connection config must be registered, ex) ../connect-config/1.aws-conn-config.sh
+
+ # 1. get the handlers and features supported by the driver
+curl -X GET http://$RESTSERVER:1024/spider/driver/aws-driver01/capability |json_pp
+
+ # 2. get the capability of the driver of the connection config
+curl -X GET http://$RESTSERVER:1024/spider/connectionconfig/aws-ohio-config/capability |json_pp
+
+ # 3. the handlers and features not supported by the driver return 501 Not Implemented
+ #    ex) the docker driver does not support SecurityHandler, ../connect-config/7.docker-conn-config.sh
+curl -i -X GET http://$RESTSERVER:1024/spider/securitygroup -H 'Content-Type: application/json' -d '{"ConnectionName":"docker-config01"}'
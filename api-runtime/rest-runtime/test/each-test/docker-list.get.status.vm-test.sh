@@ -1,7 +1,7 @@
 
 echo "####################################################################"
 echo "## VM Test Scripts for CB-Spider IID Working Version - 2020.04.21."
-echo "##   VM: List -> Get -> ListStatus -> GetStatus "
+echo "##   VM: List -> Get -> ListStatus -> GetStatus -> Console"
 echo "####################################################################"
 
 curl -sX GET http://localhost:1024/spider/vm -H 'Content-Type: application/json' -d '{ "ConnectionName": "'${CONN_CONFIG}'"}' |json_pp
@@ -9,3 +9,4 @@ curl -sX GET http://localhost:1024/spider/vm/vm-01 -H 'Content-Type: application
 curl -sX GET http://localhost:1024/spider/vmstatus -H 'Content-Type: application/json' -d '{ "ConnectionName": "'${CONN_CONFIG}'"}' |json_pp
 curl -sX GET http://localhost:1024/spider/vmstatus/vm-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "'${CONN_CONFIG}'"}' |json_pp
 
+curl -sX GET http://localhost:1024/spider/vm/vm-01/console -H 'Content-Type: application/json' -d '{ "ConnectionName": "'${CONN_CONFIG}'"}' |json_pp
@@ -0,0 +1,12 @@
+RESTSERVER=localhost
+
+ # start a VM with a fixed private IP in subnet-01(192.168.1.0/24)
+curl -X POST http://$RESTSERVER:1024/spider/vm -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "vm-01", "ImageName": "ami-0bbe28eb2173f6167", "VPCName": "vpc-01", "SubnetName": "subnet-01", "SecurityGroupNames": [ "sg-01" ], "VMSpecName": "t2.micro", "KeyPairName": "keypair-01", "PrivateIP": "192.168.1.10"} }' |json_pp
+
+ # fail: 192.168.1.10 is already used by vm-01
+curl -X POST http://$RESTSERVER:1024/spider/vm -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "vm-02", "ImageName": "ami-0bbe28eb2173f6167", "VPCName": "vpc-01", "SubnetName": "subnet-01", "SecurityGroupNames": [ "sg-01" ], "VMSpecName": "t2.micro", "KeyPairName": "keypair-01", "PrivateIP": "192.168.1.10"} }' |json_pp
+
+ # fail: 10.0.0.10 is not in subnet-01
+curl -X POST http://$RESTSERVER:1024/spider/vm -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "vm-02", "ImageName": "ami-0bbe28eb2173f6167", "VPCName": "vpc-01", "SubnetName": "subnet-01", "SecurityGroupNames": [ "sg-01" ], "VMSpecName": "t2.micro", "KeyPairName": "keypair-01", "PrivateIP": "10.0.0.10"} }' |json_pp
+
+curl -X DELETE http://$RESTSERVER:1024/spider/vm/vm-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
@@ -0,0 +1,18 @@
+RESTSERVER=localhost
+
+ # register one Image Map
+curl -X POST http://$RESTSERVER:1024/spider/imagemap -H 'Content-Type: application/json' -d '{"ImageName":"ubuntu-18.04","RegionName":"aws-oregon", "CSPImageId":"ami-0d1cd67c26f5fca19"}'
+
+ # import Image Maps with YAML
+curl -X POST http://$RESTSERVER:1024/spider/imagemap/import -H 'Content-Type: application/x-yaml' --data-binary @imagemap.yaml
+
+ # list / get
+curl -X GET http://$RESTSERVER:1024/spider/imagemap |json_pp
+curl -X GET http://$RESTSERVER:1024/spider/imagemap/ubuntu-18.04 |json_pp
+curl -X GET http://$RESTSERVER:1024/spider/imagemap/ubuntu-18.04/aws-ohio |json_pp
+
+ # start VM with the logical image name
+curl -X POST http://$RESTSERVER:1024/spider/vm -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "vm-01", "ImageName": "ubuntu-18.04", "VPCName": "vpc-01", "SubnetName": "subnet-01", "SecurityGroupNames": [ "sg-01" ], "VMSpecName": "t2.micro", "KeyPairName": "keypair-01"} }' |json_pp
+
+ # delete
+curl -X DELETE http://$RESTSERVER:1024/spider/imagemap/ubuntu-18.04/aws-oregon |json_pp
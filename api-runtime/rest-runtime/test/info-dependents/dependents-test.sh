@@ -0,0 +1,24 @@
+RESTSERVER=localhost
+
+ # the infos of ../connect-config/1.aws-conn-config.sh must be registered.
+ # A driver, credential or region used by connection configs can not be deleted (409 Conflict).
+ # A connection config used by resources(IIDs) can not be deleted (409 Conflict).
+ # cascade=true deletes the dependents first, but not the connection configs with IIDs.
+ # orphan=true with cascade=true deletes the IID infos too, and the CSP resources remain.
+
+ # 1. list the resources(IIDs) created with the connection config
+curl -X GET http://$RESTSERVER:1024/spider/connectionconfig/aws-ohio-config/dependents -H 'Content-Type: application/json' |json_pp
+
+ # 2. rejected with 409 Conflict: the message has the list of dependents
+curl -X DELETE http://$RESTSERVER:1024/spider/region/aws-ohio -H 'Content-Type: application/json' |json_pp
+curl -X DELETE http://$RESTSERVER:1024/spider/credential/aws-credential01 -H 'Content-Type: application/json' |json_pp
+curl -X DELETE http://$RESTSERVER:1024/spider/driver/aws-driver01 -H 'Content-Type: application/json' |json_pp
+
+ # 3. rejected with 409 Conflict if the connection config has IIDs: the message has the list of the resources
+curl -X DELETE "http://$RESTSERVER:1024/spider/connectionconfig/aws-ohio-config?cascade=true" -H 'Content-Type: application/json' |json_pp
+
+ # 4. delete the connection config with the IID infos of it, the resources remain in the CSP
+curl -X DELETE "http://$RESTSERVER:1024/spider/connectionconfig/aws-ohio-config?cascade=true&orphan=true" -H 'Content-Type: application/json' |json_pp
+
+ # 5. delete the region with all connection configs and image maps using it
+curl -X DELETE "http://$RESTSERVER:1024/spider/region/aws-ohio?cascade=true" -H 'Content-Type: application/json' |json_pp
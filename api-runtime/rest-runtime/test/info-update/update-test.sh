@@ -0,0 +1,23 @@
+RESTSERVER=localhost
+
+ # the infos of ../connect-config/1.aws-conn-config.sh must be registered.
+ # Version of the GET result is required, an old Version returns 409 Conflict.
+
+ # 1. get the current Version
+curl -X GET http://$RESTSERVER:1024/spider/credential/aws-credential01 -H 'Content-Type: application/json' |json_pp
+
+ # 2. replace all key/values of the credential, Version: from the GET result
+curl -X PUT http://$RESTSERVER:1024/spider/credential/aws-credential01 -H 'Content-Type: application/json' -d '{"ProviderName":"AWS", "KeyValueInfoList": [{"Key":"ClientId", "Value":"XXXXXX"}, {"Key":"ClientSecret", "Value":"XXXXXX"}], "Version":1}' |json_pp
+
+ # 3. replace only the ClientSecret, the Version was increased by the update
+curl -X PATCH http://$RESTSERVER:1024/spider/credential/aws-credential01 -H 'Content-Type: application/json' -d '{"KeyValueInfoList": [{"Key":"ClientSecret", "Value":"YYYYYY"}], "Version":2}' |json_pp
+
+ # 4. rejected with 409 Conflict: old Version
+curl -X PATCH http://$RESTSERVER:1024/spider/credential/aws-credential01 -H 'Content-Type: application/json' -d '{"KeyValueInfoList": [{"Key":"ClientSecret", "Value":"ZZZZZZ"}], "Version":2}' |json_pp
+
+ # 5. region, driver
+curl -X PATCH http://$RESTSERVER:1024/spider/region/aws-ohio -H 'Content-Type: application/json' -d '{"KeyValueInfoList": [{"Key":"Zone", "Value":"us-east-2b"}], "Version":1}' |json_pp
+curl -X PUT http://$RESTSERVER:1024/spider/driver/aws-driver01 -H 'Content-Type: application/json' -d '{"ProviderName":"AWS", "DriverLibFileName":"aws-driver-v1.0.so", "Version":1}' |json_pp
+
+ # 6. change the region of the connection config, the region must exist and be an AWS region
+curl -X PATCH http://$RESTSERVER:1024/spider/connectionconfig/aws-ohio-config -H 'Content-Type: application/json' -d '{"RegionName":"aws-oregon", "Version":1}' |json_pp
@@ -0,0 +1,9 @@
+RESTSERVER=localhost
+
+ # start a VM with two secondary NICs: subnet-02 with a fixed private IP, subnet-03 with its own security group
+curl -X POST http://$RESTSERVER:1024/spider/vm -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "vm-01", "ImageName": "ami-0bbe28eb2173f6167", "VPCName": "vpc-01", "SubnetName": "subnet-01", "SecurityGroupNames": [ "sg-01" ], "VMSpecName": "t3.medium", "KeyPairName": "keypair-01", "NetworkInterfaces": [ { "SubnetName": "subnet-02", "PrivateIP": "192.168.2.10" }, { "SubnetName": "subnet-03", "SecurityGroupNames": [ "sg-02" ] } ] } }' |json_pp
+
+ # NetworkInterfaceList shows all NICs, the primary NIC comes first
+curl -X GET http://$RESTSERVER:1024/spider/vm/vm-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+
+curl -X DELETE http://$RESTSERVER:1024/spider/vm/vm-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
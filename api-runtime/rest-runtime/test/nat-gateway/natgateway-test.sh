@@ -0,0 +1,16 @@
+RESTSERVER=localhost
+
+ # create a VPC with a public and a private subnet
+curl -X POST http://$RESTSERVER:1024/spider/vpc -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "vpc-01", "IPv4_CIDR": "192.168.0.0/16", "SubnetInfoList": [ { "Name": "public-subnet-01", "IPv4_CIDR": "192.168.1.0/24"}, { "Name": "private-subnet-01", "IPv4_CIDR": "192.168.2.0/24", "Private": true} ] } }' |json_pp
+
+ # add a NAT Gateway into the public subnet, the private subnet is routed to it
+curl -X POST http://$RESTSERVER:1024/spider/vpc/vpc-01/natgateway -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "nat-01", "SubnetName": "public-subnet-01" } }' |json_pp
+
+ # add & remove a custom route
+curl -X POST http://$RESTSERVER:1024/spider/vpc/vpc-01/route -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "SubnetName": "private-subnet-01", "DestinationCIDR": "172.16.0.0/12", "TargetType": "NATGateway", "TargetName": "nat-01" } }' |json_pp
+curl -X GET http://$RESTSERVER:1024/spider/vpc/vpc-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+curl -X DELETE http://$RESTSERVER:1024/spider/vpc/vpc-01/route -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "SubnetName": "private-subnet-01", "DestinationCIDR": "172.16.0.0/12" } }' |json_pp
+
+ # delete
+curl -X DELETE http://$RESTSERVER:1024/spider/vpc/vpc-01/natgateway/nat-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+curl -X DELETE http://$RESTSERVER:1024/spider/vpc/vpc-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
@@ -0,0 +1,21 @@
+RESTSERVER=localhost
+
+ # create a bucket, bucket names are global in most CSPs
+curl -X POST http://$RESTSERVER:1024/spider/bucket -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "cb-spider-bucket-01" } }' |json_pp
+
+curl -X GET http://$RESTSERVER:1024/spider/bucket -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+curl -X GET http://$RESTSERVER:1024/spider/bucket/cb-spider-bucket-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+
+ # objects: the body is streamed as it is, ConnectionName is a query parameter
+echo "hello cb-spider" > /tmp/cb-spider-object.txt
+curl -X PUT "http://$RESTSERVER:1024/spider/bucket/cb-spider-bucket-01/object/dir/hello.txt?ConnectionName=aws-ohio-config" -H 'Content-Type: text/plain' --data-binary @/tmp/cb-spider-object.txt |json_pp
+curl -X GET http://$RESTSERVER:1024/spider/bucket/cb-spider-bucket-01/object?prefix=dir/ -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+curl -X GET "http://$RESTSERVER:1024/spider/bucket/cb-spider-bucket-01/object/dir/hello.txt?ConnectionName=aws-ohio-config"
+
+ # presigned URLs: Method is GET or PUT, Expires in seconds
+curl -X POST http://$RESTSERVER:1024/spider/bucket/cb-spider-bucket-01/presignedurl -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "ObjectName": "dir/hello.txt", "Method": "GET", "Expires": "600" } }' |json_pp
+curl -X POST http://$RESTSERVER:1024/spider/bucket/cb-spider-bucket-01/presignedurl -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "ObjectName": "dir/upload.txt", "Method": "PUT", "Expires": "600" } }' |json_pp
+
+ # delete: a bucket must be empty
+curl -X DELETE "http://$RESTSERVER:1024/spider/bucket/cb-spider-bucket-01/object/dir/hello.txt?ConnectionName=aws-ohio-config" |json_pp
+curl -X DELETE http://$RESTSERVER:1024/spider/bucket/cb-spider-bucket-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
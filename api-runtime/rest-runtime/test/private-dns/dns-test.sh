@@ -0,0 +1,20 @@
+RESTSERVER=localhost
+
+ # create a private DNS zone attached to the VPC
+curl -X POST http://$RESTSERVER:1024/spider/dnszone -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "dnszone-01", "DomainName": "cb-spider.internal", "VPCName": "vpc-01" } }' |json_pp
+
+ # start a VM registered as vm-01.cb-spider.internal
+curl -X POST http://$RESTSERVER:1024/spider/vm -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "vm-01", "ImageName": "ami-0bbe28eb2173f6167", "VPCName": "vpc-01", "SubnetName": "subnet-01", "SecurityGroupNames": [ "sg-01" ], "VMSpecName": "t2.micro", "KeyPairName": "keypair-01", "DNSZoneName": "dnszone-01"} }' |json_pp
+
+ # records: add, update, remove
+curl -X POST http://$RESTSERVER:1024/spider/dnszone/dnszone-01/record -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "web", "Type": "CNAME", "Values": [ "vm-01.cb-spider.internal" ] } }' |json_pp
+curl -X PUT http://$RESTSERVER:1024/spider/dnszone/dnszone-01/record -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "web", "Type": "CNAME", "TTL": "60", "Values": [ "vm-01.cb-spider.internal" ] } }' |json_pp
+
+curl -X GET http://$RESTSERVER:1024/spider/dnszone/dnszone-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+curl -X GET http://$RESTSERVER:1024/spider/dnszone -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+
+curl -X DELETE http://$RESTSERVER:1024/spider/dnszone/dnszone-01/record -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "web", "Type": "CNAME" } }' |json_pp
+
+ # delete: the A record of vm-01 is removed with the VM
+curl -X DELETE http://$RESTSERVER:1024/spider/vm/vm-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+curl -X DELETE http://$RESTSERVER:1024/spider/dnszone/dnszone-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
@@ -0,0 +1,20 @@
+RESTSERVER=localhost
+
+ # allocate a reserved(static) Public IP
+curl -X POST http://$RESTSERVER:1024/spider/publicip -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "publicip-01" } }' |json_pp
+
+ # start a VM with the reserved Public IP
+curl -X POST http://$RESTSERVER:1024/spider/vm -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "vm-01", "ImageName": "ami-0bbe28eb2173f6167", "VPCName": "vpc-01", "SubnetName": "subnet-01", "SecurityGroupNames": [ "sg-01" ], "VMSpecName": "t2.micro", "KeyPairName": "keypair-01", "PublicIPName": "publicip-01"} }' |json_pp
+
+curl -X GET http://$RESTSERVER:1024/spider/publicip/publicip-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+
+ # move the Public IP: disassociate & associate
+curl -X DELETE http://$RESTSERVER:1024/spider/publicip/publicip-01/vm -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+curl -X POST http://$RESTSERVER:1024/spider/publicip/publicip-01/vm -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "VMName": "vm-01" } }' |json_pp
+
+curl -X GET http://$RESTSERVER:1024/spider/publicip -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+
+ # delete
+curl -X DELETE http://$RESTSERVER:1024/spider/publicip/publicip-01/vm -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+curl -X DELETE http://$RESTSERVER:1024/spider/vm/vm-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+curl -X DELETE http://$RESTSERVER:1024/spider/publicip/publicip-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
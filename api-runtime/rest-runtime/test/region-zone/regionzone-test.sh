@@ -0,0 +1,16 @@
+RESTSERVER=localhost
+
+ # the connection config must be registered, ex) ../connect-config/1.aws-conn-config.sh
+
+ # 1. list all regions and their zones of the CSP
+curl -X GET http://$RESTSERVER:1024/spider/regionzone -H 'Content-Type: application/json' -d '{"ConnectionName":"aws-ohio-config"}' |json_pp
+
+ # 2. get a region and its zones of the CSP
+curl -X GET http://$RESTSERVER:1024/spider/regionzone/us-east-2 -H 'Content-Type: application/json' -d '{"ConnectionName":"aws-ohio-config"}' |json_pp
+
+ # 3. list the zones of the region of the connection
+curl -X GET http://$RESTSERVER:1024/spider/zone -H 'Content-Type: application/json' -d '{"ConnectionName":"aws-ohio-config"}' |json_pp
+
+ # 4. register or update the regions of the CIM with the available regions and zones of the CSP
+curl -X POST http://$RESTSERVER:1024/spider/region/sync -H 'Content-Type: application/json' -d '{"ConnectionName":"aws-ohio-config"}' |json_pp
+curl -X GET http://$RESTSERVER:1024/spider/region |json_pp
@@ -0,0 +1,14 @@
+RESTSERVER=localhost
+
+ # start 5 VMs(web-01 ~ web-05) with one request, 3 VMs at a time
+ # RollbackOnFailure: terminate all created VMs if any VM is failed
+curl -X POST http://$RESTSERVER:1024/spider/vmgroup -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "NamePattern": "web-%02d", "Count": 5, "WorkerCount": 3, "RollbackOnFailure": true, "VMReqInfo": { "ImageName": "ami-0bbe28eb2173f6167", "VPCName": "vpc-01", "SubnetName": "subnet-01", "SecurityGroupNames": [ "sg-01" ], "VMSpecName": "t2.micro", "KeyPairName": "keypair-01"} } }' |json_pp
+
+curl -X GET http://$RESTSERVER:1024/spider/vmstatus -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+
+ # delete
+for NUM in 01 02 03 04 05
+do
+	curl -X DELETE http://$RESTSERVER:1024/spider/vm/web-$NUM -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp &
+done
+wait
@@ -0,0 +1,15 @@
+RESTSERVER=localhost
+
+ # create a VPC with a given subnet CIDR and an auto-allocated /24 subnet
+curl -X POST http://$RESTSERVER:1024/spider/vpc -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "vpc-01", "IPv4_CIDR": "192.168.0.0/16", "SubnetInfoList": [ { "Name": "subnet-01", "IPv4_CIDR": "192.168.0.0/24"}, { "Name": "subnet-02", "IPv4_CIDR": "/24"} ] } }' |json_pp
+
+ # fail: out of the VPC CIDR, overlapped with subnet-01
+curl -X POST http://$RESTSERVER:1024/spider/vpc/vpc-01/subnet -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "subnet-03", "IPv4_CIDR": "10.0.0.0/24" } }' |json_pp
+curl -X POST http://$RESTSERVER:1024/spider/vpc/vpc-01/subnet -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "subnet-03", "IPv4_CIDR": "192.168.0.128/25" } }' |json_pp
+
+ # add an auto-allocated /26 subnet
+curl -X POST http://$RESTSERVER:1024/spider/vpc/vpc-01/subnet -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "subnet-03", "IPv4_CIDR": "/26" } }' |json_pp
+
+ # delete
+curl -X DELETE http://$RESTSERVER:1024/spider/vpc/vpc-01/subnet/subnet-03 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+curl -X DELETE http://$RESTSERVER:1024/spider/vpc/vpc-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
@@ -0,0 +1,18 @@
+RESTSERVER=localhost
+
+ # create a dual-stack VPC with an Amazon provided IPv6 CIDR, subnets get the next /64
+curl -X POST http://$RESTSERVER:1024/spider/vpc -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "vpc-v6-01", "IPv4_CIDR": "192.168.0.0/16", "IPv6_CIDR": "auto", "SubnetInfoList": [ { "Name": "subnet-v6-01", "IPv4_CIDR": "192.168.1.0/24"} ] } }' |json_pp
+
+ # add a dual-stack subnet
+curl -X POST http://$RESTSERVER:1024/spider/vpc/vpc-v6-01/subnet -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "subnet-v6-02", "IPv4_CIDR": "/24" } }' |json_pp
+
+ # security group with IPv4 and IPv6 rules
+curl -X POST http://$RESTSERVER:1024/spider/securitygroup -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "sg-v6-01", "VPCName": "vpc-v6-01", "SecurityRules": [ {"FromPort": "22", "ToPort" : "22", "IPProtocol" : "tcp", "Direction" : "inbound", "CIDR" : "0.0.0.0/0"}, {"FromPort": "22", "ToPort" : "22", "IPProtocol" : "tcp", "Direction" : "inbound", "CIDR" : "::/0"} ] } }' |json_pp
+
+ # VM in a dual-stack subnet has PublicIPv6
+curl -X POST http://$RESTSERVER:1024/spider/vm -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "vm-v6-01", "ImageName": "ami-0bbe28eb2173f6167", "VPCName": "vpc-v6-01", "SubnetName": "subnet-v6-01", "SecurityGroupNames": [ "sg-v6-01" ], "VMSpecName": "t2.micro", "KeyPairName": "keypair-01"} }' |json_pp
+
+ # delete
+curl -X DELETE http://$RESTSERVER:1024/spider/vm/vm-v6-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+curl -X DELETE http://$RESTSERVER:1024/spider/securitygroup/sg-v6-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+curl -X DELETE http://$RESTSERVER:1024/spider/vpc/vpc-v6-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
@@ -0,0 +1,18 @@
+RESTSERVER=localhost
+
+ # create two VPCs with non-overlapping CIDRs
+curl -X POST http://$RESTSERVER:1024/spider/vpc -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "vpc-01", "IPv4_CIDR": "192.168.0.0/16", "SubnetInfoList": [ { "Name": "subnet-01", "IPv4_CIDR": "192.168.1.0/24"} ] } }' |json_pp
+curl -X POST http://$RESTSERVER:1024/spider/vpc -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "vpc-02", "IPv4_CIDR": "10.0.0.0/16", "SubnetInfoList": [ { "Name": "subnet-02", "IPv4_CIDR": "10.0.1.0/24"} ] } }' |json_pp
+
+ # request & accept the peering
+curl -X POST http://$RESTSERVER:1024/spider/vpcpeering -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config", "ReqInfo": { "Name": "peering-01", "RequesterVPCName": "vpc-01", "AccepterVPCName": "vpc-02" } }' |json_pp
+curl -X PUT http://$RESTSERVER:1024/spider/vpcpeering/peering-01/accept -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+
+curl -X GET http://$RESTSERVER:1024/spider/vpcpeering -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+curl -X GET http://$RESTSERVER:1024/spider/vpcpeering/peering-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+curl -X GET http://$RESTSERVER:1024/spider/allvpcpeering -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+
+ # delete
+curl -X DELETE http://$RESTSERVER:1024/spider/vpcpeering/peering-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+curl -X DELETE http://$RESTSERVER:1024/spider/vpc/vpc-01 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
+curl -X DELETE http://$RESTSERVER:1024/spider/vpc/vpc-02 -H 'Content-Type: application/json' -d '{ "ConnectionName": "aws-ohio-config"}' |json_pp
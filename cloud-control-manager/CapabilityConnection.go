@@ -0,0 +1,184 @@
+// Cloud Driver Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package clouddriverhandler
+
+import (
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
+	dim "github.com/cloud-barista/cb-spider/cloud-info-manager/driver-info-manager"
+)
+
+// CloudConnection checked by the DriverCapabilityInfo of the driver.
+// Create<X>Handler() returns idrv.NotSupportedError
+//  1. if the capability of <X>Handler is false
+//  2. if the driver returns a nil handler without an error
+type capabilityConnection struct {
+	icon.CloudConnection
+	connectionName string
+	capability     idrv.DriverCapabilityInfo
+}
+
+func newCapabilityConnection(connectionName string, cldConnection icon.CloudConnection,
+	capability idrv.DriverCapabilityInfo) icon.CloudConnection {
+	return &capabilityConnection{cldConnection, connectionName, capability}
+}
+
+func (c *capabilityConnection) notSupported(feature string) error {
+	return &idrv.NotSupportedError{Driver: c.connectionName, Feature: feature}
+}
+
+func (c *capabilityConnection) CreateImageHandler() (irs.ImageHandler, error) {
+	if !c.capability.ImageHandler {
+		return nil, c.notSupported("ImageHandler")
+	}
+	handler, err := c.CloudConnection.CreateImageHandler()
+	if err == nil && handler == nil {
+		return nil, c.notSupported("ImageHandler")
+	}
+	return handler, err
+}
+
+func (c *capabilityConnection) CreateVPCHandler() (irs.VPCHandler, error) {
+	if !c.capability.VPCHandler {
+		return nil, c.notSupported("VPCHandler")
+	}
+	handler, err := c.CloudConnection.CreateVPCHandler()
+	if err == nil && handler == nil {
+		return nil, c.notSupported("VPCHandler")
+	}
+	return handler, err
+}
+
+func (c *capabilityConnection) CreateSecurityHandler() (irs.SecurityHandler, error) {
+	if !c.capability.SecurityHandler {
+		return nil, c.notSupported("SecurityHandler")
+	}
+	handler, err := c.CloudConnection.CreateSecurityHandler()
+	if err == nil && handler == nil {
+		return nil, c.notSupported("SecurityHandler")
+	}
+	return handler, err
+}
+
+func (c *capabilityConnection) CreateKeyPairHandler() (irs.KeyPairHandler, error) {
+	if !c.capability.KeyPairHandler {
+		return nil, c.notSupported("KeyPairHandler")
+	}
+	handler, err := c.CloudConnection.CreateKeyPairHandler()
+	if err == nil && handler == nil {
+		return nil, c.notSupported("KeyPairHandler")
+	}
+	return handler, err
+}
+
+func (c *capabilityConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	if !c.capability.PublicIPHandler {
+		return nil, c.notSupported("PublicIPHandler")
+	}
+	handler, err := c.CloudConnection.CreatePublicIPHandler()
+	if err == nil && handler == nil {
+		return nil, c.notSupported("PublicIPHandler")
+	}
+	return handler, err
+}
+
+func (c *capabilityConnection) CreateVMHandler() (irs.VMHandler, error) {
+	if !c.capability.VMHandler {
+		return nil, c.notSupported("VMHandler")
+	}
+	handler, err := c.CloudConnection.CreateVMHandler()
+	if err == nil && handler == nil {
+		return nil, c.notSupported("VMHandler")
+	}
+	return handler, err
+}
+
+func (c *capabilityConnection) CreateVMSpecHandler() (irs.VMSpecHandler, error) {
+	if !c.capability.VMSpecHandler {
+		return nil, c.notSupported("VMSpecHandler")
+	}
+	handler, err := c.CloudConnection.CreateVMSpecHandler()
+	if err == nil && handler == nil {
+		return nil, c.notSupported("VMSpecHandler")
+	}
+	return handler, err
+}
+
+func (c *capabilityConnection) CreateNLBHandler() (irs.NLBHandler, error) {
+	if !c.capability.NLBHandler {
+		return nil, c.notSupported("NLBHandler")
+	}
+	handler, err := c.CloudConnection.CreateNLBHandler()
+	if err == nil && handler == nil {
+		return nil, c.notSupported("NLBHandler")
+	}
+	return handler, err
+}
+
+func (c *capabilityConnection) CreateDNSHandler() (irs.DNSHandler, error) {
+	if !c.capability.DNSHandler {
+		return nil, c.notSupported("DNSHandler")
+	}
+	handler, err := c.CloudConnection.CreateDNSHandler()
+	if err == nil && handler == nil {
+		return nil, c.notSupported("DNSHandler")
+	}
+	return handler, err
+}
+
+func (c *capabilityConnection) CreateBucketHandler() (irs.BucketHandler, error) {
+	if !c.capability.BucketHandler {
+		return nil, c.notSupported("BucketHandler")
+	}
+	handler, err := c.CloudConnection.CreateBucketHandler()
+	if err == nil && handler == nil {
+		return nil, c.notSupported("BucketHandler")
+	}
+	return handler, err
+}
+
+func (c *capabilityConnection) CreateRegionZoneHandler() (irs.RegionZoneHandler, error) {
+	if !c.capability.RegionZoneHandler {
+		return nil, c.notSupported("RegionZoneHandler")
+	}
+	handler, err := c.CloudConnection.CreateRegionZoneHandler()
+	if err == nil && handler == nil {
+		return nil, c.notSupported("RegionZoneHandler")
+	}
+	return handler, err
+}
+
+//----------------
+
+// capability of the registered driver.
+func GetDriverCapability(driverName string) (idrv.DriverCapabilityInfo, error) {
+	cldDrvInfo, err := dim.GetCloudDriver(driverName)
+	if err != nil {
+		return idrv.DriverCapabilityInfo{}, err
+	}
+
+	cldDriver, err := loadCloudDriver(*cldDrvInfo)
+	if err != nil {
+		return idrv.DriverCapabilityInfo{}, err
+	}
+
+	return cldDriver.GetDriverCapability(), nil
+}
+
+// capability of the driver of the connection config.
+func GetDriverCapabilityByConnectionName(cloudConnectName string) (idrv.DriverCapabilityInfo, error) {
+	cccInfo, err := ccim.GetConnectionConfig(cloudConnectName)
+	if err != nil {
+		return idrv.DriverCapabilityInfo{}, err
+	}
+
+	return GetDriverCapability(cccInfo.DriverName)
+}
@@ -10,6 +10,7 @@ package clouddriverhandler
 
 import (
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 
 	awsdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/aws"
 	azuredrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/azure"
@@ -18,6 +19,7 @@ import (
 	openstackdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/openstack"
 	clouditdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/cloudit"
 	dockerdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/docker"
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
 //	cloudtwindrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/cloudtwin"
 
 	icbs "github.com/cloud-barista/cb-store/interfaces"
@@ -26,6 +28,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
+	im "github.com/cloud-barista/cb-spider/cloud-info-manager"
 	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
 	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
 	dim "github.com/cloud-barista/cb-spider/cloud-info-manager/driver-info-manager"
@@ -34,8 +37,7 @@ import (
 	//"encoding/json"
 	"fmt"
 	//"net/http"
-	"os"
-	"plugin"
+	"sort"
 	"strings"
 )
 
@@ -45,6 +47,7 @@ var cblog *logrus.Logger
 func init() {
 	//CIM_RESTSERVER = "http://localhost:1024"
 	cblog = config.Cblogger
+	im.SetCloudOSSchemaGetter(GetCloudOSSchema)
 }
 
 /*
@@ -70,12 +73,7 @@ func GetCloudDriver(cloudConnectName string) (idrv.CloudDriver, error) {
 		return nil, err
 	}
 
-	pluginSW := os.Getenv("PLUGIN_SW")
-	if strings.ToUpper(pluginSW) == "OFF" {
-		return getStaticCloudDriver(*cldDrvInfo)
-	} else {
-		return getCloudDriver(*cldDrvInfo)
-	}
+	return loadCloudDriver(*cldDrvInfo)
 }
 
 // 1. get credential info
@@ -92,13 +90,7 @@ func GetCloudConnection(cloudConnectName string) (icon.CloudConnection, error) {
 		return nil, err
 	}
 
-        pluginSW := os.Getenv("PLUGIN_SW")
-	var cldDriver idrv.CloudDriver
-        if strings.ToUpper(pluginSW) == "OFF" {
-		cldDriver, err = getStaticCloudDriver(*cldDrvInfo)
-        } else {
-		cldDriver, err = getCloudDriver(*cldDrvInfo)
-	}
+	cldDriver, err := loadCloudDriver(*cldDrvInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -117,33 +109,9 @@ func GetCloudConnection(cloudConnectName string) (icon.CloudConnection, error) {
 	//cblog.Info(crdInfo)
 	//cblog.Info(rgnInfo)
 
-	regionName, zoneName, err := GetRegionNameByRegionInfo(rgnInfo)
-        if err != nil {
-                return nil, err
-        }
-
-	connectionInfo := idrv.ConnectionInfo{ // @todo powerkim
-		CredentialInfo: idrv.CredentialInfo{
-			ClientId:         getValue(crdInfo.KeyValueInfoList, "ClientId"),
-			ClientSecret:     getValue(crdInfo.KeyValueInfoList, "ClientSecret"),
-			TenantId:         getValue(crdInfo.KeyValueInfoList, "TenantId"),
-			SubscriptionId:   getValue(crdInfo.KeyValueInfoList, "SubscriptionId"),
-			IdentityEndpoint: getValue(crdInfo.KeyValueInfoList, "IdentityEndpoint"),
-			Username:         getValue(crdInfo.KeyValueInfoList, "Username"),
-			Password:         getValue(crdInfo.KeyValueInfoList, "Password"),
-			DomainName:       getValue(crdInfo.KeyValueInfoList, "DomainName"),
-			ProjectID:        getValue(crdInfo.KeyValueInfoList, "ProjectID"),
-			AuthToken:        getValue(crdInfo.KeyValueInfoList, "AuthToken"),
-			ClientEmail:      getValue(crdInfo.KeyValueInfoList, "ClientEmail"),
-			PrivateKey:       getValue(crdInfo.KeyValueInfoList, "PrivateKey"),
-			Host:       	  getValue(crdInfo.KeyValueInfoList, "Host"),
-			APIVersion:    	  getValue(crdInfo.KeyValueInfoList, "APIVersion"),
-		},
-		RegionInfo: idrv.RegionInfo{ // @todo powerkim
-			Region:        regionName,
-			Zone:          zoneName,
-			ResourceGroup: getValue(rgnInfo.KeyValueInfoList, "ResourceGroup"),
-		},
+	connectionInfo, err := getConnectionInfo(cldDriver, crdInfo, rgnInfo)
+	if err != nil {
+		return nil, err
 	}
 
 	cldConnection, err := cldDriver.ConnectCloud(connectionInfo)
@@ -151,109 +119,314 @@ func GetCloudConnection(cloudConnectName string) (icon.CloudConnection, error) {
 		return nil, err
 	}
 
-	return cldConnection, nil
+	return newCapabilityConnection(cloudConnectName, cldConnection, cldDriver.GetDriverCapability()), nil
 }
 
+// verify the registered credential with the region.
+// the secret references of the credential are resolved by GetCredentialDecrypt().
+// driverName can be empty if the provider has only one driver.
+func VerifyCredential(credentialName string, regionName string, driverName string) error {
+	crdInfo, err := cim.GetCredentialDecrypt(credentialName)
+	if err != nil {
+		return err
+	}
 
+	return verifyCredentialInfo(*crdInfo, regionName, driverName)
+}
 
-func getValue(keyValueInfoList []icbs.KeyValue, key string) string {
-	for _, kv := range keyValueInfoList {
-		if kv.Key == key {
-			return kv.Value
+// verify the credential before the registration.
+// the secret references are not resolved for the values of a request,
+// so register the credential and verify it with VerifyCredential().
+// driverName can be empty if the provider has only one driver.
+func VerifyCredentialInfo(crdInfo cim.CredentialInfo, regionName string, driverName string) error {
+	cblog.Info("CloudDriverHandler: called VerifyCredentialInfo() - " + crdInfo.CredentialName + ", " + regionName)
+
+	for _, kv := range crdInfo.KeyValueInfoList {
+		if cim.IsSecretReference(kv.Value) {
+			return fmt.Errorf("%s of Credential(%s) is a secret reference, which can be verified only after the registration!",
+				kv.Key, crdInfo.CredentialName)
 		}
 	}
-	return "Not set"
+
+	return verifyCredentialInfo(crdInfo, regionName, driverName)
 }
 
-func GetRegionNameByConnectionName(cloudConnectName string) (string, string, error) {
-        cccInfo, err := ccim.GetConnectionConfig(cloudConnectName)
-        if err != nil {
-                return "", "", err
-        }
+// 1. get region info
+// 2. get the driver of the credential's provider
+// 3. connect and call a read-only API, ListVMSpec(),
+//    or IsConnected() if the driver does not support the VMSpecHandler.
+// returns the CSP error if the credential is not valid.
+func verifyCredentialInfo(crdInfo cim.CredentialInfo, regionName string, driverName string) error {
+	rgnInfo, err := rim.GetRegion(regionName)
+	if err != nil {
+		return err
+	}
 
-        rgnInfo, err := rim.GetRegion(cccInfo.RegionName)
-        if err != nil {
-                return "", "", err
-        }
+	if !strings.EqualFold(crdInfo.ProviderName, rgnInfo.ProviderName) {
+		return fmt.Errorf("Credential(%s) is for %s, but Region(%s) is for %s!",
+			crdInfo.CredentialName, crdInfo.ProviderName, regionName, rgnInfo.ProviderName)
+	}
+
+	cldDrvInfo, err := getCloudDriverInfoByProvider(crdInfo.ProviderName, driverName)
+	if err != nil {
+		return err
+	}
+
+	cldDriver, err := loadCloudDriver(*cldDrvInfo)
+	if err != nil {
+		return err
+	}
+
+	connectionInfo, err := getConnectionInfo(cldDriver, &crdInfo, rgnInfo)
+	if err != nil {
+		return err
+	}
+
+	rawConnection, err := cldDriver.ConnectCloud(connectionInfo)
+	if err != nil {
+		cblog.Error(err)
+		return err
+	}
+	if rawConnection == nil {
+		return fmt.Errorf("%s: the driver returned no connection!", cldDrvInfo.DriverName)
+	}
+	defer rawConnection.Close()
+	cldConnection := newCapabilityConnection(cldDrvInfo.DriverName, rawConnection, cldDriver.GetDriverCapability())
 
-	return GetRegionNameByRegionInfo(rgnInfo)
+	handler, err := cldConnection.CreateVMSpecHandler()
+	if idrv.IsNotSupported(err) {
+		return verifyConnected(cldDrvInfo.DriverName, cldConnection)
+	}
+	if err != nil {
+		cblog.Error(err)
+		return err
+	}
+
+	_, err = handler.ListVMSpec(connectionInfo.RegionInfo.Region)
+	if err != nil {
+		cblog.Error(err)
+		return err
+	}
+
+	return nil
 }
 
-func GetRegionNameByRegionInfo(rgnInfo *rim.RegionInfo) (string, string, error) {
-
-        // @todo should move KeyValueList into XXXDriver.go, powerkim
-        var regionName string
-        var zoneName string
-        switch strings.ToUpper(rgnInfo.ProviderName) {
-        case "AZURE":
-                regionName = getValue(rgnInfo.KeyValueInfoList, "location")
-        case "AWS":
-                regionName = getValue(rgnInfo.KeyValueInfoList, "Region")
-                zoneName = getValue(rgnInfo.KeyValueInfoList, "Zone")
-        case "ALIBABA":
-                regionName = getValue(rgnInfo.KeyValueInfoList, "Region")
-                zoneName = getValue(rgnInfo.KeyValueInfoList, "Zone")
-        case "GCP":
-                regionName = getValue(rgnInfo.KeyValueInfoList, "Region")
-                zoneName = getValue(rgnInfo.KeyValueInfoList, "Zone")
-        case "OPENSTACK":
-                regionName = getValue(rgnInfo.KeyValueInfoList, "Region")
-        case "CLOUDTWIN":
-                regionName = getValue(rgnInfo.KeyValueInfoList, "Region")
-        case "CLOUDIT":
-                // Cloudit do not use Region, But set default @todo 2019.10.28. by powerkim.
-                regionName = getValue(rgnInfo.KeyValueInfoList, "Region")
-        case "DOCKER":
-                // docker do not use Region, But set default @todo 2020.05.06. by powerkim.
-                regionName = getValue(rgnInfo.KeyValueInfoList, "Region")
-        default:
-                errmsg := rgnInfo.ProviderName + " is not a valid ProviderName!!"
-                return "", "", fmt.Errorf(errmsg)
-        }
+func verifyConnected(driverName string, cldConnection icon.CloudConnection) error {
+	connected, err := cldConnection.IsConnected()
+	if err != nil {
+		cblog.Error(err)
+		return err
+	}
+	if !connected {
+		return fmt.Errorf("%s: the connection with the credential is not connected!", driverName)
+	}
+	return nil
+}
 
-        return regionName, zoneName, nil
+func loadCloudDriver(cldDrvInfo dim.CloudDriverInfo) (idrv.CloudDriver, error) {
+	if dim.IsPluginOff() {
+		return getStaticCloudDriver(cldDrvInfo)
+	} else {
+		return getCloudDriver(cldDrvInfo)
+	}
 }
 
-func getCloudDriver(cldDrvInfo dim.CloudDriverInfo) (idrv.CloudDriver, error) {
-	// $CBSPIDER_ROOT/cloud-driver-libs/*
-	cbspiderRoot := os.Getenv("CBSPIDER_ROOT")
-        if cbspiderRoot == "" {
-                cblog.Error("$CBSPIDER_ROOT is not set!!")
-                os.Exit(1)
-	} 
-	driverLibPath := cbspiderRoot + "/cloud-driver-libs/"
+// the driver of driverName, or the only registered driver of the provider.
+// if the provider has more than one driver, driverName is required.
+func getCloudDriverInfoByProvider(providerName string, driverName string) (*dim.CloudDriverInfo, error) {
+	if driverName != "" {
+		cldDrvInfo, err := dim.GetCloudDriver(driverName)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.EqualFold(cldDrvInfo.ProviderName, providerName) {
+			return nil, fmt.Errorf("Driver(%s) is for %s, not %s!", driverName, cldDrvInfo.ProviderName, providerName)
+		}
+		return cldDrvInfo, nil
+	}
 
-	driverFile := cldDrvInfo.DriverLibFileName // ex) "aws-test-driver-v0.5.so"
-	if driverFile == "" {
-		return nil, fmt.Errorf("%q: driver library file can't nil or empty!!", cldDrvInfo.DriverName)
+	cldDrvInfoList, err := dim.ListCloudDriver()
+	if err != nil {
+		return nil, err
+	}
+
+	var providerDrvInfoList []*dim.CloudDriverInfo
+	var driverNameList []string
+	for _, cldDrvInfo := range cldDrvInfoList {
+		if strings.EqualFold(cldDrvInfo.ProviderName, providerName) {
+			providerDrvInfoList = append(providerDrvInfoList, cldDrvInfo)
+			driverNameList = append(driverNameList, cldDrvInfo.DriverName)
+		}
+	}
+	switch len(providerDrvInfoList) {
+	case 0:
+		return nil, fmt.Errorf("%s: no registered driver for this provider!", providerName)
+	case 1:
+		return providerDrvInfoList[0], nil
+	default:
+		return nil, fmt.Errorf("%s: %d drivers are registered for this provider(%s), so the driver name is required!",
+			providerName, len(driverNameList), strings.Join(driverNameList, ", "))
+	}
+}
+
+// the driver translates the key-value lists into ConnectionInfo,
+// so a new cloud does not need any change of this handler.
+func getConnectionInfo(cldDriver idrv.CloudDriver, crdInfo *cim.CredentialInfo, rgnInfo *rim.RegionInfo) (idrv.ConnectionInfo, error) {
+	// the required keys of the driver's schema, instead of "Not set" values.
+	schema := toCloudOSSchema(crdInfo.ProviderName, cldDriver.GetConnectionSchema())
+	err := im.CheckRequiredKeyValue(schema, crdInfo.KeyValueInfoList, rgnInfo.KeyValueInfoList)
+	if err != nil {
+		return idrv.ConnectionInfo{}, err
 	}
-	driverPath := driverLibPath + driverFile
 
-	cblog.Info(cldDrvInfo.DriverName + ": driver path - " + driverPath)
+	return cldDriver.GetConnectionInfo(toDriverKeyValueList(crdInfo.KeyValueInfoList),
+		toDriverKeyValueList(rgnInfo.KeyValueInfoList))
+}
+
+// schema of the CloudOS from its driver,
+// the static driver, or the first loadable registered driver of the CloudOS by name.
+func GetCloudOSSchema(cloudOSName string) (*im.CloudOSSchema, error) {
+	cldDriver, err := getCloudDriverByCloudOS(cloudOSName)
+	if err != nil {
+		return nil, err
+	}
+	return toCloudOSSchema(cloudOSName, cldDriver.GetConnectionSchema()), nil
+}
 
-	/*---------------
-	        A plugin is only initialized once, and cannot be closed.
-	        ref) https://golang.org/pkg/plugin/
-	-----------------*/
+func getCloudDriverByCloudOS(cloudOSName string) (idrv.CloudDriver, error) {
+	if dim.IsPluginOff() {
+		return getStaticCloudDriver(dim.CloudDriverInfo{DriverName: cloudOSName, ProviderName: strings.ToUpper(cloudOSName)})
+	}
 
-	//var plug *plugin.Plugin
-	plug, err := plugin.Open(driverPath)
+	cldDrvInfoList, err := dim.ListCloudDriver()
 	if err != nil {
-		cblog.Errorf("plugin.Open: %v\n", err)
 		return nil, err
 	}
-	//      fmt.Printf("plug: %#v\n\n", plug)
+	sort.Slice(cldDrvInfoList, func(i, j int) bool {
+		return cldDrvInfoList[i].DriverName < cldDrvInfoList[j].DriverName
+	})
+	var errList []string
+	for _, cldDrvInfo := range cldDrvInfoList {
+		if !strings.EqualFold(cldDrvInfo.ProviderName, cloudOSName) {
+			continue
+		}
+		cldDriver, err := getCloudDriver(*cldDrvInfo)
+		if err != nil {
+			errList = append(errList, err.Error())
+			continue
+		}
+		return cldDriver, nil
+	}
+	if len(errList) > 0 {
+		return nil, fmt.Errorf("%s: no loadable driver for this CloudOS! %s", cloudOSName, strings.Join(errList, "; "))
+	}
+	return nil, fmt.Errorf("%s: no registered driver for this CloudOS, register its driver first!", cloudOSName)
+}
+
+func toCloudOSSchema(cloudOSName string, connSchema idrv.ConnectionSchema) *im.CloudOSSchema {
+	return &im.CloudOSSchema{
+		CloudOS:    strings.ToUpper(cloudOSName),
+		Credential: toKeySchemaList(connSchema.Credential),
+		Region:     toKeySchemaList(connSchema.Region),
+	}
+}
+
+func toKeySchemaList(driverKeySchemaList []idrv.KeySchema) []im.KeySchema {
+	keySchemaList := []im.KeySchema{}
+	for _, ks := range driverKeySchemaList {
+		keySchemaList = append(keySchemaList, im.KeySchema{Key: ks.Key, Description: ks.Description,
+			Required: ks.Required, Secret: ks.Secret})
+	}
+	return keySchemaList
+}
+
+func toDriverKeyValueList(keyValueInfoList []icbs.KeyValue) []irs.KeyValue {
+	keyValueList := []irs.KeyValue{}
+	for _, kv := range keyValueInfoList {
+		keyValueList = append(keyValueList, irs.KeyValue{Key: kv.Key, Value: kv.Value})
+	}
+	return keyValueList
+}
+
+func GetRegionNameByConnectionName(cloudConnectName string) (string, string, error) {
+	cldDriver, err := GetCloudDriver(cloudConnectName)
+	if err != nil {
+		return "", "", err
+	}
+
+	cccInfo, err := ccim.GetConnectionConfig(cloudConnectName)
+	if err != nil {
+		return "", "", err
+	}
+
+	rgnInfo, err := rim.GetRegion(cccInfo.RegionName)
+	if err != nil {
+		return "", "", err
+	}
+
+	return getRegionName(cldDriver, rgnInfo)
+}
+
+// by the driver of driverName, or the only registered driver of the region's provider.
+func GetRegionNameByRegionInfo(rgnInfo *rim.RegionInfo, driverName string) (string, string, error) {
+	cldDrvInfo, err := getCloudDriverInfoByProvider(rgnInfo.ProviderName, driverName)
+	if err != nil {
+		return "", "", err
+	}
 
-	//driver, err := plug.Lookup(cccInfo.DriverName)
-	driver, err := plug.Lookup("CloudDriver")
+	cldDriver, err := loadCloudDriver(*cldDrvInfo)
 	if err != nil {
-		cblog.Errorf("plug.Lookup: %v\n", err)
+		return "", "", err
+	}
+
+	return getRegionName(cldDriver, rgnInfo)
+}
+
+func getRegionName(cldDriver idrv.CloudDriver, rgnInfo *rim.RegionInfo) (string, string, error) {
+	regionKey, zoneKey := cldDriver.GetRegionZoneKeyName()
+
+	keyValueList := toDriverKeyValueList(rgnInfo.KeyValueInfoList)
+	var zoneName string
+	regionName := idrv.GetKeyValue(keyValueList, regionKey)
+	if zoneKey != "" {
+		zoneName = idrv.GetKeyValue(keyValueList, zoneKey)
+	}
+
+	return regionName, zoneName, nil
+}
+
+// key names of the region and the zone in the KeyValueInfoList of RegionInfo,
+// zoneKey is "" if the provider does not use a zone.
+func GetRegionZoneKeyNameByConnectionName(cloudConnectName string) (string, string, error) {
+	cldDriver, err := GetCloudDriver(cloudConnectName)
+	if err != nil {
+		return "", "", err
+	}
+
+	regionKey, zoneKey := cldDriver.GetRegionZoneKeyName()
+	return regionKey, zoneKey, nil
+}
+
+// 1. verify the SHA-256 of the driver library file recorded at the registration
+// 2. load the driver library, $CBSPIDER_ROOT/cloud-driver-libs/*
+// 3. check the interface version of the driver
+func getCloudDriver(cldDrvInfo dim.CloudDriverInfo) (idrv.CloudDriver, error) {
+	driverFile := cldDrvInfo.DriverLibFileName // ex) "aws-test-driver-v0.5.so"
+	if driverFile == "" {
+		return nil, fmt.Errorf("%q: driver library file can't nil or empty!!", cldDrvInfo.DriverName)
+	}
+
+	cblog.Info(cldDrvInfo.DriverName + ": driver library file - " + driverFile)
+
+	err := dim.VerifyDriverLibFile(cldDrvInfo)
+	if err != nil {
+		cblog.Error(err)
 		return nil, err
 	}
 
-	cloudDriver, ok := driver.(idrv.CloudDriver)
-	if !ok {
-		cblog.Error("Not CloudDriver interface!!")
+	cloudDriver, err := dim.OpenDriverLib(driverFile)
+	if err != nil {
+		cblog.Error(err)
 		return nil, err
 	}
 
@@ -281,6 +454,8 @@ func getStaticCloudDriver(cldDrvInfo dim.CloudDriverInfo) (idrv.CloudDriver, err
 			cloudDriver = new(clouditdrv.ClouditDriver)
 		case "DOCKER":
 			cloudDriver = new(dockerdrv.DockerDriver)
+		case "MOCK":
+			cloudDriver = new(mockdrv.MockDriver)
 		//case "CLOUDTWIN":
 		//	cloudDriver = new(cloudtwindrv.CloudTwinDriver)
 
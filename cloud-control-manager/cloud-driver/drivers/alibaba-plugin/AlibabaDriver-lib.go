@@ -8,8 +8,6 @@
 //
 // by zephy@mz.co.kr, 2019.09.
 
-//package alibaba
-
 package main
 
 import (
@@ -22,30 +20,85 @@ import (
 	alicon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/alibaba/connect"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	"github.com/davecgh/go-spew/spew"
 )
 
 type AlibabaDriver struct{}
 
 func (AlibabaDriver) GetDriverVersion() string {
-	return "ALIBABA-CLOUD DRIVER Version 1.0"
+	return idrv.DriverVersion("ALIBABA-CLOUD DRIVER Version 1.0")
 }
 
 func (AlibabaDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	var drvCapabilityInfo idrv.DriverCapabilityInfo
 
-	drvCapabilityInfo.ImageHandler = false
-	drvCapabilityInfo.VPCHandler = false
-	drvCapabilityInfo.SecurityHandler = false
-	drvCapabilityInfo.KeyPairHandler = false
+	drvCapabilityInfo.FIXED_SUBNET_CIDR = true
+	drvCapabilityInfo.VPC_CIDR = true
+
+	drvCapabilityInfo.ImageHandler = true
+	drvCapabilityInfo.VPCHandler = true
+	drvCapabilityInfo.SecurityHandler = true
+	drvCapabilityInfo.KeyPairHandler = true
 	drvCapabilityInfo.VNicHandler = false
 	drvCapabilityInfo.PublicIPHandler = false
-	drvCapabilityInfo.VMHandler = false
-	drvCapabilityInfo.VMSpecHandler = false
+	drvCapabilityInfo.VMHandler = true
+	drvCapabilityInfo.VMSpecHandler = true
+	drvCapabilityInfo.NLBHandler = false
+	drvCapabilityInfo.DNSHandler = false
+	drvCapabilityInfo.BucketHandler = true
+	drvCapabilityInfo.RegionZoneHandler = true
+
+	drvCapabilityInfo.VPC_IPv6_CIDR = true
+	drvCapabilityInfo.SECURITY_IPv6_RULE = true
+	// false: a secondary ENI is attached after the instance is running and detached before the termination,
+	// but the VMHandler does not manage ENIs. a follow-up of VM_MULTI_NIC, see CHANGELOG.md.
+	drvCapabilityInfo.VM_MULTI_NIC = false
+	drvCapabilityInfo.SUBNET_ADD_REMOVE = true
+	drvCapabilityInfo.VM_SUSPEND_RESUME = true
+	drvCapabilityInfo.VM_REBOOT = true
 
 	return drvCapabilityInfo
 }
 
+// key names of the region and the zone in the key-value list of the region.
+func (AlibabaDriver) GetRegionZoneKeyName() (string, string) {
+	return "Region", "Zone"
+}
+
+// translates the key-value lists of the credential and the region into ConnectionInfo.
+func (driver AlibabaDriver) GetConnectionInfo(credentialKeyValueList []irs.KeyValue, regionKeyValueList []irs.KeyValue) (idrv.ConnectionInfo, error) {
+	regionKey, zoneKey := driver.GetRegionZoneKeyName()
+
+	connectionInfo := idrv.ConnectionInfo{
+		CredentialInfo: idrv.CredentialInfo{
+			ClientId:     idrv.GetKeyValue(credentialKeyValueList, "ClientId"),
+			ClientSecret: idrv.GetKeyValue(credentialKeyValueList, "ClientSecret"),
+			KeyValueList: credentialKeyValueList,
+		},
+		RegionInfo: idrv.RegionInfo{
+			Region:       idrv.GetKeyValue(regionKeyValueList, regionKey),
+			Zone:         idrv.GetKeyValue(regionKeyValueList, zoneKey),
+			KeyValueList: regionKeyValueList,
+		},
+	}
+	return connectionInfo, nil
+}
+
+// keys of the credential and the region read by GetConnectionInfo().
+func (AlibabaDriver) GetConnectionSchema() idrv.ConnectionSchema {
+	return idrv.ConnectionSchema{
+		Credential: []idrv.KeySchema{
+			{Key: "ClientId", Description: "AccessKey ID", Required: true},
+			{Key: "ClientSecret", Description: "AccessKey Secret", Required: true, Secret: true},
+		},
+		Region: []idrv.KeySchema{
+			{Key: "Region", Description: "ex) ap-northeast-1", Required: true},
+			{Key: "Zone", Description: "ex) ap-northeast-1a", Required: true},
+		},
+	}
+}
+
 func (driver *AlibabaDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.CloudConnection, error) {
 	// 1. get info of credential and region for Test A Cloud from connectionInfo.
 	// 2. create a client object(or service  object) of Test A Cloud with credential info.
@@ -64,6 +117,7 @@ func (driver *AlibabaDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (i
 
 	iConn := alicon.AlibabaCloudConnection{
 		Region:        connectionInfo.RegionInfo,
+		CredentialInfo: connectionInfo.CredentialInfo,
 		VMClient:      ECSClient,
 		KeyPairClient: ECSClient,
 		ImageClient:   ECSClient,
@@ -11,6 +11,9 @@
 package connect
 
 import (
+	"errors"
+	"net/http"
+
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 
@@ -29,7 +32,8 @@ func init() {
 }
 
 type AlibabaCloudConnection struct {
-	Region idrv.RegionInfo
+	Region         idrv.RegionInfo
+	CredentialInfo idrv.CredentialInfo
 
 	VMClient      *ecs.Client
 	KeyPairClient *ecs.Client
@@ -103,6 +107,33 @@ func (cloudConn *AlibabaCloudConnection) CreateVMSpecHandler() (irs.VMSpecHandle
 	return &handler, nil
 }
 
+func (cloudConn *AlibabaCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	cblogger.Error("Alibaba Cloud Driver: called CreatePublicIPHandler(), but not supported!")
+	return nil, errors.New("Alibaba Cloud Driver: CreatePublicIPHandler() is not supported!")
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateNLBHandler() (irs.NLBHandler, error) {
+	cblogger.Error("Alibaba Cloud Driver: called CreateNLBHandler(), but not supported!")
+	return nil, errors.New("Alibaba Cloud Driver: CreateNLBHandler() is not supported!")
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateDNSHandler() (irs.DNSHandler, error) {
+	cblogger.Error("Alibaba Cloud Driver: called CreateDNSHandler(), but not supported!")
+	return nil, errors.New("Alibaba Cloud Driver: CreateDNSHandler() is not supported!")
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateBucketHandler() (irs.BucketHandler, error) {
+	cblogger.Info("Alibaba Cloud Driver: called CreateBucketHandler()!")
+	bucketHandler := alirs.AlibabaBucketHandler{cloudConn.Region, cloudConn.CredentialInfo, &http.Client{}}
+	return &bucketHandler, nil
+}
+
+func (cloudConn *AlibabaCloudConnection) CreateRegionZoneHandler() (irs.RegionZoneHandler, error) {
+	cblogger.Info("Alibaba Cloud Driver: called CreateRegionZoneHandler()!")
+	regionZoneHandler := alirs.AlibabaRegionZoneHandler{cloudConn.Region, cloudConn.VMClient}
+	return &regionZoneHandler, nil
+}
+
 func (AlibabaCloudConnection) IsConnected() (bool, error) {
 	return true, nil
 }
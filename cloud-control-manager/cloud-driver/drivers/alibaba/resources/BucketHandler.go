@@ -0,0 +1,406 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2020.10.
+
+// Bucket 처리 (AlibabaCloud's Bucket --> OSS Bucket 임)
+// OSS는 alibaba-cloud-sdk-go에 포함되어 있지 않아서 REST API를 직접 호출 함.
+package resources
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+type AlibabaBucketHandler struct {
+	Region     idrv.RegionInfo
+	Credential idrv.CredentialInfo
+	Client     *http.Client
+}
+
+type ossBucket struct {
+	Name         string `xml:"Name"`
+	Location     string `xml:"Location"`
+	CreationDate string `xml:"CreationDate"`
+	StorageClass string `xml:"StorageClass"`
+}
+
+type ossListBucketsResult struct {
+	IsTruncated bool        `xml:"IsTruncated"`
+	NextMarker  string      `xml:"NextMarker"`
+	Buckets     []ossBucket `xml:"Buckets>Bucket"`
+}
+
+type ossBucketInfoResult struct {
+	Bucket ossBucket `xml:"Bucket"`
+}
+
+type ossObject struct {
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	ETag         string `xml:"ETag"`
+	Size         int64  `xml:"Size"`
+}
+
+type ossListObjectsResult struct {
+	IsTruncated bool        `xml:"IsTruncated"`
+	NextMarker  string      `xml:"NextMarker"`
+	Contents    []ossObject `xml:"Contents"`
+}
+
+type ossError struct {
+	Code      string `xml:"Code"`
+	Message   string `xml:"Message"`
+	RequestId string `xml:"RequestId"`
+}
+
+func (bucketHandler *AlibabaBucketHandler) CreateBucket(bucketReqInfo irs.BucketReqInfo) (irs.BucketInfo, error) {
+	cblogger.Info(bucketReqInfo)
+
+	// Bucket은 Private ACL로 생성 함.
+	header := http.Header{}
+	header.Set("x-oss-acl", "private")
+	resp, err := bucketHandler.doRequest(http.MethodPut, bucketReqInfo.IId.NameId, "", nil, header, nil, -1)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.BucketInfo{}, err
+	}
+	resp.Body.Close()
+
+	return bucketHandler.GetBucket(bucketReqInfo.IId)
+}
+
+func (bucketHandler *AlibabaBucketHandler) ListBucket() ([]*irs.BucketInfo, error) {
+	cblogger.Info("Start ListBucket()")
+
+	bucketInfoList := []*irs.BucketInfo{}
+	marker := ""
+	for {
+		query := url.Values{}
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+		resp, err := bucketHandler.doRequest(http.MethodGet, "", "", query, nil, nil, -1)
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		var result ossListBucketsResult
+		err = decodeOSSResponse(resp, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		// GetService는 모든 Region의 Bucket을 조회하므로 연결된 Region만 남김.
+		for _, bucket := range result.Buckets {
+			if bucket.Location != "" && bucket.Location != "oss-"+bucketHandler.Region.Region {
+				continue
+			}
+			bucketInfo := extractOSSBucketInfo(bucket)
+			bucketInfoList = append(bucketInfoList, &bucketInfo)
+		}
+		if !result.IsTruncated || result.NextMarker == "" {
+			break
+		}
+		marker = result.NextMarker
+	}
+	return bucketInfoList, nil
+}
+
+func (bucketHandler *AlibabaBucketHandler) GetBucket(bucketIID irs.IID) (irs.BucketInfo, error) {
+	cblogger.Info(bucketIID)
+
+	resp, err := bucketHandler.doRequest(http.MethodGet, ossBucketName(bucketIID), "", url.Values{"bucketInfo": {""}}, nil, nil, -1)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.BucketInfo{}, err
+	}
+	var result ossBucketInfoResult
+	err = decodeOSSResponse(resp, &result)
+	if err != nil {
+		return irs.BucketInfo{}, err
+	}
+	return extractOSSBucketInfo(result.Bucket), nil
+}
+
+func (bucketHandler *AlibabaBucketHandler) DeleteBucket(bucketIID irs.IID) (bool, error) {
+	cblogger.Info(bucketIID)
+
+	resp, err := bucketHandler.doRequest(http.MethodDelete, ossBucketName(bucketIID), "", nil, nil, nil, -1)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	resp.Body.Close()
+	return true, nil
+}
+
+func (bucketHandler *AlibabaBucketHandler) ListObject(bucketIID irs.IID, prefix string) ([]*irs.ObjectInfo, error) {
+	cblogger.Info(bucketIID, prefix)
+
+	objectInfoList := []*irs.ObjectInfo{}
+	marker := ""
+	for {
+		query := url.Values{}
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+		resp, err := bucketHandler.doRequest(http.MethodGet, ossBucketName(bucketIID), "", query, nil, nil, -1)
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		var result ossListObjectsResult
+		err = decodeOSSResponse(resp, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range result.Contents {
+			lastModified, _ := time.Parse(time.RFC3339, object.LastModified)
+			objectInfoList = append(objectInfoList, &irs.ObjectInfo{
+				Name:         object.Key,
+				Size:         object.Size,
+				LastModified: lastModified,
+				ETag:         object.ETag,
+			})
+		}
+		if !result.IsTruncated || result.NextMarker == "" {
+			break
+		}
+		marker = result.NextMarker
+	}
+	return objectInfoList, nil
+}
+
+// 크기를 모르면 Chunked Encoding으로 전송 함.
+func (bucketHandler *AlibabaBucketHandler) PutObject(bucketIID irs.IID, objectName string, reader io.Reader, size int64, contentType string) (irs.ObjectInfo, error) {
+	cblogger.Info(bucketIID, objectName)
+
+	header := http.Header{}
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+	resp, err := bucketHandler.doRequest(http.MethodPut, ossBucketName(bucketIID), objectName, nil, header, reader, size)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.ObjectInfo{}, err
+	}
+	resp.Body.Close()
+
+	resp, err = bucketHandler.doRequest(http.MethodHead, ossBucketName(bucketIID), objectName, nil, nil, nil, -1)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.ObjectInfo{}, err
+	}
+	resp.Body.Close()
+	return extractOSSObjectInfo(objectName, resp.Header), nil
+}
+
+func (bucketHandler *AlibabaBucketHandler) GetObject(bucketIID irs.IID, objectName string) (io.ReadCloser, irs.ObjectInfo, error) {
+	cblogger.Info(bucketIID, objectName)
+
+	resp, err := bucketHandler.doRequest(http.MethodGet, ossBucketName(bucketIID), objectName, nil, nil, nil, -1)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, irs.ObjectInfo{}, err
+	}
+	return resp.Body, extractOSSObjectInfo(objectName, resp.Header), nil
+}
+
+func (bucketHandler *AlibabaBucketHandler) DeleteObject(bucketIID irs.IID, objectName string) (bool, error) {
+	cblogger.Info(bucketIID, objectName)
+
+	// OSS는 없는 Object를 삭제해도 성공하므로 먼저 확인 함.
+	resp, err := bucketHandler.doRequest(http.MethodHead, ossBucketName(bucketIID), objectName, nil, nil, nil, -1)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	resp.Body.Close()
+
+	resp, err = bucketHandler.doRequest(http.MethodDelete, ossBucketName(bucketIID), objectName, nil, nil, nil, -1)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	resp.Body.Close()
+	return true, nil
+}
+
+// URL에 서명을 포함하는 방식
+// https://www.alibabacloud.com/help/doc-detail/31952.htm
+func (bucketHandler *AlibabaBucketHandler) GetPresignedURL(bucketIID irs.IID, objectName string, method irs.PresignedURLMethod, expires time.Duration) (string, error) {
+	cblogger.Info(bucketIID, objectName, method, expires)
+
+	if method != irs.PresignedGet && method != irs.PresignedPut {
+		return "", errors.New("Alibaba Cloud Driver: " + string(method) + " method of the signed URL is not supported!")
+	}
+
+	bucketName := ossBucketName(bucketIID)
+	expiration := strconv.FormatInt(time.Now().Add(expires).Unix(), 10)
+	// VERB \n Content-MD5 \n Content-Type \n Expires \n CanonicalizedResource
+	stringToSign := string(method) + "\n\n\n" + expiration + "\n/" + bucketName + "/" + objectName
+
+	query := url.Values{}
+	query.Set("OSSAccessKeyId", bucketHandler.Credential.ClientId)
+	query.Set("Expires", expiration)
+	query.Set("Signature", bucketHandler.sign(stringToSign))
+	return bucketHandler.getURL(bucketName, objectName) + "?" + query.Encode(), nil
+}
+
+// Bucket은 Virtual Hosted Style로 접근 함.
+// ex) https://{bucket}.oss-{region}.aliyuncs.com/{object}
+func (bucketHandler *AlibabaBucketHandler) getURL(bucketName string, objectName string) string {
+	host := "oss-" + bucketHandler.Region.Region + ".aliyuncs.com"
+	if bucketName != "" {
+		host = bucketName + "." + host
+	}
+	return "https://" + host + "/" + (&url.URL{Path: objectName}).EscapedPath()
+}
+
+func (bucketHandler *AlibabaBucketHandler) sign(stringToSign string) string {
+	mac := hmac.New(sha1.New, []byte(bucketHandler.Credential.ClientSecret))
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Header에 서명을 포함하는 방식, 2xx가 아니면 OSS Error를 반환 함.
+// https://www.alibabacloud.com/help/doc-detail/31951.htm
+func (bucketHandler *AlibabaBucketHandler) doRequest(method string, bucketName string, objectName string, query url.Values, header http.Header, body io.Reader, size int64) (*http.Response, error) {
+	reqURL := bucketHandler.getURL(bucketName, objectName)
+	if len(query) > 0 {
+		// 값이 없는 Sub Resource(ex: ?bucketInfo)는 '='를 붙이지 않음.
+		params := []string{}
+		for key, values := range query {
+			if len(values) == 0 || values[0] == "" {
+				params = append(params, url.QueryEscape(key))
+			} else {
+				params = append(params, url.QueryEscape(key)+"="+url.QueryEscape(values[0]))
+			}
+		}
+		sort.Strings(params)
+		reqURL += "?" + strings.Join(params, "&")
+	}
+	req, err := http.NewRequest(method, reqURL, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.ContentLength = size
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+
+	// CanonicalizedOSSHeaders
+	ossHeaderKeys := []string{}
+	for key := range req.Header {
+		lowerKey := strings.ToLower(key)
+		if strings.HasPrefix(lowerKey, "x-oss-") {
+			ossHeaderKeys = append(ossHeaderKeys, lowerKey)
+		}
+	}
+	sort.Strings(ossHeaderKeys)
+	canonicalizedHeaders := ""
+	for _, key := range ossHeaderKeys {
+		canonicalizedHeaders += key + ":" + req.Header.Get(key) + "\n"
+	}
+
+	// CanonicalizedResource, 목록 조회의 prefix, marker 등은 포함하지 않음.
+	canonicalizedResource := "/"
+	if bucketName != "" {
+		canonicalizedResource += bucketName + "/" + objectName
+	}
+	if _, ok := query["bucketInfo"]; ok {
+		canonicalizedResource += "?bucketInfo"
+	}
+
+	stringToSign := method + "\n" + req.Header.Get("Content-MD5") + "\n" + req.Header.Get("Content-Type") + "\n" +
+		req.Header.Get("Date") + "\n" + canonicalizedHeaders + canonicalizedResource
+	req.Header.Set("Authorization", "OSS "+bucketHandler.Credential.ClientId+":"+bucketHandler.sign(stringToSign))
+
+	client := bucketHandler.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		defer resp.Body.Close()
+		data, _ := ioutil.ReadAll(resp.Body)
+		var ossErr ossError
+		if xml.Unmarshal(data, &ossErr) == nil && ossErr.Code != "" {
+			return nil, errors.New(ossErr.Code + ": " + ossErr.Message + " (RequestId: " + ossErr.RequestId + ")")
+		}
+		return nil, errors.New(fmt.Sprintf("%s %s: %s", method, reqURL, resp.Status))
+	}
+	return resp, nil
+}
+
+func decodeOSSResponse(resp *http.Response, result interface{}) error {
+	defer resp.Body.Close()
+	err := xml.NewDecoder(resp.Body).Decode(result)
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	return nil
+}
+
+func ossBucketName(bucketIID irs.IID) string {
+	if bucketIID.SystemId != "" {
+		return bucketIID.SystemId
+	}
+	return bucketIID.NameId
+}
+
+func extractOSSBucketInfo(bucket ossBucket) irs.BucketInfo {
+	createdTime, _ := time.Parse(time.RFC3339, bucket.CreationDate)
+	return irs.BucketInfo{
+		IId:         irs.IID{NameId: bucket.Name, SystemId: bucket.Name},
+		CreatedTime: createdTime,
+		KeyValueList: []irs.KeyValue{
+			{Key: "Location", Value: bucket.Location},
+			{Key: "StorageClass", Value: bucket.StorageClass},
+		},
+	}
+}
+
+func extractOSSObjectInfo(objectName string, header http.Header) irs.ObjectInfo {
+	size, _ := strconv.ParseInt(header.Get("Content-Length"), 10, 64)
+	lastModified, _ := time.Parse(http.TimeFormat, header.Get("Last-Modified"))
+	return irs.ObjectInfo{
+		Name:         objectName,
+		Size:         size,
+		LastModified: lastModified,
+		ETag:         header.Get("ETag"),
+		ContentType:  header.Get("Content-Type"),
+	}
+}
@@ -0,0 +1,130 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+import (
+	"errors"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// the endpoint of each request is resolved by the RegionId of the request.
+type AlibabaRegionZoneHandler struct {
+	Region idrv.RegionInfo
+	Client *ecs.Client
+}
+
+func (regionZoneHandler *AlibabaRegionZoneHandler) ListRegionZone() ([]*irs.RegionZoneInfo, error) {
+	cblogger.Info("Start")
+
+	regionList, err := regionZoneHandler.describeRegions()
+	if err != nil {
+		return nil, err
+	}
+
+	var regionZoneInfoList []*irs.RegionZoneInfo
+	for _, region := range regionList {
+		regionZoneInfo, err := regionZoneHandler.extractRegionZoneInfo(region)
+		if err != nil {
+			return nil, err
+		}
+		regionZoneInfoList = append(regionZoneInfoList, &regionZoneInfo)
+	}
+	return regionZoneInfoList, nil
+}
+
+func (regionZoneHandler *AlibabaRegionZoneHandler) GetRegionZone(Name string) (irs.RegionZoneInfo, error) {
+	cblogger.Info("Start : ", Name)
+
+	regionList, err := regionZoneHandler.describeRegions()
+	if err != nil {
+		return irs.RegionZoneInfo{}, err
+	}
+
+	for _, region := range regionList {
+		if region.RegionId == Name {
+			return regionZoneHandler.extractRegionZoneInfo(region)
+		}
+	}
+	return irs.RegionZoneInfo{}, errors.New("Region[" + Name + "] does not exist!")
+}
+
+func (regionZoneHandler *AlibabaRegionZoneHandler) ListZone() ([]*irs.ZoneInfo, error) {
+	cblogger.Info("Start")
+
+	return regionZoneHandler.describeZones(regionZoneHandler.Region.Region)
+}
+
+func (regionZoneHandler *AlibabaRegionZoneHandler) describeRegions() ([]ecs.Region, error) {
+	request := ecs.CreateDescribeRegionsRequest()
+	request.Scheme = "https"
+	request.RegionId = regionZoneHandler.Region.Region
+
+	response, err := regionZoneHandler.Client.DescribeRegions(request)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	return response.Regions.Region, nil
+}
+
+func (regionZoneHandler *AlibabaRegionZoneHandler) describeZones(regionId string) ([]*irs.ZoneInfo, error) {
+	request := ecs.CreateDescribeZonesRequest()
+	request.Scheme = "https"
+	request.RegionId = regionId
+
+	response, err := regionZoneHandler.Client.DescribeZones(request)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var zoneInfoList []*irs.ZoneInfo
+	for _, zone := range response.Zones.Zone {
+		// Zone은 상태 정보를 제공하지 않음.
+		zoneInfoList = append(zoneInfoList, &irs.ZoneInfo{
+			Name:        zone.ZoneId,
+			DisplayName: zone.LocalName,
+			Status:      irs.RegionZoneNotSupported,
+		})
+	}
+	return zoneInfoList, nil
+}
+
+func (regionZoneHandler *AlibabaRegionZoneHandler) extractRegionZoneInfo(region ecs.Region) (irs.RegionZoneInfo, error) {
+	// Status: available | soldOut
+	status := irs.RegionZoneUnavailable
+	if region.Status == "available" {
+		status = irs.RegionZoneAvailable
+	}
+
+	regionZoneInfo := irs.RegionZoneInfo{
+		Name:        region.RegionId,
+		DisplayName: region.LocalName,
+		Status:      status,
+		KeyValueList: []irs.KeyValue{
+			{Key: "RegionEndpoint", Value: region.RegionEndpoint},
+			{Key: "Status", Value: region.Status},
+		},
+	}
+
+	zoneInfoList, err := regionZoneHandler.describeZones(region.RegionId)
+	if err != nil {
+		return irs.RegionZoneInfo{}, err
+	}
+	for _, zoneInfo := range zoneInfoList {
+		regionZoneInfo.ZoneList = append(regionZoneInfo.ZoneList, *zoneInfo)
+	}
+	return regionZoneInfo, nil
+}
@@ -88,7 +88,13 @@ func (securityHandler *AlibabaSecurityHandler) AuthorizeSecurityRules(securityGr
 			request.IpProtocol = curRule.IPProtocol
 			request.PortRange = curRule.FromPort + "/" + curRule.ToPort
 			request.SecurityGroupId = securityGroupId
-			request.SourceCidrIp = "0.0.0.0/0"
+			// IPv6 CIDR은 Ipv6SourceCidrIp로 설정 함. (CIDR이 없으면 0.0.0.0/0)
+			cidr := getRuleCIDR(curRule)
+			if strings.Contains(cidr, ":") {
+				request.Ipv6SourceCidrIp = cidr
+			} else {
+				request.SourceCidrIp = cidr
+			}
 
 			cblogger.Infof("[%s] [%s] inbound rule Request", request.IpProtocol, request.PortRange)
 			spew.Dump(request)
@@ -107,7 +113,12 @@ func (securityHandler *AlibabaSecurityHandler) AuthorizeSecurityRules(securityGr
 			request.PortRange = curRule.FromPort + "/" + curRule.ToPort
 			request.SecurityGroupId = securityGroupId
 			//request.SourceCidrIp = "0.0.0.0/0"
-			request.DestCidrIp = "0.0.0.0/0"
+			cidr := getRuleCIDR(curRule)
+			if strings.Contains(cidr, ":") {
+				request.Ipv6DestCidrIp = cidr
+			} else {
+				request.DestCidrIp = cidr
+			}
 
 			cblogger.Infof("[%s] [%s] outbound rule Request", request.IpProtocol, request.PortRange)
 			spew.Dump(request)
@@ -242,6 +253,11 @@ func (securityHandler *AlibabaSecurityHandler) ExtractSecurityRuleInfo(securityG
 			}
 		*/
 		curSecurityRuleInfo.IPProtocol = curPermission.IpProtocol
+		if strings.EqualFold(curPermission.Direction, "egress") {
+			curSecurityRuleInfo.CIDR = curPermission.DestCidrIp + curPermission.Ipv6DestCidrIp
+		} else {
+			curSecurityRuleInfo.CIDR = curPermission.SourceCidrIp + curPermission.Ipv6SourceCidrIp
+		}
 
 		portRange := strings.Split(curPermission.PortRange, "/")
 
@@ -255,6 +271,14 @@ func (securityHandler *AlibabaSecurityHandler) ExtractSecurityRuleInfo(securityG
 	return securityRuleInfos, nil
 }
 
+// 보안 규칙의 CIDR, 없으면 기존과 같이 0.0.0.0/0을 사용 함.
+func getRuleCIDR(ruleInfo irs.SecurityRuleInfo) string {
+	if ruleInfo.CIDR == "" {
+		return "0.0.0.0/0"
+	}
+	return ruleInfo.CIDR
+}
+
 func (securityHandler *AlibabaSecurityHandler) DeleteSecurity(securityIID irs.IID) (bool, error) {
 	cblogger.Infof("securityID : [%s]", securityIID.SystemId)
 
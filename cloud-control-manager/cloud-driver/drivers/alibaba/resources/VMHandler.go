@@ -8,12 +8,14 @@
 package resources
 
 import (
+	"encoding/base64"
 	"errors"
 	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	cblog "github.com/cloud-barista/cb-log"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
@@ -84,6 +86,17 @@ func (vmHandler *AlibabaVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 	request.InstanceType = vmReqInfo.VMSpecName
 	request.KeyPairName = vmReqInfo.KeyPairIID.SystemId
 	request.VSwitchId = vmReqInfo.SubnetIID.SystemId
+	request.PrivateIpAddress = vmReqInfo.PrivateIP // 빈 값이면 자동 할당
+
+	// Dual-Stack VSwitch의 VM에는 IPv6 주소를 1개 할당 함.
+	ipv6Cidr, errIPv6 := vmHandler.getVSwitchIPv6CIDR(vmReqInfo.SubnetIID.SystemId)
+	if errIPv6 != nil {
+		cblogger.Error(errIPv6)
+		return irs.VMInfo{}, errIPv6
+	}
+	if ipv6Cidr != "" {
+		request.Ipv6AddressCount = requests.NewInteger(1)
+	}
 
 	request.Password = vmReqInfo.VMUserPasswd //값에는 8-30자가 포함되고 대문자, 소문자, 숫자 및/또는 특수 문자가 포함되어야 합니다.
 
@@ -95,6 +108,23 @@ func (vmHandler *AlibabaVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 	//PayByBandwidth : 대역폭 사용료는 구독 기반이고 ECS 인스턴스 사용료에 포함 됨.
 	request.InternetChargeType = "PayByBandwidth"           //Public Ip요금 방식을 1시간 단위(PayByBandwidth) 요금으로 설정 / PayByTraffic(기본값) : 1GB단위 시간당 트래픽 요금 청구
 	request.InternetMaxBandwidthOut = requests.Integer("5") // 0보다 크면 Public IP가 할당 됨 - 최대 아웃 바운드 공용 대역폭 단위 : Mbit / s 유효한 값 : 0 ~ 100
+
+	//==============
+	//구매 옵션(Spot) 설정
+	//==============
+	//NoSpot(기본값) : 일반 종량제 / SpotWithPriceLimit : 최고 가격 지정 / SpotAsPriceGo : 시장 가격(종량제 가격 이하)
+	switch vmReqInfo.PurchaseOption.PurchaseType {
+	case "", irs.OnDemand:
+	case irs.Spot:
+		if vmReqInfo.PurchaseOption.MaxPrice != "" {
+			request.SpotStrategy = "SpotWithPriceLimit"
+			request.SpotPriceLimit = requests.Float(vmReqInfo.PurchaseOption.MaxPrice)
+		} else {
+			request.SpotStrategy = "SpotAsPriceGo"
+		}
+	default:
+		return irs.VMInfo{}, errors.New("Alibaba does not support the purchase type " + string(vmReqInfo.PurchaseOption.PurchaseType))
+	}
 	spew.Dump(request)
 
 	//=============================
@@ -344,6 +374,8 @@ func (vmHandler *AlibabaVMHandler) ExtractDescribeInstances(instancInfo *ecs.Ins
 
 	if len(instancInfo.NetworkInterfaces.NetworkInterface) > 0 {
 		vmInfo.NetworkInterface = instancInfo.NetworkInterfaces.NetworkInterface[0].NetworkInterfaceId
+		// Alibaba의 IPv6 주소는 IPv6 Gateway를 통해 Public 통신이 가능한 주소 임.
+		vmInfo.PublicIPv6 = vmHandler.getNetworkInterfaceIPv6(vmInfo.NetworkInterface)
 	}
 
 	vmInfo.VMUserId = "root"
@@ -361,6 +393,21 @@ func (vmHandler *AlibabaVMHandler) ExtractDescribeInstances(instancInfo *ecs.Ins
 		vmInfo.SecurityGroupIIds = append(vmInfo.SecurityGroupIIds, irs.IID{SystemId: security})
 	}
 
+	//Spot 인스턴스의 Lifecycle 및 회수 상태 처리 - 회수 대상 인스턴스는 LockReason이 Recycling으로 설정됨
+	vmInfo.LifecycleType = irs.OnDemand
+	if instancInfo.SpotStrategy == "SpotWithPriceLimit" || instancInfo.SpotStrategy == "SpotAsPriceGo" {
+		vmInfo.LifecycleType = irs.Spot
+		vmInfo.InterruptionState = irs.NotInterrupted
+		for _, lock := range instancInfo.OperationLocks.LockReason {
+			if lock.LockReason == "Recycling" {
+				vmInfo.InterruptionState = irs.Interrupted
+				if instancInfo.Status == "Running" {
+					vmInfo.InterruptionState = irs.InterruptionNoticed
+				}
+			}
+		}
+	}
+
 	timeLen := len(instancInfo.CreationTime)
 	cblogger.Infof("서버 구동 시간 포멧 변환 처리")
 	cblogger.Infof("======> 생성시간 길이 [%s]", timeLen)
@@ -425,6 +472,38 @@ func (vmHandler *AlibabaVMHandler) ListVM() ([]*irs.VMInfo, error) {
 }
 
 //SHUTTING-DOWN / TERMINATED
+// VMHandler는 ECS Client만 가지고 있으므로 같은 인증 정보로 VPC API를 호출 함.
+func (vmHandler *AlibabaVMHandler) getVSwitchIPv6CIDR(vSwitchId string) (string, error) {
+	request := vpc.CreateDescribeVSwitchAttributesRequest()
+	request.Scheme = "https"
+	request.VSwitchId = vSwitchId
+	response := vpc.CreateDescribeVSwitchAttributesResponse()
+
+	err := vmHandler.Client.DoAction(request, response)
+	if err != nil {
+		return "", err
+	}
+	return response.Ipv6CidrBlock, nil
+}
+
+func (vmHandler *AlibabaVMHandler) getNetworkInterfaceIPv6(networkInterfaceId string) string {
+	request := ecs.CreateDescribeNetworkInterfacesRequest()
+	request.Scheme = "https"
+	request.NetworkInterfaceId = &[]string{networkInterfaceId}
+
+	response, err := vmHandler.Client.DescribeNetworkInterfaces(request)
+	if err != nil {
+		cblogger.Error(err)
+		return ""
+	}
+	for _, networkInterface := range response.NetworkInterfaceSets.NetworkInterfaceSet {
+		for _, ipv6 := range networkInterface.Ipv6Sets.Ipv6Set {
+			return ipv6.Ipv6Address
+		}
+	}
+	return ""
+}
+
 func (vmHandler *AlibabaVMHandler) GetVMStatus(vmIID irs.IID) (irs.VMStatus, error) {
 	vmID := vmIID.SystemId
 	cblogger.Infof("vmID : [%s]", vmID)
@@ -493,6 +572,28 @@ Rebooting(재시작 진행 상태): MCIS를 재부팅하는 상태
 Terminating(종료 진행 상태): MCIS의 종료를 실행하고 있는 중간 상태
 
 */
+// ECS 인스턴스의 콘솔 출력(Base64 인코딩)을 조회 함.
+func (vmHandler *AlibabaVMHandler) GetVMConsoleOutput(vmIID irs.IID) (string, error) {
+	cblogger.Infof("vmID : [%s]", vmIID.SystemId)
+
+	request := ecs.CreateGetInstanceConsoleOutputRequest()
+	request.Scheme = "https"
+	request.InstanceId = vmIID.SystemId
+
+	response, err := vmHandler.Client.GetInstanceConsoleOutput(request)
+	if err != nil {
+		cblogger.Error(err.Error())
+		return "", err
+	}
+
+	output, err := base64.StdEncoding.DecodeString(response.ConsoleOutput)
+	if err != nil {
+		cblogger.Error(err.Error())
+		return "", err
+	}
+	return string(output), nil
+}
+
 func (vmHandler *AlibabaVMHandler) ConvertVMStatusString(vmStatus string) (irs.VMStatus, error) {
 	var resultStatus string
 	cblogger.Infof("vmStatus : [%s]", vmStatus)
@@ -74,6 +74,12 @@ func ExtractVMSpecInfo(Region string, instanceTypeInfo ecs.InstanceType) irs.VMS
 	cblogger.Error(errKeyValue)
 	vmSpecInfo.KeyValueList = keyValueList
 
+	//정규화된 스펙 정보 처리 및 규격 검사
+	errNormalize := irs.NormalizeVMSpecInfo(&vmSpecInfo)
+	if errNormalize != nil {
+		cblogger.Error(errNormalize)
+	}
+
 	return vmSpecInfo
 }
 
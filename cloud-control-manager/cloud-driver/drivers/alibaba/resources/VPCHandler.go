@@ -13,13 +13,16 @@
 package resources
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"net"
 	"reflect"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/davecgh/go-spew/spew"
 
@@ -46,6 +49,13 @@ func (VPCHandler *AlibabaVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.V
 	request.Scheme = "https"
 	request.VpcName = vpcReqInfo.IId.NameId
 	request.CidrBlock = vpcReqInfo.IPv4_CIDR
+	// Dual-Stack VPC : Alibaba가 할당하는 /56 IPv6 CIDR만 지원 함.
+	if vpcReqInfo.IPv6_CIDR != "" {
+		if vpcReqInfo.IPv6_CIDR != irs.IPv6AutoCIDR {
+			return irs.VPCInfo{}, errors.New("Alibaba Cloud Driver: IPv6_CIDR supports only '" + irs.IPv6AutoCIDR + "'(Alibaba provided /56)!")
+		}
+		request.EnableIpv6 = requests.NewBoolean(true)
+	}
 
 	response, err := VPCHandler.Client.CreateVpc(request)
 	cblogger.Info(response)
@@ -63,8 +73,23 @@ func (VPCHandler *AlibabaVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.V
 	//==========================
 	cblogger.Info("Subnet 생성 시작")
 	//var resSubnetList []irs.SubnetInfo
+	vpcIPv6CIDR := ""
+	if vpcReqInfo.IPv6_CIDR != "" {
+		vpcIPv6CIDR, err = VPCHandler.getVPCIPv6CIDR(response.VpcId)
+		if err != nil {
+			return irs.VPCInfo{}, err
+		}
+	}
+	usedIPv6CIDRList := []string{}
 	for _, curSubnet := range vpcReqInfo.SubnetInfoList {
 		cblogger.Infof("[%s] Subnet 생성", curSubnet.IId.NameId)
+		if vpcIPv6CIDR != "" {
+			curSubnet.IPv6_CIDR, err = getSubnetIPv6CIDR(vpcIPv6CIDR, curSubnet.IPv6_CIDR, usedIPv6CIDRList)
+			if err != nil {
+				return irs.VPCInfo{}, err
+			}
+			usedIPv6CIDRList = append(usedIPv6CIDRList, curSubnet.IPv6_CIDR)
+		}
 		resSubnet, errSubnet := VPCHandler.CreateSubnet(response.VpcId, curSubnet)
 
 		cblogger.Info(resSubnet)
@@ -102,6 +127,14 @@ func (VPCHandler *AlibabaVPCHandler) CreateSubnet(vpcId string, reqSubnetInfo ir
 	request.VpcId = vpcId
 	request.CidrBlock = reqSubnetInfo.IPv4_CIDR
 	request.VSwitchName = reqSubnetInfo.IId.NameId
+	// Dual-Stack VSwitch의 IPv6 CIDR은 VPC /56 중 /64의 번호(0~255)로 지정 함.
+	if reqSubnetInfo.IPv6_CIDR != "" {
+		_, ipv6Net, errIPv6 := net.ParseCIDR(reqSubnetInfo.IPv6_CIDR)
+		if errIPv6 != nil {
+			return irs.SubnetInfo{}, errIPv6
+		}
+		request.Ipv6CidrBlock = requests.NewInteger(int(ipv6Net.IP.To16()[7]))
+	}
 	fmt.Printf("\n\n======= %#v\n\n", VPCHandler.Region.Zone) // by powerkim.
 	request.ZoneId = VPCHandler.Region.Zone                   //"ap-northeast-1a" // @TOTO : ZoneId 전달 받아야 함.
 	cblogger.Info(request)
@@ -114,11 +147,21 @@ func (VPCHandler *AlibabaVPCHandler) CreateSubnet(vpcId string, reqSubnetInfo ir
 	}
 	spew.Dump(response)
 
+	//Private Subnet은 Private 라우팅 테이블에 연결 함. (VSwitch가 Available 상태가 되어야 연결 가능 함)
+	if reqSubnetInfo.Private {
+		errPrivate := VPCHandler.associatePrivateRouteTable(vpcId, response.VSwitchId)
+		if errPrivate != nil {
+			cblogger.Error(errPrivate)
+			return irs.SubnetInfo{}, errPrivate
+		}
+	}
+
 	subnetInfo, errSunetInfo := VPCHandler.GetSubnet(response.VSwitchId)
 	if errSunetInfo != nil {
 		cblogger.Error(subnetInfo)
 		return irs.SubnetInfo{}, errSunetInfo
 	}
+	subnetInfo.Private = reqSubnetInfo.Private
 
 	return subnetInfo, nil
 }
@@ -157,6 +200,7 @@ func ExtractVpcDescribeInfo(vpcInfo *vpc.Vpc) irs.VPCInfo {
 	aliVpcInfo := irs.VPCInfo{
 		IId:       irs.IID{NameId: vpcInfo.VpcName, SystemId: vpcInfo.VpcId},
 		IPv4_CIDR: vpcInfo.CidrBlock,
+		IPv6_CIDR: vpcInfo.Ipv6CidrBlock,
 	}
 
 	keyValueList := []irs.KeyValue{
@@ -256,6 +300,15 @@ func (VPCHandler *AlibabaVPCHandler) GetVPC(vpcIID irs.IID) (irs.VPCInfo, error)
 	//spew.Dump(subnetInfoList)
 
 	vpcInfo.SubnetInfoList = subnetInfoList
+
+	//==========================
+	// 라우팅 테이블 및 NAT Gateway 처리
+	//==========================
+	errRoute := VPCHandler.setterRouteTableAndNATGateway(&vpcInfo)
+	if errRoute != nil {
+		cblogger.Error(errRoute)
+		return irs.VPCInfo{}, errRoute
+	}
 	return vpcInfo, nil
 }
 
@@ -278,11 +331,29 @@ func (VPCHandler *AlibabaVPCHandler) DeleteVPC(vpcIID irs.IID) (bool, error) {
 		return false, errVpcInfo
 	}
 
+	//=================
+	// NAT Gateway 삭제
+	//=================
+	for _, curNat := range vpcInfo.NATGatewayInfoList {
+		cblogger.Infof("[%s] NAT Gateway 삭제 처리", curNat.IId.SystemId)
+		_, errNat := VPCHandler.RemoveNATGateway(vpcIID, curNat.IId)
+		if errNat != nil {
+			return false, errNat
+		}
+	}
+
 	//=================
 	// Subnet삭제
 	//=================
 	for _, curSubnet := range vpcInfo.SubnetInfoList {
 		cblogger.Infof("[%s] VSwitch 삭제 처리", curSubnet.IId.SystemId)
+		if curSubnet.Private {
+			//Private 라우팅 테이블과의 연결을 해제해야 VSwitch를 삭제할 수 있음.
+			errPrivate := VPCHandler.unassociatePrivateRouteTable(vpcIID.SystemId, curSubnet.IId.SystemId)
+			if errPrivate != nil {
+				return false, errPrivate
+			}
+		}
 		_, errSubnet := VPCHandler.DeleteSubnet(curSubnet.IId)
 		if errSubnet != nil {
 			return false, errSubnet
@@ -295,6 +366,22 @@ func (VPCHandler *AlibabaVPCHandler) DeleteVPC(vpcIID irs.IID) (bool, error) {
 	//특정 시간 이후 자동 삭제되니 라우트 삭제 대신 3초 대기후 시도해 봄.
 	time.Sleep(time.Second * 3)
 
+	//Private 라우팅 테이블 삭제
+	privateRouteTableId, errPrivate := VPCHandler.getPrivateRouteTable(vpcIID.SystemId, false)
+	if errPrivate != nil {
+		return false, errPrivate
+	}
+	if privateRouteTableId != "" {
+		request := vpc.CreateDeleteRouteTableRequest()
+		request.Scheme = "https"
+		request.RouteTableId = privateRouteTableId
+		_, errPrivate = VPCHandler.Client.DeleteRouteTable(request)
+		if errPrivate != nil {
+			cblogger.Error(errPrivate)
+			return false, errPrivate
+		}
+	}
+
 	cblogger.Infof("[%s] VPC를 삭제 함.", vpcInfo.IId.SystemId)
 	//cblogger.Info("VPC 제거를 위해 생성된 IGW / Route들 제거 시작")
 
@@ -361,6 +448,7 @@ func ExtractSubnetDescribeInfo(subnetInfo vpc.VSwitch) irs.SubnetInfo {
 	vNetworkInfo := irs.SubnetInfo{
 		IId:       irs.IID{NameId: subnetInfo.VSwitchName, SystemId: subnetInfo.VSwitchId},
 		IPv4_CIDR: subnetInfo.CidrBlock,
+		IPv6_CIDR: subnetInfo.Ipv6CidrBlock,
 	}
 
 	keyValueList := []irs.KeyValue{
@@ -375,6 +463,25 @@ func ExtractSubnetDescribeInfo(subnetInfo vpc.VSwitch) irs.SubnetInfo {
 
 func (VPCHandler *AlibabaVPCHandler) AddSubnet(vpcIID irs.IID, subnetInfo irs.SubnetInfo) (irs.VPCInfo, error) {
 	cblogger.Infof("[%s] Subnet 추가 - CIDR : %s", subnetInfo.IId.NameId, subnetInfo.IPv4_CIDR)
+	vpcInfo, errVpc := VPCHandler.GetVPC(vpcIID)
+	if errVpc != nil {
+		return irs.VPCInfo{}, errVpc
+	}
+	if vpcInfo.IPv6_CIDR != "" {
+		usedIPv6CIDRList := []string{}
+		for _, curSubnet := range vpcInfo.SubnetInfoList {
+			if curSubnet.IPv6_CIDR != "" {
+				usedIPv6CIDRList = append(usedIPv6CIDRList, curSubnet.IPv6_CIDR)
+			}
+		}
+		var err error
+		subnetInfo.IPv6_CIDR, err = getSubnetIPv6CIDR(vpcInfo.IPv6_CIDR, subnetInfo.IPv6_CIDR, usedIPv6CIDRList)
+		if err != nil {
+			return irs.VPCInfo{}, err
+		}
+	} else if subnetInfo.IPv6_CIDR != "" {
+		return irs.VPCInfo{}, errors.New("Alibaba Cloud Driver: VPC " + vpcIID.SystemId + " is not a dual-stack VPC!")
+	}
 	resSubnet, errSubnet := VPCHandler.CreateSubnet(vpcIID.SystemId, subnetInfo)
 	if errSubnet != nil {
 		cblogger.Error(errSubnet)
@@ -392,3 +499,509 @@ func (VPCHandler *AlibabaVPCHandler) RemoveSubnet(vpcIID irs.IID, subnetIID irs.
 
 	return VPCHandler.DeleteSubnet(subnetIID)
 }
+
+//=================================
+// IPv6 (Dual-Stack)
+// VPC는 Alibaba가 할당하는 /56 IPv6 CIDR을 사용하며, VSwitch는 /56 중 사용하지 않는 /64를 순서대로 사용 함.
+//=================================
+func (VPCHandler *AlibabaVPCHandler) getVPCIPv6CIDR(vpcId string) (string, error) {
+	request := vpc.CreateDescribeVpcsRequest()
+	request.Scheme = "https"
+	request.VpcId = vpcId
+
+	result, err := VPCHandler.Client.DescribeVpcs(request)
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+	if len(result.Vpcs.Vpc) < 1 || result.Vpcs.Vpc[0].Ipv6CidrBlock == "" {
+		return "", errors.New("Alibaba Cloud Driver: IPv6 CIDR of VPC " + vpcId + " is not assigned!")
+	}
+	return result.Vpcs.Vpc[0].Ipv6CidrBlock, nil
+}
+
+// 요청한 VSwitch IPv6 CIDR이 없으면 VPC IPv6 CIDR에서 사용하지 않는 첫번째 /64를 사용 함.
+func getSubnetIPv6CIDR(vpcIPv6CIDR string, reqIPv6CIDR string, usedIPv6CIDRList []string) (string, error) {
+	if reqIPv6CIDR != "" && reqIPv6CIDR != irs.IPv6AutoCIDR {
+		return reqIPv6CIDR, nil
+	}
+
+	_, vpcNet, err := net.ParseCIDR(vpcIPv6CIDR)
+	if err != nil {
+		return "", err
+	}
+	vpcPrefixLen, _ := vpcNet.Mask.Size()
+	if vpcPrefixLen > 64 {
+		return "", fmt.Errorf("Alibaba Cloud Driver: VPC IPv6 CIDR %s is smaller than /64!", vpcIPv6CIDR)
+	}
+	base := binary.BigEndian.Uint64(vpcNet.IP.To16()[:8])
+	for i := uint64(0); i < uint64(1)<<uint(64-vpcPrefixLen); i++ {
+		ip := make(net.IP, net.IPv6len)
+		binary.BigEndian.PutUint64(ip[:8], base+i)
+		candidate := (&net.IPNet{IP: ip, Mask: net.CIDRMask(64, 128)}).String()
+		used := false
+		for _, usedCIDR := range usedIPv6CIDRList {
+			if usedCIDR == candidate {
+				used = true
+				break
+			}
+		}
+		if !used {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("Alibaba Cloud Driver: no free /64 in VPC IPv6 CIDR %s!", vpcIPv6CIDR)
+}
+
+func (VPCHandler *AlibabaVPCHandler) RequestVPCPeering(peeringReqInfo irs.VPCPeeringReqInfo) (irs.VPCPeeringInfo, error) {
+	return irs.VPCPeeringInfo{}, errors.New("Alibaba Cloud Driver: RequestVPCPeering() is not supported!")
+}
+
+func (VPCHandler *AlibabaVPCHandler) AcceptVPCPeering(peeringIID irs.IID) (irs.VPCPeeringInfo, error) {
+	return irs.VPCPeeringInfo{}, errors.New("Alibaba Cloud Driver: AcceptVPCPeering() is not supported!")
+}
+
+func (VPCHandler *AlibabaVPCHandler) ListVPCPeering() ([]*irs.VPCPeeringInfo, error) {
+	return nil, errors.New("Alibaba Cloud Driver: ListVPCPeering() is not supported!")
+}
+
+func (VPCHandler *AlibabaVPCHandler) GetVPCPeering(peeringIID irs.IID) (irs.VPCPeeringInfo, error) {
+	return irs.VPCPeeringInfo{}, errors.New("Alibaba Cloud Driver: GetVPCPeering() is not supported!")
+}
+
+func (VPCHandler *AlibabaVPCHandler) DeleteVPCPeering(peeringIID irs.IID) (bool, error) {
+	return false, errors.New("Alibaba Cloud Driver: DeleteVPCPeering() is not supported!")
+}
+
+//=================================
+// NAT Gateway / Route
+// Private Subnet(VSwitch)은 VPC의 Private 라우팅 테이블(alibabaPrivateRouteTableName)에 연결되며,
+// NAT Gateway(Enhanced)가 추가되면 EIP와 Private VSwitch들의 SNAT 항목, 0.0.0.0/0 라우팅 정보가 추가 됨.
+//=================================
+const alibabaPrivateRouteTableName = "cb-private-route-table"
+
+func (VPCHandler *AlibabaVPCHandler) AddNATGateway(vpcIID irs.IID, natReqInfo irs.NATGatewayReqInfo) (irs.VPCInfo, error) {
+	cblogger.Infof("[%s] VPC에 [%s] NAT Gateway 추가 - VSwitch : [%s]", vpcIID.SystemId, natReqInfo.IId.NameId, natReqInfo.SubnetIID.SystemId)
+
+	vpcInfo, err := VPCHandler.GetVPC(vpcIID)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+	if len(vpcInfo.NATGatewayInfoList) > 0 {
+		return irs.VPCInfo{}, errors.New("VPC [" + vpcIID.SystemId + "] already has the NAT Gateway [" + vpcInfo.NATGatewayInfoList[0].IId.SystemId + "].")
+	}
+
+	request := vpc.CreateCreateNatGatewayRequest()
+	request.Scheme = "https"
+	request.VpcId = vpcIID.SystemId
+	request.VSwitchId = natReqInfo.SubnetIID.SystemId
+	request.NatType = "Enhanced"
+	request.Name = natReqInfo.IId.NameId
+	cblogger.Info(request)
+
+	response, err := VPCHandler.Client.CreateNatGateway(request)
+	cblogger.Info(response)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCInfo{}, err
+	}
+	natGatewayId := response.NatGatewayId
+
+	//NAT Gateway가 Available 상태가 되어야 EIP를 연결할 수 있음.
+	errWait := VPCHandler.waitForNATGateway(vpcIID.SystemId, natGatewayId)
+	if errWait != nil {
+		return irs.VPCInfo{}, errWait
+	}
+
+	//NAT Gateway용 EIP 할당 및 연결
+	eipRequest := vpc.CreateAllocateEipAddressRequest()
+	eipRequest.Scheme = "https"
+	eipResponse, err := VPCHandler.Client.AllocateEipAddress(eipRequest)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCInfo{}, err
+	}
+
+	assocRequest := vpc.CreateAssociateEipAddressRequest()
+	assocRequest.Scheme = "https"
+	assocRequest.AllocationId = eipResponse.AllocationId
+	assocRequest.InstanceId = natGatewayId
+	assocRequest.InstanceType = "Nat"
+	_, err = VPCHandler.Client.AssociateEipAddress(assocRequest)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCInfo{}, err
+	}
+	//EIP 연결이 완료되어야 SNAT 항목을 추가할 수 있음.
+	time.Sleep(time.Second * 3)
+
+	//Private VSwitch들의 SNAT 항목 추가
+	for _, curSubnet := range vpcInfo.SubnetInfoList {
+		if !curSubnet.Private {
+			continue
+		}
+		snatRequest := vpc.CreateCreateSnatEntryRequest()
+		snatRequest.Scheme = "https"
+		snatRequest.SnatTableId = response.SnatTableIds.SnatTableId[0]
+		snatRequest.SourceVSwitchId = curSubnet.IId.SystemId
+		snatRequest.SnatIp = eipResponse.EipAddress
+		_, err := VPCHandler.Client.CreateSnatEntry(snatRequest)
+		if err != nil {
+			cblogger.Error(err)
+			return irs.VPCInfo{}, err
+		}
+	}
+
+	//Private 라우팅 테이블의 기본 라우팅을 NAT Gateway로 설정
+	routeTableId, err := VPCHandler.getPrivateRouteTable(vpcIID.SystemId, true)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+	err = VPCHandler.createRouteEntry(routeTableId, "0.0.0.0/0", "NatGateway", natGatewayId)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+
+	return VPCHandler.GetVPC(vpcIID)
+}
+
+func (VPCHandler *AlibabaVPCHandler) RemoveNATGateway(vpcIID irs.IID, natGatewayIID irs.IID) (bool, error) {
+	cblogger.Infof("[%s] VPC의 [%s] NAT Gateway 삭제", vpcIID.SystemId, natGatewayIID.SystemId)
+
+	request := vpc.CreateDescribeNatGatewaysRequest()
+	request.Scheme = "https"
+	request.VpcId = vpcIID.SystemId
+	request.NatGatewayId = natGatewayIID.SystemId
+	result, err := VPCHandler.Client.DescribeNatGateways(request)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	if len(result.NatGateways.NatGateway) < 1 {
+		return false, errors.New("Notfound: '" + natGatewayIID.SystemId + "' NAT Gateway Not found")
+	}
+	natGateway := result.NatGateways.NatGateway[0]
+
+	//NAT Gateway를 향하는 Private 라우팅 정보 제거
+	routeTableId, err := VPCHandler.getPrivateRouteTable(vpcIID.SystemId, false)
+	if err != nil {
+		return false, err
+	}
+	if routeTableId != "" {
+		deleteRequest := vpc.CreateDeleteRouteEntryRequest()
+		deleteRequest.Scheme = "https"
+		deleteRequest.RouteTableId = routeTableId
+		deleteRequest.DestinationCidrBlock = "0.0.0.0/0"
+		deleteRequest.NextHopId = natGatewayIID.SystemId
+		_, err := VPCHandler.Client.DeleteRouteEntry(deleteRequest)
+		if err != nil {
+			cblogger.Error(err)
+			return false, err
+		}
+	}
+
+	//EIP 연결 해제 및 반납
+	for _, ip := range natGateway.IpLists.IpList {
+		unassocRequest := vpc.CreateUnassociateEipAddressRequest()
+		unassocRequest.Scheme = "https"
+		unassocRequest.AllocationId = ip.AllocationId
+		unassocRequest.InstanceId = natGatewayIID.SystemId
+		unassocRequest.InstanceType = "Nat"
+		unassocRequest.Force = requests.NewBoolean(true) // SNAT 항목이 있어도 해제
+		_, err := VPCHandler.Client.UnassociateEipAddress(unassocRequest)
+		if err != nil {
+			cblogger.Error(err)
+			return false, err
+		}
+		//EIP 연결 해제가 완료되어야 반납할 수 있음.
+		time.Sleep(time.Second * 3)
+
+		releaseRequest := vpc.CreateReleaseEipAddressRequest()
+		releaseRequest.Scheme = "https"
+		releaseRequest.AllocationId = ip.AllocationId
+		_, err = VPCHandler.Client.ReleaseEipAddress(releaseRequest)
+		if err != nil {
+			cblogger.Error(err)
+			return false, err
+		}
+	}
+
+	//SNAT 항목 등 하위 리소스도 함께 삭제
+	deleteRequest := vpc.CreateDeleteNatGatewayRequest()
+	deleteRequest.Scheme = "https"
+	deleteRequest.NatGatewayId = natGatewayIID.SystemId
+	deleteRequest.Force = requests.NewBoolean(true)
+	_, err = VPCHandler.Client.DeleteNatGateway(deleteRequest)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (VPCHandler *AlibabaVPCHandler) AddRoute(vpcIID irs.IID, subnetIID irs.IID, routeInfo irs.RouteInfo) (irs.VPCInfo, error) {
+	cblogger.Infof("[%s] VSwitch에 라우팅 추가 - %s => %s [%s]", subnetIID.SystemId, routeInfo.DestinationCIDR, routeInfo.TargetType, routeInfo.TargetIID.SystemId)
+
+	var nextHopType string
+	switch routeInfo.TargetType {
+	case irs.RouteNATGateway:
+		nextHopType = "NatGateway"
+	default:
+		//Alibaba VPC는 Internet Gateway가 없으며 VPC Peering 대신 CEN/Express Connect를 사용 함.
+		return irs.VPCInfo{}, errors.New("Alibaba Cloud Driver: Route Target Type [" + string(routeInfo.TargetType) + "] is not supported!")
+	}
+
+	routeTableId, err := VPCHandler.getSubnetRouteTable(vpcIID.SystemId, subnetIID.SystemId)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+	err = VPCHandler.createRouteEntry(routeTableId, routeInfo.DestinationCIDR, nextHopType, routeInfo.TargetIID.SystemId)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+
+	return VPCHandler.GetVPC(vpcIID)
+}
+
+func (VPCHandler *AlibabaVPCHandler) RemoveRoute(vpcIID irs.IID, subnetIID irs.IID, routeInfo irs.RouteInfo) (bool, error) {
+	cblogger.Infof("[%s] VSwitch의 라우팅 삭제 - %s", subnetIID.SystemId, routeInfo.DestinationCIDR)
+
+	routeTableId, err := VPCHandler.getSubnetRouteTable(vpcIID.SystemId, subnetIID.SystemId)
+	if err != nil {
+		return false, err
+	}
+
+	request := vpc.CreateDeleteRouteEntryRequest()
+	request.Scheme = "https"
+	request.RouteTableId = routeTableId
+	request.DestinationCidrBlock = routeInfo.DestinationCIDR
+	request.NextHopId = routeInfo.TargetIID.SystemId
+	_, err = VPCHandler.Client.DeleteRouteEntry(request)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (VPCHandler *AlibabaVPCHandler) createRouteEntry(routeTableId string, cidr string, nextHopType string, nextHopId string) error {
+	request := vpc.CreateCreateRouteEntryRequest()
+	request.Scheme = "https"
+	request.RouteTableId = routeTableId
+	request.DestinationCidrBlock = cidr
+	request.NextHopType = nextHopType
+	request.NextHopId = nextHopId
+	cblogger.Info(request)
+
+	_, err := VPCHandler.Client.CreateRouteEntry(request)
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	return nil
+}
+
+// VPC의 라우팅 테이블 목록을 조회함. (System 라우팅 테이블 포함)
+func (VPCHandler *AlibabaVPCHandler) describeRouteTableList(vpcId string) ([]vpc.RouterTableListType, error) {
+	request := vpc.CreateDescribeRouteTableListRequest()
+	request.Scheme = "https"
+	request.VpcId = vpcId
+
+	result, err := VPCHandler.Client.DescribeRouteTableList(request)
+	if err != nil {
+		return nil, err
+	}
+	return result.RouterTableList.RouterTableListType, nil
+}
+
+// VPC의 Private 라우팅 테이블 ID를 조회함. 없는 경우 create가 true이면 생성하고, false이면 ""를 리턴함.
+func (VPCHandler *AlibabaVPCHandler) getPrivateRouteTable(vpcId string, create bool) (string, error) {
+	routeTables, err := VPCHandler.describeRouteTableList(vpcId)
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+	for _, routeTable := range routeTables {
+		if routeTable.RouteTableName == alibabaPrivateRouteTableName {
+			return routeTable.RouteTableId, nil
+		}
+	}
+	if !create {
+		return "", nil
+	}
+
+	cblogger.Infof("VPC[%s]에 Private 라우팅 테이블을 생성 합니다.", vpcId)
+	request := vpc.CreateCreateRouteTableRequest()
+	request.Scheme = "https"
+	request.VpcId = vpcId
+	request.RouteTableName = alibabaPrivateRouteTableName
+
+	response, err := VPCHandler.Client.CreateRouteTable(request)
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+	//생성 직후에는 VSwitch를 연결할 수 없어서 잠시 대기 함.
+	time.Sleep(time.Second * 3)
+	return response.RouteTableId, nil
+}
+
+// VSwitch에 연결된 라우팅 테이블 ID를 조회함. 없는 경우 VPC의 System 라우팅 테이블을 리턴함.
+func (VPCHandler *AlibabaVPCHandler) getSubnetRouteTable(vpcId string, vSwitchId string) (string, error) {
+	routeTables, err := VPCHandler.describeRouteTableList(vpcId)
+	if err != nil {
+		return "", err
+	}
+	systemRouteTableId := ""
+	for _, routeTable := range routeTables {
+		for _, curVSwitchId := range routeTable.VSwitchIds.VSwitchId {
+			if curVSwitchId == vSwitchId {
+				return routeTable.RouteTableId, nil
+			}
+		}
+		if routeTable.RouteTableType == "System" {
+			systemRouteTableId = routeTable.RouteTableId
+		}
+	}
+	if systemRouteTableId == "" {
+		return "", errors.New("Notfound: VPC '" + vpcId + "' route table Not found")
+	}
+	return systemRouteTableId, nil
+}
+
+func (VPCHandler *AlibabaVPCHandler) associatePrivateRouteTable(vpcId string, vSwitchId string) error {
+	//VSwitch가 Available 상태가 될때까지 잠시 대기 함.
+	time.Sleep(time.Second * 3)
+
+	routeTableId, err := VPCHandler.getPrivateRouteTable(vpcId, true)
+	if err != nil {
+		return err
+	}
+
+	request := vpc.CreateAssociateRouteTableRequest()
+	request.Scheme = "https"
+	request.RouteTableId = routeTableId
+	request.VSwitchId = vSwitchId
+	_, err = VPCHandler.Client.AssociateRouteTable(request)
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (VPCHandler *AlibabaVPCHandler) unassociatePrivateRouteTable(vpcId string, vSwitchId string) error {
+	routeTableId, err := VPCHandler.getPrivateRouteTable(vpcId, false)
+	if err != nil || routeTableId == "" {
+		return err
+	}
+
+	request := vpc.CreateUnassociateRouteTableRequest()
+	request.Scheme = "https"
+	request.RouteTableId = routeTableId
+	request.VSwitchId = vSwitchId
+	_, err = VPCHandler.Client.UnassociateRouteTable(request)
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	//연결 해제가 완료될때까지 잠시 대기 함.
+	time.Sleep(time.Second * 3)
+	return nil
+}
+
+//Pending , Available
+func (VPCHandler *AlibabaVPCHandler) waitForNATGateway(vpcId string, natGatewayId string) error {
+	cblogger.Info("======> NAT Gateway가 Available 될 때까지 대기함.")
+
+	maxRetryCnt := 60
+	request := vpc.CreateDescribeNatGatewaysRequest()
+	request.Scheme = "https"
+	request.VpcId = vpcId
+	request.NatGatewayId = natGatewayId
+
+	for curRetryCnt := 0; ; curRetryCnt++ {
+		result, err := VPCHandler.Client.DescribeNatGateways(request)
+		if err != nil {
+			return err
+		}
+		if len(result.NatGateways.NatGateway) > 0 && strings.EqualFold(result.NatGateways.NatGateway[0].Status, "Available") {
+			return nil
+		}
+		if curRetryCnt > maxRetryCnt {
+			return errors.New("장시간 NAT Gateway의 Status 값이 Available로 변경되지 않아서 강제로 중단합니다.")
+		}
+		time.Sleep(time.Second * 2)
+	}
+}
+
+func (VPCHandler *AlibabaVPCHandler) setterRouteTableAndNATGateway(vpcInfo *irs.VPCInfo) error {
+	routeTables, err := VPCHandler.describeRouteTableList(vpcInfo.IId.SystemId)
+	if err != nil {
+		return err
+	}
+	for _, routeTable := range routeTables {
+		routeTableInfo := irs.RouteTableInfo{
+			IId: irs.IID{NameId: routeTable.RouteTableName, SystemId: routeTable.RouteTableId},
+			KeyValueList: []irs.KeyValue{
+				{Key: "RouteTableType", Value: routeTable.RouteTableType},
+				{Key: "Status", Value: routeTable.Status},
+			},
+		}
+		for _, vSwitchId := range routeTable.VSwitchIds.VSwitchId {
+			routeTableInfo.SubnetIIDs = append(routeTableInfo.SubnetIIDs, irs.IID{SystemId: vSwitchId})
+			if routeTable.RouteTableName != alibabaPrivateRouteTableName {
+				continue
+			}
+			for idx, subnetInfo := range vpcInfo.SubnetInfoList {
+				if subnetInfo.IId.SystemId == vSwitchId {
+					vpcInfo.SubnetInfoList[idx].Private = true
+				}
+			}
+		}
+
+		request := vpc.CreateDescribeRouteEntryListRequest()
+		request.Scheme = "https"
+		request.RouteTableId = routeTable.RouteTableId
+		request.RouteEntryType = "Custom"
+		result, err := VPCHandler.Client.DescribeRouteEntryList(request)
+		if err != nil {
+			return err
+		}
+		for _, routeEntry := range result.RouteEntrys.RouteEntry {
+			if routeEntry.NextHopType != "NatGateway" {
+				continue
+			}
+			routeTableInfo.RouteList = append(routeTableInfo.RouteList, irs.RouteInfo{
+				DestinationCIDR: routeEntry.DestinationCidrBlock,
+				TargetType:      irs.RouteNATGateway,
+				TargetIID:       irs.IID{SystemId: routeEntry.InstanceId},
+			})
+		}
+		vpcInfo.RouteTableInfoList = append(vpcInfo.RouteTableInfoList, routeTableInfo)
+	}
+
+	request := vpc.CreateDescribeNatGatewaysRequest()
+	request.Scheme = "https"
+	request.VpcId = vpcInfo.IId.SystemId
+	result, err := VPCHandler.Client.DescribeNatGateways(request)
+	if err != nil {
+		return err
+	}
+	for _, natGateway := range result.NatGateways.NatGateway {
+		natInfo := irs.NATGatewayInfo{
+			IId:       irs.IID{NameId: natGateway.Name, SystemId: natGateway.NatGatewayId},
+			SubnetIID: irs.IID{SystemId: natGateway.NatGatewayPrivateInfo.VswitchId},
+			KeyValueList: []irs.KeyValue{
+				{Key: "NatType", Value: natGateway.NatType},
+				{Key: "Status", Value: natGateway.Status},
+			},
+		}
+		if len(natGateway.IpLists.IpList) > 0 {
+			natInfo.PublicIP = natGateway.IpLists.IpList[0].IpAddress
+		}
+		vpcInfo.NATGatewayInfoList = append(vpcInfo.NATGatewayInfoList, natInfo)
+	}
+	return nil
+}
@@ -17,12 +17,15 @@ import (
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 
 	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	//icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect/AwsNewIfCloudConnect"
 	//icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect/connect"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/s3"
 )
 import (
 	"fmt"
@@ -34,29 +37,82 @@ type AwsDriver struct {
 }
 
 func (AwsDriver) GetDriverVersion() string {
-	return "TEST AWS DRIVER Version 1.0"
+	return idrv.DriverVersion("TEST AWS DRIVER Version 1.0")
 }
 
 func (AwsDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	var drvCapabilityInfo idrv.DriverCapabilityInfo
 
+	drvCapabilityInfo.FIXED_SUBNET_CIDR = true
+	drvCapabilityInfo.VPC_CIDR = true
+
 	drvCapabilityInfo.ImageHandler = true
 	drvCapabilityInfo.VPCHandler = true
 	drvCapabilityInfo.SecurityHandler = true
 	drvCapabilityInfo.KeyPairHandler = true
 	drvCapabilityInfo.VNicHandler = false
-	drvCapabilityInfo.PublicIPHandler = false
+	drvCapabilityInfo.PublicIPHandler = true
 	drvCapabilityInfo.VMHandler = true
 	drvCapabilityInfo.VMSpecHandler = true
+	drvCapabilityInfo.NLBHandler = false
+	drvCapabilityInfo.DNSHandler = true
+	drvCapabilityInfo.BucketHandler = true
+	drvCapabilityInfo.RegionZoneHandler = true
+
+	drvCapabilityInfo.VPC_IPv6_CIDR = true
+	drvCapabilityInfo.SECURITY_IPv6_RULE = true
+	drvCapabilityInfo.VM_MULTI_NIC = true
+	drvCapabilityInfo.SUBNET_ADD_REMOVE = true
+	drvCapabilityInfo.VM_SUSPEND_RESUME = true
+	drvCapabilityInfo.VM_REBOOT = true
 
 	return drvCapabilityInfo
 }
 
+// key names of the region and the zone in the key-value list of the region.
+func (AwsDriver) GetRegionZoneKeyName() (string, string) {
+	return "Region", "Zone"
+}
+
+// translates the key-value lists of the credential and the region into ConnectionInfo.
+func (driver AwsDriver) GetConnectionInfo(credentialKeyValueList []irs.KeyValue, regionKeyValueList []irs.KeyValue) (idrv.ConnectionInfo, error) {
+	regionKey, zoneKey := driver.GetRegionZoneKeyName()
+
+	connectionInfo := idrv.ConnectionInfo{
+		CredentialInfo: idrv.CredentialInfo{
+			ClientId:     idrv.GetKeyValue(credentialKeyValueList, "ClientId"),
+			ClientSecret: idrv.GetKeyValue(credentialKeyValueList, "ClientSecret"),
+			KeyValueList: credentialKeyValueList,
+		},
+		RegionInfo: idrv.RegionInfo{
+			Region:       idrv.GetKeyValue(regionKeyValueList, regionKey),
+			Zone:         idrv.GetKeyValue(regionKeyValueList, zoneKey),
+			KeyValueList: regionKeyValueList,
+		},
+	}
+	return connectionInfo, nil
+}
+
+// keys of the credential and the region read by GetConnectionInfo().
+func (AwsDriver) GetConnectionSchema() idrv.ConnectionSchema {
+	return idrv.ConnectionSchema{
+		Credential: []idrv.KeySchema{
+			{Key: "ClientId", Description: "AWS Access Key ID", Required: true},
+			{Key: "ClientSecret", Description: "AWS Secret Access Key", Required: true, Secret: true},
+		},
+		Region: []idrv.KeySchema{
+			{Key: "Region", Description: "ex) us-east-2", Required: true},
+			{Key: "Zone", Description: "ex) us-east-2a, required to create a VPC and to list VM specs"},
+		},
+	}
+}
+
 //func getVMClient(regionInfo idrv.RegionInfo) (*ec2.EC2, error) {
 func getVMClient(connectionInfo idrv.ConnectionInfo) (*ec2.EC2, error) {
 
 	// setup Region
 	fmt.Println("AwsDriver : getVMClient() - Region : [" + connectionInfo.RegionInfo.Region + "]")
+	fmt.Println("AwsDriver : getVMClient() - Zone : [" + connectionInfo.RegionInfo.Zone + "]")
 	//fmt.Println("전달 받은 커넥션 정보")
 	//spew.Dump(connectionInfo)
 
@@ -80,6 +136,36 @@ func getVMClient(connectionInfo idrv.ConnectionInfo) (*ec2.EC2, error) {
 	return svc, nil
 }
 
+// Route 53 is a global service, but the region is used for the VPC of a private hosted zone.
+func getDNSClient(connectionInfo idrv.ConnectionInfo) (*route53.Route53, error) {
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String(connectionInfo.RegionInfo.Region),
+		Credentials: credentials.NewStaticCredentials(connectionInfo.CredentialInfo.ClientId, connectionInfo.CredentialInfo.ClientSecret, "")},
+	)
+	if err != nil {
+		fmt.Println("Could not create aws New Session", err)
+		return nil, err
+	}
+
+	// Create Route 53 service client
+	return route53.New(sess), nil
+}
+
+// S3 buckets are created in the region of the connection.
+func getBucketClient(connectionInfo idrv.ConnectionInfo) (*s3.S3, error) {
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String(connectionInfo.RegionInfo.Region),
+		Credentials: credentials.NewStaticCredentials(connectionInfo.CredentialInfo.ClientId, connectionInfo.CredentialInfo.ClientSecret, "")},
+	)
+	if err != nil {
+		fmt.Println("Could not create aws New Session", err)
+		return nil, err
+	}
+
+	// Create S3 service client
+	return s3.New(sess), nil
+}
+
 func (driver *AwsDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.CloudConnection, error) {
 	// 1. get info of credential and region for Test A Cloud from connectionInfo.
 	// 2. create a client object(or service  object) of Test A Cloud with credential info.
@@ -97,6 +183,16 @@ func (driver *AwsDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.
 		return nil, err
 	}
 
+	dnsClient, err := getDNSClient(connectionInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketClient, err := getBucketClient(connectionInfo)
+	if err != nil {
+		return nil, err
+	}
+
 	//iConn = acon.AwsCloudConnection{}
 	iConn := acon.AwsCloudConnection{
 		Region:        connectionInfo.RegionInfo,
@@ -106,9 +202,11 @@ func (driver *AwsDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.
 		VNetworkClient: vmClient,
 		//VNicClient:     vmClient,
 		ImageClient: vmClient,
-		//PublicIPClient: vmClient,
+		PublicIPClient: vmClient,
 		SecurityClient: vmClient,
 		VmSpecClient:   vmClient,
+		DNSClient:      dnsClient,
+		BucketClient:   bucketClient,
 	}
 
 	return &iConn, nil // return type: (icon.CloudConnection, error)
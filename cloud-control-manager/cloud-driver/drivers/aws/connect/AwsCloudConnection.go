@@ -11,6 +11,7 @@
 package connect
 
 import (
+	"errors"
 	cblog "github.com/cloud-barista/cb-log"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 
@@ -22,6 +23,8 @@ import (
 
 	//ec2drv "github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/s3"
 )
 
 //type AwsCloudConnection struct{}
@@ -33,9 +36,11 @@ type AwsCloudConnection struct {
 	VNetworkClient *ec2.EC2
 	//VNicClient     *ec2.EC2
 	ImageClient *ec2.EC2
-	//PublicIPClient *ec2.EC2
+	PublicIPClient *ec2.EC2
 	SecurityClient *ec2.EC2
 	VmSpecClient   *ec2.EC2
+	DNSClient      *route53.Route53
+	BucketClient   *s3.S3
 }
 
 var cblogger *logrus.Logger
@@ -96,6 +101,7 @@ func (cloudConn *AwsCloudConnection) CreateVNicHandler() (irs.VNicHandler, error
 
 	return &handler, nil
 }
+*/
 
 func (cloudConn *AwsCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
 	cblogger.Info("Start")
@@ -103,10 +109,32 @@ func (cloudConn *AwsCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandle
 
 	return &handler, nil
 }
-*/
 
 func (cloudConn *AwsCloudConnection) CreateVMSpecHandler() (irs.VMSpecHandler, error) {
 	cblogger.Info("Start")
 	handler := ars.AwsVmSpecHandler{cloudConn.Region, cloudConn.VmSpecClient}
 	return &handler, nil
 }
+
+func (cloudConn *AwsCloudConnection) CreateNLBHandler() (irs.NLBHandler, error) {
+	cblogger.Error("AWS Cloud Driver: called CreateNLBHandler(), but not supported!")
+	return nil, errors.New("AWS Cloud Driver: CreateNLBHandler() is not supported!")
+}
+
+func (cloudConn *AwsCloudConnection) CreateDNSHandler() (irs.DNSHandler, error) {
+	cblogger.Info("Start")
+	handler := ars.AwsDNSHandler{cloudConn.Region, cloudConn.DNSClient}
+	return &handler, nil
+}
+
+func (cloudConn *AwsCloudConnection) CreateBucketHandler() (irs.BucketHandler, error) {
+	cblogger.Info("Start")
+	handler := ars.AwsBucketHandler{cloudConn.Region, cloudConn.BucketClient}
+	return &handler, nil
+}
+
+func (cloudConn *AwsCloudConnection) CreateRegionZoneHandler() (irs.RegionZoneHandler, error) {
+	cblogger.Info("Start")
+	handler := ars.AwsRegionZoneHandler{cloudConn.Region, cloudConn.VMClient}
+	return &handler, nil
+}
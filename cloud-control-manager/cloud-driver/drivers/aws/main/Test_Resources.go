@@ -615,7 +615,7 @@ func handleVPC() {
 				if err != nil {
 					cblogger.Infof("[%s] VNetwork 조회 실패 : ", reqSubnetId, err)
 				} else {
-					cblogger.Infof("[%s] VNetwork 조회 결과 : [%s]", reqSubnetId, result)
+					cblogger.Infof("[%s] VNetwork 조회 결과 : [%v]", reqSubnetId, result)
 					spew.Dump(result)
 				}
 
@@ -0,0 +1,251 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// S3 Bucket
+// IID.NameId, IID.SystemId => Bucket Name
+type AwsBucketHandler struct {
+	Region idrv.RegionInfo
+	Client *s3.S3
+}
+
+func (bucketHandler *AwsBucketHandler) CreateBucket(bucketReqInfo irs.BucketReqInfo) (irs.BucketInfo, error) {
+	cblogger.Info("Start : ", bucketReqInfo)
+
+	input := &s3.CreateBucketInput{
+		Bucket: aws.String(bucketReqInfo.IId.NameId),
+	}
+	// us-east-1은 LocationConstraint를 지정하면 오류가 발생 함.
+	if bucketHandler.Region.Region != "us-east-1" {
+		input.CreateBucketConfiguration = &s3.CreateBucketConfiguration{
+			LocationConstraint: aws.String(bucketHandler.Region.Region),
+		}
+	}
+	_, err := bucketHandler.Client.CreateBucket(input)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.BucketInfo{}, err
+	}
+
+	err = bucketHandler.Client.WaitUntilBucketExists(&s3.HeadBucketInput{Bucket: aws.String(bucketReqInfo.IId.NameId)})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.BucketInfo{}, err
+	}
+	cblogger.Infof("S3 Bucket 생성 성공 - Bucket : [%s]", bucketReqInfo.IId.NameId)
+
+	return bucketHandler.GetBucket(bucketReqInfo.IId)
+}
+
+// S3의 Bucket 목록은 모든 리전의 Bucket을 포함 함.
+func (bucketHandler *AwsBucketHandler) ListBucket() ([]*irs.BucketInfo, error) {
+	cblogger.Info("Start")
+
+	result, err := bucketHandler.Client.ListBuckets(&s3.ListBucketsInput{})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	bucketInfoList := []*irs.BucketInfo{}
+	for _, bucket := range result.Buckets {
+		bucketInfo := extractBucketInfo(bucket)
+		bucketInfoList = append(bucketInfoList, &bucketInfo)
+	}
+	return bucketInfoList, nil
+}
+
+// 단일 Bucket의 생성 시간을 조회하는 API가 없어서 목록에서 찾음.
+func (bucketHandler *AwsBucketHandler) GetBucket(bucketIID irs.IID) (irs.BucketInfo, error) {
+	cblogger.Info("Start : ", bucketIID)
+
+	result, err := bucketHandler.Client.ListBuckets(&s3.ListBucketsInput{})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.BucketInfo{}, err
+	}
+	for _, bucket := range result.Buckets {
+		if aws.StringValue(bucket.Name) == awsBucketName(bucketIID) {
+			bucketInfo := extractBucketInfo(bucket)
+			bucketInfo.KeyValueList = append(bucketInfo.KeyValueList, irs.KeyValue{Key: "Region", Value: bucketHandler.Region.Region})
+			return bucketInfo, nil
+		}
+	}
+	return irs.BucketInfo{}, errors.New("S3 Bucket [" + awsBucketName(bucketIID) + "] 정보가 존재하지 않습니다.")
+}
+
+func (bucketHandler *AwsBucketHandler) DeleteBucket(bucketIID irs.IID) (bool, error) {
+	cblogger.Info("Start : ", bucketIID)
+
+	_, err := bucketHandler.Client.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String(awsBucketName(bucketIID))})
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (bucketHandler *AwsBucketHandler) ListObject(bucketIID irs.IID, prefix string) ([]*irs.ObjectInfo, error) {
+	cblogger.Info("Start : ", bucketIID, prefix)
+
+	objectInfoList := []*irs.ObjectInfo{}
+	input := &s3.ListObjectsV2Input{Bucket: aws.String(awsBucketName(bucketIID))}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	// 1000개 단위의 페이지를 모두 조회 함.
+	err := bucketHandler.Client.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			objectInfoList = append(objectInfoList, &irs.ObjectInfo{
+				Name:         aws.StringValue(object.Key),
+				Size:         aws.Int64Value(object.Size),
+				LastModified: aws.TimeValue(object.LastModified),
+				ETag:         aws.StringValue(object.ETag),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	return objectInfoList, nil
+}
+
+// 크기를 모르는 Stream도 Multipart Upload로 처리 함.
+func (bucketHandler *AwsBucketHandler) PutObject(bucketIID irs.IID, objectName string, reader io.Reader, size int64, contentType string) (irs.ObjectInfo, error) {
+	cblogger.Info("Start : ", bucketIID, objectName)
+
+	input := &s3manager.UploadInput{
+		Bucket: aws.String(awsBucketName(bucketIID)),
+		Key:    aws.String(objectName),
+		Body:   reader,
+	}
+	if contentType != "" {
+		input.ContentType = aws.String(contentType)
+	}
+	uploader := s3manager.NewUploaderWithClient(bucketHandler.Client)
+	_, err := uploader.Upload(input)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.ObjectInfo{}, err
+	}
+
+	result, err := bucketHandler.Client.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(awsBucketName(bucketIID)),
+		Key:    aws.String(objectName),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.ObjectInfo{}, err
+	}
+	if size >= 0 && aws.Int64Value(result.ContentLength) != size {
+		cblogger.Errorf("Object [%s] 크기 불일치 - 요청 : [%d], 저장 : [%d]", objectName, size, aws.Int64Value(result.ContentLength))
+	}
+	return irs.ObjectInfo{
+		Name:         objectName,
+		Size:         aws.Int64Value(result.ContentLength),
+		LastModified: aws.TimeValue(result.LastModified),
+		ETag:         aws.StringValue(result.ETag),
+		ContentType:  aws.StringValue(result.ContentType),
+	}, nil
+}
+
+func (bucketHandler *AwsBucketHandler) GetObject(bucketIID irs.IID, objectName string) (io.ReadCloser, irs.ObjectInfo, error) {
+	cblogger.Info("Start : ", bucketIID, objectName)
+
+	result, err := bucketHandler.Client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(awsBucketName(bucketIID)),
+		Key:    aws.String(objectName),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, irs.ObjectInfo{}, err
+	}
+	return result.Body, irs.ObjectInfo{
+		Name:         objectName,
+		Size:         aws.Int64Value(result.ContentLength),
+		LastModified: aws.TimeValue(result.LastModified),
+		ETag:         aws.StringValue(result.ETag),
+		ContentType:  aws.StringValue(result.ContentType),
+	}, nil
+}
+
+func (bucketHandler *AwsBucketHandler) DeleteObject(bucketIID irs.IID, objectName string) (bool, error) {
+	cblogger.Info("Start : ", bucketIID, objectName)
+
+	_, err := bucketHandler.Client.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(awsBucketName(bucketIID)),
+		Key:    aws.String(objectName),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// Signature V4 Presigned URL, 최대 유효 기간은 7일 임.
+func (bucketHandler *AwsBucketHandler) GetPresignedURL(bucketIID irs.IID, objectName string, method irs.PresignedURLMethod, expires time.Duration) (string, error) {
+	cblogger.Info("Start : ", bucketIID, objectName, method, expires)
+
+	var presignURL string
+	var err error
+	switch method {
+	case irs.PresignedGet:
+		req, _ := bucketHandler.Client.GetObjectRequest(&s3.GetObjectInput{
+			Bucket: aws.String(awsBucketName(bucketIID)),
+			Key:    aws.String(objectName),
+		})
+		presignURL, err = req.Presign(expires)
+	case irs.PresignedPut:
+		req, _ := bucketHandler.Client.PutObjectRequest(&s3.PutObjectInput{
+			Bucket: aws.String(awsBucketName(bucketIID)),
+			Key:    aws.String(objectName),
+		})
+		presignURL, err = req.Presign(expires)
+	default:
+		return "", errors.New("AWS는 [" + string(method) + "] Presigned URL을 지원하지 않습니다.")
+	}
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+	return presignURL, nil
+}
+
+// SystemId가 없으면 NameId를 Bucket 이름으로 사용 함.
+func awsBucketName(bucketIID irs.IID) string {
+	if bucketIID.SystemId != "" {
+		return bucketIID.SystemId
+	}
+	return bucketIID.NameId
+}
+
+func extractBucketInfo(bucket *s3.Bucket) irs.BucketInfo {
+	name := aws.StringValue(bucket.Name)
+	return irs.BucketInfo{
+		IId:         irs.IID{NameId: name, SystemId: name},
+		CreatedTime: aws.TimeValue(bucket.CreationDate),
+	}
+}
@@ -0,0 +1,285 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// Route 53 Private Hosted Zone
+// IID.SystemId => HostedZone Id(without "/hostedzone/"), IID.NameId => HostedZone Comment
+type AwsDNSHandler struct {
+	Region idrv.RegionInfo
+	Client *route53.Route53
+}
+
+func (dnsHandler *AwsDNSHandler) CreateZone(zoneReqInfo irs.DNSZoneReqInfo) (irs.DNSZoneInfo, error) {
+	cblogger.Info("Start : ", zoneReqInfo)
+
+	// CallerReference는 중복 요청 방지용으로 요청마다 유일해야 함.
+	result, err := dnsHandler.Client.CreateHostedZone(&route53.CreateHostedZoneInput{
+		Name:            aws.String(zoneReqInfo.DomainName),
+		CallerReference: aws.String(zoneReqInfo.IId.NameId + "-" + strconv.FormatInt(time.Now().UnixNano(), 10)),
+		HostedZoneConfig: &route53.HostedZoneConfig{
+			Comment:     aws.String(zoneReqInfo.IId.NameId),
+			PrivateZone: aws.Bool(true),
+		},
+		VPC: &route53.VPC{
+			VPCId:     aws.String(zoneReqInfo.VpcIID.SystemId),
+			VPCRegion: aws.String(dnsHandler.Region.Region),
+		},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DNSZoneInfo{}, err
+	}
+	zoneId := awsHostedZoneId(*result.HostedZone.Id)
+	cblogger.Infof("Private Hosted Zone 생성 성공 - Zone Id : [%s]", zoneId)
+
+	return dnsHandler.GetZone(irs.IID{SystemId: zoneId})
+}
+
+func (dnsHandler *AwsDNSHandler) ListZone() ([]*irs.DNSZoneInfo, error) {
+	cblogger.Info("Start")
+
+	var zoneInfoList []*irs.DNSZoneInfo
+	var pageErr error
+	err := dnsHandler.Client.ListHostedZonesPages(&route53.ListHostedZonesInput{}, func(page *route53.ListHostedZonesOutput, lastPage bool) bool {
+		for _, zone := range page.HostedZones {
+			// Public Hosted Zone은 제외 함.
+			if zone.Config == nil || !aws.BoolValue(zone.Config.PrivateZone) {
+				continue
+			}
+			zoneInfo, err := dnsHandler.GetZone(irs.IID{SystemId: awsHostedZoneId(*zone.Id)})
+			if err != nil {
+				pageErr = err
+				return false
+			}
+			zoneInfoList = append(zoneInfoList, &zoneInfo)
+		}
+		return true
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	if pageErr != nil {
+		cblogger.Error(pageErr)
+		return nil, pageErr
+	}
+	return zoneInfoList, nil
+}
+
+func (dnsHandler *AwsDNSHandler) GetZone(zoneIID irs.IID) (irs.DNSZoneInfo, error) {
+	cblogger.Info("Zone Id : ", zoneIID.SystemId)
+
+	result, err := dnsHandler.Client.GetHostedZone(&route53.GetHostedZoneInput{
+		Id: aws.String(zoneIID.SystemId),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.DNSZoneInfo{}, err
+	}
+
+	domainName := strings.TrimSuffix(*result.HostedZone.Name, ".")
+	zoneInfo := irs.DNSZoneInfo{
+		IId:        irs.IID{NameId: zoneIID.NameId, SystemId: awsHostedZoneId(*result.HostedZone.Id)},
+		DomainName: domainName,
+		KeyValueList: []irs.KeyValue{
+			{Key: "ResourceRecordSetCount", Value: strconv.FormatInt(aws.Int64Value(result.HostedZone.ResourceRecordSetCount), 10)},
+		},
+	}
+	if result.HostedZone.Config != nil && result.HostedZone.Config.Comment != nil {
+		zoneInfo.IId.NameId = *result.HostedZone.Config.Comment
+	}
+	if len(result.VPCs) > 0 {
+		zoneInfo.VpcIID = irs.IID{SystemId: aws.StringValue(result.VPCs[0].VPCId)}
+	}
+
+	recordSets, err := dnsHandler.listRecordSets(zoneInfo.IId.SystemId)
+	if err != nil {
+		return irs.DNSZoneInfo{}, err
+	}
+	for _, recordSet := range recordSets {
+		zoneInfo.RecordList = append(zoneInfo.RecordList, extractRecordInfo(recordSet, domainName))
+	}
+
+	return zoneInfo, nil
+}
+
+// Route 53은 레코드가 남아 있으면 Hosted Zone을 삭제할 수 없으므로 A, CNAME 레코드를 먼저 삭제 함.
+func (dnsHandler *AwsDNSHandler) DeleteZone(zoneIID irs.IID) (bool, error) {
+	cblogger.Info("Zone Id : ", zoneIID.SystemId)
+
+	recordSets, err := dnsHandler.listRecordSets(zoneIID.SystemId)
+	if err != nil {
+		return false, err
+	}
+	for _, recordSet := range recordSets {
+		err = dnsHandler.changeRecordSet(zoneIID.SystemId, route53.ChangeActionDelete, recordSet)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	_, err = dnsHandler.Client.DeleteHostedZone(&route53.DeleteHostedZoneInput{
+		Id: aws.String(zoneIID.SystemId),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (dnsHandler *AwsDNSHandler) AddRecord(zoneIID irs.IID, recordInfo irs.DNSRecordInfo) (irs.DNSZoneInfo, error) {
+	cblogger.Infof("Zone Id : [%s], Record : [%v]", zoneIID.SystemId, recordInfo)
+
+	zoneInfo, err := dnsHandler.GetZone(zoneIID)
+	if err != nil {
+		return irs.DNSZoneInfo{}, err
+	}
+	// CREATE는 같은 이름, 유형의 레코드가 있으면 실패 함.
+	err = dnsHandler.changeRecordSet(zoneIID.SystemId, route53.ChangeActionCreate, newRecordSet(recordInfo, zoneInfo.DomainName))
+	if err != nil {
+		return irs.DNSZoneInfo{}, err
+	}
+	return dnsHandler.GetZone(zoneIID)
+}
+
+func (dnsHandler *AwsDNSHandler) UpdateRecord(zoneIID irs.IID, recordInfo irs.DNSRecordInfo) (irs.DNSZoneInfo, error) {
+	cblogger.Infof("Zone Id : [%s], Record : [%v]", zoneIID.SystemId, recordInfo)
+
+	zoneInfo, err := dnsHandler.GetZone(zoneIID)
+	if err != nil {
+		return irs.DNSZoneInfo{}, err
+	}
+	// UPSERT는 없는 레코드를 생성하므로 레코드 존재 여부를 먼저 확인 함.
+	if findRecordInfo(zoneInfo.RecordList, recordInfo.Name, recordInfo.Type) == nil {
+		return irs.DNSZoneInfo{}, errors.New("Record [" + recordInfo.Name + ":" + string(recordInfo.Type) + "] does not exist in the Hosted Zone [" + zoneIID.SystemId + "].")
+	}
+	err = dnsHandler.changeRecordSet(zoneIID.SystemId, route53.ChangeActionUpsert, newRecordSet(recordInfo, zoneInfo.DomainName))
+	if err != nil {
+		return irs.DNSZoneInfo{}, err
+	}
+	return dnsHandler.GetZone(zoneIID)
+}
+
+// Route 53의 DELETE는 기존 레코드의 TTL, 값이 모두 일치해야 하므로 현재 레코드를 조회하여 삭제 함.
+func (dnsHandler *AwsDNSHandler) RemoveRecord(zoneIID irs.IID, recordName string, recordType irs.DNSRecordType) (bool, error) {
+	cblogger.Infof("Zone Id : [%s], Record : [%s:%s]", zoneIID.SystemId, recordName, recordType)
+
+	zoneInfo, err := dnsHandler.GetZone(zoneIID)
+	if err != nil {
+		return false, err
+	}
+	recordInfo := findRecordInfo(zoneInfo.RecordList, recordName, recordType)
+	if recordInfo == nil {
+		return false, errors.New("Record [" + recordName + ":" + string(recordType) + "] does not exist in the Hosted Zone [" + zoneIID.SystemId + "].")
+	}
+	err = dnsHandler.changeRecordSet(zoneIID.SystemId, route53.ChangeActionDelete, newRecordSet(*recordInfo, zoneInfo.DomainName))
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Hosted Zone의 A, CNAME 레코드 목록(SOA, NS 레코드 제외)
+func (dnsHandler *AwsDNSHandler) listRecordSets(zoneId string) ([]*route53.ResourceRecordSet, error) {
+	var recordSets []*route53.ResourceRecordSet
+	err := dnsHandler.Client.ListResourceRecordSetsPages(&route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneId),
+	}, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		for _, recordSet := range page.ResourceRecordSets {
+			recordType := aws.StringValue(recordSet.Type)
+			if recordType == string(irs.DNSRecordA) || recordType == string(irs.DNSRecordCNAME) {
+				recordSets = append(recordSets, recordSet)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	return recordSets, nil
+}
+
+func (dnsHandler *AwsDNSHandler) changeRecordSet(zoneId string, action string, recordSet *route53.ResourceRecordSet) error {
+	_, err := dnsHandler.Client.ChangeResourceRecordSets(&route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneId),
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: []*route53.Change{
+				{
+					Action:            aws.String(action),
+					ResourceRecordSet: recordSet,
+				},
+			},
+		},
+	})
+	if err != nil {
+		cblogger.Errorf("Hosted Zone[%s] 레코드[%s:%s] %s 실패", zoneId, aws.StringValue(recordSet.Name), aws.StringValue(recordSet.Type), action)
+		cblogger.Error(err)
+		return err
+	}
+	return nil
+}
+
+func newRecordSet(recordInfo irs.DNSRecordInfo, domainName string) *route53.ResourceRecordSet {
+	ttl := recordInfo.TTL
+	if ttl == 0 {
+		ttl = irs.DNSDefaultTTL
+	}
+	recordSet := &route53.ResourceRecordSet{
+		Name: aws.String(recordInfo.Name + "." + domainName),
+		Type: aws.String(string(recordInfo.Type)),
+		TTL:  aws.Int64(ttl),
+	}
+	for _, value := range recordInfo.Values {
+		recordSet.ResourceRecords = append(recordSet.ResourceRecords, &route53.ResourceRecord{Value: aws.String(value)})
+	}
+	return recordSet
+}
+
+// Route 53의 레코드 이름은 FQDN(예: web-01.cb-spider.internal.)이므로 Zone 도메인을 제외한 이름으로 변환 함.
+func extractRecordInfo(recordSet *route53.ResourceRecordSet, domainName string) irs.DNSRecordInfo {
+	recordInfo := irs.DNSRecordInfo{
+		Name: strings.TrimSuffix(strings.TrimSuffix(aws.StringValue(recordSet.Name), "."), "."+domainName),
+		Type: irs.DNSRecordType(aws.StringValue(recordSet.Type)),
+		TTL:  aws.Int64Value(recordSet.TTL),
+	}
+	for _, record := range recordSet.ResourceRecords {
+		recordInfo.Values = append(recordInfo.Values, aws.StringValue(record.Value))
+	}
+	return recordInfo
+}
+
+func findRecordInfo(recordList []irs.DNSRecordInfo, recordName string, recordType irs.DNSRecordType) *irs.DNSRecordInfo {
+	for idx := range recordList {
+		if strings.EqualFold(recordList[idx].Name, recordName) && recordList[idx].Type == recordType {
+			return &recordList[idx]
+		}
+	}
+	return nil
+}
+
+// "/hostedzone/Z1234" => "Z1234"
+func awsHostedZoneId(id string) string {
+	return strings.TrimPrefix(id, "/hostedzone/")
+}
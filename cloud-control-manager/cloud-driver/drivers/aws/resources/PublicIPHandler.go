@@ -0,0 +1,180 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// Elastic IP
+// IID.SystemId => AllocationId, IID.NameId => Name Tag
+type AwsPublicIPHandler struct {
+	Region idrv.RegionInfo
+	Client *ec2.EC2
+}
+
+func (publicIpHandler *AwsPublicIPHandler) AllocatePublicIP(publicIPReqInfo irs.PublicIPReqInfo) (irs.PublicIPInfo, error) {
+	cblogger.Info("Start : ", publicIPReqInfo)
+
+	allocRes, err := publicIpHandler.Client.AllocateAddress(&ec2.AllocateAddressInput{
+		Domain: aws.String("vpc"), // 적용 범위 : VPC
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to allocate IP address, %v", err)
+		return irs.PublicIPInfo{}, err
+	}
+	cblogger.Infof("EIP 생성 성공 - Public IP : [%s], Allocation Id : [%s]", *allocRes.PublicIp, *allocRes.AllocationId)
+
+	// Tag에 Name 설정
+	if !SetNameTag(publicIpHandler.Client, *allocRes.AllocationId, publicIPReqInfo.IId.NameId) {
+		// rollback
+		publicIpHandler.ReleasePublicIP(irs.IID{SystemId: *allocRes.AllocationId})
+		return irs.PublicIPInfo{}, errors.New("PublicIP [" + *allocRes.PublicIp + "]에 [" + publicIPReqInfo.IId.NameId + "] Name Tag 설정 실패")
+	}
+
+	return publicIpHandler.GetPublicIP(irs.IID{NameId: publicIPReqInfo.IId.NameId, SystemId: *allocRes.AllocationId})
+}
+
+func (publicIpHandler *AwsPublicIPHandler) ListPublicIP() ([]*irs.PublicIPInfo, error) {
+	cblogger.Debug("Start ListPublicIP()")
+
+	result, err := publicIpHandler.Client.DescribeAddresses(&ec2.DescribeAddressesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("domain"),
+				Values: aws.StringSlice([]string{"vpc"}),
+			},
+		},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var publicIPList []*irs.PublicIPInfo
+	for _, address := range result.Addresses {
+		publicIPInfo := extractPublicIPDescribeInfo(address)
+		publicIPList = append(publicIPList, &publicIPInfo)
+	}
+	return publicIPList, nil
+}
+
+func (publicIpHandler *AwsPublicIPHandler) GetPublicIP(publicIPIID irs.IID) (irs.PublicIPInfo, error) {
+	cblogger.Info("publicIPIID : ", publicIPIID)
+
+	address, err := publicIpHandler.describeAddress(publicIPIID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.PublicIPInfo{}, err
+	}
+	return extractPublicIPDescribeInfo(address), nil
+}
+
+func (publicIpHandler *AwsPublicIPHandler) ReleasePublicIP(publicIPIID irs.IID) (bool, error) {
+	cblogger.Info("publicIPIID : ", publicIPIID)
+
+	_, err := publicIpHandler.Client.ReleaseAddress(&ec2.ReleaseAddressInput{
+		AllocationId: aws.String(publicIPIID.SystemId),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to release IP address, %v", err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (publicIpHandler *AwsPublicIPHandler) AssociatePublicIP(publicIPIID irs.IID, vmIID irs.IID) (irs.PublicIPInfo, error) {
+	cblogger.Infof("publicIPIID : [%s], vmIID : [%s]", publicIPIID.SystemId, vmIID.SystemId)
+
+	// an EIP can be moved from other instance only when AllowReassociation is true,
+	// so an associated EIP returns an error.
+	_, err := publicIpHandler.Client.AssociateAddress(&ec2.AssociateAddressInput{
+		AllocationId:       aws.String(publicIPIID.SystemId),
+		InstanceId:         aws.String(vmIID.SystemId),
+		AllowReassociation: aws.Bool(false),
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to associate IP address, %v", err)
+		return irs.PublicIPInfo{}, err
+	}
+
+	return publicIpHandler.GetPublicIP(publicIPIID)
+}
+
+func (publicIpHandler *AwsPublicIPHandler) DisassociatePublicIP(publicIPIID irs.IID) (bool, error) {
+	cblogger.Info("publicIPIID : ", publicIPIID)
+
+	address, err := publicIpHandler.describeAddress(publicIPIID)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	if address.AssociationId == nil {
+		return false, errors.New("PublicIP [" + publicIPIID.SystemId + "] is not associated with any VM.")
+	}
+
+	_, err = publicIpHandler.Client.DisassociateAddress(&ec2.DisassociateAddressInput{
+		AssociationId: address.AssociationId,
+	})
+	if err != nil {
+		cblogger.Errorf("Unable to disassociate IP address, %v", err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (publicIpHandler *AwsPublicIPHandler) describeAddress(publicIPIID irs.IID) (*ec2.Address, error) {
+	result, err := publicIpHandler.Client.DescribeAddresses(&ec2.DescribeAddressesInput{
+		AllocationIds: []*string{aws.String(publicIPIID.SystemId)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Addresses) < 1 {
+		return nil, errors.New("PublicIP [" + publicIPIID.SystemId + "] does not exist.")
+	}
+	return result.Addresses[0], nil
+}
+
+func extractPublicIPDescribeInfo(address *ec2.Address) irs.PublicIPInfo {
+	publicIPInfo := irs.PublicIPInfo{
+		IId:      irs.IID{SystemId: aws.StringValue(address.AllocationId)},
+		PublicIP: aws.StringValue(address.PublicIp),
+		Status:   irs.PublicIPAvailable,
+	}
+
+	for _, t := range address.Tags {
+		if aws.StringValue(t.Key) == "Name" {
+			publicIPInfo.IId.NameId = aws.StringValue(t.Value)
+			break
+		}
+	}
+
+	if address.InstanceId != nil {
+		publicIPInfo.Status = irs.PublicIPAssociated
+		publicIPInfo.AssociatedVMIID = irs.IID{SystemId: *address.InstanceId}
+	}
+
+	keyValueList := []irs.KeyValue{
+		{Key: "Domain", Value: aws.StringValue(address.Domain)},
+		{Key: "AssociationId", Value: aws.StringValue(address.AssociationId)},
+		{Key: "NetworkInterfaceId", Value: aws.StringValue(address.NetworkInterfaceId)},
+		{Key: "PrivateIpAddress", Value: aws.StringValue(address.PrivateIpAddress)},
+	}
+	publicIPInfo.KeyValueList = keyValueList
+
+	return publicIPInfo
+}
@@ -0,0 +1,134 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// regions enabled for the account, the zones are described with a client of each region.
+type AwsRegionZoneHandler struct {
+	Region idrv.RegionInfo
+	Client *ec2.EC2
+}
+
+func (regionZoneHandler *AwsRegionZoneHandler) ListRegionZone() ([]*irs.RegionZoneInfo, error) {
+	cblogger.Info("Start")
+
+	// AllRegions: false => 활성화(opt-in)된 Region만 조회 함.
+	result, err := regionZoneHandler.Client.DescribeRegions(&ec2.DescribeRegionsInput{AllRegions: aws.Bool(false)})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var regionZoneInfoList []*irs.RegionZoneInfo
+	for _, region := range result.Regions {
+		regionZoneInfo, err := regionZoneHandler.extractRegionZoneInfo(region)
+		if err != nil {
+			return nil, err
+		}
+		regionZoneInfoList = append(regionZoneInfoList, &regionZoneInfo)
+	}
+	return regionZoneInfoList, nil
+}
+
+func (regionZoneHandler *AwsRegionZoneHandler) GetRegionZone(Name string) (irs.RegionZoneInfo, error) {
+	cblogger.Info("Start : ", Name)
+
+	result, err := regionZoneHandler.Client.DescribeRegions(&ec2.DescribeRegionsInput{
+		AllRegions:  aws.Bool(true),
+		RegionNames: []*string{aws.String(Name)},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.RegionZoneInfo{}, err
+	}
+	if len(result.Regions) == 0 {
+		return irs.RegionZoneInfo{}, errors.New("Region[" + Name + "] does not exist!")
+	}
+	return regionZoneHandler.extractRegionZoneInfo(result.Regions[0])
+}
+
+func (regionZoneHandler *AwsRegionZoneHandler) ListZone() ([]*irs.ZoneInfo, error) {
+	cblogger.Info("Start")
+
+	return listAwsZone(regionZoneHandler.Client)
+}
+
+func (regionZoneHandler *AwsRegionZoneHandler) extractRegionZoneInfo(region *ec2.Region) (irs.RegionZoneInfo, error) {
+	regionName := aws.StringValue(region.RegionName)
+	optInStatus := aws.StringValue(region.OptInStatus)
+
+	regionZoneInfo := irs.RegionZoneInfo{
+		Name:   regionName,
+		Status: irs.RegionZoneAvailable,
+		KeyValueList: []irs.KeyValue{
+			{Key: "Endpoint", Value: aws.StringValue(region.Endpoint)},
+			{Key: "OptInStatus", Value: optInStatus},
+		},
+	}
+	if optInStatus == "not-opted-in" {
+		// 활성화 되지 않은 Region의 Zone은 조회할 수 없음.
+		regionZoneInfo.Status = irs.RegionZoneUnavailable
+		return regionZoneInfo, nil
+	}
+
+	// DescribeAvailabilityZones는 Client의 Region에 있는 Zone만 조회 함.
+	sess, err := session.NewSession(&aws.Config{
+		Region:      aws.String(regionName),
+		Credentials: regionZoneHandler.Client.Config.Credentials,
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.RegionZoneInfo{}, err
+	}
+	zoneInfoList, err := listAwsZone(ec2.New(sess))
+	if err != nil {
+		return irs.RegionZoneInfo{}, err
+	}
+	for _, zoneInfo := range zoneInfoList {
+		regionZoneInfo.ZoneList = append(regionZoneInfo.ZoneList, *zoneInfo)
+	}
+	return regionZoneInfo, nil
+}
+
+func listAwsZone(client *ec2.EC2) ([]*irs.ZoneInfo, error) {
+	result, err := client.DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var zoneInfoList []*irs.ZoneInfo
+	for _, zone := range result.AvailabilityZones {
+		// State: available | information | impaired | unavailable
+		status := irs.RegionZoneUnavailable
+		if aws.StringValue(zone.State) == "available" {
+			status = irs.RegionZoneAvailable
+		}
+		zoneInfoList = append(zoneInfoList, &irs.ZoneInfo{
+			Name:   aws.StringValue(zone.ZoneName),
+			Status: status,
+			KeyValueList: []irs.KeyValue{
+				{Key: "ZoneId", Value: aws.StringValue(zone.ZoneId)},
+				{Key: "State", Value: aws.StringValue(zone.State)},
+			},
+		})
+	}
+	return zoneInfoList, nil
+}
@@ -14,6 +14,7 @@ import (
 	"errors"
 	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -115,11 +116,7 @@ func (securityHandler *AwsSecurityHandler) CreateSecurity(securityReqInfo irs.Se
 			//ipPermission.SetToPort(0)
 		}
 
-		ipPermission.SetIpRanges([]*ec2.IpRange{
-			(&ec2.IpRange{}).
-				//SetCidrIp(ip.Cidr),
-				SetCidrIp("0.0.0.0/0"),
-		})
+		setIpPermissionCIDR(ipPermission, ip.CIDR)
 		ipPermissions = append(ipPermissions, ipPermission)
 	}
 
@@ -175,11 +172,7 @@ func (securityHandler *AwsSecurityHandler) CreateSecurity(securityReqInfo irs.Se
 			//ipPermission.SetToPort(0)
 		}
 
-		ipPermission.SetIpRanges([]*ec2.IpRange{
-			(&ec2.IpRange{}).
-				//SetCidrIp(ip.Cidr),
-				SetCidrIp("0.0.0.0/0"),
-		})
+		setIpPermissionCIDR(ipPermission, ip.CIDR)
 		//ipPermissions = append(ipPermissions, ipPermission)
 		ipPermissionsEgress = append(ipPermissionsEgress, ipPermission)
 	}
@@ -375,6 +368,22 @@ func ExtractSecurityInfo(securityGroupResult *ec2.SecurityGroup) irs.SecurityInf
 }
 
 // IpPermission에서 공통정보 추출
+// CIDR이 없으면 기존과 같이 0.0.0.0/0을 사용하며, IPv6 CIDR은 Ipv6Ranges로 설정 함.
+func setIpPermissionCIDR(ipPermission *ec2.IpPermission, cidr string) {
+	if cidr == "" {
+		cidr = "0.0.0.0/0"
+	}
+	if strings.Contains(cidr, ":") {
+		ipPermission.SetIpv6Ranges([]*ec2.Ipv6Range{
+			(&ec2.Ipv6Range{}).SetCidrIpv6(cidr),
+		})
+		return
+	}
+	ipPermission.SetIpRanges([]*ec2.IpRange{
+		(&ec2.IpRange{}).SetCidrIp(cidr),
+	})
+}
+
 func ExtractIpPermissionCommon(ip *ec2.IpPermission, securityRuleInfo *irs.SecurityRuleInfo) {
 	//공통 정보
 	if !reflect.ValueOf(ip.FromPort).IsNil() {
@@ -400,7 +409,7 @@ func ExtractIpPermissions(ipPermissions []*ec2.IpPermission, direction string) [
 			cblogger.Debug("Inbound/Outbound 정보 조회 : ", *ip.IpProtocol)
 			securityRuleInfo := irs.SecurityRuleInfo{
 				Direction: direction, // "inbound | outbound"
-				//Cidr: *ipv4.CidrIp,
+				CIDR:      *ipv4.CidrIp,
 			}
 			cblogger.Debug(*ipv4.CidrIp)
 
@@ -412,7 +421,7 @@ func ExtractIpPermissions(ipPermissions []*ec2.IpPermission, direction string) [
 		for _, ipv6 := range ip.Ipv6Ranges {
 			securityRuleInfo := irs.SecurityRuleInfo{
 				Direction: direction, // "inbound | outbound"
-				//Cidr: *ipv6.CidrIpv6,
+				CIDR:      *ipv6.CidrIpv6,
 			}
 			cblogger.Debug(*ipv6.CidrIpv6)
 
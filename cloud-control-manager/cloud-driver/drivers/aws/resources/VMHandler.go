@@ -7,9 +7,11 @@
 package resources
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -138,6 +140,61 @@ func (vmHandler *AwsVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 
 		//ec2.InstanceNetworkInterfaceSpecification
 	}
+
+	// 고정 Private IP 요청 시 Primary 네트워크 인터페이스에 지정 함.
+	if vmReqInfo.PrivateIP != "" {
+		input.NetworkInterfaces[0].PrivateIpAddress = aws.String(vmReqInfo.PrivateIP)
+	}
+
+	//=============================
+	// 보조 네트워크 인터페이스 처리
+	//=============================
+	// 네트워크 인터페이스가 2개 이상인 경우 AWS는 AssociatePublicIpAddress 옵션을 허용하지 않음.
+	if len(vmReqInfo.NetworkInterfaceList) > 0 {
+		input.NetworkInterfaces[0].AssociatePublicIpAddress = nil
+	}
+	for idx, nicReqInfo := range vmReqInfo.NetworkInterfaceList {
+		if nicReqInfo.PublicIP {
+			return irs.VMInfo{}, errors.New("AWS는 다중 네트워크 인터페이스 사용 시 Public IP 자동 할당을 지원하지 않습니다.")
+		}
+		nicSecurityGroupIds := newSecurityGroupIds
+		if len(nicReqInfo.SecurityGroupIIDs) > 0 {
+			nicSecurityGroupIds = []string{}
+			for _, sgIID := range nicReqInfo.SecurityGroupIIDs {
+				nicSecurityGroupIds = append(nicSecurityGroupIds, sgIID.SystemId)
+			}
+		}
+		nicSpec := &ec2.InstanceNetworkInterfaceSpecification{
+			DeviceIndex: aws.Int64(int64(idx + 1)),
+			Groups:      aws.StringSlice(nicSecurityGroupIds),
+			SubnetId:    aws.String(nicReqInfo.SubnetIID.SystemId),
+		}
+		if nicReqInfo.PrivateIP != "" {
+			nicSpec.PrivateIpAddress = aws.String(nicReqInfo.PrivateIP)
+		}
+		input.NetworkInterfaces = append(input.NetworkInterfaces, nicSpec)
+	}
+
+	//=============================
+	// 구매 옵션(Spot) 처리
+	//=============================
+	switch vmReqInfo.PurchaseOption.PurchaseType {
+	case "", irs.OnDemand:
+	case irs.Spot:
+		spotOptions := &ec2.SpotMarketOptions{
+			SpotInstanceType:             aws.String(ec2.SpotInstanceTypeOneTime),
+			InstanceInterruptionBehavior: aws.String(ec2.InstanceInterruptionBehaviorTerminate),
+		}
+		if vmReqInfo.PurchaseOption.MaxPrice != "" {
+			spotOptions.MaxPrice = aws.String(vmReqInfo.PurchaseOption.MaxPrice)
+		}
+		input.InstanceMarketOptions = &ec2.InstanceMarketOptionsRequest{
+			MarketType:  aws.String(ec2.MarketTypeSpot),
+			SpotOptions: spotOptions,
+		}
+	default:
+		return irs.VMInfo{}, errors.New("AWS는 [" + string(vmReqInfo.PurchaseOption.PurchaseType) + "] 구매 옵션을 지원하지 않습니다.")
+	}
 	cblogger.Info(input)
 
 	// Specify the details of the instance that you want to create.
@@ -561,6 +618,18 @@ func (vmHandler *AwsVMHandler) ExtractDescribeInstances(reservation *ec2.Reserva
 			vmInfo.SecurityGroupIIds = append(vmInfo.SecurityGroupIIds, irs.IID{*security.GroupName, *security.GroupId})
 		}
 
+		// Dual-Stack Subnet의 VM은 Subnet 설정(AssignIpv6AddressOnCreation)에 의해 IPv6 주소가 자동 할당 됨.
+		// AWS의 IPv6 주소는 Public 주소 임.
+		for _, ipv6 := range reservation.Instances[0].NetworkInterfaces[0].Ipv6Addresses {
+			if !reflect.ValueOf(ipv6.Ipv6Address).IsNil() {
+				vmInfo.PublicIPv6 = *ipv6.Ipv6Address
+				break
+			}
+		}
+
+		// 모든 네트워크 인터페이스 정보 (DeviceIndex 순서, Primary가 첫번째)
+		vmInfo.NetworkInterfaceList = extractNetworkInterfaceList(reservation.Instances[0].NetworkInterfaces)
+
 		/*
 			if !reflect.ValueOf(reservation.Instances[0].NetworkInterfaces[0].Groups).IsNil() {
 				vmInfo.SecurityGroupIds = *reservation.Instances[0].NetworkInterfaces[0].Groups[0]
@@ -602,10 +671,89 @@ func (vmHandler *AwsVMHandler) ExtractDescribeInstances(reservation *ec2.Reserva
 		}
 	}
 
+	//Spot 인스턴스의 Lifecycle 및 회수(Interruption) 상태 처리
+	vmInfo.LifecycleType = irs.OnDemand
+	if !reflect.ValueOf(reservation.Instances[0].InstanceLifecycle).IsNil() && *reservation.Instances[0].InstanceLifecycle == ec2.InstanceLifecycleTypeSpot {
+		vmInfo.LifecycleType = irs.Spot
+		vmInfo.InterruptionState = vmHandler.getSpotInterruptionState(reservation.Instances[0])
+	}
+
 	vmInfo.KeyValueList = keyValueList
 	return vmInfo
 }
 
+// EC2의 네트워크 인터페이스 목록을 DeviceIndex 순서(Primary가 첫번째)로 변환함.
+func extractNetworkInterfaceList(eniList []*ec2.InstanceNetworkInterface) []irs.NetworkInterfaceInfo {
+	sortedList := append([]*ec2.InstanceNetworkInterface{}, eniList...)
+	sort.SliceStable(sortedList, func(i, j int) bool {
+		return getDeviceIndex(sortedList[i]) < getDeviceIndex(sortedList[j])
+	})
+
+	nicInfoList := []irs.NetworkInterfaceInfo{}
+	for _, eni := range sortedList {
+		nicInfo := irs.NetworkInterfaceInfo{
+			IId:       irs.IID{NameId: fmt.Sprintf("eth%d", getDeviceIndex(eni)), SystemId: aws.StringValue(eni.NetworkInterfaceId)},
+			SubnetIID: irs.IID{SystemId: aws.StringValue(eni.SubnetId)},
+			PrivateIP: aws.StringValue(eni.PrivateIpAddress),
+		}
+		for _, security := range eni.Groups {
+			nicInfo.SecurityGroupIIds = append(nicInfo.SecurityGroupIIds, irs.IID{aws.StringValue(security.GroupName), aws.StringValue(security.GroupId)})
+		}
+		if eni.Association != nil {
+			nicInfo.PublicIP = aws.StringValue(eni.Association.PublicIp)
+		}
+		nicInfoList = append(nicInfoList, nicInfo)
+	}
+	return nicInfoList
+}
+
+func getDeviceIndex(eni *ec2.InstanceNetworkInterface) int64 {
+	if eni.Attachment == nil {
+		return 0
+	}
+	return aws.Int64Value(eni.Attachment.DeviceIndex)
+}
+
+// Spot 인스턴스의 회수 상태를 조회함.
+// - StateReason이 Spot 회수인 경우 : Interrupted
+// - Spot 요청 상태가 marked-for-* 인 경우 : InterruptionNoticed (회수 2분 전 통보)
+func (vmHandler *AwsVMHandler) getSpotInterruptionState(instance *ec2.Instance) irs.VMInterruptionState {
+	if !reflect.ValueOf(instance.StateReason).IsNil() && !reflect.ValueOf(instance.StateReason.Code).IsNil() {
+		switch *instance.StateReason.Code {
+		case "Server.SpotInstanceTermination", "Server.SpotInstanceShutdown":
+			return irs.Interrupted
+		}
+	}
+
+	if reflect.ValueOf(instance.SpotInstanceRequestId).IsNil() {
+		return irs.NotInterrupted
+	}
+
+	result, err := vmHandler.Client.DescribeSpotInstanceRequests(&ec2.DescribeSpotInstanceRequestsInput{
+		SpotInstanceRequestIds: []*string{instance.SpotInstanceRequestId},
+	})
+	if err != nil {
+		cblogger.Errorf("[%s] Spot 요청 정보 조회 실패", *instance.SpotInstanceRequestId)
+		cblogger.Error(err)
+		return irs.NotInterrupted
+	}
+
+	for _, spotReq := range result.SpotInstanceRequests {
+		if reflect.ValueOf(spotReq.Status).IsNil() || reflect.ValueOf(spotReq.Status.Code).IsNil() {
+			continue
+		}
+		if strings.HasPrefix(*spotReq.Status.Code, "marked-for-") {
+			return irs.InterruptionNoticed
+		}
+		if strings.HasPrefix(*spotReq.Status.Code, "instance-terminated-") || strings.HasPrefix(*spotReq.Status.Code, "instance-stopped-") {
+			if *spotReq.Status.Code != "instance-terminated-by-user" && *spotReq.Status.Code != "instance-stopped-by-user" {
+				return irs.Interrupted
+			}
+		}
+	}
+	return irs.NotInterrupted
+}
+
 func ExtractVmName(Tags []*ec2.Tag) string {
 	for _, t := range Tags {
 		if *t.Key == "Name" {
@@ -803,6 +951,37 @@ func (vmHandler *AwsVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
 	return vmStatusList, nil
 }
 
+// EC2의 콘솔 출력(최근 64KB)을 조회 함.
+func (vmHandler *AwsVMHandler) GetVMConsoleOutput(vmIID irs.IID) (string, error) {
+	cblogger.Infof("vmID : [%s]", vmIID.SystemId)
+
+	input := &ec2.GetConsoleOutputInput{
+		InstanceId: aws.String(vmIID.SystemId),
+	}
+
+	result, err := vmHandler.Client.GetConsoleOutput(input)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok {
+			cblogger.Error(aerr.Error())
+		} else {
+			cblogger.Error(err.Error())
+		}
+		return "", err
+	}
+
+	// 부팅 직후에는 출력이 없을 수 있음.
+	if result.Output == nil {
+		return "", nil
+	}
+
+	output, err := base64.StdEncoding.DecodeString(*result.Output)
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+	return string(output), nil
+}
+
 // AssociationId 대신 PublicIP로도 가능 함.
 func (vmHandler *AwsVMHandler) AssociatePublicIP(allocationId string, instanceId string) (bool, error) {
 	cblogger.Infof("EC2에 퍼블릭 IP할당 - AllocationId : [%s], InstanceId : [%s]", allocationId, instanceId)
@@ -54,6 +54,7 @@ func ExtractVMSpecInfo(Region string, instanceTypeInfo *ec2.InstanceTypeInfo) ir
 	//VCPU 정보 처리 - Clock
 	if !reflect.ValueOf(instanceTypeInfo.ProcessorInfo.SustainedClockSpeedInGhz).IsNil() {
 		vCpuInfo.Clock = strconv.FormatFloat(*instanceTypeInfo.ProcessorInfo.SustainedClockSpeedInGhz, 'f', 1, 64)
+		vCpuInfo.ClockGHz = *instanceTypeInfo.ProcessorInfo.SustainedClockSpeedInGhz
 	}
 	vmSpecInfo.VCpu = vCpuInfo
 
@@ -89,6 +90,12 @@ func ExtractVMSpecInfo(Region string, instanceTypeInfo *ec2.InstanceTypeInfo) ir
 	*/
 	vmSpecInfo.KeyValueList = keyValueList
 
+	//정규화된 스펙 정보 처리 및 규격 검사
+	errNormalize := irs.NormalizeVMSpecInfo(&vmSpecInfo)
+	if errNormalize != nil {
+		cblogger.Error(errNormalize)
+	}
+
 	return vmSpecInfo
 }
 
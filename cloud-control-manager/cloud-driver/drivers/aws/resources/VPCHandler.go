@@ -11,9 +11,14 @@
 package resources
 
 import (
+	"encoding/binary"
 	"errors"
+	"fmt"
+	"net"
 	"reflect"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -42,6 +47,13 @@ func (VPCHandler *AwsVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCIn
 	input := &ec2.CreateVpcInput{
 		CidrBlock: aws.String(vpcReqInfo.IPv4_CIDR),
 	}
+	// Dual-Stack VPC : AWS는 Amazon이 할당하는 /56 IPv6 CIDR만 지원 함.
+	if vpcReqInfo.IPv6_CIDR != "" {
+		if vpcReqInfo.IPv6_CIDR != irs.IPv6AutoCIDR {
+			return irs.VPCInfo{}, errors.New("AWS Cloud Driver: IPv6_CIDR supports only '" + irs.IPv6AutoCIDR + "'(Amazon provided /56)!")
+		}
+		input.AmazonProvidedIpv6CidrBlock = aws.Bool(true)
+	}
 
 	spew.Dump(input)
 	// logger for HisCall
@@ -146,13 +158,33 @@ func (VPCHandler *AwsVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCIn
 		return retVpcInfo, errRoute
 	}
 
+	// Dual-Stack VPC는 할당된 IPv6 CIDR를 확인하고 IGW에 대한 라우팅(::/0) 정보를 추가 함.
+	if vpcReqInfo.IPv6_CIDR != "" {
+		retVpcInfo.IPv6_CIDR, err = VPCHandler.waitVPCIPv6CIDR(retVpcInfo.IId.SystemId)
+		if err != nil {
+			return retVpcInfo, err
+		}
+		errRoute = VPCHandler.createRouteIGWIPv6(retVpcInfo.IId.SystemId, *resultIGW.InternetGateway.InternetGatewayId)
+		if errRoute != nil {
+			return retVpcInfo, errRoute
+		}
+	}
+
 	//==========================
 	// Subnet 생성
 	//==========================
 	//VPCHandler.CreateSubnet(retVpcInfo.IId.SystemId, vpcReqInfo.SubnetInfoList[0])
 	var resSubnetList []irs.SubnetInfo
+	usedIPv6CIDRList := []string{}
 	for _, curSubnet := range vpcReqInfo.SubnetInfoList {
 		cblogger.Infof("[%s] Subnet 생성", curSubnet.IId.NameId)
+		if retVpcInfo.IPv6_CIDR != "" {
+			curSubnet.IPv6_CIDR, err = getSubnetIPv6CIDR(retVpcInfo.IPv6_CIDR, curSubnet.IPv6_CIDR, usedIPv6CIDRList)
+			if err != nil {
+				return retVpcInfo, err
+			}
+			usedIPv6CIDRList = append(usedIPv6CIDRList, curSubnet.IPv6_CIDR)
+		}
 		cblogger.Infof("Reqt Subnet Info [%v]", curSubnet)
 		resSubnet, errSubnet := VPCHandler.CreateSubnet(retVpcInfo.IId.SystemId, curSubnet)
 
@@ -234,6 +266,13 @@ func (VPCHandler *AwsVPCHandler) GetDefaultRouteTable(vpcId string) (string, err
 					aws.String(vpcId),
 				},
 			},
+			// Private 라우팅 테이블이 아닌 VPC의 기본(main) 라우팅 테이블만 조회
+			{
+				Name: aws.String("association.main"),
+				Values: []*string{
+					aws.String("true"),
+				},
+			},
 		},
 	}
 
@@ -290,6 +329,9 @@ func (VPCHandler *AwsVPCHandler) CreateSubnet(vpcId string, reqSubnetInfo irs.Su
 		//AvailabilityZoneId: aws.String(zoneId),	//use1-az1, use1-az2, use1-az3, use1-az4, use1-az5, use1-az6
 		AvailabilityZone: aws.String(zoneId),
 	}
+	if reqSubnetInfo.IPv6_CIDR != "" {
+		input.Ipv6CidrBlock = aws.String(reqSubnetInfo.IPv6_CIDR)
+	}
 
 	// logger for HisCall
 	callogger := call.GetLogger("HISCALL")
@@ -340,12 +382,30 @@ func (VPCHandler *AwsVPCHandler) CreateSubnet(vpcId string, reqSubnetInfo irs.Su
 
 	vNetworkInfo.IId.NameId = reqSubnetInfo.IId.NameId
 
+	// Dual-Stack Subnet은 VM 생성시 IPv6 주소가 자동 할당되도록 설정 함.
+	if reqSubnetInfo.IPv6_CIDR != "" {
+		_, errAttr := VPCHandler.Client.ModifySubnetAttribute(&ec2.ModifySubnetAttributeInput{
+			SubnetId:                    result.Subnet.SubnetId,
+			AssignIpv6AddressOnCreation: &ec2.AttributeBooleanValue{Value: aws.Bool(true)},
+		})
+		if errAttr != nil {
+			cblogger.Error(errAttr)
+			return vNetworkInfo, errAttr
+		}
+	}
+
 	// VPC의 라우팅 테이블에 생성된 Subnet 정보를 추가 함.
-	errSubnetRoute := VPCHandler.AssociateRouteTable(vpcId, vNetworkInfo.IId.SystemId)
-	if errSubnetRoute != nil {
+	// Private Subnet은 IGW 라우팅이 없는 Private 라우팅 테이블에 연결 함.
+	var errSubnetRoute error
+	if reqSubnetInfo.Private {
+		errSubnetRoute = VPCHandler.AssociatePrivateRouteTable(vpcId, vNetworkInfo.IId.SystemId)
 	} else {
+		errSubnetRoute = VPCHandler.AssociateRouteTable(vpcId, vNetworkInfo.IId.SystemId)
+	}
+	if errSubnetRoute != nil {
 		return vNetworkInfo, errSubnetRoute
 	}
+	vNetworkInfo.Private = reqSubnetInfo.Private
 
 	return vNetworkInfo, nil
 }
@@ -357,6 +417,20 @@ func (VPCHandler *AwsVPCHandler) AssociateRouteTable(vpcId string, subnetId stri
 		return errRoute
 	}
 
+	return VPCHandler.associateRouteTableId(routeTableId, subnetId)
+}
+
+// VPC의 Private 라우팅 테이블(없으면 생성)에 생성된 Subnet을 연결 함.
+func (VPCHandler *AwsVPCHandler) AssociatePrivateRouteTable(vpcId string, subnetId string) error {
+	routeTableId, errRoute := VPCHandler.getPrivateRouteTable(vpcId, true)
+	if errRoute != nil {
+		return errRoute
+	}
+
+	return VPCHandler.associateRouteTableId(routeTableId, subnetId)
+}
+
+func (VPCHandler *AwsVPCHandler) associateRouteTableId(routeTableId string, subnetId string) error {
 	input := &ec2.AssociateRouteTableInput{
 		RouteTableId: aws.String(routeTableId),
 		SubnetId:     aws.String(subnetId),
@@ -505,6 +579,34 @@ func (VPCHandler *AwsVPCHandler) GetVPC(vpcIID irs.IID) (irs.VPCInfo, error) {
 		return awsVpcInfo, errSubnet
 	}
 
+	//라우팅 테이블 및 NAT Gateway 정보
+	routeTables, errRouteTable := VPCHandler.describeRouteTables(vpcIID.SystemId)
+	if errRouteTable != nil {
+		return awsVpcInfo, errRouteTable
+	}
+	for _, routeTable := range routeTables {
+		routeTableInfo := extractRouteTableDescribeInfo(routeTable)
+		awsVpcInfo.RouteTableInfoList = append(awsVpcInfo.RouteTableInfoList, routeTableInfo)
+		if !isPrivateRouteTable(routeTable) {
+			continue
+		}
+		for _, subnetIID := range routeTableInfo.SubnetIIDs {
+			for idx, subnetInfo := range awsVpcInfo.SubnetInfoList {
+				if subnetInfo.IId.SystemId == subnetIID.SystemId {
+					awsVpcInfo.SubnetInfoList[idx].Private = true
+				}
+			}
+		}
+	}
+
+	natGateways, errNat := VPCHandler.describeNATGateways(vpcIID.SystemId)
+	if errNat != nil {
+		return awsVpcInfo, errNat
+	}
+	for _, natGateway := range natGateways {
+		awsVpcInfo.NATGatewayInfoList = append(awsVpcInfo.NATGatewayInfoList, extractNATGatewayDescribeInfo(natGateway))
+	}
+
 	return awsVpcInfo, nil
 }
 
@@ -525,6 +627,11 @@ func ExtractVpcDescribeInfo(vpcInfo *ec2.Vpc) irs.VPCInfo {
 		//IsDefault: *vpcInfo.IsDefault,
 		//State:     *vpcInfo.State,
 	}
+	for _, ipv6Assoc := range vpcInfo.Ipv6CidrBlockAssociationSet {
+		if ipv6Assoc.Ipv6CidrBlock != nil && *ipv6Assoc.Ipv6CidrBlockState.State == ec2.VpcCidrBlockStateCodeAssociated {
+			awsVpcInfo.IPv6_CIDR = *ipv6Assoc.Ipv6CidrBlock
+		}
+	}
 
 	//Name은 Tag의 "Name" 속성에만 저장됨
 	//NameId는 전달할 필요가 없음.
@@ -592,6 +699,17 @@ func (VPCHandler *AwsVPCHandler) DeleteVPC(vpcIID irs.IID) (bool, error) {
 		return false, errVpcInfo
 	}
 
+	//=================
+	// NAT Gateway 삭제 (Subnet 삭제 전에 제거해야 함)
+	//=================
+	for _, curNat := range vpcInfo.NATGatewayInfoList {
+		cblogger.Infof("[%s] NAT Gateway 삭제", curNat.IId.SystemId)
+		_, errNat := VPCHandler.RemoveNATGateway(vpcIID, curNat.IId)
+		if errNat != nil {
+			return false, errNat
+		}
+	}
+
 	//=================
 	// Subnet삭제
 	//=================
@@ -627,6 +745,21 @@ func (VPCHandler *AwsVPCHandler) DeleteVPC(vpcIID irs.IID) (bool, error) {
 		//	cblogger.Info("라우팅 테이블에 추가한 0.0.0.0/0 IGW 라우터 삭제 완료")
 	}
 
+	// Private Subnet용으로 생성한 라우팅 테이블을 삭제함.
+	privateRouteTableId, errPrivate := VPCHandler.getPrivateRouteTable(vpcInfo.IId.SystemId, false)
+	if errPrivate != nil {
+		return false, errPrivate
+	}
+	if privateRouteTableId != "" {
+		_, errPrivate = VPCHandler.Client.DeleteRouteTable(&ec2.DeleteRouteTableInput{
+			RouteTableId: aws.String(privateRouteTableId),
+		})
+		if errPrivate != nil {
+			cblogger.Error(errPrivate)
+			return false, errPrivate
+		}
+	}
+
 	//VPC에 연결된 모든 IGW를 삭제함. (VPC에 할당된 모든 IGW조회후 삭제)
 	errIgw := VPCHandler.DeleteAllIGW(vpcInfo.IId.SystemId)
 	if errIgw != nil {
@@ -736,6 +869,13 @@ func (VPCHandler *AwsVPCHandler) DeleteRouteIGW(vpcId string) error {
 					aws.String(vpcId),
 				},
 			},
+			// Private 라우팅 테이블이 아닌 VPC의 기본(main) 라우팅 테이블만 조회
+			{
+				Name: aws.String("association.main"),
+				Values: []*string{
+					aws.String("true"),
+				},
+			},
 		},
 	}
 
@@ -1042,6 +1182,11 @@ func ExtractSubnetDescribeInfo(subnetInfo *ec2.Subnet) irs.SubnetInfo {
 		IPv4_CIDR: *subnetInfo.CidrBlock,
 		//Status:    *subnetInfo.State,
 	}
+	for _, ipv6Assoc := range subnetInfo.Ipv6CidrBlockAssociationSet {
+		if ipv6Assoc.Ipv6CidrBlock != nil && *ipv6Assoc.Ipv6CidrBlockState.State == ec2.SubnetCidrBlockStateCodeAssociated {
+			vNetworkInfo.IPv6_CIDR = *ipv6Assoc.Ipv6CidrBlock
+		}
+	}
 
 	/*
 		cblogger.Debug("Name Tag 찾기")
@@ -1068,6 +1213,25 @@ func ExtractSubnetDescribeInfo(subnetInfo *ec2.Subnet) irs.SubnetInfo {
 
 func (VPCHandler *AwsVPCHandler) AddSubnet(vpcIID irs.IID, subnetInfo irs.SubnetInfo) (irs.VPCInfo, error) {
 	cblogger.Infof("[%s] Subnet 추가 - CIDR : %s", subnetInfo.IId.NameId, subnetInfo.IPv4_CIDR)
+	vpcInfo, errVpc := VPCHandler.GetVPC(vpcIID)
+	if errVpc != nil {
+		return irs.VPCInfo{}, errVpc
+	}
+	if vpcInfo.IPv6_CIDR != "" {
+		usedIPv6CIDRList := []string{}
+		for _, curSubnet := range vpcInfo.SubnetInfoList {
+			if curSubnet.IPv6_CIDR != "" {
+				usedIPv6CIDRList = append(usedIPv6CIDRList, curSubnet.IPv6_CIDR)
+			}
+		}
+		var err error
+		subnetInfo.IPv6_CIDR, err = getSubnetIPv6CIDR(vpcInfo.IPv6_CIDR, subnetInfo.IPv6_CIDR, usedIPv6CIDRList)
+		if err != nil {
+			return irs.VPCInfo{}, err
+		}
+	} else if subnetInfo.IPv6_CIDR != "" {
+		return irs.VPCInfo{}, errors.New("AWS Cloud Driver: VPC " + vpcIID.SystemId + " is not a dual-stack VPC!")
+	}
 	resSubnet, errSubnet := VPCHandler.CreateSubnet(vpcIID.SystemId, subnetInfo)
 	if errSubnet != nil {
 		cblogger.Error(errSubnet)
@@ -1084,3 +1248,750 @@ func (VPCHandler *AwsVPCHandler) RemoveSubnet(vpcIID irs.IID, subnetIID irs.IID)
 	return VPCHandler.DeleteSubnet(subnetIID)
 	//return false, nil
 }
+
+//=================================
+// IPv6 (Dual-Stack)
+// VPC는 Amazon이 할당하는 /56 IPv6 CIDR을 사용하며, Subnet은 /56 중 사용하지 않는 /64를 순서대로 사용 함.
+//=================================
+
+// IPv6 CIDR은 CreateVpc() 이후 비동기로 연결되므로 연결 완료를 기다림.
+func (VPCHandler *AwsVPCHandler) waitVPCIPv6CIDR(vpcId string) (string, error) {
+	for i := 0; i < 30; i++ {
+		result, err := VPCHandler.Client.DescribeVpcs(&ec2.DescribeVpcsInput{VpcIds: []*string{aws.String(vpcId)}})
+		if err != nil {
+			cblogger.Error(err)
+			return "", err
+		}
+		if len(result.Vpcs) > 0 {
+			if ipv6Cidr := ExtractVpcDescribeInfo(result.Vpcs[0]).IPv6_CIDR; ipv6Cidr != "" {
+				return ipv6Cidr, nil
+			}
+		}
+		time.Sleep(time.Second * 2)
+	}
+	return "", errors.New("AWS Cloud Driver: IPv6 CIDR of VPC " + vpcId + " is not associated!")
+}
+
+// VPC의 기본 라우팅 테이블에 IGW에 대한 IPv6 라우팅(::/0) 정보를 추가 함.
+func (VPCHandler *AwsVPCHandler) createRouteIGWIPv6(vpcId string, igwId string) error {
+	routeTableId, errRoute := VPCHandler.GetDefaultRouteTable(vpcId)
+	if errRoute != nil {
+		return errRoute
+	}
+	_, err := VPCHandler.Client.CreateRoute(&ec2.CreateRouteInput{
+		DestinationIpv6CidrBlock: aws.String("::/0"),
+		GatewayId:                aws.String(igwId),
+		RouteTableId:             aws.String(routeTableId),
+	})
+	if err != nil {
+		cblogger.Errorf("RouteTable[%s]에 IGW[%s]에 대한 라우팅(::/0) 정보 추가 실패", routeTableId, igwId)
+		cblogger.Error(err)
+		return err
+	}
+	return nil
+}
+
+// 요청한 Subnet IPv6 CIDR이 없으면 VPC IPv6 CIDR에서 사용하지 않는 첫번째 /64를 사용 함.
+func getSubnetIPv6CIDR(vpcIPv6CIDR string, reqIPv6CIDR string, usedIPv6CIDRList []string) (string, error) {
+	if reqIPv6CIDR != "" && reqIPv6CIDR != irs.IPv6AutoCIDR {
+		return reqIPv6CIDR, nil
+	}
+
+	_, vpcNet, err := net.ParseCIDR(vpcIPv6CIDR)
+	if err != nil {
+		return "", err
+	}
+	vpcPrefixLen, _ := vpcNet.Mask.Size()
+	if vpcPrefixLen > 64 {
+		return "", fmt.Errorf("AWS Cloud Driver: VPC IPv6 CIDR %s is smaller than /64!", vpcIPv6CIDR)
+	}
+	// /64의 Network 주소는 상위 64bit 이므로 /56의 경우 하위 8bit가 Subnet 번호가 됨.
+	base := binary.BigEndian.Uint64(vpcNet.IP.To16()[:8])
+	for i := uint64(0); i < uint64(1)<<uint(64-vpcPrefixLen); i++ {
+		ip := make(net.IP, net.IPv6len)
+		binary.BigEndian.PutUint64(ip[:8], base+i)
+		candidate := (&net.IPNet{IP: ip, Mask: net.CIDRMask(64, 128)}).String()
+		used := false
+		for _, usedCIDR := range usedIPv6CIDRList {
+			if usedCIDR == candidate {
+				used = true
+				break
+			}
+		}
+		if !used {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("AWS Cloud Driver: no free /64 in VPC IPv6 CIDR %s!", vpcIPv6CIDR)
+}
+
+//=================================
+// NAT Gateway / Route
+// NAT Gateway IID.SystemId => NatGatewayId, IID.NameId => Name Tag
+// Private Subnet은 awsPrivateRouteTableTag 태그가 설정된 라우팅 테이블에 연결되며,
+// NAT Gateway가 추가되면 이 라우팅 테이블에 0.0.0.0/0 라우팅 정보가 추가 됨.
+//=================================
+const awsPrivateRouteTableTag = "CB-Spider-PrivateRouteTable"
+
+func (VPCHandler *AwsVPCHandler) AddNATGateway(vpcIID irs.IID, natReqInfo irs.NATGatewayReqInfo) (irs.VPCInfo, error) {
+	cblogger.Infof("[%s] VPC에 [%s] NAT Gateway 추가 - Subnet : [%s]", vpcIID.SystemId, natReqInfo.IId.NameId, natReqInfo.SubnetIID.SystemId)
+
+	natGateways, err := VPCHandler.describeNATGateways(vpcIID.SystemId)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+	if len(natGateways) > 0 {
+		return irs.VPCInfo{}, errors.New("VPC [" + vpcIID.SystemId + "] already has the NAT Gateway [" + aws.StringValue(natGateways[0].NatGatewayId) + "].")
+	}
+
+	// NAT Gateway용 EIP 할당
+	resultAddr, err := VPCHandler.Client.AllocateAddress(&ec2.AllocateAddressInput{
+		Domain: aws.String("vpc"),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCInfo{}, err
+	}
+	cblogger.Info(resultAddr)
+
+	// logger for HisCall
+	callogger := call.GetLogger("HISCALL")
+	callLogInfo := call.CLOUDLOGSCHEMA{
+		CloudOS:      call.AWS,
+		RegionZone:   VPCHandler.Region.Zone,
+		ResourceType: call.VPCSUBNET,
+		ResourceName: natReqInfo.IId.NameId,
+		CloudOSAPI:   "CreateNatGateway()",
+		ElapsedTime:  "",
+		ErrorMSG:     "",
+	}
+	callLogStart := call.Start()
+
+	result, err := VPCHandler.Client.CreateNatGateway(&ec2.CreateNatGatewayInput{
+		AllocationId: resultAddr.AllocationId,
+		SubnetId:     aws.String(natReqInfo.SubnetIID.SystemId),
+	})
+	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	if err != nil {
+		cblogger.Error(err)
+		callLogInfo.ErrorMSG = err.Error()
+		callogger.Info(call.String(callLogInfo))
+		VPCHandler.Client.ReleaseAddress(&ec2.ReleaseAddressInput{AllocationId: resultAddr.AllocationId})
+		return irs.VPCInfo{}, err
+	}
+	callogger.Info(call.String(callLogInfo))
+	natGatewayId := aws.StringValue(result.NatGateway.NatGatewayId)
+
+	//NAT Gateway Name Tag 설정
+	if SetNameTag(VPCHandler.Client, natGatewayId, natReqInfo.IId.NameId) {
+		cblogger.Infof("NAT Gateway에 %s Name 설정 성공", natReqInfo.IId.NameId)
+	} else {
+		cblogger.Errorf("NAT Gateway에 %s Name 설정 실패", natReqInfo.IId.NameId)
+	}
+
+	cblogger.Infof("NAT Gateway[%s]가 available 상태가 될 때까지 대기 합니다.", natGatewayId)
+	err = VPCHandler.Client.WaitUntilNatGatewayAvailable(&ec2.DescribeNatGatewaysInput{
+		NatGatewayIds: []*string{aws.String(natGatewayId)},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCInfo{}, err
+	}
+
+	// Private 라우팅 테이블의 기본 라우팅을 NAT Gateway로 설정
+	routeTableId, err := VPCHandler.getPrivateRouteTable(vpcIID.SystemId, true)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+	_, err = VPCHandler.Client.CreateRoute(&ec2.CreateRouteInput{
+		DestinationCidrBlock: aws.String("0.0.0.0/0"),
+		NatGatewayId:         aws.String(natGatewayId),
+		RouteTableId:         aws.String(routeTableId),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCInfo{}, err
+	}
+
+	return VPCHandler.GetVPC(vpcIID)
+}
+
+func (VPCHandler *AwsVPCHandler) RemoveNATGateway(vpcIID irs.IID, natGatewayIID irs.IID) (bool, error) {
+	cblogger.Infof("[%s] VPC의 [%s] NAT Gateway 삭제", vpcIID.SystemId, natGatewayIID.SystemId)
+
+	result, err := VPCHandler.Client.DescribeNatGateways(&ec2.DescribeNatGatewaysInput{
+		NatGatewayIds: []*string{aws.String(natGatewayIID.SystemId)},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	if len(result.NatGateways) < 1 {
+		return false, errors.New("NAT Gateway [" + natGatewayIID.SystemId + "] does not exist.")
+	}
+	natGateway := result.NatGateways[0]
+
+	// NAT Gateway를 향하는 Private 라우팅 테이블의 라우팅 정보 제거
+	routeTableId, err := VPCHandler.getPrivateRouteTable(vpcIID.SystemId, false)
+	if err != nil {
+		return false, err
+	}
+	if routeTableId != "" {
+		routeTables, err := VPCHandler.describeRouteTables(vpcIID.SystemId)
+		if err != nil {
+			return false, err
+		}
+		for _, routeTable := range routeTables {
+			if aws.StringValue(routeTable.RouteTableId) != routeTableId {
+				continue
+			}
+			for _, route := range routeTable.Routes {
+				if aws.StringValue(route.NatGatewayId) != natGatewayIID.SystemId {
+					continue
+				}
+				_, err := VPCHandler.Client.DeleteRoute(&ec2.DeleteRouteInput{
+					DestinationCidrBlock: route.DestinationCidrBlock,
+					RouteTableId:         routeTable.RouteTableId,
+				})
+				if err != nil {
+					cblogger.Error(err)
+					return false, err
+				}
+			}
+		}
+	}
+
+	// logger for HisCall
+	callogger := call.GetLogger("HISCALL")
+	callLogInfo := call.CLOUDLOGSCHEMA{
+		CloudOS:      call.AWS,
+		RegionZone:   VPCHandler.Region.Zone,
+		ResourceType: call.VPCSUBNET,
+		ResourceName: natGatewayIID.SystemId,
+		CloudOSAPI:   "DeleteNatGateway()",
+		ElapsedTime:  "",
+		ErrorMSG:     "",
+	}
+	callLogStart := call.Start()
+
+	_, err = VPCHandler.Client.DeleteNatGateway(&ec2.DeleteNatGatewayInput{
+		NatGatewayId: aws.String(natGatewayIID.SystemId),
+	})
+	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	if err != nil {
+		cblogger.Error(err)
+		callLogInfo.ErrorMSG = err.Error()
+		callogger.Info(call.String(callLogInfo))
+		return false, err
+	}
+	callogger.Info(call.String(callLogInfo))
+
+	// EIP는 NAT Gateway가 삭제된 후에 반납할 수 있음.
+	cblogger.Infof("NAT Gateway[%s]가 deleted 상태가 될 때까지 대기 합니다.", natGatewayIID.SystemId)
+	for i := 0; ; i++ {
+		resultWait, err := VPCHandler.Client.DescribeNatGateways(&ec2.DescribeNatGatewaysInput{
+			NatGatewayIds: []*string{aws.String(natGatewayIID.SystemId)},
+		})
+		if err != nil {
+			cblogger.Error(err)
+			return false, err
+		}
+		if len(resultWait.NatGateways) < 1 || aws.StringValue(resultWait.NatGateways[0].State) == "deleted" {
+			break
+		}
+		if i >= 60 {
+			return false, errors.New("NAT Gateway [" + natGatewayIID.SystemId + "] was not deleted in time.")
+		}
+		time.Sleep(5 * time.Second)
+	}
+	for _, address := range natGateway.NatGatewayAddresses {
+		if address.AllocationId == nil {
+			continue
+		}
+		_, err := VPCHandler.Client.ReleaseAddress(&ec2.ReleaseAddressInput{AllocationId: address.AllocationId})
+		if err != nil {
+			cblogger.Error(err)
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func (VPCHandler *AwsVPCHandler) AddRoute(vpcIID irs.IID, subnetIID irs.IID, routeInfo irs.RouteInfo) (irs.VPCInfo, error) {
+	cblogger.Infof("[%s] Subnet에 라우팅 추가 - %s => %s [%s]", subnetIID.SystemId, routeInfo.DestinationCIDR, routeInfo.TargetType, routeInfo.TargetIID.SystemId)
+
+	routeTableId, err := VPCHandler.getSubnetRouteTable(vpcIID.SystemId, subnetIID.SystemId)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+
+	input := &ec2.CreateRouteInput{
+		DestinationCidrBlock: aws.String(routeInfo.DestinationCIDR),
+		RouteTableId:         aws.String(routeTableId),
+	}
+	switch routeInfo.TargetType {
+	case irs.RouteInternetGateway:
+		igwId, err := VPCHandler.getInternetGatewayId(vpcIID.SystemId)
+		if err != nil {
+			return irs.VPCInfo{}, err
+		}
+		input.GatewayId = aws.String(igwId)
+	case irs.RouteNATGateway:
+		input.NatGatewayId = aws.String(routeInfo.TargetIID.SystemId)
+	case irs.RouteVPCPeering:
+		input.VpcPeeringConnectionId = aws.String(routeInfo.TargetIID.SystemId)
+	default:
+		return irs.VPCInfo{}, errors.New("Route Target Type [" + string(routeInfo.TargetType) + "] is not supported.")
+	}
+
+	_, err = VPCHandler.Client.CreateRoute(input)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCInfo{}, err
+	}
+
+	return VPCHandler.GetVPC(vpcIID)
+}
+
+func (VPCHandler *AwsVPCHandler) RemoveRoute(vpcIID irs.IID, subnetIID irs.IID, routeInfo irs.RouteInfo) (bool, error) {
+	cblogger.Infof("[%s] Subnet의 라우팅 삭제 - %s", subnetIID.SystemId, routeInfo.DestinationCIDR)
+
+	routeTableId, err := VPCHandler.getSubnetRouteTable(vpcIID.SystemId, subnetIID.SystemId)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = VPCHandler.Client.DeleteRoute(&ec2.DeleteRouteInput{
+		DestinationCidrBlock: aws.String(routeInfo.DestinationCIDR),
+		RouteTableId:         aws.String(routeTableId),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// VPC의 Private 라우팅 테이블 ID를 조회함. 없는 경우 create가 true이면 생성하고, false이면 ""를 리턴함.
+func (VPCHandler *AwsVPCHandler) getPrivateRouteTable(vpcId string, create bool) (string, error) {
+	result, err := VPCHandler.Client.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []*string{aws.String(vpcId)},
+			},
+			{
+				Name:   aws.String("tag-key"),
+				Values: []*string{aws.String(awsPrivateRouteTableTag)},
+			},
+		},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+	if len(result.RouteTables) > 0 {
+		return aws.StringValue(result.RouteTables[0].RouteTableId), nil
+	}
+	if !create {
+		return "", nil
+	}
+
+	cblogger.Infof("VPC[%s]에 Private 라우팅 테이블을 생성 합니다.", vpcId)
+	resultCreate, err := VPCHandler.Client.CreateRouteTable(&ec2.CreateRouteTableInput{
+		VpcId: aws.String(vpcId),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+	routeTableId := aws.StringValue(resultCreate.RouteTable.RouteTableId)
+
+	_, err = VPCHandler.Client.CreateTags(&ec2.CreateTagsInput{
+		Resources: []*string{aws.String(routeTableId)},
+		Tags: []*ec2.Tag{
+			{
+				Key:   aws.String(awsPrivateRouteTableTag),
+				Value: aws.String("true"),
+			},
+		},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		VPCHandler.Client.DeleteRouteTable(&ec2.DeleteRouteTableInput{RouteTableId: aws.String(routeTableId)})
+		return "", err
+	}
+	return routeTableId, nil
+}
+
+// Subnet에 명시적으로 연결된 라우팅 테이블 ID를 조회함. 없는 경우 VPC의 기본 라우팅 테이블을 리턴함.
+func (VPCHandler *AwsVPCHandler) getSubnetRouteTable(vpcId string, subnetId string) (string, error) {
+	routeTables, err := VPCHandler.describeRouteTables(vpcId)
+	if err != nil {
+		return "", err
+	}
+	for _, routeTable := range routeTables {
+		for _, association := range routeTable.Associations {
+			if aws.StringValue(association.SubnetId) == subnetId {
+				return aws.StringValue(routeTable.RouteTableId), nil
+			}
+		}
+	}
+	return VPCHandler.GetDefaultRouteTable(vpcId)
+}
+
+func (VPCHandler *AwsVPCHandler) getInternetGatewayId(vpcId string) (string, error) {
+	result, err := VPCHandler.Client.DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("attachment.vpc-id"),
+				Values: []*string{aws.String(vpcId)},
+			},
+		},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+	if len(result.InternetGateways) < 1 {
+		return "", errors.New("VPC [" + vpcId + "] has no Internet Gateway.")
+	}
+	return aws.StringValue(result.InternetGateways[0].InternetGatewayId), nil
+}
+
+// 삭제되지 않은 VPC의 NAT Gateway 목록을 조회함.
+func (VPCHandler *AwsVPCHandler) describeNATGateways(vpcId string) ([]*ec2.NatGateway, error) {
+	result, err := VPCHandler.Client.DescribeNatGateways(&ec2.DescribeNatGatewaysInput{
+		Filter: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []*string{aws.String(vpcId)},
+			},
+			{
+				Name:   aws.String("state"),
+				Values: []*string{aws.String("pending"), aws.String("available")},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.NatGateways, nil
+}
+
+func isPrivateRouteTable(routeTable *ec2.RouteTable) bool {
+	for _, t := range routeTable.Tags {
+		if aws.StringValue(t.Key) == awsPrivateRouteTableTag {
+			return true
+		}
+	}
+	return false
+}
+
+func extractNATGatewayDescribeInfo(natGateway *ec2.NatGateway) irs.NATGatewayInfo {
+	natGatewayInfo := irs.NATGatewayInfo{
+		IId:       irs.IID{SystemId: aws.StringValue(natGateway.NatGatewayId)},
+		SubnetIID: irs.IID{SystemId: aws.StringValue(natGateway.SubnetId)},
+	}
+
+	for _, t := range natGateway.Tags {
+		if aws.StringValue(t.Key) == "Name" {
+			natGatewayInfo.IId.NameId = aws.StringValue(t.Value)
+			break
+		}
+	}
+	if len(natGateway.NatGatewayAddresses) > 0 {
+		natGatewayInfo.PublicIP = aws.StringValue(natGateway.NatGatewayAddresses[0].PublicIp)
+	}
+
+	natGatewayInfo.KeyValueList = []irs.KeyValue{
+		{Key: "State", Value: aws.StringValue(natGateway.State)},
+	}
+	return natGatewayInfo
+}
+
+func extractRouteTableDescribeInfo(routeTable *ec2.RouteTable) irs.RouteTableInfo {
+	routeTableInfo := irs.RouteTableInfo{
+		IId: irs.IID{SystemId: aws.StringValue(routeTable.RouteTableId)},
+	}
+
+	main := false
+	for _, association := range routeTable.Associations {
+		if aws.BoolValue(association.Main) {
+			main = true
+		}
+		if association.SubnetId != nil {
+			routeTableInfo.SubnetIIDs = append(routeTableInfo.SubnetIIDs, irs.IID{SystemId: aws.StringValue(association.SubnetId)})
+		}
+	}
+
+	for _, route := range routeTable.Routes {
+		routeInfo := irs.RouteInfo{DestinationCIDR: aws.StringValue(route.DestinationCidrBlock)}
+		switch {
+		case route.NatGatewayId != nil:
+			routeInfo.TargetType = irs.RouteNATGateway
+			routeInfo.TargetIID = irs.IID{SystemId: aws.StringValue(route.NatGatewayId)}
+		case route.VpcPeeringConnectionId != nil:
+			routeInfo.TargetType = irs.RouteVPCPeering
+			routeInfo.TargetIID = irs.IID{SystemId: aws.StringValue(route.VpcPeeringConnectionId)}
+		case strings.HasPrefix(aws.StringValue(route.GatewayId), "igw-"):
+			routeInfo.TargetType = irs.RouteInternetGateway
+			routeInfo.TargetIID = irs.IID{SystemId: aws.StringValue(route.GatewayId)}
+		default:
+			// VPC 내부(local) 라우팅 등은 제외
+			continue
+		}
+		routeTableInfo.RouteList = append(routeTableInfo.RouteList, routeInfo)
+	}
+
+	routeTableInfo.KeyValueList = []irs.KeyValue{
+		{Key: "Main", Value: strconv.FormatBool(main)},
+		{Key: "Private", Value: strconv.FormatBool(isPrivateRouteTable(routeTable))},
+	}
+	return routeTableInfo
+}
+
+//=================================
+// VPC Peering
+// IID.SystemId => VpcPeeringConnectionId, IID.NameId => Name Tag
+//=================================
+func (VPCHandler *AwsVPCHandler) RequestVPCPeering(peeringReqInfo irs.VPCPeeringReqInfo) (irs.VPCPeeringInfo, error) {
+	cblogger.Infof("VPC Peering 요청 - Requester : [%s] / Accepter : [%s]", peeringReqInfo.RequesterVPCIID.SystemId, peeringReqInfo.AccepterVPCIID.SystemId)
+
+	result, err := VPCHandler.Client.CreateVpcPeeringConnection(&ec2.CreateVpcPeeringConnectionInput{
+		VpcId:     aws.String(peeringReqInfo.RequesterVPCIID.SystemId),
+		PeerVpcId: aws.String(peeringReqInfo.AccepterVPCIID.SystemId),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCPeeringInfo{}, err
+	}
+	peeringId := *result.VpcPeeringConnection.VpcPeeringConnectionId
+	cblogger.Infof("VPC Peering 요청 성공 - Id : [%s]", peeringId)
+
+	// Tag에 Name 설정
+	if !SetNameTag(VPCHandler.Client, peeringId, peeringReqInfo.IId.NameId) {
+		// rollback
+		VPCHandler.DeleteVPCPeering(irs.IID{SystemId: peeringId})
+		return irs.VPCPeeringInfo{}, errors.New("VPC Peering [" + peeringId + "]에 [" + peeringReqInfo.IId.NameId + "] Name Tag 설정 실패")
+	}
+
+	return VPCHandler.GetVPCPeering(irs.IID{NameId: peeringReqInfo.IId.NameId, SystemId: peeringId})
+}
+
+func (VPCHandler *AwsVPCHandler) AcceptVPCPeering(peeringIID irs.IID) (irs.VPCPeeringInfo, error) {
+	cblogger.Info("peeringIID : ", peeringIID)
+
+	_, err := VPCHandler.Client.AcceptVpcPeeringConnection(&ec2.AcceptVpcPeeringConnectionInput{
+		VpcPeeringConnectionId: aws.String(peeringIID.SystemId),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCPeeringInfo{}, err
+	}
+
+	peering, err := VPCHandler.describeVPCPeering(peeringIID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCPeeringInfo{}, err
+	}
+
+	// 양쪽 VPC의 라우팅 테이블에 상대 VPC CIDR에 대한 라우팅 정보를 추가함
+	requester := peering.RequesterVpcInfo
+	accepter := peering.AccepterVpcInfo
+	err = VPCHandler.createPeeringRoutes(*requester.VpcId, *accepter.CidrBlock, peeringIID.SystemId)
+	if err == nil {
+		err = VPCHandler.createPeeringRoutes(*accepter.VpcId, *requester.CidrBlock, peeringIID.SystemId)
+	}
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCPeeringInfo{}, err
+	}
+
+	return VPCHandler.GetVPCPeering(peeringIID)
+}
+
+func (VPCHandler *AwsVPCHandler) ListVPCPeering() ([]*irs.VPCPeeringInfo, error) {
+	cblogger.Debug("Start ListVPCPeering()")
+
+	result, err := VPCHandler.Client.DescribeVpcPeeringConnections(&ec2.DescribeVpcPeeringConnectionsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("status-code"),
+				Values: aws.StringSlice([]string{"pending-acceptance", "provisioning", "active"}),
+			},
+		},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var peeringList []*irs.VPCPeeringInfo
+	for _, peering := range result.VpcPeeringConnections {
+		peeringInfo := extractVPCPeeringDescribeInfo(peering)
+		peeringList = append(peeringList, &peeringInfo)
+	}
+	return peeringList, nil
+}
+
+func (VPCHandler *AwsVPCHandler) GetVPCPeering(peeringIID irs.IID) (irs.VPCPeeringInfo, error) {
+	cblogger.Info("peeringIID : ", peeringIID)
+
+	peering, err := VPCHandler.describeVPCPeering(peeringIID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCPeeringInfo{}, err
+	}
+	return extractVPCPeeringDescribeInfo(peering), nil
+}
+
+func (VPCHandler *AwsVPCHandler) DeleteVPCPeering(peeringIID irs.IID) (bool, error) {
+	cblogger.Info("peeringIID : ", peeringIID)
+
+	peering, err := VPCHandler.describeVPCPeering(peeringIID)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+
+	// 라우팅 정보를 먼저 제거함
+	for _, vpcInfo := range []*ec2.VpcPeeringConnectionVpcInfo{peering.RequesterVpcInfo, peering.AccepterVpcInfo} {
+		if vpcInfo == nil || vpcInfo.VpcId == nil {
+			continue
+		}
+		err = VPCHandler.deletePeeringRoutes(*vpcInfo.VpcId, peeringIID.SystemId)
+		if err != nil {
+			cblogger.Error(err)
+			return false, err
+		}
+	}
+
+	_, err = VPCHandler.Client.DeleteVpcPeeringConnection(&ec2.DeleteVpcPeeringConnectionInput{
+		VpcPeeringConnectionId: aws.String(peeringIID.SystemId),
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (VPCHandler *AwsVPCHandler) describeVPCPeering(peeringIID irs.IID) (*ec2.VpcPeeringConnection, error) {
+	result, err := VPCHandler.Client.DescribeVpcPeeringConnections(&ec2.DescribeVpcPeeringConnectionsInput{
+		VpcPeeringConnectionIds: []*string{aws.String(peeringIID.SystemId)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(result.VpcPeeringConnections) < 1 {
+		return nil, errors.New("VPC Peering [" + peeringIID.SystemId + "] does not exist.")
+	}
+	return result.VpcPeeringConnections[0], nil
+}
+
+// VPC의 모든 라우팅 테이블에 Peering 대상 CIDR 라우팅 정보를 추가함
+func (VPCHandler *AwsVPCHandler) createPeeringRoutes(vpcId string, peerCidr string, peeringId string) error {
+	routeTables, err := VPCHandler.describeRouteTables(vpcId)
+	if err != nil {
+		return err
+	}
+
+	for _, routeTable := range routeTables {
+		cblogger.Infof("RouteTable[%s]에 Peering[%s]에 대한 라우팅(%s) 정보를 추가 합니다.", *routeTable.RouteTableId, peeringId, peerCidr)
+		_, err := VPCHandler.Client.CreateRoute(&ec2.CreateRouteInput{
+			DestinationCidrBlock:   aws.String(peerCidr),
+			VpcPeeringConnectionId: aws.String(peeringId),
+			RouteTableId:           routeTable.RouteTableId,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VPC의 라우팅 테이블에서 Peering을 향하는 라우팅 정보를 제거함
+func (VPCHandler *AwsVPCHandler) deletePeeringRoutes(vpcId string, peeringId string) error {
+	routeTables, err := VPCHandler.describeRouteTables(vpcId)
+	if err != nil {
+		return err
+	}
+
+	for _, routeTable := range routeTables {
+		for _, route := range routeTable.Routes {
+			if aws.StringValue(route.VpcPeeringConnectionId) != peeringId {
+				continue
+			}
+			cblogger.Infof("RouteTable[%s]에서 Peering[%s]에 대한 라우팅(%s) 정보를 제거 합니다.", *routeTable.RouteTableId, peeringId, aws.StringValue(route.DestinationCidrBlock))
+			_, err := VPCHandler.Client.DeleteRoute(&ec2.DeleteRouteInput{
+				DestinationCidrBlock: route.DestinationCidrBlock,
+				RouteTableId:         routeTable.RouteTableId,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (VPCHandler *AwsVPCHandler) describeRouteTables(vpcId string) ([]*ec2.RouteTable, error) {
+	result, err := VPCHandler.Client.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: []*string{aws.String(vpcId)},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.RouteTables, nil
+}
+
+func extractVPCPeeringDescribeInfo(peering *ec2.VpcPeeringConnection) irs.VPCPeeringInfo {
+	peeringInfo := irs.VPCPeeringInfo{
+		IId:    irs.IID{SystemId: aws.StringValue(peering.VpcPeeringConnectionId)},
+		Status: irs.VPCPeeringFailed,
+	}
+
+	for _, t := range peering.Tags {
+		if aws.StringValue(t.Key) == "Name" {
+			peeringInfo.IId.NameId = aws.StringValue(t.Value)
+			break
+		}
+	}
+
+	if peering.RequesterVpcInfo != nil {
+		peeringInfo.RequesterVPCIID = irs.IID{SystemId: aws.StringValue(peering.RequesterVpcInfo.VpcId)}
+	}
+	if peering.AccepterVpcInfo != nil {
+		peeringInfo.AccepterVPCIID = irs.IID{SystemId: aws.StringValue(peering.AccepterVpcInfo.VpcId)}
+	}
+
+	statusCode := ""
+	if peering.Status != nil {
+		statusCode = aws.StringValue(peering.Status.Code)
+	}
+	switch statusCode {
+	case "initiating-request", "pending-acceptance", "provisioning":
+		peeringInfo.Status = irs.VPCPeeringPending
+	case "active":
+		peeringInfo.Status = irs.VPCPeeringActive
+	}
+
+	peeringInfo.KeyValueList = []irs.KeyValue{
+		{Key: "StatusCode", Value: statusCode},
+	}
+	if peering.RequesterVpcInfo != nil {
+		peeringInfo.KeyValueList = append(peeringInfo.KeyValueList, irs.KeyValue{Key: "RequesterCidrBlock", Value: aws.StringValue(peering.RequesterVpcInfo.CidrBlock)})
+	}
+	if peering.AccepterVpcInfo != nil {
+		peeringInfo.KeyValueList = append(peeringInfo.KeyValueList, irs.KeyValue{Key: "AccepterCidrBlock", Value: aws.StringValue(peering.AccepterVpcInfo.CidrBlock)})
+	}
+
+	return peeringInfo
+}
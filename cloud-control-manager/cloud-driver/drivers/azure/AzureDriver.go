@@ -12,26 +12,33 @@ package azure
 
 import (
 	"context"
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	network2019 "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-06-01/subscriptions"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/Azure/go-autorest/autorest/to"
 	azcon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/azure/connect"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	"time"
 )
 
 type AzureDriver struct{}
 
 func (AzureDriver) GetDriverVersion() string {
-	return "AZURE DRIVER Version 1.0"
+	return idrv.DriverVersion("AZURE DRIVER Version 1.0")
 }
 
 func (AzureDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	var drvCapabilityInfo idrv.DriverCapabilityInfo
 
+	drvCapabilityInfo.FIXED_SUBNET_CIDR = true
+	drvCapabilityInfo.VPC_CIDR = true
+
 	drvCapabilityInfo.ImageHandler = true
 	drvCapabilityInfo.VPCHandler = true
 	drvCapabilityInfo.SecurityHandler = true
@@ -40,10 +47,65 @@ func (AzureDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	drvCapabilityInfo.PublicIPHandler = false
 	drvCapabilityInfo.VMHandler = true
 	drvCapabilityInfo.VMSpecHandler = true
+	drvCapabilityInfo.NLBHandler = false
+	drvCapabilityInfo.DNSHandler = false
+	drvCapabilityInfo.BucketHandler = true
+	drvCapabilityInfo.RegionZoneHandler = true
+
+	drvCapabilityInfo.VPC_IPv6_CIDR = true
+	drvCapabilityInfo.SECURITY_IPv6_RULE = true
+	// false: a secondary NIC is a separate NIC resource referenced with the Primary flag and limited by the VM size,
+	// but the VMHandler creates and deletes only the primary NIC. a follow-up of VM_MULTI_NIC, see CHANGELOG.md.
+	drvCapabilityInfo.VM_MULTI_NIC = false
+	drvCapabilityInfo.SUBNET_ADD_REMOVE = true
+	drvCapabilityInfo.VM_SUSPEND_RESUME = true
+	drvCapabilityInfo.VM_REBOOT = true
 
 	return drvCapabilityInfo
 }
 
+// key names of the region and the zone in the key-value list of the region.
+func (AzureDriver) GetRegionZoneKeyName() (string, string) {
+	return "location", ""
+}
+
+// translates the key-value lists of the credential and the region into ConnectionInfo.
+func (driver AzureDriver) GetConnectionInfo(credentialKeyValueList []irs.KeyValue, regionKeyValueList []irs.KeyValue) (idrv.ConnectionInfo, error) {
+	regionKey, _ := driver.GetRegionZoneKeyName()
+
+	connectionInfo := idrv.ConnectionInfo{
+		CredentialInfo: idrv.CredentialInfo{
+			ClientId:       idrv.GetKeyValue(credentialKeyValueList, "ClientId"),
+			ClientSecret:   idrv.GetKeyValue(credentialKeyValueList, "ClientSecret"),
+			TenantId:       idrv.GetKeyValue(credentialKeyValueList, "TenantId"),
+			SubscriptionId: idrv.GetKeyValue(credentialKeyValueList, "SubscriptionId"),
+			KeyValueList:   credentialKeyValueList,
+		},
+		RegionInfo: idrv.RegionInfo{
+			Region:        idrv.GetKeyValue(regionKeyValueList, regionKey),
+			ResourceGroup: idrv.GetKeyValue(regionKeyValueList, "ResourceGroup"),
+			KeyValueList:  regionKeyValueList,
+		},
+	}
+	return connectionInfo, nil
+}
+
+// keys of the credential and the region read by GetConnectionInfo().
+func (AzureDriver) GetConnectionSchema() idrv.ConnectionSchema {
+	return idrv.ConnectionSchema{
+		Credential: []idrv.KeySchema{
+			{Key: "ClientId", Description: "Application(client) ID of the Service Principal", Required: true},
+			{Key: "ClientSecret", Description: "Client Secret of the Service Principal", Required: true, Secret: true},
+			{Key: "TenantId", Description: "Directory(tenant) ID", Required: true},
+			{Key: "SubscriptionId", Description: "Subscription ID", Required: true},
+		},
+		Region: []idrv.KeySchema{
+			{Key: "location", Description: "ex) koreacentral", Required: true},
+			{Key: "ResourceGroup", Description: "Resource Group of all resources", Required: true},
+		},
+	}
+}
+
 func (driver *AzureDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.CloudConnection, error) {
 	// 1. get info of credential and region for Test A Cloud from connectionInfo.
 	// 2. create a client object(or service  object) of Test A Cloud with credential info.
@@ -100,22 +162,47 @@ func (driver *AzureDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (ico
 	if err != nil {
 		return nil, err
 	}
+	Ctx, StorageAccountClient, err := getStorageAccountClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, NatGatewayClient, err := getNatGatewayClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, RouteTableClient, err := getRouteTableClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, RouteSubnetClient, err := getRouteSubnetClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+	Ctx, SubscriptionClient, err := getSubscriptionClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
 
 	iConn := azcon.AzureCloudConnection{
-		CredentialInfo:      connectionInfo.CredentialInfo,
-		Region:              connectionInfo.RegionInfo,
-		Ctx:                 Ctx,
-		VMClient:            VMClient,
-		ImageClient:         imageClient,
-		PublicIPClient:      publicIPClient,
-		SecurityGroupClient: sgClient,
-		VNetClient:          VNetClient,
-		VNicClient:          vNicClient,
-		IPConfigClient:      IPConfigClient,
-		SubnetClient:        SubnetClient,
-		VMImageClient:       VMImageClient,
-		DiskClient:          DiskClient,
-		VmSpecClient:        VmSpecClient,
+		CredentialInfo:       connectionInfo.CredentialInfo,
+		Region:               connectionInfo.RegionInfo,
+		Ctx:                  Ctx,
+		VMClient:             VMClient,
+		ImageClient:          imageClient,
+		PublicIPClient:       publicIPClient,
+		SecurityGroupClient:  sgClient,
+		VNetClient:           VNetClient,
+		VNicClient:           vNicClient,
+		IPConfigClient:       IPConfigClient,
+		SubnetClient:         SubnetClient,
+		VMImageClient:        VMImageClient,
+		DiskClient:           DiskClient,
+		VmSpecClient:         VmSpecClient,
+		StorageAccountClient: StorageAccountClient,
+		NatGatewayClient:     NatGatewayClient,
+		RouteTableClient:     RouteTableClient,
+		RouteSubnetClient:    RouteSubnetClient,
+		SubscriptionClient:   SubscriptionClient,
 	}
 	return &iConn, nil
 }
@@ -301,4 +388,76 @@ func getVmSpecClient(credential idrv.CredentialInfo) (context.Context, *compute.
 	return ctx, &vmSpecClient, nil
 }
 
+func getStorageAccountClient(credential idrv.CredentialInfo) (context.Context, *storage.AccountsClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storageAccountClient := storage.NewAccountsClient(credential.SubscriptionId)
+	storageAccountClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &storageAccountClient, nil
+}
+
 var CloudDriver AzureDriver
+
+func getNatGatewayClient(credential idrv.CredentialInfo) (context.Context, *network2019.NatGatewaysClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	natGatewayClient := network2019.NewNatGatewaysClient(credential.SubscriptionId)
+	natGatewayClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &natGatewayClient, nil
+}
+
+func getRouteTableClient(credential idrv.CredentialInfo) (context.Context, *network2019.RouteTablesClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	routeTableClient := network2019.NewRouteTablesClient(credential.SubscriptionId)
+	routeTableClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &routeTableClient, nil
+}
+
+// NAT Gateway, 라우팅 테이블 연결을 위한 network 2019-06-01 Subnet Client
+func getRouteSubnetClient(credential idrv.CredentialInfo) (context.Context, *network2019.SubnetsClient, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subnetClient := network2019.NewSubnetsClient(credential.SubscriptionId)
+	subnetClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &subnetClient, nil
+}
+
+// Location 목록 조회를 위한 Subscription Client
+func getSubscriptionClient(credential idrv.CredentialInfo) (context.Context, *subscriptions.Client, error) {
+	config := auth.NewClientCredentialsConfig(credential.ClientId, credential.ClientSecret, credential.TenantId)
+	authorizer, err := config.Authorizer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subscriptionClient := subscriptions.NewClient()
+	subscriptionClient.Authorizer = authorizer
+	ctx, _ := context.WithTimeout(context.Background(), 600*time.Second)
+
+	return ctx, &subscriptionClient, nil
+}
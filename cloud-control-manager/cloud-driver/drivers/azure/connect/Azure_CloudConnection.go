@@ -11,9 +11,13 @@
 package connect
 
 import (
+	"errors"
 	"context"
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	network2019 "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-06-01/subscriptions"
 	cblog "github.com/cloud-barista/cb-log"
 	azrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/azure/resources"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
@@ -29,20 +33,25 @@ func init() {
 }
 
 type AzureCloudConnection struct {
-	CredentialInfo      idrv.CredentialInfo
-	Region              idrv.RegionInfo
-	Ctx                 context.Context
-	VMClient            *compute.VirtualMachinesClient
-	ImageClient         *compute.ImagesClient
-	VMImageClient       *compute.VirtualMachineImagesClient
-	PublicIPClient      *network.PublicIPAddressesClient
-	SecurityGroupClient *network.SecurityGroupsClient
-	VNetClient          *network.VirtualNetworksClient
-	VNicClient          *network.InterfacesClient
-	IPConfigClient      *network.InterfaceIPConfigurationsClient
-	SubnetClient        *network.SubnetsClient
-	DiskClient          *compute.DisksClient
-	VmSpecClient        *compute.VirtualMachineSizesClient
+	CredentialInfo       idrv.CredentialInfo
+	Region               idrv.RegionInfo
+	Ctx                  context.Context
+	VMClient             *compute.VirtualMachinesClient
+	ImageClient          *compute.ImagesClient
+	VMImageClient        *compute.VirtualMachineImagesClient
+	PublicIPClient       *network.PublicIPAddressesClient
+	SecurityGroupClient  *network.SecurityGroupsClient
+	VNetClient           *network.VirtualNetworksClient
+	VNicClient           *network.InterfacesClient
+	IPConfigClient       *network.InterfaceIPConfigurationsClient
+	SubnetClient         *network.SubnetsClient
+	DiskClient           *compute.DisksClient
+	VmSpecClient         *compute.VirtualMachineSizesClient
+	StorageAccountClient *storage.AccountsClient
+	NatGatewayClient     *network2019.NatGatewaysClient
+	RouteTableClient     *network2019.RouteTablesClient
+	RouteSubnetClient    *network2019.SubnetsClient
+	SubscriptionClient   *subscriptions.Client
 }
 
 func (cloudConn *AzureCloudConnection) CreateImageHandler() (irs.ImageHandler, error) {
@@ -59,7 +68,16 @@ func (cloudConn *AzureCloudConnection) CreateImageHandler() (irs.ImageHandler, e
 
 func (cloudConn *AzureCloudConnection) CreateVPCHandler() (irs.VPCHandler, error) {
 	cblogger.Info("Azure Cloud Driver: called CreateVPCHandler()!")
-	vpcHandler := azrs.AzureVPCHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.VNetClient, cloudConn.SubnetClient}
+	vpcHandler := azrs.AzureVPCHandler{
+		Region:            cloudConn.Region,
+		Ctx:               cloudConn.Ctx,
+		Client:            cloudConn.VNetClient,
+		SubnetClient:      cloudConn.SubnetClient,
+		NatGatewayClient:  cloudConn.NatGatewayClient,
+		RouteTableClient:  cloudConn.RouteTableClient,
+		RouteSubnetClient: cloudConn.RouteSubnetClient,
+		PublicIPClient:    cloudConn.PublicIPClient,
+	}
 	return &vpcHandler, nil
 }
 
@@ -90,14 +108,15 @@ func (cloudConn *AzureCloudConnection) CreateKeyPairHandler() (irs.KeyPairHandle
 func (cloudConn *AzureCloudConnection) CreateVMHandler() (irs.VMHandler, error) {
 	cblogger.Info("Azure Cloud Driver: called CreateVMHandler()!")
 	vmHandler := azrs.AzureVMHandler{
-		CredentialInfo: cloudConn.CredentialInfo,
-		Region:         cloudConn.Region,
-		Ctx:            cloudConn.Ctx,
-		Client:         cloudConn.VMClient,
-		SubnetClient:   cloudConn.SubnetClient,
-		NicClient:      cloudConn.VNicClient,
-		PublicIPClient: cloudConn.PublicIPClient,
-		DiskClient:     cloudConn.DiskClient,
+		CredentialInfo:       cloudConn.CredentialInfo,
+		Region:               cloudConn.Region,
+		Ctx:                  cloudConn.Ctx,
+		Client:               cloudConn.VMClient,
+		SubnetClient:         cloudConn.SubnetClient,
+		NicClient:            cloudConn.VNicClient,
+		PublicIPClient:       cloudConn.PublicIPClient,
+		DiskClient:           cloudConn.DiskClient,
+		StorageAccountClient: cloudConn.StorageAccountClient,
 	}
 	return &vmHandler, nil
 }
@@ -108,6 +127,43 @@ func (cloudConn *AzureCloudConnection) CreateVMSpecHandler() (irs.VMSpecHandler,
 	return &vmSpecHandler, nil
 }
 
+func (cloudConn *AzureCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	cblogger.Error("Azure Cloud Driver: called CreatePublicIPHandler(), but not supported!")
+	return nil, errors.New("Azure Cloud Driver: CreatePublicIPHandler() is not supported!")
+}
+
+func (cloudConn *AzureCloudConnection) CreateNLBHandler() (irs.NLBHandler, error) {
+	cblogger.Error("Azure Cloud Driver: called CreateNLBHandler(), but not supported!")
+	return nil, errors.New("Azure Cloud Driver: CreateNLBHandler() is not supported!")
+}
+
+func (cloudConn *AzureCloudConnection) CreateDNSHandler() (irs.DNSHandler, error) {
+	cblogger.Error("Azure Cloud Driver: called CreateDNSHandler(), but not supported!")
+	return nil, errors.New("Azure Cloud Driver: CreateDNSHandler() is not supported!")
+}
+
+func (cloudConn *AzureCloudConnection) CreateBucketHandler() (irs.BucketHandler, error) {
+	cblogger.Info("Azure Cloud Driver: called CreateBucketHandler()!")
+	bucketHandler := azrs.AzureBucketHandler{
+		CredentialInfo:       cloudConn.CredentialInfo,
+		Region:               cloudConn.Region,
+		Ctx:                  cloudConn.Ctx,
+		StorageAccountClient: cloudConn.StorageAccountClient,
+	}
+	return &bucketHandler, nil
+}
+
+func (cloudConn *AzureCloudConnection) CreateRegionZoneHandler() (irs.RegionZoneHandler, error) {
+	cblogger.Info("Azure Cloud Driver: called CreateRegionZoneHandler()!")
+	regionZoneHandler := azrs.AzureRegionZoneHandler{
+		CredentialInfo:     cloudConn.CredentialInfo,
+		Region:             cloudConn.Region,
+		Ctx:                cloudConn.Ctx,
+		SubscriptionClient: cloudConn.SubscriptionClient,
+	}
+	return &regionZoneHandler, nil
+}
+
 func (cloudConn *AzureCloudConnection) IsConnected() (bool, error) {
 	return true, nil
 }
@@ -0,0 +1,374 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is a Cloud Driver Example for PoC Test.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	blob "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest/to"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// Put Block 단위 크기, Block은 최대 50,000개까지 가능 함.
+const azureBlockSize = 4 * 1024 * 1024
+
+// Bucket => Blob Container
+// Container는 Resource Group마다 하나씩 생성되는 Storage Account에 만들어 짐.
+type AzureBucketHandler struct {
+	CredentialInfo       idrv.CredentialInfo
+	Region               idrv.RegionInfo
+	Ctx                  context.Context
+	StorageAccountClient *storage.AccountsClient
+}
+
+func (bucketHandler *AzureBucketHandler) CreateBucket(bucketReqInfo irs.BucketReqInfo) (irs.BucketInfo, error) {
+	blobService, err := bucketHandler.getBlobService(true)
+	if err != nil {
+		return irs.BucketInfo{}, err
+	}
+
+	container := blobService.GetContainerReference(bucketReqInfo.IId.NameId)
+	exist, err := container.Exists()
+	if err != nil {
+		cblogger.Error(err)
+		return irs.BucketInfo{}, err
+	}
+	if exist {
+		return irs.BucketInfo{}, errors.New(fmt.Sprintf("Container with name %s already exist", bucketReqInfo.IId.NameId))
+	}
+
+	// private access
+	err = container.Create(&blob.CreateContainerOptions{Access: blob.ContainerAccessTypePrivate})
+	if err != nil {
+		cblogger.Error(err)
+		return irs.BucketInfo{}, err
+	}
+	return bucketHandler.GetBucket(bucketReqInfo.IId)
+}
+
+func (bucketHandler *AzureBucketHandler) ListBucket() ([]*irs.BucketInfo, error) {
+	// Storage Account가 없으면 Container도 없음.
+	blobService, err := bucketHandler.getBlobService(false)
+	if err != nil {
+		return nil, err
+	}
+	bucketInfoList := []*irs.BucketInfo{}
+	if blobService == nil {
+		return bucketInfoList, nil
+	}
+
+	params := blob.ListContainersParameters{}
+	for {
+		result, err := blobService.ListContainers(params)
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		for _, container := range result.Containers {
+			bucketInfo := bucketHandler.mappingBucketInfo(container)
+			bucketInfoList = append(bucketInfoList, &bucketInfo)
+		}
+		if result.NextMarker == "" {
+			break
+		}
+		params.Marker = result.NextMarker
+	}
+	return bucketInfoList, nil
+}
+
+func (bucketHandler *AzureBucketHandler) GetBucket(bucketIID irs.IID) (irs.BucketInfo, error) {
+	container, err := bucketHandler.getContainer(bucketIID)
+	if err != nil {
+		return irs.BucketInfo{}, err
+	}
+	err = container.GetProperties()
+	if err != nil {
+		cblogger.Error(err)
+		return irs.BucketInfo{}, err
+	}
+	return bucketHandler.mappingBucketInfo(*container), nil
+}
+
+func (bucketHandler *AzureBucketHandler) DeleteBucket(bucketIID irs.IID) (bool, error) {
+	container, err := bucketHandler.getContainer(bucketIID)
+	if err != nil {
+		return false, err
+	}
+
+	// Azure는 Blob이 있어도 Container를 삭제하므로 직접 확인 함.
+	result, err := container.ListBlobs(blob.ListBlobsParameters{MaxResults: 1})
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	if len(result.Blobs) > 0 {
+		return false, errors.New(fmt.Sprintf("Container %s is not empty", container.Name))
+	}
+
+	err = container.Delete(nil)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (bucketHandler *AzureBucketHandler) ListObject(bucketIID irs.IID, prefix string) ([]*irs.ObjectInfo, error) {
+	container, err := bucketHandler.getContainer(bucketIID)
+	if err != nil {
+		return nil, err
+	}
+
+	objectInfoList := []*irs.ObjectInfo{}
+	params := blob.ListBlobsParameters{Prefix: prefix}
+	for {
+		result, err := container.ListBlobs(params)
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		for _, b := range result.Blobs {
+			objectInfo := mappingObjectInfo(b.Name, b.Properties)
+			objectInfoList = append(objectInfoList, &objectInfo)
+		}
+		if result.NextMarker == "" {
+			break
+		}
+		params.Marker = result.NextMarker
+	}
+	return objectInfoList, nil
+}
+
+// Block 단위로 전송 후 Block List를 Commit 하므로 전체를 메모리에 올리지 않음.
+func (bucketHandler *AzureBucketHandler) PutObject(bucketIID irs.IID, objectName string, reader io.Reader, size int64, contentType string) (irs.ObjectInfo, error) {
+	container, err := bucketHandler.getContainer(bucketIID)
+	if err != nil {
+		return irs.ObjectInfo{}, err
+	}
+	b := container.GetBlobReference(objectName)
+
+	blockList := []blob.Block{}
+	chunk := make([]byte, azureBlockSize)
+	for {
+		n, readErr := io.ReadFull(reader, chunk)
+		if n > 0 {
+			// Block ID는 Blob 내에서 길이가 모두 같아야 함.
+			blockID := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("block-%08d", len(blockList))))
+			err = b.PutBlock(blockID, chunk[:n], nil)
+			if err != nil {
+				cblogger.Error(err)
+				return irs.ObjectInfo{}, err
+			}
+			blockList = append(blockList, blob.Block{ID: blockID, Status: blob.BlockStatusUncommitted})
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			cblogger.Error(readErr)
+			return irs.ObjectInfo{}, readErr
+		}
+	}
+
+	b.Properties.ContentType = contentType
+	err = b.PutBlockList(blockList, nil)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.ObjectInfo{}, err
+	}
+	// Put Block List는 Content-Type을 Header로만 전달하므로 다시 설정 함.
+	if contentType != "" {
+		err = b.SetProperties(nil)
+		if err != nil {
+			cblogger.Error(err)
+			return irs.ObjectInfo{}, err
+		}
+	}
+
+	err = b.GetProperties(nil)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.ObjectInfo{}, err
+	}
+	if size >= 0 && b.Properties.ContentLength != size {
+		cblogger.Error(fmt.Sprintf("size of blob %s is %d, not %d", objectName, b.Properties.ContentLength, size))
+	}
+	return mappingObjectInfo(objectName, b.Properties), nil
+}
+
+func (bucketHandler *AzureBucketHandler) GetObject(bucketIID irs.IID, objectName string) (io.ReadCloser, irs.ObjectInfo, error) {
+	container, err := bucketHandler.getContainer(bucketIID)
+	if err != nil {
+		return nil, irs.ObjectInfo{}, err
+	}
+	b := container.GetBlobReference(objectName)
+	body, err := b.Get(nil)
+	if err != nil {
+		cblogger.Error(err)
+		if body != nil {
+			body.Close()
+		}
+		return nil, irs.ObjectInfo{}, err
+	}
+	return body, mappingObjectInfo(objectName, b.Properties), nil
+}
+
+func (bucketHandler *AzureBucketHandler) DeleteObject(bucketIID irs.IID, objectName string) (bool, error) {
+	container, err := bucketHandler.getContainer(bucketIID)
+	if err != nil {
+		return false, err
+	}
+	err = container.GetBlobReference(objectName).Delete(nil)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// Service SAS URI
+func (bucketHandler *AzureBucketHandler) GetPresignedURL(bucketIID irs.IID, objectName string, method irs.PresignedURLMethod, expires time.Duration) (string, error) {
+	container, err := bucketHandler.getContainer(bucketIID)
+	if err != nil {
+		return "", err
+	}
+
+	options := blob.BlobSASOptions{
+		SASOptions: blob.SASOptions{
+			Start:    time.Now().Add(-5 * time.Minute), // clock skew
+			Expiry:   time.Now().Add(expires),
+			UseHTTPS: true,
+		},
+	}
+	switch method {
+	case irs.PresignedGet:
+		options.BlobServiceSASPermissions = blob.BlobServiceSASPermissions{Read: true}
+	case irs.PresignedPut:
+		// PUT 요청 시 x-ms-blob-type: BlockBlob Header가 필요 함.
+		options.BlobServiceSASPermissions = blob.BlobServiceSASPermissions{Create: true, Write: true}
+	default:
+		return "", errors.New(fmt.Sprintf("%s method of the SAS URI is not supported", method))
+	}
+
+	sasURI, err := container.GetBlobReference(objectName).GetSASURI(options)
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+	return sasURI, nil
+}
+
+// Storage Account 이름은 전역에서 유일해야 하고 3~24자의 소문자, 숫자만 가능 함.
+// ex) cbspider + sha1(subscription/resourcegroup/region)[:16]
+func (bucketHandler *AzureBucketHandler) getStorageAccountName() string {
+	hash := sha1.Sum([]byte(bucketHandler.CredentialInfo.SubscriptionId + "/" + bucketHandler.Region.ResourceGroup + "/" + bucketHandler.Region.Region))
+	return "cbspider" + hex.EncodeToString(hash[:])[:16]
+}
+
+// Storage Account Key로 Blob Service Client를 생성 함.
+// create가 false이고 Storage Account가 없으면 nil을 반환 함.
+func (bucketHandler *AzureBucketHandler) getBlobService(create bool) (*blob.BlobStorageClient, error) {
+	accountName := bucketHandler.getStorageAccountName()
+	resourceGroup := bucketHandler.Region.ResourceGroup
+
+	account, err := bucketHandler.StorageAccountClient.GetProperties(bucketHandler.Ctx, resourceGroup, accountName, "")
+	if account.ID == nil {
+		if !create {
+			return nil, nil
+		}
+		createParams := storage.AccountCreateParameters{
+			Sku:      &storage.Sku{Name: storage.StandardLRS},
+			Kind:     storage.StorageV2,
+			Location: to.StringPtr(bucketHandler.Region.Region),
+			AccountPropertiesCreateParameters: &storage.AccountPropertiesCreateParameters{
+				EnableHTTPSTrafficOnly: to.BoolPtr(true),
+			},
+		}
+		future, err := bucketHandler.StorageAccountClient.Create(bucketHandler.Ctx, resourceGroup, accountName, createParams)
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+		err = future.WaitForCompletionRef(bucketHandler.Ctx, bucketHandler.StorageAccountClient.Client)
+		if err != nil {
+			cblogger.Error(err)
+			return nil, err
+		}
+	} else if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	keys, err := bucketHandler.StorageAccountClient.ListKeys(bucketHandler.Ctx, resourceGroup, accountName, "")
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	if keys.Keys == nil || len(*keys.Keys) == 0 {
+		return nil, errors.New(fmt.Sprintf("key of the storage account %s is not found", accountName))
+	}
+
+	client, err := blob.NewBasicClient(accountName, to.String((*keys.Keys)[0].Value))
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	blobService := client.GetBlobService()
+	return &blobService, nil
+}
+
+func (bucketHandler *AzureBucketHandler) getContainer(bucketIID irs.IID) (*blob.Container, error) {
+	blobService, err := bucketHandler.getBlobService(false)
+	if err != nil {
+		return nil, err
+	}
+	name := bucketIID.SystemId
+	if name == "" {
+		name = bucketIID.NameId
+	}
+	if blobService == nil {
+		return nil, errors.New(fmt.Sprintf("Container %s is not found", name))
+	}
+	return blobService.GetContainerReference(name), nil
+}
+
+// Container는 생성 시간을 제공하지 않으므로 마지막 수정 시간을 사용 함.
+func (bucketHandler *AzureBucketHandler) mappingBucketInfo(container blob.Container) irs.BucketInfo {
+	createdTime, _ := time.Parse(time.RFC1123, container.Properties.LastModified)
+	return irs.BucketInfo{
+		IId:         irs.IID{NameId: container.Name, SystemId: container.Name},
+		CreatedTime: createdTime,
+		KeyValueList: []irs.KeyValue{
+			{Key: "StorageAccount", Value: bucketHandler.getStorageAccountName()},
+			{Key: "ResourceGroup", Value: bucketHandler.Region.ResourceGroup},
+		},
+	}
+}
+
+func mappingObjectInfo(name string, properties blob.BlobProperties) irs.ObjectInfo {
+	return irs.ObjectInfo{
+		Name:         name,
+		Size:         properties.ContentLength,
+		LastModified: time.Time(properties.LastModified),
+		ETag:         properties.Etag,
+		ContentType:  properties.ContentType,
+	}
+}
@@ -0,0 +1,79 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is a Cloud Driver Example for PoC Test.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-06-01/subscriptions"
+	"github.com/Azure/go-autorest/autorest/to"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// Region => Location of the subscription
+// Location의 Zone("1", "2", "3")은 VM Spec마다 다르므로 ZoneList는 제공하지 않음.
+type AzureRegionZoneHandler struct {
+	CredentialInfo     idrv.CredentialInfo
+	Region             idrv.RegionInfo
+	Ctx                context.Context
+	SubscriptionClient *subscriptions.Client
+}
+
+func (regionZoneHandler *AzureRegionZoneHandler) ListRegionZone() ([]*irs.RegionZoneInfo, error) {
+	cblogger.Info("Start")
+
+	result, err := regionZoneHandler.SubscriptionClient.ListLocations(regionZoneHandler.Ctx, regionZoneHandler.CredentialInfo.SubscriptionId)
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var regionZoneInfoList []*irs.RegionZoneInfo
+	if result.Value == nil {
+		return regionZoneInfoList, nil
+	}
+	for _, location := range *result.Value {
+		regionZoneInfoList = append(regionZoneInfoList, &irs.RegionZoneInfo{
+			Name:        to.String(location.Name),
+			DisplayName: to.String(location.DisplayName),
+			// 구독에서 조회된 Location은 사용 가능 함.
+			Status: irs.RegionZoneAvailable,
+			KeyValueList: []irs.KeyValue{
+				{Key: "Latitude", Value: to.String(location.Latitude)},
+				{Key: "Longitude", Value: to.String(location.Longitude)},
+			},
+		})
+	}
+	return regionZoneInfoList, nil
+}
+
+func (regionZoneHandler *AzureRegionZoneHandler) GetRegionZone(Name string) (irs.RegionZoneInfo, error) {
+	cblogger.Info("Start : ", Name)
+
+	regionZoneInfoList, err := regionZoneHandler.ListRegionZone()
+	if err != nil {
+		return irs.RegionZoneInfo{}, err
+	}
+	for _, regionZoneInfo := range regionZoneInfoList {
+		if regionZoneInfo.Name == Name {
+			return *regionZoneInfo, nil
+		}
+	}
+	return irs.RegionZoneInfo{}, errors.New("Location[" + Name + "] does not exist!")
+}
+
+func (regionZoneHandler *AzureRegionZoneHandler) ListZone() ([]*irs.ZoneInfo, error) {
+	cblogger.Info("Start")
+
+	return []*irs.ZoneInfo{}, nil
+}
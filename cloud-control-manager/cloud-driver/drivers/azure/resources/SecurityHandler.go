@@ -58,6 +58,15 @@ func (securityHandler *AzureSecurityHandler) setterSec(securityGroup network.Sec
 			ruleInfo.ToPort = toPort
 		}
 
+		// Inbound는 Source, Outbound는 Destination 주소를 CIDR로 사용 함.
+		addressPrefix := sgRule.SourceAddressPrefix
+		if sgRule.Direction == network.SecurityRuleDirectionOutbound {
+			addressPrefix = sgRule.DestinationAddressPrefix
+		}
+		if addressPrefix != nil && *addressPrefix != "*" {
+			ruleInfo.CIDR = *addressPrefix
+		}
+
 		securityRuleArr = append(securityRuleArr, ruleInfo)
 	}
 	security.SecurityRules = &securityRuleArr
@@ -91,6 +100,15 @@ func (securityHandler *AzureSecurityHandler) CreateSecurity(securityReqInfo irs.
 			},
 		}
 
+		// CIDR(IPv4 또는 IPv6)이 지정되면 Inbound는 Source, Outbound는 Destination 주소로 설정 함.
+		if rule.CIDR != "" {
+			if strings.EqualFold(rule.Direction, string(network.SecurityRuleDirectionOutbound)) {
+				sgRuleInfo.DestinationAddressPrefix = to.StringPtr(rule.CIDR)
+			} else {
+				sgRuleInfo.SourceAddressPrefix = to.StringPtr(rule.CIDR)
+			}
+		}
+
 		if strings.ToLower(rule.IPProtocol) == ICMP || (rule.FromPort == "*" && rule.ToPort == "*") {
 			sgRuleInfo.SourcePortRange = to.StringPtr("*")
 		} else if rule.FromPort == rule.ToPort {
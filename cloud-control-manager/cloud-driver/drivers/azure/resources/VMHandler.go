@@ -14,13 +14,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	network2019 "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-06-01/storage"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/Azure/go-autorest/autorest/to"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	"io/ioutil"
+	"net/http"
+	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -28,14 +36,15 @@ const (
 )
 
 type AzureVMHandler struct {
-	CredentialInfo idrv.CredentialInfo
-	Region         idrv.RegionInfo
-	Ctx            context.Context
-	Client         *compute.VirtualMachinesClient
-	SubnetClient   *network.SubnetsClient
-	NicClient      *network.InterfacesClient
-	PublicIPClient *network.PublicIPAddressesClient
-	DiskClient     *compute.DisksClient
+	CredentialInfo       idrv.CredentialInfo
+	Region               idrv.RegionInfo
+	Ctx                  context.Context
+	Client               *compute.VirtualMachinesClient
+	SubnetClient         *network.SubnetsClient
+	NicClient            *network.InterfacesClient
+	PublicIPClient       *network.PublicIPAddressesClient
+	DiskClient           *compute.DisksClient
+	StorageAccountClient *storage.AccountsClient
 }
 
 func (vmHandler *AzureVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, error) {
@@ -91,6 +100,28 @@ func (vmHandler *AzureVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, e
 		},
 	}
 
+	// 구매 옵션 설정 (Spot VM은 회수 시 Deallocate)
+	switch vmReqInfo.PurchaseOption.PurchaseType {
+	case "", irs.OnDemand:
+	case irs.Spot:
+		maxPrice := float64(-1) // -1: up to the on-demand price
+		if vmReqInfo.PurchaseOption.MaxPrice != "" {
+			maxPrice, err = strconv.ParseFloat(vmReqInfo.PurchaseOption.MaxPrice, 64)
+			if err != nil {
+				createErr := errors.New(fmt.Sprintf("Invalid MaxPrice %s, error=%s", vmReqInfo.PurchaseOption.MaxPrice, err))
+				return irs.VMInfo{}, createErr
+			}
+		}
+		vmOpts.VirtualMachineProperties.Priority = compute.Spot
+		vmOpts.VirtualMachineProperties.EvictionPolicy = compute.Deallocate
+		vmOpts.VirtualMachineProperties.BillingProfile = &compute.BillingProfile{
+			MaxPrice: to.Float64Ptr(maxPrice),
+		}
+	default:
+		createErr := errors.New(fmt.Sprintf("Azure does not support the purchase type %s", vmReqInfo.PurchaseOption.PurchaseType))
+		return irs.VMInfo{}, createErr
+	}
+
 	// Image 설정
 	if strings.Contains(vmReqInfo.ImageIID.SystemId, ":") {
 		imageArr := strings.Split(vmReqInfo.ImageIID.SystemId, ":")
@@ -162,7 +193,7 @@ func (vmHandler *AzureVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, e
 }
 
 func (vmHandler *AzureVMHandler) SuspendVM(vmIID irs.IID) (irs.VMStatus, error) {
-	future, err := vmHandler.Client.PowerOff(vmHandler.Ctx, vmHandler.Region.ResourceGroup, vmIID.NameId)
+	future, err := vmHandler.Client.PowerOff(vmHandler.Ctx, vmHandler.Region.ResourceGroup, vmIID.NameId, nil)
 	if err != nil {
 		cblogger.Error(err)
 		return irs.Failed, err
@@ -348,11 +379,107 @@ func (vmHandler *AzureVMHandler) GetVM(vmIID irs.IID) (irs.VMInfo, error) {
 	return vmInfo, nil
 }
 
+// Serial Console 로그 Blob 조회용 HTTP Client
+var consoleHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+// Boot Diagnostics의 Serial Console 로그를 조회
+// 로그 Blob에 접근하기 위해 Storage Account의 읽기 전용 SAS 토큰을 발급 받아 사용
+func (vmHandler *AzureVMHandler) GetVMConsoleOutput(vmIID irs.IID) (string, error) {
+	instanceView, err := vmHandler.Client.InstanceView(vmHandler.Ctx, vmHandler.Region.ResourceGroup, vmIID.NameId)
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+	if instanceView.BootDiagnostics == nil || instanceView.BootDiagnostics.SerialConsoleLogBlobURI == nil {
+		return "", errors.New(fmt.Sprintf("boot diagnostics of the VM %s is not enabled", vmIID.NameId))
+	}
+
+	// ex) https://{account}.blob.core.windows.net/{container}/{blob}
+	blobURL, err := url.Parse(*instanceView.BootDiagnostics.SerialConsoleLogBlobURI)
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+	accountName := strings.Split(blobURL.Host, ".")[0]
+
+	// Storage Account의 Resource Group 조회
+	accountList, err := vmHandler.StorageAccountClient.List(vmHandler.Ctx)
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+	var accountGroup string
+	for _, account := range accountList.Values() {
+		if account.Name != nil && *account.Name == accountName {
+			// ex) /subscriptions/{id}/resourceGroups/{group}/providers/...
+			if account.ID == nil {
+				return "", errors.New(fmt.Sprintf("storage account %s of the boot diagnostics has no ID", accountName))
+			}
+			idArr := strings.Split(*account.ID, "/")
+			if len(idArr) < 5 {
+				return "", errors.New(fmt.Sprintf("invalid ID of the storage account %s: %s", accountName, *account.ID))
+			}
+			accountGroup = idArr[4]
+			break
+		}
+	}
+	if accountGroup == "" {
+		return "", errors.New(fmt.Sprintf("storage account %s of the boot diagnostics is not found", accountName))
+	}
+
+	sasParams := storage.ServiceSasParameters{
+		CanonicalizedResource:  to.StringPtr("/blob/" + accountName + blobURL.Path),
+		Resource:               storage.SignedResourceB,
+		Permissions:            storage.R,
+		SharedAccessExpiryTime: &date.Time{Time: time.Now().Add(10 * time.Minute)},
+	}
+	sas, err := vmHandler.StorageAccountClient.ListServiceSAS(vmHandler.Ctx, accountGroup, accountName, sasParams)
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+
+	resp, err := consoleHTTPClient.Get(blobURL.String() + "?" + *sas.ServiceSasToken)
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	output, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.New(fmt.Sprintf("failed to get the serial console log: %s", resp.Status))
+	}
+	return string(output), nil
+}
+
+// nil-safe Statuses of the VM, the VM being provisioned has no InstanceView or Statuses.
+func getVmStatusList(server compute.VirtualMachine) []compute.InstanceViewStatus {
+	if server.VirtualMachineProperties == nil || server.VirtualMachineProperties.InstanceView == nil ||
+		server.VirtualMachineProperties.InstanceView.Statuses == nil {
+		return nil
+	}
+	return *server.VirtualMachineProperties.InstanceView.Statuses
+}
+
 func getVmStatus(instanceView compute.VirtualMachineInstanceView) irs.VMStatus {
 	var powerState, provisioningState string
 
+	if instanceView.Statuses == nil {
+		return irs.Creating
+	}
 	for _, stat := range *instanceView.Statuses {
+		if stat.Code == nil {
+			continue
+		}
 		statArr := strings.Split(*stat.Code, "/")
+		if len(statArr) < 2 {
+			continue
+		}
 
 		if statArr[0] == "PowerState" {
 			powerState = strings.ToLower(statArr[1])
@@ -463,6 +590,9 @@ func (vmHandler *AzureVMHandler) mappingServerInfo(server compute.VirtualMachine
 			vpcIdArr := subnetIdArr[:len(subnetIdArr)-2]
 			vpcName := vpcIdArr[len(vpcIdArr)-1]
 			vmInfo.VpcIID = irs.IID{NameId: vpcName, SystemId: strings.Join(vpcIdArr, "/")}
+		} else if ip.PrivateIPAddressVersion == network.IPv6 && ip.PrivateIPAddress != nil {
+			// Dual-Stack Subnet의 IPv6 IP 설정 정보
+			vmInfo.PrivateIPv6 = *ip.PrivateIPAddress
 		}
 	}
 
@@ -480,10 +610,28 @@ func (vmHandler *AzureVMHandler) mappingServerInfo(server compute.VirtualMachine
 	}
 
 	// Get StartTime
-	if server.VirtualMachineProperties.InstanceView != nil {
-		for _, status := range *server.VirtualMachineProperties.InstanceView.Statuses {
-			if strings.EqualFold(*status.Code, PROVISIONING_STATE_CODE) {
+	// InstanceView and its Statuses are omitted while the VM is provisioning.
+	for _, status := range getVmStatusList(server) {
+		if status.Code != nil && strings.EqualFold(*status.Code, PROVISIONING_STATE_CODE) {
+			if status.Time != nil {
 				vmInfo.StartTime = status.Time.Local()
+			}
+			break
+		}
+	}
+
+	// Set Lifecycle, Interruption State
+	// Spot VM is deallocated when it is evicted, and CB-Spider does not deallocate VMs(SuspendVM is PowerOff).
+	vmInfo.LifecycleType = irs.OnDemand
+	if server.VirtualMachineProperties.Priority == compute.Spot || server.VirtualMachineProperties.Priority == compute.Low {
+		vmInfo.LifecycleType = irs.Spot
+		vmInfo.InterruptionState = irs.NotInterrupted
+		for _, status := range getVmStatusList(server) {
+			if status.Code == nil {
+				continue
+			}
+			if strings.EqualFold(*status.Code, "PowerState/deallocated") || strings.EqualFold(*status.Code, "PowerState/deallocating") {
+				vmInfo.InterruptionState = irs.Interrupted
 				break
 			}
 		}
@@ -605,8 +753,26 @@ func CreateVNic(vmHandler *AzureVMHandler, vmReqInfo irs.VMReqInfo, publicIPIId
 			},
 		},
 	}
+	// 고정 Private IP 요청 시 Static 할당 방식을 사용 함.
+	if vmReqInfo.PrivateIP != "" {
+		ipConfig.PrivateIPAllocationMethod = "Static"
+		ipConfig.PrivateIPAddress = to.StringPtr(vmReqInfo.PrivateIP)
+	}
 	ipConfigArr = append(ipConfigArr, ipConfig)
 
+	// Dual-Stack Subnet이면 IPv6 IP 설정을 추가 함.
+	if isDualStackSubnet(vmHandler, vmReqInfo.VpcIID.NameId, vmReqInfo.SubnetIID.NameId) {
+		ipConfigArr[0].Primary = to.BoolPtr(true)
+		ipConfigArr = append(ipConfigArr, network.InterfaceIPConfiguration{
+			Name: to.StringPtr("ipConfig-v6"),
+			InterfaceIPConfigurationPropertiesFormat: &network.InterfaceIPConfigurationPropertiesFormat{
+				Subnet:                    &subnet,
+				PrivateIPAllocationMethod: "Dynamic",
+				PrivateIPAddressVersion:   network.IPv6,
+			},
+		})
+	}
+
 	/*
 	 test VM is interfacingProperties
 	*/
@@ -639,6 +805,22 @@ func CreateVNic(vmHandler *AzureVMHandler, vmReqInfo irs.VMReqInfo, publicIPIId
 	return VNicIId, nil
 }
 
+// 2018-04-01 API의 Subnet은 AddressPrefixes 정보가 없으므로 2019-06-01 API로 IPv6 CIDR 여부를 확인 함.
+func isDualStackSubnet(vmHandler *AzureVMHandler, vpcName string, subnetName string) bool {
+	subnetClient := network2019.NewSubnetsClient(vmHandler.CredentialInfo.SubscriptionId)
+	subnetClient.Authorizer = vmHandler.SubnetClient.Authorizer
+	subnet, err := subnetClient.Get(vmHandler.Ctx, vmHandler.Region.ResourceGroup, vpcName, subnetName, "")
+	if err != nil || subnet.SubnetPropertiesFormat == nil || subnet.AddressPrefixes == nil {
+		return false
+	}
+	for _, prefix := range *subnet.AddressPrefixes {
+		if strings.Contains(prefix, ":") {
+			return true
+		}
+	}
+	return false
+}
+
 // VNic 삭제 전 PublicIP 연결 해제
 func DetachVNic(vmHandler *AzureVMHandler, vmInfo irs.VMInfo) (irs.VMStatus, error) {
 	var ipConfigArr []network.InterfaceIPConfiguration
@@ -5,7 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	"strconv"
@@ -26,6 +26,9 @@ func setterVmSpec(region string, vmSpec compute.VirtualMachineSize) *irs.VMSpecI
 		Gpu:          nil,
 		KeyValueList: nil,
 	}
+	if err := irs.NormalizeVMSpecInfo(vmSpecInfo); err != nil {
+		cblogger.Error(err)
+	}
 	return vmSpecInfo
 }
 
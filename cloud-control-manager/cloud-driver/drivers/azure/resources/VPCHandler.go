@@ -2,9 +2,14 @@ package resources
 
 import (
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"net"
+	"strings"
+
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	network2019 "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-06-01/network"
 	"github.com/Azure/go-autorest/autorest/to"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
@@ -15,8 +20,20 @@ type AzureVPCHandler struct {
 	Ctx          context.Context
 	Client       *network.VirtualNetworksClient
 	SubnetClient *network.SubnetsClient
+	// NAT Gateway는 network 2019-06-01 API부터 지원되므로 NAT Gateway와 라우팅 테이블, Subnet 연결은 2019-06-01 Client를 사용 함.
+	NatGatewayClient  *network2019.NatGatewaysClient
+	RouteTableClient  *network2019.RouteTablesClient
+	RouteSubnetClient *network2019.SubnetsClient
+	PublicIPClient    *network.PublicIPAddressesClient
 }
 
+const (
+	azureVPCTagKey               = "vpc"         // NAT Gateway, 라우팅 테이블이 속한 VPC 태그
+	azurePrivateRouteTableSuffix = "-private-rt" // Private Subnet 라우팅 테이블 : {vpc}-private-rt
+	azureDefaultRouteName        = "default-route"
+	azureRoutePrefix             = "cb-route-"
+)
+
 func (vpcHandler *AzureVPCHandler) setterVPC(network network.VirtualNetwork) *irs.VPCInfo {
 	vpcInfo := &irs.VPCInfo{
 		IId: irs.IID{
@@ -26,6 +43,14 @@ func (vpcHandler *AzureVPCHandler) setterVPC(network network.VirtualNetwork) *ir
 		IPv4_CIDR:    (*network.AddressSpace.AddressPrefixes)[0],
 		KeyValueList: []irs.KeyValue{{Key: "ResourceGroup", Value: vpcHandler.Region.ResourceGroup}},
 	}
+	// Dual-Stack VNet은 AddressSpace에 IPv4, IPv6 CIDR을 함께 가짐
+	for _, prefix := range *network.AddressSpace.AddressPrefixes {
+		if strings.Contains(prefix, ":") {
+			vpcInfo.IPv6_CIDR = prefix
+		} else if strings.Contains(vpcInfo.IPv4_CIDR, ":") {
+			vpcInfo.IPv4_CIDR = prefix
+		}
+	}
 
 	subnetArr := make([]irs.SubnetInfo, len(*network.Subnets))
 	for i, subnet := range *network.Subnets {
@@ -45,6 +70,7 @@ func (vpcHandler *AzureVPCHandler) setterSubnet(subnet network.Subnet) *irs.Subn
 		IPv4_CIDR:    *subnet.AddressPrefix,
 		KeyValueList: []irs.KeyValue{{Key: "ResourceGroup", Value: vpcHandler.Region.ResourceGroup}},
 	}
+	subnetInfo.Private = isPrivateSubnet(subnet)
 	return subnetInfo
 }
 
@@ -59,12 +85,21 @@ func (vpcHandler *AzureVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPC
 		return irs.VPCInfo{}, createErr
 	}
 
+	// Dual-Stack VNet : Azure는 IPv6 CIDR 자동 할당을 지원하지 않으므로 사용자가 지정한 CIDR을 사용 함.
+	addressPrefixes := []string{vpcReqInfo.IPv4_CIDR}
+	if vpcReqInfo.IPv6_CIDR != "" {
+		if vpcReqInfo.IPv6_CIDR == irs.IPv6AutoCIDR {
+			return irs.VPCInfo{}, errors.New("Azure Cloud Driver: IPv6_CIDR '" + irs.IPv6AutoCIDR + "' is not supported, use an IPv6 CIDR(ex: fd00:db8:1234::/48)!")
+		}
+		addressPrefixes = append(addressPrefixes, vpcReqInfo.IPv6_CIDR)
+	}
+
 	// Create VPC
 	createOpts := network.VirtualNetwork{
 		Name: to.StringPtr(vpcReqInfo.IId.NameId),
 		VirtualNetworkPropertiesFormat: &network.VirtualNetworkPropertiesFormat{
 			AddressSpace: &network.AddressSpace{
-				AddressPrefixes: &[]string{vpcReqInfo.IPv4_CIDR},
+				AddressPrefixes: &addressPrefixes,
 			},
 		},
 		Location: &vpcHandler.Region.Region,
@@ -81,6 +116,7 @@ func (vpcHandler *AzureVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPC
 
 	// Create Subnet
 	var subnetCreateOpts network.Subnet
+	usedIPv6CIDRList := []string{}
 	for _, subnet := range vpcReqInfo.SubnetInfoList {
 		subnetCreateOpts = network.Subnet{
 			Name: to.StringPtr(subnet.IId.NameId),
@@ -98,6 +134,23 @@ func (vpcHandler *AzureVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPC
 			cblogger.Error(fmt.Sprint("Failed to create subnet with name %s", subnet.IId.NameId))
 			continue
 		}
+		if vpcReqInfo.IPv6_CIDR != "" {
+			ipv6CIDR, err := getSubnetIPv6CIDR(vpcReqInfo.IPv6_CIDR, subnet.IPv6_CIDR, usedIPv6CIDRList)
+			if err != nil {
+				return irs.VPCInfo{}, err
+			}
+			err = vpcHandler.setSubnetIPv6CIDR(vpcReqInfo.IId.NameId, subnet.IId.NameId, ipv6CIDR)
+			if err != nil {
+				return irs.VPCInfo{}, err
+			}
+			usedIPv6CIDRList = append(usedIPv6CIDRList, ipv6CIDR)
+		}
+		if subnet.Private {
+			err = vpcHandler.setupPrivateSubnet(vpcReqInfo.IId.NameId, subnet.IId.NameId)
+			if err != nil {
+				return irs.VPCInfo{}, err
+			}
+		}
 	}
 
 	// 생성된 VNetwork 정보 리턴
@@ -117,6 +170,14 @@ func (vpcHandler *AzureVPCHandler) ListVPC() ([]*irs.VPCInfo, error) {
 	vpcInfoList := make([]*irs.VPCInfo, len(vpcList.Values()))
 	for i, vpc := range vpcList.Values() {
 		vpcInfoList[i] = vpcHandler.setterVPC(vpc)
+		err = vpcHandler.setterSubnetIPv6(vpcInfoList[i])
+		if err != nil {
+			return nil, err
+		}
+		err = vpcHandler.setterNATAndRouteTable(vpcInfoList[i])
+		if err != nil {
+			return nil, err
+		}
 	}
 	return vpcInfoList, nil
 }
@@ -128,10 +189,23 @@ func (vpcHandler *AzureVPCHandler) GetVPC(vpcIID irs.IID) (irs.VPCInfo, error) {
 	}
 
 	vpcInfo := vpcHandler.setterVPC(vpc)
+	err = vpcHandler.setterSubnetIPv6(vpcInfo)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+	err = vpcHandler.setterNATAndRouteTable(vpcInfo)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
 	return *vpcInfo, nil
 }
 
 func (vpcHandler *AzureVPCHandler) DeleteVPC(vpcIID irs.IID) (bool, error) {
+	natGateway, err := vpcHandler.getNATGateway(vpcIID.NameId)
+	if err != nil {
+		return false, err
+	}
+
 	future, err := vpcHandler.Client.Delete(vpcHandler.Ctx, vpcHandler.Region.ResourceGroup, vpcIID.NameId)
 	if err != nil {
 		return false, err
@@ -140,6 +214,28 @@ func (vpcHandler *AzureVPCHandler) DeleteVPC(vpcIID irs.IID) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+
+	// VPC(Subnet) 삭제 후 VPC에 속한 NAT Gateway와 라우팅 테이블 삭제
+	if natGateway != nil {
+		err = vpcHandler.deleteNATGateway(*natGateway)
+		if err != nil {
+			return false, err
+		}
+	}
+	routeTables, err := vpcHandler.listRouteTable(vpcIID.NameId)
+	if err != nil {
+		return false, err
+	}
+	for _, routeTable := range routeTables {
+		rtFuture, err := vpcHandler.RouteTableClient.Delete(vpcHandler.Ctx, vpcHandler.Region.ResourceGroup, *routeTable.Name)
+		if err != nil {
+			return false, err
+		}
+		err = rtFuture.WaitForCompletionRef(vpcHandler.Ctx, vpcHandler.RouteTableClient.Client)
+		if err != nil {
+			return false, err
+		}
+	}
 	return true, nil
 }
 
@@ -150,6 +246,14 @@ func (VPCHandler *AzureVPCHandler) AddSubnet(vpcIID irs.IID, subnetInfo irs.Subn
 		createErr := errors.New(errMsg)
 		return irs.VPCInfo{}, createErr
 	}
+	vpcInfo := VPCHandler.setterVPC(vpc)
+	err := VPCHandler.setterSubnetIPv6(vpcInfo)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+	if vpcInfo.IPv6_CIDR == "" && subnetInfo.IPv6_CIDR != "" {
+		return irs.VPCInfo{}, errors.New("Azure Cloud Driver: VPC " + vpcIID.NameId + " is not a Dual-Stack VPC!")
+	}
 	subnetCreateOpts := network.Subnet{
 		Name: to.StringPtr(subnetInfo.IId.NameId),
 		SubnetPropertiesFormat: &network.SubnetPropertiesFormat{
@@ -166,6 +270,28 @@ func (VPCHandler *AzureVPCHandler) AddSubnet(vpcIID irs.IID, subnetInfo irs.Subn
 		cblogger.Error(fmt.Sprint("Failed to create subnet with name %s", subnetInfo.IId.NameId))
 		return irs.VPCInfo{}, err
 	}
+	if vpcInfo.IPv6_CIDR != "" {
+		usedIPv6CIDRList := []string{}
+		for _, curSubnet := range vpcInfo.SubnetInfoList {
+			if curSubnet.IPv6_CIDR != "" {
+				usedIPv6CIDRList = append(usedIPv6CIDRList, curSubnet.IPv6_CIDR)
+			}
+		}
+		ipv6CIDR, err := getSubnetIPv6CIDR(vpcInfo.IPv6_CIDR, subnetInfo.IPv6_CIDR, usedIPv6CIDRList)
+		if err != nil {
+			return irs.VPCInfo{}, err
+		}
+		err = VPCHandler.setSubnetIPv6CIDR(*vpc.Name, subnetInfo.IId.NameId, ipv6CIDR)
+		if err != nil {
+			return irs.VPCInfo{}, err
+		}
+	}
+	if subnetInfo.Private {
+		err = VPCHandler.setupPrivateSubnet(*vpc.Name, subnetInfo.IId.NameId)
+		if err != nil {
+			return irs.VPCInfo{}, err
+		}
+	}
 	result, err := VPCHandler.GetVPC(irs.IID{NameId: vpcIID.NameId})
 	if err != nil {
 		return irs.VPCInfo{}, err
@@ -184,3 +310,523 @@ func (VPCHandler *AzureVPCHandler) RemoveSubnet(vpcIID irs.IID, subnetIID irs.II
 	}
 	return true, nil
 }
+
+func (VPCHandler *AzureVPCHandler) RequestVPCPeering(peeringReqInfo irs.VPCPeeringReqInfo) (irs.VPCPeeringInfo, error) {
+	return irs.VPCPeeringInfo{}, errors.New("Azure Cloud Driver: RequestVPCPeering() is not supported!")
+}
+
+func (VPCHandler *AzureVPCHandler) AcceptVPCPeering(peeringIID irs.IID) (irs.VPCPeeringInfo, error) {
+	return irs.VPCPeeringInfo{}, errors.New("Azure Cloud Driver: AcceptVPCPeering() is not supported!")
+}
+
+func (VPCHandler *AzureVPCHandler) ListVPCPeering() ([]*irs.VPCPeeringInfo, error) {
+	return nil, errors.New("Azure Cloud Driver: ListVPCPeering() is not supported!")
+}
+
+func (VPCHandler *AzureVPCHandler) GetVPCPeering(peeringIID irs.IID) (irs.VPCPeeringInfo, error) {
+	return irs.VPCPeeringInfo{}, errors.New("Azure Cloud Driver: GetVPCPeering() is not supported!")
+}
+
+func (VPCHandler *AzureVPCHandler) DeleteVPCPeering(peeringIID irs.IID) (bool, error) {
+	return false, errors.New("Azure Cloud Driver: DeleteVPCPeering() is not supported!")
+}
+
+// NAT Gateway는 Subnet이 아닌 VPC의 Private Subnet들에 연결되므로 NATGatewayReqInfo.SubnetIID는 사용하지 않음.
+func (VPCHandler *AzureVPCHandler) AddNATGateway(vpcIID irs.IID, natReqInfo irs.NATGatewayReqInfo) (irs.VPCInfo, error) {
+	vpc, _ := VPCHandler.Client.Get(VPCHandler.Ctx, VPCHandler.Region.ResourceGroup, vpcIID.NameId, "")
+	if vpc.ID == nil {
+		return irs.VPCInfo{}, errors.New(fmt.Sprintf("VPC with name %s not exist", vpcIID.NameId))
+	}
+	natGateway, err := VPCHandler.getNATGateway(vpcIID.NameId)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+	if natGateway != nil {
+		return irs.VPCInfo{}, errors.New(fmt.Sprintf("VPC with name %s already has the NAT Gateway %s", vpcIID.NameId, *natGateway.Name))
+	}
+
+	// NAT Gateway는 Standard SKU의 PublicIP만 사용 가능
+	publicIPName := natReqInfo.IId.NameId + "-ip"
+	publicIPOpts := network.PublicIPAddress{
+		Name: to.StringPtr(publicIPName),
+		Sku: &network.PublicIPAddressSku{
+			Name: network.PublicIPAddressSkuNameStandard,
+		},
+		PublicIPAddressPropertiesFormat: &network.PublicIPAddressPropertiesFormat{
+			PublicIPAddressVersion:   network.IPVersion("IPv4"),
+			PublicIPAllocationMethod: network.IPAllocationMethod("Static"),
+		},
+		Location: &VPCHandler.Region.Region,
+	}
+	ipFuture, err := VPCHandler.PublicIPClient.CreateOrUpdate(VPCHandler.Ctx, VPCHandler.Region.ResourceGroup, publicIPName, publicIPOpts)
+	if err != nil {
+		return irs.VPCInfo{}, errors.New(fmt.Sprintf("Failed to create PublicIP, error=%s", err))
+	}
+	err = ipFuture.WaitForCompletionRef(VPCHandler.Ctx, VPCHandler.PublicIPClient.Client)
+	if err != nil {
+		return irs.VPCInfo{}, errors.New(fmt.Sprintf("Failed to create PublicIP, error=%s", err))
+	}
+	publicIP, err := VPCHandler.PublicIPClient.Get(VPCHandler.Ctx, VPCHandler.Region.ResourceGroup, publicIPName, "")
+	if err != nil {
+		return irs.VPCInfo{}, errors.New(fmt.Sprintf("Failed to get PublicIP, error=%s", err))
+	}
+
+	natCreateOpts := network2019.NatGateway{
+		Sku: &network2019.NatGatewaySku{
+			Name: network2019.Standard,
+		},
+		NatGatewayPropertiesFormat: &network2019.NatGatewayPropertiesFormat{
+			PublicIPAddresses: &[]network2019.SubResource{{ID: publicIP.ID}},
+		},
+		Location: &VPCHandler.Region.Region,
+		Tags:     map[string]*string{azureVPCTagKey: to.StringPtr(vpcIID.NameId)},
+	}
+	natFuture, err := VPCHandler.NatGatewayClient.CreateOrUpdate(VPCHandler.Ctx, VPCHandler.Region.ResourceGroup, natReqInfo.IId.NameId, natCreateOpts)
+	if err != nil {
+		return irs.VPCInfo{}, errors.New(fmt.Sprintf("Failed to create NAT Gateway, error=%s", err))
+	}
+	err = natFuture.WaitForCompletionRef(VPCHandler.Ctx, VPCHandler.NatGatewayClient.Client)
+	if err != nil {
+		return irs.VPCInfo{}, errors.New(fmt.Sprintf("Failed to create NAT Gateway, error=%s", err))
+	}
+	newNatGateway, err := VPCHandler.NatGatewayClient.Get(VPCHandler.Ctx, VPCHandler.Region.ResourceGroup, natReqInfo.IId.NameId, "")
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+
+	// Private Subnet들을 NAT Gateway에 연결하고 기본 라우팅을 Internet(NAT Gateway 경유)으로 변경
+	for _, subnet := range *vpc.Subnets {
+		if !isPrivateSubnet(subnet) {
+			continue
+		}
+		err = VPCHandler.updateSubnet(vpcIID.NameId, *subnet.Name, func(subnet *network2019.Subnet) {
+			subnet.NatGateway = &network2019.SubResource{ID: newNatGateway.ID}
+		})
+		if err != nil {
+			return irs.VPCInfo{}, err
+		}
+	}
+	err = VPCHandler.setPrivateDefaultRoute(vpcIID.NameId, network2019.RouteNextHopTypeInternet)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+
+	return VPCHandler.GetVPC(irs.IID{NameId: vpcIID.NameId})
+}
+
+func (VPCHandler *AzureVPCHandler) RemoveNATGateway(vpcIID irs.IID, natGatewayIID irs.IID) (bool, error) {
+	natGateway, err := VPCHandler.NatGatewayClient.Get(VPCHandler.Ctx, VPCHandler.Region.ResourceGroup, natGatewayIID.NameId, "")
+	if err != nil {
+		return false, err
+	}
+
+	// Private Subnet은 NAT Gateway가 없으면 외부로 나갈 수 없도록 기본 라우팅을 None으로 변경
+	err = VPCHandler.setPrivateDefaultRoute(vpcIID.NameId, network2019.RouteNextHopTypeNone)
+	if err != nil {
+		return false, err
+	}
+	if natGateway.Subnets != nil {
+		for _, subnet := range *natGateway.Subnets {
+			err = VPCHandler.updateSubnet(vpcIID.NameId, azureResourceName(*subnet.ID), func(subnet *network2019.Subnet) {
+				subnet.NatGateway = nil
+			})
+			if err != nil {
+				return false, err
+			}
+		}
+	}
+
+	err = VPCHandler.deleteNATGateway(natGateway)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Azure는 Internet 방향의 라우팅만 지원 함. (NAT Gateway가 연결된 Private Subnet은 NAT Gateway를 경유 함)
+func (VPCHandler *AzureVPCHandler) AddRoute(vpcIID irs.IID, subnetIID irs.IID, routeInfo irs.RouteInfo) (irs.VPCInfo, error) {
+	if routeInfo.TargetType != irs.RouteInternetGateway {
+		return irs.VPCInfo{}, errors.New("Azure Cloud Driver: Route Target Type " + string(routeInfo.TargetType) + " is not supported!")
+	}
+
+	subnet, err := VPCHandler.RouteSubnetClient.Get(VPCHandler.Ctx, VPCHandler.Region.ResourceGroup, vpcIID.NameId, subnetIID.NameId, "")
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+
+	// Subnet에 라우팅 테이블이 없으면 Subnet 전용 라우팅 테이블({vpc}-{subnet}-rt)을 생성 후 연결
+	var routeTableName string
+	if subnet.RouteTable != nil && subnet.RouteTable.ID != nil {
+		routeTableName = azureResourceName(*subnet.RouteTable.ID)
+	} else {
+		routeTableName = vpcIID.NameId + "-" + subnetIID.NameId + "-rt"
+		routeTable, err := VPCHandler.getOrCreateRouteTable(vpcIID.NameId, routeTableName, nil)
+		if err != nil {
+			return irs.VPCInfo{}, err
+		}
+		err = VPCHandler.updateSubnet(vpcIID.NameId, subnetIID.NameId, func(subnet *network2019.Subnet) {
+			subnet.RouteTable = &network2019.RouteTable{ID: routeTable.ID}
+		})
+		if err != nil {
+			return irs.VPCInfo{}, err
+		}
+	}
+
+	err = VPCHandler.updateRouteTable(routeTableName, func(routes []network2019.Route) []network2019.Route {
+		return append(routes, network2019.Route{
+			Name: to.StringPtr(azureRouteName(routeInfo.DestinationCIDR)),
+			RoutePropertiesFormat: &network2019.RoutePropertiesFormat{
+				AddressPrefix: to.StringPtr(routeInfo.DestinationCIDR),
+				NextHopType:   network2019.RouteNextHopTypeInternet,
+			},
+		})
+	})
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+
+	return VPCHandler.GetVPC(irs.IID{NameId: vpcIID.NameId})
+}
+
+func (VPCHandler *AzureVPCHandler) RemoveRoute(vpcIID irs.IID, subnetIID irs.IID, routeInfo irs.RouteInfo) (bool, error) {
+	subnet, err := VPCHandler.RouteSubnetClient.Get(VPCHandler.Ctx, VPCHandler.Region.ResourceGroup, vpcIID.NameId, subnetIID.NameId, "")
+	if err != nil {
+		return false, err
+	}
+	if subnet.RouteTable == nil || subnet.RouteTable.ID == nil {
+		return false, errors.New(fmt.Sprintf("Subnet with name %s has no route table", subnetIID.NameId))
+	}
+
+	routeName := azureRouteName(routeInfo.DestinationCIDR)
+	found := false
+	err = VPCHandler.updateRouteTable(azureResourceName(*subnet.RouteTable.ID), func(routes []network2019.Route) []network2019.Route {
+		var result []network2019.Route
+		for _, route := range routes {
+			if *route.Name == routeName {
+				found = true
+				continue
+			}
+			result = append(result, route)
+		}
+		return result
+	})
+	if err != nil {
+		return false, err
+	}
+	if !found {
+		return false, errors.New(fmt.Sprintf("Route to %s not exist", routeInfo.DestinationCIDR))
+	}
+	return true, nil
+}
+
+// Private Subnet을 Private 라우팅 테이블(없으면 생성)과 VPC의 NAT Gateway(있는 경우)에 연결 함.
+func (vpcHandler *AzureVPCHandler) setupPrivateSubnet(vpcName string, subnetName string) error {
+	natGateway, err := vpcHandler.getNATGateway(vpcName)
+	if err != nil {
+		return err
+	}
+
+	// NAT Gateway가 없으면 외부로 나가는 기본 라우팅을 막음
+	nextHopType := network2019.RouteNextHopTypeNone
+	if natGateway != nil {
+		nextHopType = network2019.RouteNextHopTypeInternet
+	}
+	defaultRoutes := []network2019.Route{
+		{
+			Name: to.StringPtr(azureDefaultRouteName),
+			RoutePropertiesFormat: &network2019.RoutePropertiesFormat{
+				AddressPrefix: to.StringPtr("0.0.0.0/0"),
+				NextHopType:   nextHopType,
+			},
+		},
+	}
+	routeTable, err := vpcHandler.getOrCreateRouteTable(vpcName, vpcName+azurePrivateRouteTableSuffix, defaultRoutes)
+	if err != nil {
+		return err
+	}
+
+	return vpcHandler.updateSubnet(vpcName, subnetName, func(subnet *network2019.Subnet) {
+		subnet.RouteTable = &network2019.RouteTable{ID: routeTable.ID}
+		if natGateway != nil {
+			subnet.NatGateway = &network2019.SubResource{ID: natGateway.ID}
+		}
+	})
+}
+
+func (vpcHandler *AzureVPCHandler) setPrivateDefaultRoute(vpcName string, nextHopType network2019.RouteNextHopType) error {
+	routeTableName := vpcName + azurePrivateRouteTableSuffix
+	_, err := vpcHandler.RouteTableClient.Get(vpcHandler.Ctx, vpcHandler.Region.ResourceGroup, routeTableName, "")
+	if err != nil {
+		// Private Subnet이 없는 경우
+		return nil
+	}
+	return vpcHandler.updateRouteTable(routeTableName, func(routes []network2019.Route) []network2019.Route {
+		for i, route := range routes {
+			if *route.Name == azureDefaultRouteName {
+				routes[i].NextHopType = nextHopType
+			}
+		}
+		return routes
+	})
+}
+
+func (vpcHandler *AzureVPCHandler) getOrCreateRouteTable(vpcName string, routeTableName string, routes []network2019.Route) (network2019.RouteTable, error) {
+	routeTable, err := vpcHandler.RouteTableClient.Get(vpcHandler.Ctx, vpcHandler.Region.ResourceGroup, routeTableName, "")
+	if err == nil && routeTable.ID != nil {
+		return routeTable, nil
+	}
+
+	createOpts := network2019.RouteTable{
+		RouteTablePropertiesFormat: &network2019.RouteTablePropertiesFormat{
+			Routes: &routes,
+		},
+		Location: &vpcHandler.Region.Region,
+		Tags:     map[string]*string{azureVPCTagKey: to.StringPtr(vpcName)},
+	}
+	future, err := vpcHandler.RouteTableClient.CreateOrUpdate(vpcHandler.Ctx, vpcHandler.Region.ResourceGroup, routeTableName, createOpts)
+	if err != nil {
+		return network2019.RouteTable{}, errors.New(fmt.Sprintf("Failed to create route table, error=%s", err))
+	}
+	err = future.WaitForCompletionRef(vpcHandler.Ctx, vpcHandler.RouteTableClient.Client)
+	if err != nil {
+		return network2019.RouteTable{}, errors.New(fmt.Sprintf("Failed to create route table, error=%s", err))
+	}
+	return vpcHandler.RouteTableClient.Get(vpcHandler.Ctx, vpcHandler.Region.ResourceGroup, routeTableName, "")
+}
+
+func (vpcHandler *AzureVPCHandler) updateRouteTable(routeTableName string, update func(routes []network2019.Route) []network2019.Route) error {
+	routeTable, err := vpcHandler.RouteTableClient.Get(vpcHandler.Ctx, vpcHandler.Region.ResourceGroup, routeTableName, "")
+	if err != nil {
+		return err
+	}
+	var routes []network2019.Route
+	if routeTable.Routes != nil {
+		routes = *routeTable.Routes
+	}
+	routes = update(routes)
+	routeTable.Routes = &routes
+
+	future, err := vpcHandler.RouteTableClient.CreateOrUpdate(vpcHandler.Ctx, vpcHandler.Region.ResourceGroup, routeTableName, routeTable)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to update route table, error=%s", err))
+	}
+	return future.WaitForCompletionRef(vpcHandler.Ctx, vpcHandler.RouteTableClient.Client)
+}
+
+func (vpcHandler *AzureVPCHandler) updateSubnet(vpcName string, subnetName string, update func(subnet *network2019.Subnet)) error {
+	subnet, err := vpcHandler.RouteSubnetClient.Get(vpcHandler.Ctx, vpcHandler.Region.ResourceGroup, vpcName, subnetName, "")
+	if err != nil {
+		return err
+	}
+	update(&subnet)
+
+	future, err := vpcHandler.RouteSubnetClient.CreateOrUpdate(vpcHandler.Ctx, vpcHandler.Region.ResourceGroup, vpcName, subnetName, subnet)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to update subnet, error=%s", err))
+	}
+	return future.WaitForCompletionRef(vpcHandler.Ctx, vpcHandler.RouteSubnetClient.Client)
+}
+
+// VPC 태그가 설정된 NAT Gateway를 조회함. 없으면 nil을 리턴 함.
+func (vpcHandler *AzureVPCHandler) getNATGateway(vpcName string) (*network2019.NatGateway, error) {
+	natList, err := vpcHandler.NatGatewayClient.List(vpcHandler.Ctx, vpcHandler.Region.ResourceGroup)
+	if err != nil {
+		return nil, err
+	}
+	for _, natGateway := range natList.Values() {
+		if tag, ok := natGateway.Tags[azureVPCTagKey]; ok && tag != nil && *tag == vpcName {
+			return &natGateway, nil
+		}
+	}
+	return nil, nil
+}
+
+func (vpcHandler *AzureVPCHandler) deleteNATGateway(natGateway network2019.NatGateway) error {
+	future, err := vpcHandler.NatGatewayClient.Delete(vpcHandler.Ctx, vpcHandler.Region.ResourceGroup, *natGateway.Name)
+	if err != nil {
+		return err
+	}
+	err = future.WaitForCompletionRef(vpcHandler.Ctx, vpcHandler.NatGatewayClient.Client)
+	if err != nil {
+		return err
+	}
+
+	// NAT Gateway용으로 생성한 PublicIP 삭제
+	if natGateway.PublicIPAddresses != nil {
+		for _, publicIP := range *natGateway.PublicIPAddresses {
+			ipFuture, err := vpcHandler.PublicIPClient.Delete(vpcHandler.Ctx, vpcHandler.Region.ResourceGroup, azureResourceName(*publicIP.ID))
+			if err != nil {
+				return err
+			}
+			err = ipFuture.WaitForCompletionRef(vpcHandler.Ctx, vpcHandler.PublicIPClient.Client)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// VPC 태그가 설정된 라우팅 테이블 목록을 조회함.
+func (vpcHandler *AzureVPCHandler) listRouteTable(vpcName string) ([]network2019.RouteTable, error) {
+	rtList, err := vpcHandler.RouteTableClient.List(vpcHandler.Ctx, vpcHandler.Region.ResourceGroup)
+	if err != nil {
+		return nil, err
+	}
+	var routeTables []network2019.RouteTable
+	for _, routeTable := range rtList.Values() {
+		if tag, ok := routeTable.Tags[azureVPCTagKey]; ok && tag != nil && *tag == vpcName {
+			routeTables = append(routeTables, routeTable)
+		}
+	}
+	return routeTables, nil
+}
+
+func (vpcHandler *AzureVPCHandler) setterNATAndRouteTable(vpcInfo *irs.VPCInfo) error {
+	natGateway, err := vpcHandler.getNATGateway(vpcInfo.IId.NameId)
+	if err != nil {
+		return err
+	}
+	var natIID irs.IID
+	if natGateway != nil {
+		natIID = irs.IID{NameId: *natGateway.Name, SystemId: *natGateway.ID}
+		natInfo := irs.NATGatewayInfo{
+			IId:          natIID,
+			KeyValueList: []irs.KeyValue{{Key: "ResourceGroup", Value: vpcHandler.Region.ResourceGroup}},
+		}
+		if natGateway.PublicIPAddresses != nil && len(*natGateway.PublicIPAddresses) > 0 {
+			publicIP, err := vpcHandler.PublicIPClient.Get(vpcHandler.Ctx, vpcHandler.Region.ResourceGroup, azureResourceName(*(*natGateway.PublicIPAddresses)[0].ID), "")
+			if err == nil && publicIP.IPAddress != nil {
+				natInfo.PublicIP = *publicIP.IPAddress
+			}
+		}
+		vpcInfo.NATGatewayInfoList = []irs.NATGatewayInfo{natInfo}
+	}
+
+	routeTables, err := vpcHandler.listRouteTable(vpcInfo.IId.NameId)
+	if err != nil {
+		return err
+	}
+	for _, routeTable := range routeTables {
+		routeTableInfo := irs.RouteTableInfo{
+			IId:          irs.IID{NameId: *routeTable.Name, SystemId: *routeTable.ID},
+			KeyValueList: []irs.KeyValue{{Key: "ResourceGroup", Value: vpcHandler.Region.ResourceGroup}},
+		}
+		if routeTable.Subnets != nil {
+			for _, subnet := range *routeTable.Subnets {
+				routeTableInfo.SubnetIIDs = append(routeTableInfo.SubnetIIDs, irs.IID{NameId: azureResourceName(*subnet.ID), SystemId: *subnet.ID})
+			}
+		}
+		if routeTable.Routes != nil {
+			for _, route := range *routeTable.Routes {
+				if route.NextHopType != network2019.RouteNextHopTypeInternet {
+					continue
+				}
+				routeInfo := irs.RouteInfo{
+					DestinationCIDR: *route.AddressPrefix,
+					TargetType:      irs.RouteInternetGateway,
+				}
+				// Private 라우팅 테이블의 기본 라우팅은 NAT Gateway를 경유 함.
+				if *route.Name == azureDefaultRouteName && natGateway != nil {
+					routeInfo.TargetType = irs.RouteNATGateway
+					routeInfo.TargetIID = natIID
+				}
+				routeTableInfo.RouteList = append(routeTableInfo.RouteList, routeInfo)
+			}
+		}
+		vpcInfo.RouteTableInfoList = append(vpcInfo.RouteTableInfoList, routeTableInfo)
+	}
+	return nil
+}
+
+// Subnet의 IPv6 CIDR은 network 2019-06-01 API의 AddressPrefixes로 설정 함.
+func (vpcHandler *AzureVPCHandler) setSubnetIPv6CIDR(vpcName string, subnetName string, ipv6CIDR string) error {
+	return vpcHandler.updateSubnet(vpcName, subnetName, func(subnet *network2019.Subnet) {
+		addressPrefixes := []string{ipv6CIDR}
+		if subnet.AddressPrefix != nil {
+			addressPrefixes = []string{*subnet.AddressPrefix, ipv6CIDR}
+		} else if subnet.AddressPrefixes != nil {
+			addressPrefixes = append(*subnet.AddressPrefixes, ipv6CIDR)
+		}
+		subnet.AddressPrefix = nil
+		subnet.AddressPrefixes = &addressPrefixes
+	})
+}
+
+// 2018-04-01 API의 Subnet은 AddressPrefixes 정보가 없으므로 Dual-Stack VNet의 Subnet CIDR은 2019-06-01 API로 조회 함.
+func (vpcHandler *AzureVPCHandler) setterSubnetIPv6(vpcInfo *irs.VPCInfo) error {
+	if vpcInfo.IPv6_CIDR == "" {
+		return nil
+	}
+	subnetList, err := vpcHandler.RouteSubnetClient.List(vpcHandler.Ctx, vpcHandler.Region.ResourceGroup, vpcInfo.IId.NameId)
+	if err != nil {
+		return err
+	}
+	for _, subnet := range subnetList.Values() {
+		if subnet.SubnetPropertiesFormat == nil || subnet.AddressPrefixes == nil {
+			continue
+		}
+		for i, subnetInfo := range vpcInfo.SubnetInfoList {
+			if subnetInfo.IId.NameId != *subnet.Name {
+				continue
+			}
+			for _, prefix := range *subnet.AddressPrefixes {
+				if strings.Contains(prefix, ":") {
+					vpcInfo.SubnetInfoList[i].IPv6_CIDR = prefix
+				} else {
+					vpcInfo.SubnetInfoList[i].IPv4_CIDR = prefix
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// 요청한 Subnet IPv6 CIDR이 없으면 VNet IPv6 CIDR에서 사용하지 않는 첫번째 /64를 사용 함.
+func getSubnetIPv6CIDR(vpcIPv6CIDR string, reqIPv6CIDR string, usedIPv6CIDRList []string) (string, error) {
+	if reqIPv6CIDR != "" && reqIPv6CIDR != irs.IPv6AutoCIDR {
+		return reqIPv6CIDR, nil
+	}
+
+	_, vpcNet, err := net.ParseCIDR(vpcIPv6CIDR)
+	if err != nil {
+		return "", err
+	}
+	vpcPrefixLen, _ := vpcNet.Mask.Size()
+	if vpcPrefixLen > 64 {
+		return "", fmt.Errorf("Azure Cloud Driver: VPC IPv6 CIDR %s is smaller than /64!", vpcIPv6CIDR)
+	}
+	base := binary.BigEndian.Uint64(vpcNet.IP.To16()[:8])
+	for i := uint64(0); i < uint64(1)<<uint(64-vpcPrefixLen); i++ {
+		ip := make(net.IP, net.IPv6len)
+		binary.BigEndian.PutUint64(ip[:8], base+i)
+		candidate := (&net.IPNet{IP: ip, Mask: net.CIDRMask(64, 128)}).String()
+		used := false
+		for _, usedCIDR := range usedIPv6CIDRList {
+			if usedCIDR == candidate {
+				used = true
+				break
+			}
+		}
+		if !used {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("Azure Cloud Driver: no free /64 in VPC IPv6 CIDR %s!", vpcIPv6CIDR)
+}
+
+func isPrivateSubnet(subnet network.Subnet) bool {
+	return subnet.SubnetPropertiesFormat != nil && subnet.RouteTable != nil && subnet.RouteTable.ID != nil &&
+		strings.HasSuffix(*subnet.RouteTable.ID, azurePrivateRouteTableSuffix)
+}
+
+// Resource ID의 마지막 항목(이름)을 리턴 함.
+func azureResourceName(resourceId string) string {
+	str := strings.Split(resourceId, "/")
+	return str[len(str)-1]
+}
+
+// 라우팅 이름 : cb-route-{cidr} (예: cb-route-10-0-0-0-16)
+func azureRouteName(cidr string) string {
+	return azureRoutePrefix + strings.NewReplacer(".", "-", "/", "-").Replace(cidr)
+}
@@ -6,6 +6,7 @@ import (
 	cicon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/cloudit/connect"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	"github.com/sirupsen/logrus"
 )
 
@@ -19,23 +20,76 @@ func init() {
 type ClouditDriver struct{}
 
 func (ClouditDriver) GetDriverVersion() string {
-	return "CLOUDIT DRIVER Version 1.0"
+	return idrv.DriverVersion("CLOUDIT DRIVER Version 1.0")
 }
 
 func (ClouditDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	var drvCapabilityInfo idrv.DriverCapabilityInfo
 
-	drvCapabilityInfo.ImageHandler = false
-	drvCapabilityInfo.VPCHandler = false
-	drvCapabilityInfo.SecurityHandler = false
+	drvCapabilityInfo.FIXED_SUBNET_CIDR = false
+	drvCapabilityInfo.VPC_CIDR = false
+
+	drvCapabilityInfo.ImageHandler = true
+	drvCapabilityInfo.VPCHandler = true
+	drvCapabilityInfo.SecurityHandler = true
 	drvCapabilityInfo.KeyPairHandler = false
 	drvCapabilityInfo.VNicHandler = false
 	drvCapabilityInfo.PublicIPHandler = false
 	drvCapabilityInfo.VMHandler = true
+	drvCapabilityInfo.VMSpecHandler = true
+
+	drvCapabilityInfo.VPC_IPv6_CIDR = false
+	drvCapabilityInfo.SECURITY_IPv6_RULE = false
+	drvCapabilityInfo.VM_MULTI_NIC = false
+	drvCapabilityInfo.SUBNET_ADD_REMOVE = true
+	drvCapabilityInfo.VM_SUSPEND_RESUME = true
+	drvCapabilityInfo.VM_REBOOT = true
 
 	return drvCapabilityInfo
 }
 
+// key names of the region and the zone in the key-value list of the region.
+func (ClouditDriver) GetRegionZoneKeyName() (string, string) {
+	return "Region", ""
+}
+
+// translates the key-value lists of the credential and the region into ConnectionInfo.
+func (driver ClouditDriver) GetConnectionInfo(credentialKeyValueList []irs.KeyValue, regionKeyValueList []irs.KeyValue) (idrv.ConnectionInfo, error) {
+	regionKey, _ := driver.GetRegionZoneKeyName()
+
+	connectionInfo := idrv.ConnectionInfo{
+		CredentialInfo: idrv.CredentialInfo{
+			IdentityEndpoint: idrv.GetKeyValue(credentialKeyValueList, "IdentityEndpoint"),
+			AuthToken:        idrv.GetKeyValue(credentialKeyValueList, "AuthToken"),
+			TenantId:         idrv.GetKeyValue(credentialKeyValueList, "TenantId"),
+			Username:         idrv.GetKeyValue(credentialKeyValueList, "Username"),
+			Password:         idrv.GetKeyValue(credentialKeyValueList, "Password"),
+			KeyValueList:     credentialKeyValueList,
+		},
+		RegionInfo: idrv.RegionInfo{
+			Region:       idrv.GetKeyValue(regionKeyValueList, regionKey),
+			KeyValueList: regionKeyValueList,
+		},
+	}
+	return connectionInfo, nil
+}
+
+// keys of the credential and the region read by GetConnectionInfo().
+func (ClouditDriver) GetConnectionSchema() idrv.ConnectionSchema {
+	return idrv.ConnectionSchema{
+		Credential: []idrv.KeySchema{
+			{Key: "IdentityEndpoint", Description: "Cloudit API endpoint", Required: true},
+			{Key: "AuthToken", Description: "API auth token", Required: true, Secret: true},
+			{Key: "TenantId", Description: "Tenant ID", Required: true},
+			{Key: "Username", Description: "User name"},
+			{Key: "Password", Description: "User password", Secret: true},
+		},
+		Region: []idrv.KeySchema{
+			{Key: "Region", Description: "not used, ex) default"},
+		},
+	}
+}
+
 func (driver *ClouditDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.CloudConnection, error) {
 	// 1. get info of credential and region for Test A Cloud from connectionInfo.
 	// 2. create a client object(or service  object) of Test A Cloud with credential info.
@@ -11,6 +11,7 @@
 package connect
 
 import (
+	"errors"
 	cblog "github.com/cloud-barista/cb-log"
 	"github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/cloudit/client"
 	cirs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/cloudit/resources"
@@ -51,7 +52,7 @@ func (cloudConn ClouditCloudConnection) CreateSecurityHandler() (irs.SecurityHan
 
 func (cloudConn *ClouditCloudConnection) CreateKeyPairHandler() (irs.KeyPairHandler, error) {
 	cblogger.Error("Cloudit Cloud Driver: called CreateKeyPairHandler(), but not supported!")
-	return nil, nil
+	return nil, errors.New("Cloudit Cloud Driver: CreateKeyPairHandler() is not supported!")
 }
 
 /*func (cloudConn ClouditCloudConnection) CreateVNicHandler() (irs.VNicHandler, error) {
@@ -78,6 +79,31 @@ func (cloudConn *ClouditCloudConnection) CreateVMSpecHandler() (irs.VMSpecHandle
 	return &vmSpecHandler, nil
 }
 
+func (cloudConn *ClouditCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	cblogger.Error("Cloudit Cloud Driver: called CreatePublicIPHandler(), but not supported!")
+	return nil, errors.New("Cloudit Cloud Driver: CreatePublicIPHandler() is not supported!")
+}
+
+func (cloudConn *ClouditCloudConnection) CreateNLBHandler() (irs.NLBHandler, error) {
+	cblogger.Error("Cloudit Cloud Driver: called CreateNLBHandler(), but not supported!")
+	return nil, errors.New("Cloudit Cloud Driver: CreateNLBHandler() is not supported!")
+}
+
+func (cloudConn *ClouditCloudConnection) CreateDNSHandler() (irs.DNSHandler, error) {
+	cblogger.Error("Cloudit Cloud Driver: called CreateDNSHandler(), but not supported!")
+	return nil, errors.New("Cloudit Cloud Driver: CreateDNSHandler() is not supported!")
+}
+
+func (cloudConn *ClouditCloudConnection) CreateBucketHandler() (irs.BucketHandler, error) {
+	cblogger.Error("Cloudit Cloud Driver: called CreateBucketHandler(), but not supported!")
+	return nil, errors.New("Cloudit Cloud Driver: CreateBucketHandler() is not supported!")
+}
+
+func (cloudConn *ClouditCloudConnection) CreateRegionZoneHandler() (irs.RegionZoneHandler, error) {
+	cblogger.Error("Cloudit Cloud Driver: called CreateRegionZoneHandler(), but not supported!")
+	return nil, errors.New("Cloudit Cloud Driver: CreateRegionZoneHandler() is not supported!")
+}
+
 func (ClouditCloudConnection) IsConnected() (bool, error) {
 	return true, nil
 }
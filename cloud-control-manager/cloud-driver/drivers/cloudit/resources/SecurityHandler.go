@@ -47,6 +47,7 @@ func setterSecGroup(secGroup securitygroup.SecurityGroupInfo) *irs.SecurityInfo
 			secRuleInfo.FromPort = sgRule.Port
 			secRuleInfo.ToPort = sgRule.Port
 		}
+		secRuleInfo.CIDR = sgRule.Target
 		secRuleArr[i] = secRuleInfo
 	}
 	secInfo.SecurityRules = &secRuleArr
@@ -73,11 +74,19 @@ func (securityHandler *ClouditSecurityHandler) CreateSecurity(securityReqInfo ir
 	// SecurityGroup Rule 설정
 	ruleList := make([]securitygroup.SecurityGroupRules, len(*securityReqInfo.SecurityRules))
 	for i, rule := range *securityReqInfo.SecurityRules {
+		// Cloudit은 IPv6 보안그룹 룰을 지원하지 않음
+		target := defaultSecGroupCIDR
+		if rule.CIDR != "" {
+			if strings.Contains(rule.CIDR, ":") {
+				return irs.SecurityInfo{}, errors.New("Cloudit Cloud Driver: IPv6 CIDR(" + rule.CIDR + ") of security rule is not supported!")
+			}
+			target = rule.CIDR
+		}
 		secRuleInfo := securitygroup.SecurityGroupRules{
 			Name:     fmt.Sprintf("%s-rules-%d", securityReqInfo.IId.NameId, i+1),
 			Type:     rule.Direction,
 			Port:     rule.FromPort + "-" + rule.ToPort,
-			Target:   defaultSecGroupCIDR,
+			Target:   target,
 			Protocol: strings.ToLower(rule.IPProtocol),
 		}
 		ruleList[i] = secRuleInfo
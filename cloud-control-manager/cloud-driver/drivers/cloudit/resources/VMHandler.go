@@ -49,6 +49,11 @@ func (vmHandler *ClouditVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 		return irs.VMInfo{}, createErr
 	}
 
+	// Cloudit은 VM 생성 시 고정 Private IP 지정을 지원하지 않음.
+	if vmReqInfo.PrivateIP != "" {
+		return irs.VMInfo{}, errors.New("Cloudit does not support a fixed private IP of VM")
+	}
+
 	// 이미지 정보 조회 (Name)
 	imageHandler := ClouditImageHandler{
 		Client:         vmHandler.Client,
@@ -361,6 +366,11 @@ func (vmHandler *ClouditVMHandler) GetVM(vmIID irs.IID) (irs.VMInfo, error) {
 }
 
 // VM에 PublicIP 연결
+func (vmHandler *ClouditVMHandler) GetVMConsoleOutput(vmIID irs.IID) (string, error) {
+	// Cloudit API에서 콘솔 출력 조회를 지원하지 않음
+	return "", errors.New("Cloudit Driver: GetVMConsoleOutput() is not supported")
+}
+
 func (vmHandler *ClouditVMHandler) AssociatePublicIP(vmName string, vmIp string) (bool, error) {
 	vmHandler.Client.TokenID = vmHandler.CredentialInfo.AuthToken
 	authHeader := vmHandler.Client.AuthenticatedHeaders()
@@ -42,6 +42,9 @@ func setterVMSpec(region string, vmSpec specs.VMSpecInfo) *irs.VMSpecInfo {
 		KeyValueList: nil,
 	}
 	vmSpecInfo.Mem = strconv.FormatFloat(float64(vmSpec.Mem)*1024, 'f', 0, 64)
+	if err := irs.NormalizeVMSpecInfo(vmSpecInfo); err != nil {
+		cblogger.Error(err)
+	}
 	return vmSpecInfo
 }
 
@@ -298,3 +298,39 @@ func (VPCHandler *ClouditVPCHandler) RemoveSubnet(vpcIID irs.IID, subnetIID irs.
 	}
 	return true, nil
 }
+
+func (VPCHandler *ClouditVPCHandler) RequestVPCPeering(peeringReqInfo irs.VPCPeeringReqInfo) (irs.VPCPeeringInfo, error) {
+	return irs.VPCPeeringInfo{}, errors.New("Cloudit Cloud Driver: RequestVPCPeering() is not supported!")
+}
+
+func (VPCHandler *ClouditVPCHandler) AcceptVPCPeering(peeringIID irs.IID) (irs.VPCPeeringInfo, error) {
+	return irs.VPCPeeringInfo{}, errors.New("Cloudit Cloud Driver: AcceptVPCPeering() is not supported!")
+}
+
+func (VPCHandler *ClouditVPCHandler) ListVPCPeering() ([]*irs.VPCPeeringInfo, error) {
+	return nil, errors.New("Cloudit Cloud Driver: ListVPCPeering() is not supported!")
+}
+
+func (VPCHandler *ClouditVPCHandler) GetVPCPeering(peeringIID irs.IID) (irs.VPCPeeringInfo, error) {
+	return irs.VPCPeeringInfo{}, errors.New("Cloudit Cloud Driver: GetVPCPeering() is not supported!")
+}
+
+func (VPCHandler *ClouditVPCHandler) DeleteVPCPeering(peeringIID irs.IID) (bool, error) {
+	return false, errors.New("Cloudit Cloud Driver: DeleteVPCPeering() is not supported!")
+}
+
+func (VPCHandler *ClouditVPCHandler) AddNATGateway(vpcIID irs.IID, natReqInfo irs.NATGatewayReqInfo) (irs.VPCInfo, error) {
+	return irs.VPCInfo{}, errors.New("Cloudit Cloud Driver: AddNATGateway() is not supported!")
+}
+
+func (VPCHandler *ClouditVPCHandler) RemoveNATGateway(vpcIID irs.IID, natGatewayIID irs.IID) (bool, error) {
+	return false, errors.New("Cloudit Cloud Driver: RemoveNATGateway() is not supported!")
+}
+
+func (VPCHandler *ClouditVPCHandler) AddRoute(vpcIID irs.IID, subnetIID irs.IID, routeInfo irs.RouteInfo) (irs.VPCInfo, error) {
+	return irs.VPCInfo{}, errors.New("Cloudit Cloud Driver: AddRoute() is not supported!")
+}
+
+func (VPCHandler *ClouditVPCHandler) RemoveRoute(vpcIID irs.IID, subnetIID irs.IID, routeInfo irs.RouteInfo) (bool, error) {
+	return false, errors.New("Cloudit Cloud Driver: RemoveRoute() is not supported!")
+}
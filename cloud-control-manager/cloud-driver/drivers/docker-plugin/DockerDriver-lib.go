@@ -20,6 +20,7 @@ import (
 	dkcon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/docker/connect"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 )
 
 type DockerDriver struct{}
@@ -32,12 +33,15 @@ func init() {
 
 
 func (DockerDriver) GetDriverVersion() string {
-	return "DOCKER DRIVER Version 1.0"
+	return idrv.DriverVersion("DOCKER DRIVER Version 1.0")
 }
 
 func (DockerDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	var drvCapabilityInfo idrv.DriverCapabilityInfo
 
+	drvCapabilityInfo.FIXED_SUBNET_CIDR = false
+	drvCapabilityInfo.VPC_CIDR = false
+
 	drvCapabilityInfo.ImageHandler = true
 	drvCapabilityInfo.VPCHandler = false
 	drvCapabilityInfo.SecurityHandler = false
@@ -46,10 +50,58 @@ func (DockerDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	drvCapabilityInfo.PublicIPHandler = false
 	drvCapabilityInfo.VMHandler = true
 	drvCapabilityInfo.VMSpecHandler = false
+	drvCapabilityInfo.NLBHandler = false
+	drvCapabilityInfo.DNSHandler = false
+	drvCapabilityInfo.BucketHandler = false
+	drvCapabilityInfo.RegionZoneHandler = false
+
+	drvCapabilityInfo.VPC_IPv6_CIDR = false
+	drvCapabilityInfo.SECURITY_IPv6_RULE = false
+	drvCapabilityInfo.VM_MULTI_NIC = false
+	drvCapabilityInfo.SUBNET_ADD_REMOVE = false
+	drvCapabilityInfo.VM_SUSPEND_RESUME = true
+	drvCapabilityInfo.VM_REBOOT = true
 
 	return drvCapabilityInfo
 }
 
+// key names of the region and the zone in the key-value list of the region.
+func (DockerDriver) GetRegionZoneKeyName() (string, string) {
+	return "Region", ""
+}
+
+// translates the key-value lists of the credential and the region into ConnectionInfo.
+func (driver DockerDriver) GetConnectionInfo(credentialKeyValueList []irs.KeyValue, regionKeyValueList []irs.KeyValue) (idrv.ConnectionInfo, error) {
+	regionKey, _ := driver.GetRegionZoneKeyName()
+
+	connectionInfo := idrv.ConnectionInfo{
+		CredentialInfo: idrv.CredentialInfo{
+			Host:         idrv.GetKeyValue(credentialKeyValueList, "Host"),
+			APIVersion:   idrv.GetKeyValue(credentialKeyValueList, "APIVersion"),
+			KeyValueList: credentialKeyValueList,
+		},
+		RegionInfo: idrv.RegionInfo{
+			Region:       idrv.GetKeyValue(regionKeyValueList, regionKey),
+			KeyValueList: regionKeyValueList,
+		},
+	}
+	return connectionInfo, nil
+}
+
+// keys of the credential and the region read by GetConnectionInfo().
+func (DockerDriver) GetConnectionSchema() idrv.ConnectionSchema {
+	return idrv.ConnectionSchema{
+		Credential: []idrv.KeySchema{
+			{Key: "Host", Description: "Docker daemon, ex) http://192.168.0.10:1004", Required: true},
+			{Key: "APIVersion", Description: "Docker API version, ex) v1.38", Required: true},
+		},
+		Region: []idrv.KeySchema{
+			{Key: "Region", Description: "not used, ex) default"},
+			{Key: "Zone", Description: "not used"},
+		},
+	}
+}
+
 func (driver *DockerDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.CloudConnection, error) {
         // 1. get info of credential and region for Test A Cloud from connectionInfo.
         // 2. create a client object(or service  object) of XXX Cloud with credential info.
@@ -11,6 +11,7 @@
 package connect
 
 import (
+	"errors"
 	"context"
 	cblog "github.com/cloud-barista/cb-log"
 	"github.com/docker/docker/client"
@@ -52,22 +53,47 @@ func (cloudConn *DockerCloudConnection) CreateVMHandler() (irs.VMHandler, error)
 
 func (cloudConn *DockerCloudConnection) CreateVPCHandler() (irs.VPCHandler, error) {
         cblogger.Error("Docker Cloud Driver: called CreateVPCHandler(), but not supported!")
-        return nil, nil
+        return nil, errors.New("Docker Cloud Driver: CreateVPCHandler() is not supported!")
 }
 
 func (cloudConn DockerCloudConnection) CreateSecurityHandler() (irs.SecurityHandler, error) {
         cblogger.Error("Docker Cloud Driver: called CreateSecurityHandler(), but not supported!")
-        return nil, nil
+        return nil, errors.New("Docker Cloud Driver: CreateSecurityHandler() is not supported!")
 }
 
 func (cloudConn *DockerCloudConnection) CreateKeyPairHandler() (irs.KeyPairHandler, error) {
         cblogger.Error("Docker Cloud Driver: called CreateKeyPairHandler(), but not supported!")
-        return nil, nil
+        return nil, errors.New("Docker Cloud Driver: CreateKeyPairHandler() is not supported!")
 }
 
 func (cloudConn *DockerCloudConnection) CreateVMSpecHandler() (irs.VMSpecHandler, error) {
         cblogger.Error("Docker Cloud Driver: called CreateVMSpecHandler(), but not supported!")
-	return nil, nil
+	return nil, errors.New("Docker Cloud Driver: CreateVMSpecHandler() is not supported!")
+}
+
+func (cloudConn *DockerCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+        cblogger.Error("Docker Cloud Driver: called CreatePublicIPHandler(), but not supported!")
+        return nil, errors.New("Docker Cloud Driver: CreatePublicIPHandler() is not supported!")
+}
+
+func (cloudConn *DockerCloudConnection) CreateNLBHandler() (irs.NLBHandler, error) {
+        cblogger.Error("Docker Cloud Driver: called CreateNLBHandler(), but not supported!")
+        return nil, errors.New("Docker Cloud Driver: CreateNLBHandler() is not supported!")
+}
+
+func (cloudConn *DockerCloudConnection) CreateDNSHandler() (irs.DNSHandler, error) {
+        cblogger.Error("Docker Cloud Driver: called CreateDNSHandler(), but not supported!")
+        return nil, errors.New("Docker Cloud Driver: CreateDNSHandler() is not supported!")
+}
+
+func (cloudConn *DockerCloudConnection) CreateBucketHandler() (irs.BucketHandler, error) {
+        cblogger.Error("Docker Cloud Driver: called CreateBucketHandler(), but not supported!")
+        return nil, errors.New("Docker Cloud Driver: CreateBucketHandler() is not supported!")
+}
+
+func (cloudConn *DockerCloudConnection) CreateRegionZoneHandler() (irs.RegionZoneHandler, error) {
+        cblogger.Error("Docker Cloud Driver: called CreateRegionZoneHandler(), but not supported!")
+        return nil, errors.New("Docker Cloud Driver: CreateRegionZoneHandler() is not supported!")
 }
 
 func (cloudConn *DockerCloudConnection) IsConnected() (bool, error) {
@@ -11,10 +11,14 @@
 package resources
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"io"
 	"github.com/docker/docker/client"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/docker/go-connections/nat"
 
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
@@ -32,6 +36,11 @@ type DockerVMHandler struct {
 func (vmHandler *DockerVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, error) {
         cblogger.Info("Docker Cloud Driver: called StartVM()!")
 
+	if vmReqInfo.PrivateIP != "" {
+		cblogger.Error("Docker Cloud Driver: a fixed private IP of VM is not supported!")
+		return irs.VMInfo{}, errors.New("Docker Cloud Driver: a fixed private IP of VM is not supported!")
+	}
+
 /*
  ref) https://godoc.org/github.com/docker/docker/api/types/container#Config
 type Config struct {
@@ -328,6 +337,41 @@ func (vmHandler *DockerVMHandler) GetVMStatus(vmIID irs.IID) (irs.VMStatus, erro
 	return getMappedStatus(container.State.Status), nil
 }
 
+func (vmHandler *DockerVMHandler) GetVMConsoleOutput(vmIID irs.IID) (string, error) {
+        cblogger.Info("Docker Cloud Driver: called GetVMConsoleOutput()!")
+
+        container, err := vmHandler.Client.ContainerInspect(vmHandler.Context, vmIID.SystemId)
+        if err != nil {
+                cblogger.Error(err)
+                return "", err
+        }
+
+        logs, err := vmHandler.Client.ContainerLogs(vmHandler.Context, vmIID.SystemId, types.ContainerLogsOptions{
+                ShowStdout: true,
+                ShowStderr: true,
+                Tail:       "1000",
+        })
+        if err != nil {
+                cblogger.Error(err)
+                return "", err
+        }
+        defer logs.Close()
+
+        // the log stream of a container without TTY is multiplexed(stdout + stderr)
+        var output bytes.Buffer
+        if container.Config != nil && container.Config.Tty {
+                _, err = io.Copy(&output, logs)
+        } else {
+                _, err = stdcopy.StdCopy(&output, &output, logs)
+        }
+        if err != nil {
+                cblogger.Error(err)
+                return "", err
+        }
+
+        return output.String(), nil
+}
+
 func (vmHandler *DockerVMHandler) ListVM() ([]*irs.VMInfo, error) {
 	cblogger.Info("Docker Cloud Driver: called ListVM()!")
 
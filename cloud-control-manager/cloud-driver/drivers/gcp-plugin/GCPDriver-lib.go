@@ -8,9 +8,8 @@
 //
 // by jazmandorf@gmail.com MZC
 
-package main
 
-//package gcp
+package main
 
 import (
 	"C"
@@ -23,23 +22,28 @@ import (
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 
 	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 
 	o2 "golang.org/x/oauth2"
 	goo "golang.org/x/oauth2/google"
 
 	compute "google.golang.org/api/compute/v1"
+	storage "google.golang.org/api/storage/v1"
 )
 
 type GCPDriver struct {
 }
 
 func (GCPDriver) GetDriverVersion() string {
-	return "GCP DRIVER Version 1.0"
+	return idrv.DriverVersion("GCP DRIVER Version 1.0")
 }
 
 func (GCPDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	var drvCapabilityInfo idrv.DriverCapabilityInfo
 
+	drvCapabilityInfo.FIXED_SUBNET_CIDR = true
+	drvCapabilityInfo.VPC_CIDR = false
+
 	drvCapabilityInfo.ImageHandler = true
 	drvCapabilityInfo.VPCHandler = true
 	drvCapabilityInfo.SecurityHandler = true
@@ -48,11 +52,64 @@ func (GCPDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	//drvCapabilityInfo.PublicIPHandler = true
 	drvCapabilityInfo.VMHandler = true
 	drvCapabilityInfo.VMSpecHandler = true
+	drvCapabilityInfo.NLBHandler = false
+	drvCapabilityInfo.DNSHandler = false
+	drvCapabilityInfo.BucketHandler = true
+	drvCapabilityInfo.RegionZoneHandler = true
 	drvCapabilityInfo.VPCHandler = true
 
+	drvCapabilityInfo.VPC_IPv6_CIDR = false
+	drvCapabilityInfo.SECURITY_IPv6_RULE = false
+	// false: each NIC of a GCP VM must be in a different VPC network,
+	// but VMReqInfo has only one VpcIID. a follow-up of VM_MULTI_NIC, see CHANGELOG.md.
+	drvCapabilityInfo.VM_MULTI_NIC = false
+	drvCapabilityInfo.SUBNET_ADD_REMOVE = true
+	drvCapabilityInfo.VM_SUSPEND_RESUME = true
+	drvCapabilityInfo.VM_REBOOT = true
+
 	return drvCapabilityInfo
 }
 
+// key names of the region and the zone in the key-value list of the region.
+func (GCPDriver) GetRegionZoneKeyName() (string, string) {
+	return "Region", "Zone"
+}
+
+// translates the key-value lists of the credential and the region into ConnectionInfo.
+func (driver GCPDriver) GetConnectionInfo(credentialKeyValueList []irs.KeyValue, regionKeyValueList []irs.KeyValue) (idrv.ConnectionInfo, error) {
+	regionKey, zoneKey := driver.GetRegionZoneKeyName()
+
+	connectionInfo := idrv.ConnectionInfo{
+		CredentialInfo: idrv.CredentialInfo{
+			PrivateKey:   idrv.GetKeyValue(credentialKeyValueList, "PrivateKey"),
+			ProjectID:    idrv.GetKeyValue(credentialKeyValueList, "ProjectID"),
+			ClientEmail:  idrv.GetKeyValue(credentialKeyValueList, "ClientEmail"),
+			KeyValueList: credentialKeyValueList,
+		},
+		RegionInfo: idrv.RegionInfo{
+			Region:       idrv.GetKeyValue(regionKeyValueList, regionKey),
+			Zone:         idrv.GetKeyValue(regionKeyValueList, zoneKey),
+			KeyValueList: regionKeyValueList,
+		},
+	}
+	return connectionInfo, nil
+}
+
+// keys of the credential and the region read by GetConnectionInfo().
+func (GCPDriver) GetConnectionSchema() idrv.ConnectionSchema {
+	return idrv.ConnectionSchema{
+		Credential: []idrv.KeySchema{
+			{Key: "PrivateKey", Description: "private_key of the Service Account key file", Required: true, Secret: true},
+			{Key: "ProjectID", Description: "project_id of the Service Account key file", Required: true},
+			{Key: "ClientEmail", Description: "client_email of the Service Account key file", Required: true},
+		},
+		Region: []idrv.KeySchema{
+			{Key: "Region", Description: "ex) asia-northeast3", Required: true},
+			{Key: "Zone", Description: "ex) asia-northeast3-a", Required: true},
+		},
+	}
+}
+
 func (driver *GCPDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.CloudConnection, error) {
 	// 1. get info of credential and region for Test A Cloud from connectionInfo.
 	// 2. create a client object(or service  object) of Test A Cloud with credential info.
@@ -67,6 +124,11 @@ func (driver *GCPDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.
 		return nil, err
 	}
 
+	StorageClient, err := getStorageClient(connectionInfo.CredentialInfo)
+	if err != nil {
+		return nil, err
+	}
+
 	iConn := gcpcon.GCPCloudConnection{
 		Region:      connectionInfo.RegionInfo,
 		Credential:  connectionInfo.CredentialInfo,
@@ -80,6 +142,7 @@ func (driver *GCPDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.
 		SubnetClient:  VMClient,
 		VMSpecHandler: VMClient,
 		VPCHandler:    VMClient,
+		StorageClient: StorageClient,
 	}
 
 	//fmt.Println("################## resource ConnectionInfo ##################")
@@ -122,4 +185,22 @@ func getVMClient(credential idrv.CredentialInfo) (context.Context, *compute.Serv
 	return ctx, vmClient, nil
 }
 
+// GCS는 compute와 다른 Scope가 필요 함.
+func getStorageClient(credential idrv.CredentialInfo) (*storage.Service, error) {
+	data := make(map[string]string)
+
+	data["type"] = "service_account"
+	data["private_key"] = credential.PrivateKey
+	data["client_email"] = credential.ClientEmail
+
+	res, _ := json.Marshal(data)
+	conf, err := goo.JWTConfigFromJSON(res, storage.DevstorageFullControlScope)
+	if err != nil {
+		return nil, err
+	}
+
+	client := conf.Client(o2.NoContext)
+	return storage.New(client)
+}
+
 var CloudDriver GCPDriver
@@ -11,6 +11,7 @@
 package connect
 
 import (
+	"errors"
 	"context"
 
 	cblog "github.com/cloud-barista/cb-log"
@@ -19,6 +20,7 @@ import (
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	"github.com/sirupsen/logrus"
 	compute "google.golang.org/api/compute/v1"
+	storage "google.golang.org/api/storage/v1"
 )
 
 var cblogger *logrus.Logger
@@ -41,6 +43,7 @@ type GCPCloudConnection struct {
 	SubnetClient        *compute.Service
 	VMSpecHandler       *compute.Service
 	VPCHandler          *compute.Service
+	StorageClient       *storage.Service
 }
 
 // func (cloudConn *GCPCloudConnection) CreateVNetworkHandler() (irs.VNetworkHandler, error) {
@@ -98,6 +101,33 @@ func (cloudConn *GCPCloudConnection) CreateVMSpecHandler() (irs.VMSpecHandler, e
 	return &vmSpecHandler, nil
 }
 
+func (cloudConn *GCPCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	cblogger.Error("GCP Cloud Driver: called CreatePublicIPHandler(), but not supported!")
+	return nil, errors.New("GCP Cloud Driver: CreatePublicIPHandler() is not supported!")
+}
+
+func (cloudConn *GCPCloudConnection) CreateNLBHandler() (irs.NLBHandler, error) {
+	cblogger.Error("GCP Cloud Driver: called CreateNLBHandler(), but not supported!")
+	return nil, errors.New("GCP Cloud Driver: CreateNLBHandler() is not supported!")
+}
+
+func (cloudConn *GCPCloudConnection) CreateDNSHandler() (irs.DNSHandler, error) {
+	cblogger.Error("GCP Cloud Driver: called CreateDNSHandler(), but not supported!")
+	return nil, errors.New("GCP Cloud Driver: CreateDNSHandler() is not supported!")
+}
+
+func (cloudConn *GCPCloudConnection) CreateBucketHandler() (irs.BucketHandler, error) {
+	cblogger.Info("GCP Cloud Driver: called CreateBucketHandler()!")
+	bucketHandler := gcprs.GCPBucketHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.StorageClient, cloudConn.Credential}
+	return &bucketHandler, nil
+}
+
+func (cloudConn *GCPCloudConnection) CreateRegionZoneHandler() (irs.RegionZoneHandler, error) {
+	cblogger.Info("GCP Cloud Driver: called CreateRegionZoneHandler()!")
+	regionZoneHandler := gcprs.GCPRegionZoneHandler{cloudConn.Region, cloudConn.Ctx, cloudConn.VMClient, cloudConn.Credential}
+	return &regionZoneHandler, nil
+}
+
 func (GCPCloudConnection) IsConnected() (bool, error) {
 	return true, nil
 }
@@ -495,7 +495,7 @@ func handleVPC() {
 				if err != nil {
 					cblogger.Infof("[%s] VPC 조회 실패 : ", reqVpcId, err)
 				} else {
-					cblogger.Infof("[%s] VPC 조회 결과 : [%s]", reqVpcId, result)
+					cblogger.Infof("[%s] VPC 조회 결과 : [%v]", reqVpcId, result)
 					spew.Dump(result)
 					cblogger.Infof("Subnet 목록 수 : [%d]", len(result.SubnetInfoList))
 				}
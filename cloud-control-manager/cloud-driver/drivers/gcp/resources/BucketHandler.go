@@ -0,0 +1,265 @@
+// Proof of Concepts of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is a Cloud Driver Example for PoC Test.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	storage "google.golang.org/api/storage/v1"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// GCS Bucket은 Project 단위로 관리되며 Bucket 이름은 전역에서 유일해야 함.
+type GCPBucketHandler struct {
+	Region     idrv.RegionInfo
+	Ctx        context.Context
+	Client     *storage.Service
+	Credential idrv.CredentialInfo
+}
+
+func (bucketHandler *GCPBucketHandler) CreateBucket(bucketReqInfo irs.BucketReqInfo) (irs.BucketInfo, error) {
+	cblogger.Info(bucketReqInfo)
+
+	if bucketReqInfo.IId.NameId == "" {
+		return irs.BucketInfo{}, errors.New("Invalid Request - Bucket NameId is required.")
+	}
+
+	// 연결 정보의 Region에 Bucket을 생성 함.
+	bucket := &storage.Bucket{
+		Name:     bucketReqInfo.IId.NameId,
+		Location: bucketHandler.Region.Region,
+	}
+	cblogger.Infof("[%s] Bucket 생성 시작", bucket.Name)
+	result, err := bucketHandler.Client.Buckets.Insert(bucketHandler.Credential.ProjectID, bucket).Context(bucketHandler.Ctx).Do()
+	if err != nil {
+		cblogger.Errorf("[%s] Bucket 생성 실패", bucket.Name)
+		cblogger.Error(err)
+		return irs.BucketInfo{}, err
+	}
+	return extractGCPBucketInfo(result), nil
+}
+
+func (bucketHandler *GCPBucketHandler) ListBucket() ([]*irs.BucketInfo, error) {
+	cblogger.Info("ListBucket")
+
+	bucketInfoList := []*irs.BucketInfo{}
+	err := bucketHandler.Client.Buckets.List(bucketHandler.Credential.ProjectID).Pages(bucketHandler.Ctx, func(page *storage.Buckets) error {
+		for _, bucket := range page.Items {
+			bucketInfo := extractGCPBucketInfo(bucket)
+			bucketInfoList = append(bucketInfoList, &bucketInfo)
+		}
+		return nil
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	return bucketInfoList, nil
+}
+
+func (bucketHandler *GCPBucketHandler) GetBucket(bucketIID irs.IID) (irs.BucketInfo, error) {
+	cblogger.Info(bucketIID)
+
+	result, err := bucketHandler.Client.Buckets.Get(gcpBucketName(bucketIID)).Context(bucketHandler.Ctx).Do()
+	if err != nil {
+		cblogger.Error(err)
+		return irs.BucketInfo{}, err
+	}
+	return extractGCPBucketInfo(result), nil
+}
+
+func (bucketHandler *GCPBucketHandler) DeleteBucket(bucketIID irs.IID) (bool, error) {
+	cblogger.Info(bucketIID)
+
+	err := bucketHandler.Client.Buckets.Delete(gcpBucketName(bucketIID)).Context(bucketHandler.Ctx).Do()
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (bucketHandler *GCPBucketHandler) ListObject(bucketIID irs.IID, prefix string) ([]*irs.ObjectInfo, error) {
+	cblogger.Info(bucketIID, prefix)
+
+	objectInfoList := []*irs.ObjectInfo{}
+	call := bucketHandler.Client.Objects.List(gcpBucketName(bucketIID))
+	if prefix != "" {
+		call = call.Prefix(prefix)
+	}
+	err := call.Pages(bucketHandler.Ctx, func(page *storage.Objects) error {
+		for _, object := range page.Items {
+			objectInfo := extractGCPObjectInfo(object)
+			objectInfoList = append(objectInfoList, &objectInfo)
+		}
+		return nil
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	return objectInfoList, nil
+}
+
+// Resumable Upload로 전송되므로 크기를 몰라도 됨.
+func (bucketHandler *GCPBucketHandler) PutObject(bucketIID irs.IID, objectName string, reader io.Reader, size int64, contentType string) (irs.ObjectInfo, error) {
+	cblogger.Info(bucketIID, objectName)
+
+	object := &storage.Object{
+		Name:        objectName,
+		ContentType: contentType,
+	}
+	options := []googleapi.MediaOption{}
+	if contentType != "" {
+		options = append(options, googleapi.ContentType(contentType))
+	}
+	result, err := bucketHandler.Client.Objects.Insert(gcpBucketName(bucketIID), object).Media(reader, options...).Context(bucketHandler.Ctx).Do()
+	if err != nil {
+		cblogger.Error(err)
+		return irs.ObjectInfo{}, err
+	}
+	if size >= 0 && int64(result.Size) != size {
+		cblogger.Errorf("Object [%s] 크기 불일치 - 요청 : [%d], 저장 : [%d]", objectName, size, result.Size)
+	}
+	return extractGCPObjectInfo(result), nil
+}
+
+func (bucketHandler *GCPBucketHandler) GetObject(bucketIID irs.IID, objectName string) (io.ReadCloser, irs.ObjectInfo, error) {
+	cblogger.Info(bucketIID, objectName)
+
+	// Download()는 Metadata를 주지 않으므로 먼저 조회 함.
+	object, err := bucketHandler.Client.Objects.Get(gcpBucketName(bucketIID), objectName).Context(bucketHandler.Ctx).Do()
+	if err != nil {
+		cblogger.Error(err)
+		return nil, irs.ObjectInfo{}, err
+	}
+	resp, err := bucketHandler.Client.Objects.Get(gcpBucketName(bucketIID), objectName).Context(bucketHandler.Ctx).Download()
+	if err != nil {
+		cblogger.Error(err)
+		return nil, irs.ObjectInfo{}, err
+	}
+	return resp.Body, extractGCPObjectInfo(object), nil
+}
+
+func (bucketHandler *GCPBucketHandler) DeleteObject(bucketIID irs.IID, objectName string) (bool, error) {
+	cblogger.Info(bucketIID, objectName)
+
+	err := bucketHandler.Client.Objects.Delete(gcpBucketName(bucketIID), objectName).Context(bucketHandler.Ctx).Do()
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// Service Account의 Private Key로 V2 Signed URL을 생성 함.
+// https://cloud.google.com/storage/docs/access-control/signed-urls-v2
+func (bucketHandler *GCPBucketHandler) GetPresignedURL(bucketIID irs.IID, objectName string, method irs.PresignedURLMethod, expires time.Duration) (string, error) {
+	cblogger.Info(bucketIID, objectName, method, expires)
+
+	if method != irs.PresignedGet && method != irs.PresignedPut {
+		return "", errors.New("GCP는 [" + string(method) + "] Signed URL을 지원하지 않습니다.")
+	}
+
+	privateKey, err := parseGCPPrivateKey(bucketHandler.Credential.PrivateKey)
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+
+	expiration := time.Now().Add(expires).Unix()
+	resource := "/" + gcpBucketName(bucketIID) + "/" + escapeObjectName(objectName)
+	// METHOD \n Content-MD5 \n Content-Type \n Expiration \n Resource
+	stringToSign := fmt.Sprintf("%s\n\n\n%d\n%s", method, expiration, resource)
+	hashed := sha256.Sum256([]byte(stringToSign))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, hashed[:])
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+
+	query := url.Values{}
+	query.Set("GoogleAccessId", bucketHandler.Credential.ClientEmail)
+	query.Set("Expires", fmt.Sprintf("%d", expiration))
+	query.Set("Signature", base64.StdEncoding.EncodeToString(signature))
+	return "https://storage.googleapis.com" + resource + "?" + query.Encode(), nil
+}
+
+func parseGCPPrivateKey(privateKey string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil {
+		return nil, errors.New("GCP Credential의 Private Key가 PEM 형식이 아닙니다.")
+	}
+	parsedKey, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+	rsaKey, ok := parsedKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("GCP Credential의 Private Key가 RSA Key가 아닙니다.")
+	}
+	return rsaKey, nil
+}
+
+// '/'는 경로 구분자로 유지 함.
+func escapeObjectName(objectName string) string {
+	parts := strings.Split(objectName, "/")
+	for idx, part := range parts {
+		parts[idx] = url.PathEscape(part)
+	}
+	return strings.Join(parts, "/")
+}
+
+func gcpBucketName(bucketIID irs.IID) string {
+	if bucketIID.SystemId != "" {
+		return bucketIID.SystemId
+	}
+	return bucketIID.NameId
+}
+
+func extractGCPBucketInfo(bucket *storage.Bucket) irs.BucketInfo {
+	createdTime, _ := time.Parse(time.RFC3339, bucket.TimeCreated)
+	return irs.BucketInfo{
+		IId:         irs.IID{NameId: bucket.Name, SystemId: bucket.Name},
+		CreatedTime: createdTime,
+		KeyValueList: []irs.KeyValue{
+			{Key: "Location", Value: bucket.Location},
+			{Key: "StorageClass", Value: bucket.StorageClass},
+		},
+	}
+}
+
+func extractGCPObjectInfo(object *storage.Object) irs.ObjectInfo {
+	lastModified, _ := time.Parse(time.RFC3339, object.Updated)
+	return irs.ObjectInfo{
+		Name:         object.Name,
+		Size:         int64(object.Size),
+		LastModified: lastModified,
+		ETag:         object.Etag,
+		ContentType:  object.ContentType,
+	}
+}
@@ -0,0 +1,133 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+import (
+	"context"
+	"strings"
+
+	compute "google.golang.org/api/compute/v1"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// regions and zones of the project of the credential
+type GCPRegionZoneHandler struct {
+	Region     idrv.RegionInfo
+	Ctx        context.Context
+	Client     *compute.Service
+	Credential idrv.CredentialInfo
+}
+
+func (regionZoneHandler *GCPRegionZoneHandler) ListRegionZone() ([]*irs.RegionZoneInfo, error) {
+	cblogger.Info("Start")
+
+	projectID := regionZoneHandler.Credential.ProjectID
+
+	zoneMap, err := regionZoneHandler.listZoneByRegion()
+	if err != nil {
+		return nil, err
+	}
+
+	var regionZoneInfoList []*irs.RegionZoneInfo
+	err = regionZoneHandler.Client.Regions.List(projectID).Pages(regionZoneHandler.Ctx, func(page *compute.RegionList) error {
+		for _, region := range page.Items {
+			regionZoneInfo := extractRegionZoneInfo(region, zoneMap[region.Name])
+			regionZoneInfoList = append(regionZoneInfoList, &regionZoneInfo)
+		}
+		return nil
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	return regionZoneInfoList, nil
+}
+
+func (regionZoneHandler *GCPRegionZoneHandler) GetRegionZone(Name string) (irs.RegionZoneInfo, error) {
+	cblogger.Info("Start : ", Name)
+
+	projectID := regionZoneHandler.Credential.ProjectID
+
+	region, err := regionZoneHandler.Client.Regions.Get(projectID, Name).Do()
+	if err != nil {
+		cblogger.Error(err)
+		return irs.RegionZoneInfo{}, err
+	}
+
+	zoneMap, err := regionZoneHandler.listZoneByRegion()
+	if err != nil {
+		return irs.RegionZoneInfo{}, err
+	}
+	return extractRegionZoneInfo(region, zoneMap[region.Name]), nil
+}
+
+func (regionZoneHandler *GCPRegionZoneHandler) ListZone() ([]*irs.ZoneInfo, error) {
+	cblogger.Info("Start")
+
+	zoneMap, err := regionZoneHandler.listZoneByRegion()
+	if err != nil {
+		return nil, err
+	}
+
+	var zoneInfoList []*irs.ZoneInfo
+	for _, zoneInfo := range zoneMap[regionZoneHandler.Region.Region] {
+		zoneInfoList = append(zoneInfoList, zoneInfo)
+	}
+	return zoneInfoList, nil
+}
+
+// zones of the project, key: region name
+func (regionZoneHandler *GCPRegionZoneHandler) listZoneByRegion() (map[string][]*irs.ZoneInfo, error) {
+	projectID := regionZoneHandler.Credential.ProjectID
+
+	zoneMap := make(map[string][]*irs.ZoneInfo)
+	err := regionZoneHandler.Client.Zones.List(projectID).Pages(regionZoneHandler.Ctx, func(page *compute.ZoneList) error {
+		for _, zone := range page.Items {
+			// Region: "https://www.googleapis.com/compute/v1/projects/{project}/regions/{region}"
+			regionName := zone.Region[strings.LastIndex(zone.Region, "/")+1:]
+			zoneMap[regionName] = append(zoneMap[regionName], &irs.ZoneInfo{
+				Name:         zone.Name,
+				DisplayName:  zone.Description,
+				Status:       gcpRegionZoneStatus(zone.Status),
+				KeyValueList: []irs.KeyValue{{Key: "Status", Value: zone.Status}},
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+	return zoneMap, nil
+}
+
+func extractRegionZoneInfo(region *compute.Region, zoneInfoList []*irs.ZoneInfo) irs.RegionZoneInfo {
+	regionZoneInfo := irs.RegionZoneInfo{
+		Name:         region.Name,
+		DisplayName:  region.Description,
+		Status:       gcpRegionZoneStatus(region.Status),
+		KeyValueList: []irs.KeyValue{{Key: "Status", Value: region.Status}},
+	}
+	for _, zoneInfo := range zoneInfoList {
+		regionZoneInfo.ZoneList = append(regionZoneInfo.ZoneList, *zoneInfo)
+	}
+	return regionZoneInfo
+}
+
+// Status: UP | DOWN
+func gcpRegionZoneStatus(status string) irs.RegionZoneStatus {
+	if status == "UP" {
+		return irs.RegionZoneAvailable
+	}
+	return irs.RegionZoneUnavailable
+}
@@ -13,6 +13,7 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -118,13 +119,36 @@ func (securityHandler *GCPSecurityHandler) CreateSecurity(securityReqInfo irs.Se
 	//networkURL := prefix + "/global/networks/" + securityReqInfo.VpcIID.NameId
 	networkURL := prefix + "/global/networks/" + securityReqInfo.VpcIID.SystemId
 
+	// GCP 방화벽은 룰 별 CIDR이 아닌 방화벽 단위의 SourceRanges를 사용하므로 룰의 CIDR을 모아서 설정 함.
+	// (사용하는 compute API 버전은 IPv6를 지원하지 않음)
+	var sourceRanges []string
+	for _, item := range ports {
+		if item.CIDR == "" {
+			continue
+		}
+		if strings.Contains(item.CIDR, ":") {
+			return irs.SecurityInfo{}, errors.New("GCP Cloud Driver: IPv6 CIDR(" + item.CIDR + ") of security rule is not supported!")
+		}
+		exist := false
+		for _, sourceRange := range sourceRanges {
+			if sourceRange == item.CIDR {
+				exist = true
+				break
+			}
+		}
+		if !exist {
+			sourceRanges = append(sourceRanges, item.CIDR)
+		}
+	}
+	if len(sourceRanges) == 0 {
+		sourceRanges = []string{"0.0.0.0/0"}
+	}
+
 	fireWall := &compute.Firewall{
-		Allowed:   firewallAllowed,
-		Direction: sgDirection, //INGRESS(inbound), EGRESS(outbound)
-		SourceRanges: []string{
-			"0.0.0.0/0",
-		},
-		Name: securityReqInfo.IId.NameId,
+		Allowed:      firewallAllowed,
+		Direction:    sgDirection, //INGRESS(inbound), EGRESS(outbound)
+		SourceRanges: sourceRanges,
+		Name:         securityReqInfo.IId.NameId,
 		TargetTags: []string{
 			securityReqInfo.IId.NameId,
 		},
@@ -197,6 +221,7 @@ func (securityHandler *GCPSecurityHandler) GetSecurity(securityIID irs.IID) (irs
 			ToPort:     toPort,
 			IPProtocol: item.IPProtocol,
 			Direction:  security.Direction,
+			CIDR:       strings.Join(security.SourceRanges, ","),
 		})
 	}
 	vpcArr := strings.Split(security.Network, "/")
@@ -169,6 +169,7 @@ func (vmHandler *GCPVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 				},
 				Network:    networkURL,
 				Subnetwork: subnetWorkURL,
+				NetworkIP:  vmReqInfo.PrivateIP, // 빈 값이면 자동 할당
 			},
 		},
 		ServiceAccounts: []*compute.ServiceAccount{
@@ -185,6 +186,23 @@ func (vmHandler *GCPVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 		},
 	}
 
+	// 구매 옵션 처리 - GCP는 가격 상한 없는 Preemptible VM만 지원하므로 Spot 요청도 Preemptible로 생성함.
+	switch vmReqInfo.PurchaseOption.PurchaseType {
+	case "", irs.OnDemand:
+	case irs.Preemptible, irs.Spot:
+		if vmReqInfo.PurchaseOption.MaxPrice != "" {
+			return irs.VMInfo{}, errors.New("GCP does not support MaxPrice of Preemptible VM")
+		}
+		automaticRestart := false
+		instance.Scheduling = &compute.Scheduling{
+			Preemptible:       true,
+			AutomaticRestart:  &automaticRestart,
+			OnHostMaintenance: "TERMINATE",
+		}
+	default:
+		return irs.VMInfo{}, errors.New("GCP does not support the purchase type " + string(vmReqInfo.PurchaseOption.PurchaseType))
+	}
+
 	cblogger.Info("VM 생성 시작")
 	cblogger.Info(instance)
 	spew.Dump(instance)
@@ -520,6 +538,20 @@ func (vmHandler *GCPVMHandler) GetVM(vmID irs.IID) (irs.VMInfo, error) {
 // 	return vmState
 // }
 
+// 시리얼 포트(1번) 출력을 조회한다.
+func (vmHandler *GCPVMHandler) GetVMConsoleOutput(vmID irs.IID) (string, error) {
+	projectID := vmHandler.Credential.ProjectID
+	zone := vmHandler.Region.Zone
+
+	serialPortOutput, err := vmHandler.Client.Instances.GetSerialPortOutput(projectID, zone, vmID.SystemId).Port(1).Do()
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+
+	return serialPortOutput.Contents, nil
+}
+
 func (vmHandler *GCPVMHandler) mappingServerInfo(server *compute.Instance) irs.VMInfo {
 	cblogger.Infof("=====================================================")
 	spew.Dump(server)
@@ -589,6 +621,13 @@ func (vmHandler *GCPVMHandler) mappingServerInfo(server *compute.Instance) irs.V
 		vmInfo.VMSpecName = arrVmSpec[len(arrVmSpec)-1]
 	}
 
+	// Preemptible VM의 Lifecycle 및 회수(Preemption) 상태 처리
+	vmInfo.LifecycleType = irs.OnDemand
+	if server.Scheduling != nil && server.Scheduling.Preemptible {
+		vmInfo.LifecycleType = irs.Preemptible
+		vmInfo.InterruptionState = vmHandler.getPreemptionState(server)
+	}
+
 	//2020-05-13T00:15:37.183-07:00
 	if len(server.CreationTimestamp) > 5 {
 		cblogger.Infof("서버 구동 시간 처리 : [%s]", server.CreationTimestamp)
@@ -604,6 +643,28 @@ func (vmHandler *GCPVMHandler) mappingServerInfo(server *compute.Instance) irs.V
 	return vmInfo
 }
 
+// Preemptible VM이 회수되었는지 Zone Operation 이력으로 확인함.
+// 회수된 VM은 TERMINATED 상태가 되며, compute.instances.preempted Operation이 남음.
+func (vmHandler *GCPVMHandler) getPreemptionState(server *compute.Instance) irs.VMInterruptionState {
+	if server.Status != "TERMINATED" && server.Status != "STOPPING" {
+		return irs.NotInterrupted
+	}
+
+	projectID := vmHandler.Credential.ProjectID
+	zone := vmHandler.Region.Zone
+	filter := fmt.Sprintf("(operationType = \"compute.instances.preempted\") AND (targetLink = \"%s\")", server.SelfLink)
+	opList, err := vmHandler.Client.ZoneOperations.List(projectID, zone).Filter(filter).Do()
+	if err != nil {
+		cblogger.Error(err)
+		return irs.NotInterrupted
+	}
+
+	if len(opList.Items) > 0 {
+		return irs.Interrupted
+	}
+	return irs.NotInterrupted
+}
+
 //이미지 URL 방식 대신 이름을 사용하도록 변경 중
 //@TODO : 2020-05-15 카푸치노 버전에서는 이름 대신 URL을 사용하기로 했음.
 func (vmHandler *GCPVMHandler) getImageInfo(diskname string) irs.IID {
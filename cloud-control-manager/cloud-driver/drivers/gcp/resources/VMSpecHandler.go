@@ -50,6 +50,9 @@ func (vmSpecHandler *GCPVMSpecHandler) ListVMSpec(Region string) ([]*irs.VMSpecI
 			},
 			Mem: strconv.FormatInt(i.MemoryMb, 10),
 		}
+		if err := irs.NormalizeVMSpecInfo(&info); err != nil {
+			cblogger.Error(err)
+		}
 		vmSpecInfo = append(vmSpecInfo, &info)
 	}
 	return vmSpecInfo, nil
@@ -84,6 +87,9 @@ func (vmSpecHandler *GCPVMSpecHandler) GetVMSpec(Region string, Name string) (ir
 			},
 		},
 	}
+	if err := irs.NormalizeVMSpecInfo(&vmSpecInfo); err != nil {
+		cblogger.Error(err)
+	}
 
 	return vmSpecInfo, nil
 }
@@ -106,10 +106,12 @@ func (vVPCHandler *GCPVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCI
 		cblogger.Info(" Subnet info : ", checkInfo)
 
 		//서브네 생성
+		// Private Subnet은 외부 IP 없이 Google API에 접근할 수 있도록 설정 함.
 		subnetWork := &compute.Subnetwork{
-			Name:        subnetName,
-			IpCidrRange: item.IPv4_CIDR,
-			Network:     vpcNetworkUrl,
+			Name:                  subnetName,
+			IpCidrRange:           item.IPv4_CIDR,
+			Network:               vpcNetworkUrl,
+			PrivateIpGoogleAccess: item.Private,
 		}
 		cblogger.Infof("[%s] Subnet 생성시작", subnetName)
 		cblogger.Info(subnetWork)
@@ -414,6 +416,23 @@ func (vVPCHandler *GCPVPCHandler) GetVPC(vpcIID irs.IID) (irs.VPCInfo, error) {
 		},
 	}
 
+	//NAT Gateway(Cloud NAT) 및 라우팅 정보
+	networkInfo.NATGatewayInfoList, err = vVPCHandler.listNATGateway(infoVPC.SelfLink)
+	if err != nil {
+		cblogger.Error(err)
+		return networkInfo, err
+	}
+	routeTableInfo, err := vVPCHandler.getRouteTable(infoVPC.SelfLink)
+	if err != nil {
+		cblogger.Error(err)
+		return networkInfo, err
+	}
+	for _, subnetInfo := range subnetInfoList {
+		routeTableInfo.SubnetIIDs = append(routeTableInfo.SubnetIIDs, subnetInfo.IId)
+	}
+	routeTableInfo.IId = networkInfo.IId
+	networkInfo.RouteTableInfoList = []irs.RouteTableInfo{routeTableInfo}
+
 	return networkInfo, nil
 }
 
@@ -430,6 +449,7 @@ func mappingSubnet(subnet *compute.Subnetwork) irs.SubnetInfo {
 			SystemId: subnet.Name,
 		},
 		IPv4_CIDR: subnet.IpCidrRange,
+		Private:   subnet.PrivateIpGoogleAccess,
 		KeyValueList: []irs.KeyValue{
 			{"region", region},
 			{"subnet", subnetName},
@@ -450,6 +470,22 @@ func (vVPCHandler *GCPVPCHandler) DeleteVPC(vpcID irs.IID) (bool, error) {
 		cblogger.Error(subErr)
 		return false, subErr
 	}
+	//Subnet 삭제 전에 NAT Gateway(Cloud Router)와 추가한 라우팅 정보를 삭제 함.
+	for _, item := range subnetInfo.NATGatewayInfoList {
+		_, errNat := vVPCHandler.RemoveNATGateway(vpcID, item.IId)
+		if errNat != nil {
+			return false, errNat
+		}
+	}
+	for _, routeTable := range subnetInfo.RouteTableInfoList {
+		for _, route := range routeTable.RouteList {
+			_, errRoute := vVPCHandler.RemoveRoute(vpcID, irs.IID{}, route)
+			if errRoute != nil {
+				return false, errRoute
+			}
+		}
+	}
+
 	if subnetInfo.SubnetInfoList != nil {
 		for _, item := range subnetInfo.SubnetInfoList {
 			for _, si := range item.KeyValueList {
@@ -549,9 +585,10 @@ func (vVPCHandler *GCPVPCHandler) CreateSubnet(vpcId string, reqSubnetInfo irs.S
 
 	//서브넷 생성
 	subnetWork := &compute.Subnetwork{
-		Name:        subnetName,
-		IpCidrRange: reqSubnetInfo.IPv4_CIDR,
-		Network:     vpcNetworkUrl,
+		Name:                  subnetName,
+		IpCidrRange:           reqSubnetInfo.IPv4_CIDR,
+		Network:               vpcNetworkUrl,
+		PrivateIpGoogleAccess: reqSubnetInfo.Private,
 	}
 	cblogger.Infof("[%s] Subnet 생성시작", subnetName)
 	cblogger.Info(subnetWork)
@@ -605,3 +642,202 @@ func (vVPCHandler *GCPVPCHandler) RemoveSubnet(vpcIID irs.IID, subnetIID irs.IID
 
 	return true, nil
 }
+
+func (VPCHandler *GCPVPCHandler) RequestVPCPeering(peeringReqInfo irs.VPCPeeringReqInfo) (irs.VPCPeeringInfo, error) {
+	return irs.VPCPeeringInfo{}, errors.New("GCP Cloud Driver: RequestVPCPeering() is not supported!")
+}
+
+func (VPCHandler *GCPVPCHandler) AcceptVPCPeering(peeringIID irs.IID) (irs.VPCPeeringInfo, error) {
+	return irs.VPCPeeringInfo{}, errors.New("GCP Cloud Driver: AcceptVPCPeering() is not supported!")
+}
+
+func (VPCHandler *GCPVPCHandler) ListVPCPeering() ([]*irs.VPCPeeringInfo, error) {
+	return nil, errors.New("GCP Cloud Driver: ListVPCPeering() is not supported!")
+}
+
+func (VPCHandler *GCPVPCHandler) GetVPCPeering(peeringIID irs.IID) (irs.VPCPeeringInfo, error) {
+	return irs.VPCPeeringInfo{}, errors.New("GCP Cloud Driver: GetVPCPeering() is not supported!")
+}
+
+func (VPCHandler *GCPVPCHandler) DeleteVPCPeering(peeringIID irs.IID) (bool, error) {
+	return false, errors.New("GCP Cloud Driver: DeleteVPCPeering() is not supported!")
+}
+
+//=================================
+// NAT Gateway / Route
+// NAT Gateway는 Cloud Router의 Cloud NAT으로 생성되며 VPC의 모든 Subnet에 적용 됨. (IID => Cloud Router 이름)
+// GCP의 라우팅은 VPC(Network) 단위이므로 Subnet IID는 사용하지 않음.
+//=================================
+func (vVPCHandler *GCPVPCHandler) AddNATGateway(vpcIID irs.IID, natReqInfo irs.NATGatewayReqInfo) (irs.VPCInfo, error) {
+	cblogger.Infof("[%s] VPC에 [%s] NAT Gateway 추가", vpcIID.SystemId, natReqInfo.IId.NameId)
+
+	projectID := vVPCHandler.Credential.ProjectID
+	region := vVPCHandler.Region.Region
+	vpcNetworkUrl := "https://www.googleapis.com/compute/v1/projects/" + projectID + "/global/networks/" + vpcIID.SystemId
+
+	natList, err := vVPCHandler.listNATGateway(vpcNetworkUrl)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCInfo{}, err
+	}
+	if len(natList) > 0 {
+		return irs.VPCInfo{}, errors.New("VPC [" + vpcIID.SystemId + "] already has the NAT Gateway [" + natList[0].IId.NameId + "].")
+	}
+
+	router := &compute.Router{
+		Name:    natReqInfo.IId.NameId,
+		Network: vpcNetworkUrl,
+		Nats: []*compute.RouterNat{
+			{
+				Name:                          natReqInfo.IId.NameId,
+				NatIpAllocateOption:           "AUTO_ONLY",
+				SourceSubnetworkIpRangesToNat: "ALL_SUBNETWORKS_ALL_IP_RANGES",
+			},
+		},
+	}
+	cblogger.Info(router)
+
+	op, err := vVPCHandler.Client.Routers.Insert(projectID, region, router).Do()
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCInfo{}, err
+	}
+	errWait := vVPCHandler.WaitUntilComplete(strconv.FormatUint(op.Id, 10), false)
+	if errWait != nil {
+		cblogger.Errorf("[%s] NAT Gateway 생성 완료 대기 실패", natReqInfo.IId.NameId)
+		cblogger.Error(errWait)
+		return irs.VPCInfo{}, errWait
+	}
+
+	return vVPCHandler.GetVPC(vpcIID)
+}
+
+func (vVPCHandler *GCPVPCHandler) RemoveNATGateway(vpcIID irs.IID, natGatewayIID irs.IID) (bool, error) {
+	cblogger.Infof("[%s] VPC의 [%s] NAT Gateway 삭제", vpcIID.SystemId, natGatewayIID.SystemId)
+
+	projectID := vVPCHandler.Credential.ProjectID
+	region := vVPCHandler.Region.Region
+
+	op, err := vVPCHandler.Client.Routers.Delete(projectID, region, natGatewayIID.SystemId).Do()
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	errWait := vVPCHandler.WaitUntilComplete(strconv.FormatUint(op.Id, 10), false)
+	if errWait != nil {
+		cblogger.Errorf("[%s] NAT Gateway 삭제 완료 대기 실패", natGatewayIID.SystemId)
+		cblogger.Error(errWait)
+		return false, errWait
+	}
+
+	return true, nil
+}
+
+func (vVPCHandler *GCPVPCHandler) AddRoute(vpcIID irs.IID, subnetIID irs.IID, routeInfo irs.RouteInfo) (irs.VPCInfo, error) {
+	cblogger.Infof("[%s] VPC에 라우팅 추가 - %s => %s", vpcIID.SystemId, routeInfo.DestinationCIDR, routeInfo.TargetType)
+
+	if routeInfo.TargetType != irs.RouteInternetGateway {
+		return irs.VPCInfo{}, errors.New("GCP Cloud Driver: Route Target Type [" + string(routeInfo.TargetType) + "] is not supported!")
+	}
+
+	projectID := vVPCHandler.Credential.ProjectID
+	route := &compute.Route{
+		Name:           gcpRouteName(vpcIID.SystemId, routeInfo.DestinationCIDR),
+		Network:        "https://www.googleapis.com/compute/v1/projects/" + projectID + "/global/networks/" + vpcIID.SystemId,
+		DestRange:      routeInfo.DestinationCIDR,
+		NextHopGateway: "https://www.googleapis.com/compute/v1/projects/" + projectID + "/global/gateways/default-internet-gateway",
+	}
+	cblogger.Info(route)
+
+	op, err := vVPCHandler.Client.Routes.Insert(projectID, route).Do()
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VPCInfo{}, err
+	}
+	errWait := vVPCHandler.WaitUntilComplete(strconv.FormatUint(op.Id, 10), true)
+	if errWait != nil {
+		cblogger.Errorf("[%s] 라우팅 생성 완료 대기 실패", route.Name)
+		cblogger.Error(errWait)
+		return irs.VPCInfo{}, errWait
+	}
+
+	return vVPCHandler.GetVPC(vpcIID)
+}
+
+func (vVPCHandler *GCPVPCHandler) RemoveRoute(vpcIID irs.IID, subnetIID irs.IID, routeInfo irs.RouteInfo) (bool, error) {
+	cblogger.Infof("[%s] VPC의 라우팅 삭제 - %s", vpcIID.SystemId, routeInfo.DestinationCIDR)
+
+	routeName := gcpRouteName(vpcIID.SystemId, routeInfo.DestinationCIDR)
+	op, err := vVPCHandler.Client.Routes.Delete(vVPCHandler.Credential.ProjectID, routeName).Do()
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	errWait := vVPCHandler.WaitUntilComplete(strconv.FormatUint(op.Id, 10), true)
+	if errWait != nil {
+		cblogger.Errorf("[%s] 라우팅 삭제 완료 대기 실패", routeName)
+		cblogger.Error(errWait)
+		return false, errWait
+	}
+
+	return true, nil
+}
+
+// Cloud NAT이 설정된 VPC의 Cloud Router 목록을 NAT Gateway 정보로 리턴 함.
+func (vVPCHandler *GCPVPCHandler) listNATGateway(vpcNetworkUrl string) ([]irs.NATGatewayInfo, error) {
+	projectID := vVPCHandler.Credential.ProjectID
+	region := vVPCHandler.Region.Region
+
+	routerList, err := vVPCHandler.Client.Routers.List(projectID, region).Do()
+	if err != nil {
+		return nil, err
+	}
+
+	natList := []irs.NATGatewayInfo{}
+	for _, router := range routerList.Items {
+		if router.Network != vpcNetworkUrl || len(router.Nats) == 0 {
+			continue
+		}
+		natInfo := irs.NATGatewayInfo{
+			IId: irs.IID{NameId: router.Name, SystemId: router.Name},
+			KeyValueList: []irs.KeyValue{
+				{Key: "NatIpAllocateOption", Value: router.Nats[0].NatIpAllocateOption},
+				{Key: "SourceSubnetworkIpRangesToNat", Value: router.Nats[0].SourceSubnetworkIpRangesToNat},
+			},
+		}
+		// 자동 할당된 NAT IP는 Router 상태 정보에서만 조회 가능 함.
+		status, err := vVPCHandler.Client.Routers.GetRouterStatus(projectID, region, router.Name).Do()
+		if err == nil && status.Result != nil && len(status.Result.NatStatus) > 0 && len(status.Result.NatStatus[0].AutoAllocatedNatIps) > 0 {
+			natInfo.PublicIP = status.Result.NatStatus[0].AutoAllocatedNatIps[0]
+		}
+		natList = append(natList, natInfo)
+	}
+	return natList, nil
+}
+
+// VPC에 추가한 라우팅 정보를 조회함. (GCP가 자동으로 생성하는 기본 라우팅은 제외)
+func (vVPCHandler *GCPVPCHandler) getRouteTable(vpcNetworkUrl string) (irs.RouteTableInfo, error) {
+	routeList, err := vVPCHandler.Client.Routes.List(vVPCHandler.Credential.ProjectID).Filter("network=\"" + vpcNetworkUrl + "\"").Do()
+	if err != nil {
+		return irs.RouteTableInfo{}, err
+	}
+
+	routeTableInfo := irs.RouteTableInfo{}
+	for _, route := range routeList.Items {
+		if !strings.HasPrefix(route.Name, gcpRoutePrefix) {
+			continue
+		}
+		routeTableInfo.RouteList = append(routeTableInfo.RouteList, irs.RouteInfo{
+			DestinationCIDR: route.DestRange,
+			TargetType:      irs.RouteInternetGateway,
+		})
+	}
+	return routeTableInfo, nil
+}
+
+const gcpRoutePrefix = "cb-route-"
+
+// 라우팅 이름 : cb-route-{vpc}-{cidr} (예: cb-route-vpc01-10-0-0-0-16)
+func gcpRouteName(vpcName string, cidr string) string {
+	return gcpRoutePrefix + vpcName + "-" + strings.NewReplacer(".", "-", "/", "-").Replace(cidr)
+}
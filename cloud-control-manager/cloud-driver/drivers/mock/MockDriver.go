@@ -18,6 +18,7 @@ import (
 	mkcon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock/connect"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 )
 
 type MockDriver struct{}
@@ -29,24 +30,72 @@ func init() {
 }
 
 func (MockDriver) GetDriverVersion() string {
-	return "MOCK DRIVER Version 1.0"
+	return idrv.DriverVersion("MOCK DRIVER Version 1.0")
 }
 
 func (MockDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	var drvCapabilityInfo idrv.DriverCapabilityInfo
 
+	drvCapabilityInfo.FIXED_SUBNET_CIDR = true
+	drvCapabilityInfo.VPC_CIDR = true
+
 	drvCapabilityInfo.ImageHandler = true
 	drvCapabilityInfo.VPCHandler = true
-	drvCapabilityInfo.SecurityHandler = true
+	drvCapabilityInfo.SecurityHandler = false
 	drvCapabilityInfo.KeyPairHandler = true
 	drvCapabilityInfo.VNicHandler = false
-	drvCapabilityInfo.PublicIPHandler = false
+	drvCapabilityInfo.PublicIPHandler = true
 	drvCapabilityInfo.VMHandler = true
 	drvCapabilityInfo.VMSpecHandler = true
+	drvCapabilityInfo.NLBHandler = true
+	drvCapabilityInfo.DNSHandler = true
+	drvCapabilityInfo.BucketHandler = true
+	drvCapabilityInfo.RegionZoneHandler = true
+
+	drvCapabilityInfo.VPC_IPv6_CIDR = true
+	drvCapabilityInfo.SECURITY_IPv6_RULE = false
+	drvCapabilityInfo.VM_MULTI_NIC = true
+	drvCapabilityInfo.SUBNET_ADD_REMOVE = true
+	drvCapabilityInfo.VM_SUSPEND_RESUME = true
+	drvCapabilityInfo.VM_REBOOT = true
 
 	return drvCapabilityInfo
 }
 
+// key names of the region and the zone in the key-value list of the region.
+func (MockDriver) GetRegionZoneKeyName() (string, string) {
+	return "Region", ""
+}
+
+// translates the key-value lists of the credential and the region into ConnectionInfo.
+func (driver MockDriver) GetConnectionInfo(credentialKeyValueList []irs.KeyValue, regionKeyValueList []irs.KeyValue) (idrv.ConnectionInfo, error) {
+	regionKey, _ := driver.GetRegionZoneKeyName()
+
+	connectionInfo := idrv.ConnectionInfo{
+		CredentialInfo: idrv.CredentialInfo{
+			MockName:     idrv.GetKeyValue(credentialKeyValueList, "MockName"),
+			KeyValueList: credentialKeyValueList,
+		},
+		RegionInfo: idrv.RegionInfo{
+			Region:       idrv.GetKeyValue(regionKeyValueList, regionKey),
+			KeyValueList: regionKeyValueList,
+		},
+	}
+	return connectionInfo, nil
+}
+
+// keys of the credential and the region read by GetConnectionInfo().
+func (MockDriver) GetConnectionSchema() idrv.ConnectionSchema {
+	return idrv.ConnectionSchema{
+		Credential: []idrv.KeySchema{
+			{Key: "MockName", Description: "name of the in-memory cloud, ex) mock_unit_full", Required: true},
+		},
+		Region: []idrv.KeySchema{
+			{Key: "Region", Description: "not used, ex) default"},
+		},
+	}
+}
+
 func (driver *MockDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.CloudConnection, error) {
 	// <standard flow>
         // 1. get info of credential and region for Test A Cloud from connectionInfo.
@@ -58,6 +107,7 @@ func (driver *MockDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon
         // MockName = "mock01"
 	iConn := mkcon.MockConnection{
 		MockName:      connectionInfo.CredentialInfo.MockName,
+		Region:        connectionInfo.RegionInfo,
 	}
 	return &iConn, nil
 }
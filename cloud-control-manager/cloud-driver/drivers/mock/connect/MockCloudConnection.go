@@ -11,8 +11,10 @@
 package connect
 
 import (
+	"errors"
 	cblog "github.com/cloud-barista/cb-log"
 	mkrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock/resources"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	"github.com/sirupsen/logrus"
 )
@@ -26,6 +28,7 @@ func init() {
 
 type MockConnection struct {
 	MockName	string
+	Region		idrv.RegionInfo
 }
 
 func (cloudConn *MockConnection) CreateImageHandler() (irs.ImageHandler, error) {
@@ -37,24 +40,19 @@ func (cloudConn *MockConnection) CreateImageHandler() (irs.ImageHandler, error)
 
 func (cloudConn *MockConnection) CreateVMHandler() (irs.VMHandler, error) {
 	cblogger.Info("Mock Driver: called CreateVMHandler()!")
-	/*vmHandler := dkrs.DockerVMHandler{
-		Region:         cloudConn.ConnectionInfo.RegionInfo,
-		Context:        cloudConn.Context,
-		Client:         cloudConn.Client,
-	}
-	return &vmHandler, nil
-	*/
-	return nil, nil
+	handler := mkrs.MockVMHandler{MockName: cloudConn.MockName}
+	return &handler, nil
 }
 
 func (cloudConn *MockConnection) CreateVPCHandler() (irs.VPCHandler, error) {
-        cblogger.Error("Mock Driver: called CreateVPCHandler(), but not supported!")
-        return nil, nil
+	cblogger.Info("Mock Driver: called CreateVPCHandler()!")
+	handler := mkrs.MockVPCHandler{MockName: cloudConn.MockName}
+	return &handler, nil
 }
 
 func (cloudConn MockConnection) CreateSecurityHandler() (irs.SecurityHandler, error) {
         cblogger.Error("Mock Driver: called CreateSecurityHandler(), but not supported!")
-        return nil, nil
+        return nil, errors.New("Mock Driver: CreateSecurityHandler() is not supported!")
 }
 
 func (cloudConn *MockConnection) CreateKeyPairHandler() (irs.KeyPairHandler, error) {
@@ -69,6 +67,36 @@ func (cloudConn *MockConnection) CreateVMSpecHandler() (irs.VMSpecHandler, error
 	return &handler, nil
 }
 
+func (cloudConn *MockConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	cblogger.Info("Mock Driver: called CreatePublicIPHandler()!")
+	handler := mkrs.MockPublicIPHandler{MockName: cloudConn.MockName}
+	return &handler, nil
+}
+
+func (cloudConn *MockConnection) CreateNLBHandler() (irs.NLBHandler, error) {
+	cblogger.Info("Mock Driver: called CreateNLBHandler()!")
+	handler := mkrs.MockNLBHandler{MockName: cloudConn.MockName}
+	return &handler, nil
+}
+
+func (cloudConn *MockConnection) CreateDNSHandler() (irs.DNSHandler, error) {
+	cblogger.Info("Mock Driver: called CreateDNSHandler()!")
+	handler := mkrs.MockDNSHandler{MockName: cloudConn.MockName}
+	return &handler, nil
+}
+
+func (cloudConn *MockConnection) CreateBucketHandler() (irs.BucketHandler, error) {
+	cblogger.Info("Mock Driver: called CreateBucketHandler()!")
+	handler := mkrs.MockBucketHandler{MockName: cloudConn.MockName}
+	return &handler, nil
+}
+
+func (cloudConn *MockConnection) CreateRegionZoneHandler() (irs.RegionZoneHandler, error) {
+	cblogger.Info("Mock Driver: called CreateRegionZoneHandler()!")
+	handler := mkrs.MockRegionZoneHandler{MockName: cloudConn.MockName, Region: cloudConn.Region}
+	return &handler, nil
+}
+
 func (cloudConn *MockConnection) IsConnected() (bool, error) {
         cblogger.Info("Mock Driver: called IsConnected()!")
 	if cloudConn == nil {
@@ -0,0 +1,300 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Mock Driver.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	cblog "github.com/cloud-barista/cb-log"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+type mockObject struct {
+	info irs.ObjectInfo
+	data []byte
+}
+
+type mockBucket struct {
+	info      irs.BucketInfo
+	objectMap map[string]*mockObject // object name => object
+}
+
+var bucketInfoMap map[string][]*mockBucket
+
+// objects are kept in memory, so big objects are not for the mock.
+var bucketMapLock = new(sync.RWMutex)
+
+// S3 style bucket name: 3~63 characters of lower case letters, numbers, dots and hyphens.
+var mockBucketNameRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
+const mockDefaultContentType string = "application/octet-stream"
+
+type MockBucketHandler struct {
+	MockName string
+}
+
+func init() {
+	bucketInfoMap = make(map[string][]*mockBucket)
+}
+
+// (1) check the bucket name
+// (2) create bucketInfo object
+// (3) insert bucket into global Map
+func (bucketHandler *MockBucketHandler) CreateBucket(bucketReqInfo irs.BucketReqInfo) (irs.BucketInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called CreateBucket()!")
+
+	mockName := bucketHandler.MockName
+	bucketReqInfo.IId.SystemId = bucketReqInfo.IId.NameId
+
+	// (1) check the bucket name
+	if !mockBucketNameRegexp.MatchString(bucketReqInfo.IId.NameId) {
+		return irs.BucketInfo{}, fmt.Errorf("%s is not a valid bucket name!!", bucketReqInfo.IId.NameId)
+	}
+
+	bucketMapLock.Lock()
+	defer bucketMapLock.Unlock()
+
+	if bucketHandler.findBucket(bucketReqInfo.IId) != nil {
+		return irs.BucketInfo{}, fmt.Errorf("%s bucket already exists!!", bucketReqInfo.IId.NameId)
+	}
+
+	// (2) create bucketInfo object
+	bucket := mockBucket{
+		info: irs.BucketInfo{
+			IId:          bucketReqInfo.IId,
+			CreatedTime:  time.Now(),
+			KeyValueList: bucketReqInfo.KeyValueList,
+		},
+		objectMap: make(map[string]*mockObject),
+	}
+
+	// (3) insert bucket into global Map
+	bucketInfoMap[mockName] = append(bucketInfoMap[mockName], &bucket)
+
+	return bucket.info, nil
+}
+
+func (bucketHandler *MockBucketHandler) ListBucket() ([]*irs.BucketInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListBucket()!")
+
+	bucketMapLock.RLock()
+	defer bucketMapLock.RUnlock()
+
+	resultList := []*irs.BucketInfo{}
+	for _, bucket := range bucketInfoMap[bucketHandler.MockName] {
+		clone := bucket.info
+		resultList = append(resultList, &clone)
+	}
+	return resultList, nil
+}
+
+func (bucketHandler *MockBucketHandler) GetBucket(bucketIID irs.IID) (irs.BucketInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetBucket()!")
+
+	bucketMapLock.RLock()
+	defer bucketMapLock.RUnlock()
+
+	bucket := bucketHandler.findBucket(bucketIID)
+	if bucket == nil {
+		return irs.BucketInfo{}, fmt.Errorf("%s bucket does not exist!!", bucketIID.NameId)
+	}
+	return bucket.info, nil
+}
+
+func (bucketHandler *MockBucketHandler) DeleteBucket(bucketIID irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DeleteBucket()!")
+
+	mockName := bucketHandler.MockName
+
+	bucketMapLock.Lock()
+	defer bucketMapLock.Unlock()
+
+	bucketList := bucketInfoMap[mockName]
+	for idx, bucket := range bucketList {
+		if bucket.info.IId.NameId == bucketIID.NameId {
+			if len(bucket.objectMap) > 0 {
+				return false, fmt.Errorf("%s bucket is not empty!!", bucketIID.NameId)
+			}
+			bucketInfoMap[mockName] = append(bucketList[:idx], bucketList[idx+1:]...)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("%s bucket does not exist!!", bucketIID.NameId)
+}
+
+func (bucketHandler *MockBucketHandler) ListObject(bucketIID irs.IID, prefix string) ([]*irs.ObjectInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListObject()!")
+
+	bucketMapLock.RLock()
+	defer bucketMapLock.RUnlock()
+
+	bucket := bucketHandler.findBucket(bucketIID)
+	if bucket == nil {
+		return nil, fmt.Errorf("%s bucket does not exist!!", bucketIID.NameId)
+	}
+
+	// sorted by name like CSPs
+	resultList := []*irs.ObjectInfo{}
+	for name, object := range bucket.objectMap {
+		if strings.HasPrefix(name, prefix) {
+			clone := object.info
+			resultList = append(resultList, &clone)
+		}
+	}
+	sort.Slice(resultList, func(i, j int) bool {
+		return resultList[i].Name < resultList[j].Name
+	})
+	return resultList, nil
+}
+
+func (bucketHandler *MockBucketHandler) PutObject(bucketIID irs.IID, objectName string, reader io.Reader, size int64, contentType string) (irs.ObjectInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called PutObject()!")
+
+	if objectName == "" {
+		return irs.ObjectInfo{}, fmt.Errorf("object name is empty!!")
+	}
+
+	// read the stream before locking the map.
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return irs.ObjectInfo{}, err
+	}
+	if size >= 0 && int64(len(data)) != size {
+		return irs.ObjectInfo{}, fmt.Errorf("size of %s object is %d, not %d!!", objectName, len(data), size)
+	}
+	if contentType == "" {
+		contentType = mockDefaultContentType
+	}
+
+	bucketMapLock.Lock()
+	defer bucketMapLock.Unlock()
+
+	bucket := bucketHandler.findBucket(bucketIID)
+	if bucket == nil {
+		return irs.ObjectInfo{}, fmt.Errorf("%s bucket does not exist!!", bucketIID.NameId)
+	}
+
+	md5Sum := md5.Sum(data)
+	object := mockObject{
+		info: irs.ObjectInfo{
+			Name:         objectName,
+			Size:         int64(len(data)),
+			LastModified: time.Now(),
+			ETag:         `"` + hex.EncodeToString(md5Sum[:]) + `"`,
+			ContentType:  contentType,
+		},
+		data: data,
+	}
+	// overwrite like CSPs
+	bucket.objectMap[objectName] = &object
+
+	return object.info, nil
+}
+
+func (bucketHandler *MockBucketHandler) GetObject(bucketIID irs.IID, objectName string) (io.ReadCloser, irs.ObjectInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetObject()!")
+
+	bucketMapLock.RLock()
+	defer bucketMapLock.RUnlock()
+
+	object, err := bucketHandler.findObject(bucketIID, objectName)
+	if err != nil {
+		return nil, irs.ObjectInfo{}, err
+	}
+	// the data is never changed in place, a new object replaces it.
+	return ioutil.NopCloser(bytes.NewReader(object.data)), object.info, nil
+}
+
+func (bucketHandler *MockBucketHandler) DeleteObject(bucketIID irs.IID, objectName string) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DeleteObject()!")
+
+	bucketMapLock.Lock()
+	defer bucketMapLock.Unlock()
+
+	if _, err := bucketHandler.findObject(bucketIID, objectName); err != nil {
+		return false, err
+	}
+	delete(bucketHandler.findBucket(bucketIID).objectMap, objectName)
+	return true, nil
+}
+
+// fake URL with the expiration, it can not be accessed.
+func (bucketHandler *MockBucketHandler) GetPresignedURL(bucketIID irs.IID, objectName string, method irs.PresignedURLMethod, expires time.Duration) (string, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetPresignedURL()!")
+
+	if method != irs.PresignedGet && method != irs.PresignedPut {
+		return "", fmt.Errorf("%s method is not supported!!", method)
+	}
+	if expires <= 0 {
+		return "", fmt.Errorf("expiration must be positive!!")
+	}
+
+	bucketMapLock.RLock()
+	defer bucketMapLock.RUnlock()
+
+	if bucketHandler.findBucket(bucketIID) == nil {
+		return "", fmt.Errorf("%s bucket does not exist!!", bucketIID.NameId)
+	}
+	// an object to upload does not exist yet.
+	if method == irs.PresignedGet {
+		if _, err := bucketHandler.findObject(bucketIID, objectName); err != nil {
+			return "", err
+		}
+	}
+
+	query := url.Values{}
+	query.Set("X-Mock-Method", string(method))
+	query.Set("X-Mock-Expires", fmt.Sprintf("%d", time.Now().Add(expires).Unix()))
+	return fmt.Sprintf("https://%s.mock-storage.local/%s?%s", bucketIID.NameId, objectName, query.Encode()), nil
+}
+
+// caller must hold bucketMapLock.
+func (bucketHandler *MockBucketHandler) findBucket(bucketIID irs.IID) *mockBucket {
+	for _, bucket := range bucketInfoMap[bucketHandler.MockName] {
+		if bucket.info.IId.NameId == bucketIID.NameId {
+			return bucket
+		}
+	}
+	return nil
+}
+
+// caller must hold bucketMapLock.
+func (bucketHandler *MockBucketHandler) findObject(bucketIID irs.IID, objectName string) (*mockObject, error) {
+	bucket := bucketHandler.findBucket(bucketIID)
+	if bucket == nil {
+		return nil, fmt.Errorf("%s bucket does not exist!!", bucketIID.NameId)
+	}
+	object, ok := bucket.objectMap[objectName]
+	if !ok {
+		return nil, fmt.Errorf("%s object does not exist in %s bucket!!", objectName, bucketIID.NameId)
+	}
+	return object, nil
+}
@@ -0,0 +1,264 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Mock Driver.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	cblog "github.com/cloud-barista/cb-log"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+var dnsZoneInfoMap map[string][]*irs.DNSZoneInfo
+
+// lock order: dnsMapLock => vpcMapLock
+var dnsMapLock = new(sync.RWMutex)
+
+type MockDNSHandler struct {
+	MockName string
+}
+
+func init() {
+	dnsZoneInfoMap = make(map[string][]*irs.DNSZoneInfo)
+}
+
+// (1) check the VPC to attach
+// (2) create dnsZoneInfo object without records
+// (3) insert dnsZoneInfo into global Map
+func (dnsHandler *MockDNSHandler) CreateZone(zoneReqInfo irs.DNSZoneReqInfo) (irs.DNSZoneInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called CreateZone()!")
+
+	mockName := dnsHandler.MockName
+	zoneReqInfo.IId.SystemId = zoneReqInfo.IId.NameId
+
+	if zoneReqInfo.DomainName == "" {
+		return irs.DNSZoneInfo{}, fmt.Errorf("domain name of %s dnszone is empty!!", zoneReqInfo.IId.NameId)
+	}
+
+	dnsMapLock.Lock()
+	defer dnsMapLock.Unlock()
+
+	for _, info := range dnsZoneInfoMap[mockName] {
+		if info.IId.NameId == zoneReqInfo.IId.NameId {
+			return irs.DNSZoneInfo{}, fmt.Errorf("%s dnszone already exists!!", zoneReqInfo.IId.NameId)
+		}
+	}
+
+	// (1) check the VPC to attach
+	vpcMapLock.RLock()
+	vpcInfo := (&MockVPCHandler{MockName: mockName}).findVPC(zoneReqInfo.VpcIID)
+	vpcMapLock.RUnlock()
+	if vpcInfo == nil {
+		return irs.DNSZoneInfo{}, fmt.Errorf("%s vpc does not exist!!", zoneReqInfo.VpcIID.NameId)
+	}
+	for _, info := range dnsZoneInfoMap[mockName] {
+		if info.VpcIID.NameId == vpcInfo.IId.NameId && strings.EqualFold(info.DomainName, zoneReqInfo.DomainName) {
+			return irs.DNSZoneInfo{}, fmt.Errorf("%s domain is already attached to %s vpc!!", zoneReqInfo.DomainName, vpcInfo.IId.NameId)
+		}
+	}
+
+	// (2) create dnsZoneInfo object
+	zoneInfo := irs.DNSZoneInfo{
+		IId:          zoneReqInfo.IId,
+		DomainName:   zoneReqInfo.DomainName,
+		VpcIID:       vpcInfo.IId,
+		RecordList:   []irs.DNSRecordInfo{},
+		KeyValueList: zoneReqInfo.KeyValueList,
+	}
+
+	// (3) insert dnsZoneInfo into global Map
+	dnsZoneInfoMap[mockName] = append(dnsZoneInfoMap[mockName], &zoneInfo)
+
+	return cloneDNSZoneInfo(&zoneInfo), nil
+}
+
+func (dnsHandler *MockDNSHandler) ListZone() ([]*irs.DNSZoneInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListZone()!")
+
+	dnsMapLock.RLock()
+	defer dnsMapLock.RUnlock()
+
+	infoList := dnsZoneInfoMap[dnsHandler.MockName]
+	// cloning list of DNS Zone
+	resultList := make([]*irs.DNSZoneInfo, len(infoList))
+	for idx, info := range infoList {
+		clone := cloneDNSZoneInfo(info)
+		resultList[idx] = &clone
+	}
+	return resultList, nil
+}
+
+func (dnsHandler *MockDNSHandler) GetZone(zoneIID irs.IID) (irs.DNSZoneInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetZone()!")
+
+	dnsMapLock.RLock()
+	defer dnsMapLock.RUnlock()
+
+	info := dnsHandler.findZone(zoneIID)
+	if info == nil {
+		return irs.DNSZoneInfo{}, fmt.Errorf("%s dnszone does not exist!!", zoneIID.NameId)
+	}
+	return cloneDNSZoneInfo(info), nil
+}
+
+func (dnsHandler *MockDNSHandler) DeleteZone(zoneIID irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DeleteZone()!")
+
+	mockName := dnsHandler.MockName
+
+	dnsMapLock.Lock()
+	defer dnsMapLock.Unlock()
+
+	infoList := dnsZoneInfoMap[mockName]
+	for idx, info := range infoList {
+		if info.IId.NameId == zoneIID.NameId {
+			dnsZoneInfoMap[mockName] = append(infoList[:idx], infoList[idx+1:]...)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("%s dnszone does not exist!!", zoneIID.NameId)
+}
+
+func (dnsHandler *MockDNSHandler) AddRecord(zoneIID irs.IID, recordInfo irs.DNSRecordInfo) (irs.DNSZoneInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called AddRecord()!")
+
+	if err := validateDNSRecord(recordInfo); err != nil {
+		return irs.DNSZoneInfo{}, err
+	}
+
+	dnsMapLock.Lock()
+	defer dnsMapLock.Unlock()
+
+	info := dnsHandler.findZone(zoneIID)
+	if info == nil {
+		return irs.DNSZoneInfo{}, fmt.Errorf("%s dnszone does not exist!!", zoneIID.NameId)
+	}
+	for _, record := range info.RecordList {
+		if strings.EqualFold(record.Name, recordInfo.Name) {
+			// like CSPs, a CNAME record can not coexist with other records of the same name.
+			if record.Type == recordInfo.Type || record.Type == irs.DNSRecordCNAME || recordInfo.Type == irs.DNSRecordCNAME {
+				return irs.DNSZoneInfo{}, fmt.Errorf("%s record already exists in %s dnszone!!", recordInfo.Name, zoneIID.NameId)
+			}
+		}
+	}
+
+	if recordInfo.TTL == 0 {
+		recordInfo.TTL = irs.DNSDefaultTTL
+	}
+	recordInfo.Values = append([]string{}, recordInfo.Values...)
+	info.RecordList = append(info.RecordList, recordInfo)
+
+	return cloneDNSZoneInfo(info), nil
+}
+
+func (dnsHandler *MockDNSHandler) UpdateRecord(zoneIID irs.IID, recordInfo irs.DNSRecordInfo) (irs.DNSZoneInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called UpdateRecord()!")
+
+	if err := validateDNSRecord(recordInfo); err != nil {
+		return irs.DNSZoneInfo{}, err
+	}
+
+	dnsMapLock.Lock()
+	defer dnsMapLock.Unlock()
+
+	info := dnsHandler.findZone(zoneIID)
+	if info == nil {
+		return irs.DNSZoneInfo{}, fmt.Errorf("%s dnszone does not exist!!", zoneIID.NameId)
+	}
+	for idx, record := range info.RecordList {
+		if strings.EqualFold(record.Name, recordInfo.Name) && record.Type == recordInfo.Type {
+			if recordInfo.TTL == 0 {
+				recordInfo.TTL = irs.DNSDefaultTTL
+			}
+			recordInfo.Name = record.Name
+			recordInfo.Values = append([]string{}, recordInfo.Values...)
+			info.RecordList[idx] = recordInfo
+			return cloneDNSZoneInfo(info), nil
+		}
+	}
+	return irs.DNSZoneInfo{}, fmt.Errorf("%s %s record does not exist in %s dnszone!!", recordInfo.Name, recordInfo.Type, zoneIID.NameId)
+}
+
+func (dnsHandler *MockDNSHandler) RemoveRecord(zoneIID irs.IID, recordName string, recordType irs.DNSRecordType) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called RemoveRecord()!")
+
+	dnsMapLock.Lock()
+	defer dnsMapLock.Unlock()
+
+	info := dnsHandler.findZone(zoneIID)
+	if info == nil {
+		return false, fmt.Errorf("%s dnszone does not exist!!", zoneIID.NameId)
+	}
+	for idx, record := range info.RecordList {
+		if strings.EqualFold(record.Name, recordName) && record.Type == recordType {
+			info.RecordList = append(info.RecordList[:idx], info.RecordList[idx+1:]...)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("%s %s record does not exist in %s dnszone!!", recordName, recordType, zoneIID.NameId)
+}
+
+// caller must hold dnsMapLock.
+func (dnsHandler *MockDNSHandler) findZone(zoneIID irs.IID) *irs.DNSZoneInfo {
+	for _, info := range dnsZoneInfoMap[dnsHandler.MockName] {
+		if info.IId.NameId == zoneIID.NameId {
+			return info
+		}
+	}
+	return nil
+}
+
+func validateDNSRecord(recordInfo irs.DNSRecordInfo) error {
+	if recordInfo.Name == "" {
+		return fmt.Errorf("record name is empty!!")
+	}
+	if recordInfo.TTL < 0 {
+		return fmt.Errorf("TTL of %s record is negative!!", recordInfo.Name)
+	}
+	if len(recordInfo.Values) == 0 {
+		return fmt.Errorf("%s record has no value!!", recordInfo.Name)
+	}
+	switch recordInfo.Type {
+	case irs.DNSRecordA:
+		for _, value := range recordInfo.Values {
+			if ip := net.ParseIP(value); ip == nil || ip.To4() == nil {
+				return fmt.Errorf("%s is not an IPv4 address for %s A record!!", value, recordInfo.Name)
+			}
+		}
+	case irs.DNSRecordCNAME:
+		if len(recordInfo.Values) != 1 {
+			return fmt.Errorf("%s CNAME record must have only one value!!", recordInfo.Name)
+		}
+	default:
+		return fmt.Errorf("%s record type is not supported!!", recordInfo.Type)
+	}
+	return nil
+}
+
+func cloneDNSZoneInfo(info *irs.DNSZoneInfo) irs.DNSZoneInfo {
+	clone := *info
+	clone.RecordList = make([]irs.DNSRecordInfo, len(info.RecordList))
+	for idx, record := range info.RecordList {
+		record.Values = append([]string{}, record.Values...)
+		clone.RecordList[idx] = record
+	}
+	return clone
+}
@@ -0,0 +1,315 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Mock Driver.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	cblog "github.com/cloud-barista/cb-log"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+var nlbInfoMap map[string][]*irs.NLBInfo
+
+var nlbMapLock = new(sync.RWMutex)
+
+type MockNLBHandler struct {
+	MockName string
+}
+
+func init() {
+	nlbInfoMap = make(map[string][]*irs.NLBInfo)
+}
+
+// (1) check backend VMs
+// (2) create nlbInfo object
+// (3) insert nlbInfo into global Map
+func (nlbHandler *MockNLBHandler) CreateNLB(nlbReqInfo irs.NLBReqInfo) (irs.NLBInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called CreateNLB()!")
+
+	mockName := nlbHandler.MockName
+	nlbReqInfo.IId.SystemId = nlbReqInfo.IId.NameId
+
+	nlbType := nlbReqInfo.Type
+	switch nlbType {
+	case "":
+		nlbType = "PUBLIC"
+	case "PUBLIC", "INTERNAL":
+	default:
+		return irs.NLBInfo{}, fmt.Errorf("%s nlb type is not supported!!", nlbReqInfo.Type)
+	}
+
+	// (1) check backend VMs
+	vmIIDs, err := nlbHandler.checkVMs(nlbReqInfo.VMGroup.VMs)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.NLBInfo{}, err
+	}
+
+	nlbMapLock.Lock()
+	defer nlbMapLock.Unlock()
+
+	for _, info := range nlbInfoMap[mockName] {
+		if info.IId.NameId == nlbReqInfo.IId.NameId {
+			return irs.NLBInfo{}, fmt.Errorf("%s nlb already exists!!", nlbReqInfo.IId.NameId)
+		}
+	}
+
+	// (2) create nlbInfo object
+	listener := nlbReqInfo.Listener
+	listener.IP = "0.0.0.0"
+	listener.DNSName = nlbReqInfo.IId.NameId + ".nlb.mock"
+
+	vmGroup := nlbReqInfo.VMGroup
+	vmGroup.VMs = vmIIDs
+
+	nlbInfo := irs.NLBInfo{
+		IId:           nlbReqInfo.IId,
+		VpcIID:        nlbReqInfo.VpcIID,
+		Type:          nlbType,
+		Listener:      listener,
+		VMGroup:       vmGroup,
+		HealthChecker: nlbReqInfo.HealthChecker,
+		CreatedTime:   time.Now(),
+		KeyValueList:  nil,
+	}
+
+	// (3) insert nlbInfo into global Map
+	nlbInfoMap[mockName] = append(nlbInfoMap[mockName], &nlbInfo)
+
+	return cloneNLBInfo(nlbInfo), nil
+}
+
+func (nlbHandler *MockNLBHandler) ListNLB() ([]*irs.NLBInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListNLB()!")
+
+	mockName := nlbHandler.MockName
+
+	nlbMapLock.RLock()
+	defer nlbMapLock.RUnlock()
+
+	infoList, ok := nlbInfoMap[mockName]
+	if !ok {
+		return []*irs.NLBInfo{}, nil
+	}
+	// cloning list of NLB
+	resultList := make([]*irs.NLBInfo, len(infoList))
+	for idx, info := range infoList {
+		clone := cloneNLBInfo(*info)
+		resultList[idx] = &clone
+	}
+	return resultList, nil
+}
+
+func (nlbHandler *MockNLBHandler) GetNLB(nlbIID irs.IID) (irs.NLBInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetNLB()!")
+
+	nlbMapLock.RLock()
+	defer nlbMapLock.RUnlock()
+
+	info, err := nlbHandler.findNLB(nlbIID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.NLBInfo{}, err
+	}
+	return cloneNLBInfo(*info), nil
+}
+
+func (nlbHandler *MockNLBHandler) DeleteNLB(nlbIID irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DeleteNLB()!")
+
+	mockName := nlbHandler.MockName
+
+	nlbMapLock.Lock()
+	defer nlbMapLock.Unlock()
+
+	infoList := nlbInfoMap[mockName]
+	for idx, info := range infoList {
+		if info.IId.NameId == nlbIID.NameId {
+			nlbInfoMap[mockName] = append(infoList[:idx], infoList[idx+1:]...)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("%s nlb does not exist!!", nlbIID.NameId)
+}
+
+func (nlbHandler *MockNLBHandler) ChangeListener(nlbIID irs.IID, listener irs.ListenerInfo) (irs.ListenerInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ChangeListener()!")
+
+	nlbMapLock.Lock()
+	defer nlbMapLock.Unlock()
+
+	info, err := nlbHandler.findNLB(nlbIID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.ListenerInfo{}, err
+	}
+
+	// IP and DNSName are managed by the mock cloud.
+	listener.IP = info.Listener.IP
+	listener.DNSName = info.Listener.DNSName
+	info.Listener = listener
+	return listener, nil
+}
+
+func (nlbHandler *MockNLBHandler) AddVMs(nlbIID irs.IID, vmIIDs []irs.IID) (irs.NLBVMGroupInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called AddVMs()!")
+
+	checkedIIDs, err := nlbHandler.checkVMs(vmIIDs)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.NLBVMGroupInfo{}, err
+	}
+
+	nlbMapLock.Lock()
+	defer nlbMapLock.Unlock()
+
+	info, err := nlbHandler.findNLB(nlbIID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.NLBVMGroupInfo{}, err
+	}
+
+	for _, vmIID := range checkedIIDs {
+		if containsIID(info.VMGroup.VMs, vmIID) {
+			return irs.NLBVMGroupInfo{}, fmt.Errorf("%s vm is already in the vm group of %s nlb!!", vmIID.NameId, nlbIID.NameId)
+		}
+	}
+	vms := make([]irs.IID, 0, len(info.VMGroup.VMs)+len(checkedIIDs))
+	vms = append(vms, info.VMGroup.VMs...)
+	info.VMGroup.VMs = append(vms, checkedIIDs...)
+
+	return cloneNLBInfo(*info).VMGroup, nil
+}
+
+func (nlbHandler *MockNLBHandler) RemoveVMs(nlbIID irs.IID, vmIIDs []irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called RemoveVMs()!")
+
+	nlbMapLock.Lock()
+	defer nlbMapLock.Unlock()
+
+	info, err := nlbHandler.findNLB(nlbIID)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+
+	for _, vmIID := range vmIIDs {
+		if !containsIID(info.VMGroup.VMs, vmIID) {
+			return false, fmt.Errorf("%s vm is not in the vm group of %s nlb!!", vmIID.NameId, nlbIID.NameId)
+		}
+	}
+	vms := []irs.IID{}
+	for _, vmIID := range info.VMGroup.VMs {
+		if !containsIID(vmIIDs, vmIID) {
+			vms = append(vms, vmIID)
+		}
+	}
+	info.VMGroup.VMs = vms
+	return true, nil
+}
+
+// Running VMs are Healthy, the others are Unhealthy.
+func (nlbHandler *MockNLBHandler) GetVMGroupHealthInfo(nlbIID irs.IID) (irs.HealthInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetVMGroupHealthInfo()!")
+
+	nlbInfo, err := nlbHandler.GetNLB(nlbIID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.HealthInfo{}, err
+	}
+
+	vmMapLock.RLock()
+	defer vmMapLock.RUnlock()
+
+	healthInfo := irs.HealthInfo{AllVMs: []irs.VMHealthInfo{}}
+	for _, vmIID := range nlbInfo.VMGroup.VMs {
+		state := irs.Unhealthy
+		if vmStatusMap[nlbHandler.MockName][vmIID.NameId] == irs.Running {
+			state = irs.Healthy
+		}
+		healthInfo.AllVMs = append(healthInfo.AllVMs, irs.VMHealthInfo{VMIID: vmIID, HealthState: state})
+	}
+	return healthInfo, nil
+}
+
+func (nlbHandler *MockNLBHandler) ChangeHealthCheckerInfo(nlbIID irs.IID, healthChecker irs.HealthCheckerInfo) (irs.HealthCheckerInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ChangeHealthCheckerInfo()!")
+
+	nlbMapLock.Lock()
+	defer nlbMapLock.Unlock()
+
+	info, err := nlbHandler.findNLB(nlbIID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.HealthCheckerInfo{}, err
+	}
+	info.HealthChecker = healthChecker
+	return healthChecker, nil
+}
+
+// caller must hold nlbMapLock.
+func (nlbHandler *MockNLBHandler) findNLB(nlbIID irs.IID) (*irs.NLBInfo, error) {
+	for _, info := range nlbInfoMap[nlbHandler.MockName] {
+		if info.IId.NameId == nlbIID.NameId {
+			return info, nil
+		}
+	}
+	return nil, fmt.Errorf("%s nlb does not exist!!", nlbIID.NameId)
+}
+
+// returns the IIDs of the mock VMs with their SystemId filled.
+func (nlbHandler *MockNLBHandler) checkVMs(vmIIDs []irs.IID) ([]irs.IID, error) {
+	vmMapLock.RLock()
+	defer vmMapLock.RUnlock()
+
+	resultIIDs := []irs.IID{}
+	for _, vmIID := range vmIIDs {
+		found := false
+		for _, info := range vmInfoMap[nlbHandler.MockName] {
+			if info.IId.NameId == vmIID.NameId {
+				resultIIDs = append(resultIIDs, info.IId)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%s vm does not exist!!", vmIID.NameId)
+		}
+	}
+	return resultIIDs, nil
+}
+
+func containsIID(iidList []irs.IID, iid irs.IID) bool {
+	for _, one := range iidList {
+		if one.NameId == iid.NameId {
+			return true
+		}
+	}
+	return false
+}
+
+func cloneNLBInfo(info irs.NLBInfo) irs.NLBInfo {
+	clone := info
+	clone.VMGroup.VMs = append([]irs.IID{}, info.VMGroup.VMs...)
+	return clone
+}
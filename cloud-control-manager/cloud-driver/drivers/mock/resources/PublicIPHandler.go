@@ -0,0 +1,248 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Mock Driver.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+import (
+	"fmt"
+	"sync"
+
+	cblog "github.com/cloud-barista/cb-log"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// ephemeral Public IP of the mock VM
+const mockEphemeralPublicIP string = "0.0.0.0"
+
+var publicIPInfoMap map[string][]*irs.PublicIPInfo
+var publicIPSeqMap map[string]int // MockName => last sequence of allocated IPs
+
+// lock order: publicIPMapLock => vmMapLock
+var publicIPMapLock = new(sync.RWMutex)
+
+type MockPublicIPHandler struct {
+	MockName string
+}
+
+func init() {
+	publicIPInfoMap = make(map[string][]*irs.PublicIPInfo)
+	publicIPSeqMap = make(map[string]int)
+}
+
+// (1) create publicIPInfo object with a new IP of TEST-NET-3(203.0.113.0/24)
+// (2) insert publicIPInfo into global Map
+func (publicIPHandler *MockPublicIPHandler) AllocatePublicIP(publicIPReqInfo irs.PublicIPReqInfo) (irs.PublicIPInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called AllocatePublicIP()!")
+
+	mockName := publicIPHandler.MockName
+	publicIPReqInfo.IId.SystemId = publicIPReqInfo.IId.NameId
+
+	publicIPMapLock.Lock()
+	defer publicIPMapLock.Unlock()
+
+	for _, info := range publicIPInfoMap[mockName] {
+		if info.IId.NameId == publicIPReqInfo.IId.NameId {
+			return irs.PublicIPInfo{}, fmt.Errorf("%s publicip already exists!!", publicIPReqInfo.IId.NameId)
+		}
+	}
+
+	seq := publicIPSeqMap[mockName] + 1
+	if seq > 254 {
+		return irs.PublicIPInfo{}, fmt.Errorf("no more public ip can be allocated!!")
+	}
+	publicIPSeqMap[mockName] = seq
+
+	// (1) create publicIPInfo object
+	publicIPInfo := irs.PublicIPInfo{
+		IId:          publicIPReqInfo.IId,
+		PublicIP:     fmt.Sprintf("203.0.113.%d", seq),
+		Status:       irs.PublicIPAvailable,
+		KeyValueList: publicIPReqInfo.KeyValueList,
+	}
+
+	// (2) insert publicIPInfo into global Map
+	publicIPInfoMap[mockName] = append(publicIPInfoMap[mockName], &publicIPInfo)
+
+	return publicIPInfo, nil
+}
+
+func (publicIPHandler *MockPublicIPHandler) ListPublicIP() ([]*irs.PublicIPInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListPublicIP()!")
+
+	mockName := publicIPHandler.MockName
+
+	publicIPMapLock.Lock()
+	defer publicIPMapLock.Unlock()
+
+	publicIPHandler.releaseTerminatedVMs()
+
+	infoList, ok := publicIPInfoMap[mockName]
+	if !ok {
+		return []*irs.PublicIPInfo{}, nil
+	}
+	// cloning list of PublicIP
+	resultList := make([]*irs.PublicIPInfo, len(infoList))
+	for idx, info := range infoList {
+		clone := *info
+		resultList[idx] = &clone
+	}
+	return resultList, nil
+}
+
+func (publicIPHandler *MockPublicIPHandler) GetPublicIP(publicIPIID irs.IID) (irs.PublicIPInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetPublicIP()!")
+
+	publicIPMapLock.Lock()
+	defer publicIPMapLock.Unlock()
+
+	publicIPHandler.releaseTerminatedVMs()
+
+	info, err := publicIPHandler.findPublicIP(publicIPIID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.PublicIPInfo{}, err
+	}
+	return *info, nil
+}
+
+func (publicIPHandler *MockPublicIPHandler) ReleasePublicIP(publicIPIID irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ReleasePublicIP()!")
+
+	mockName := publicIPHandler.MockName
+
+	publicIPMapLock.Lock()
+	defer publicIPMapLock.Unlock()
+
+	publicIPHandler.releaseTerminatedVMs()
+
+	infoList := publicIPInfoMap[mockName]
+	for idx, info := range infoList {
+		if info.IId.NameId == publicIPIID.NameId {
+			if info.Status == irs.PublicIPAssociated {
+				return false, fmt.Errorf("%s publicip is associated with %s vm!!", publicIPIID.NameId, info.AssociatedVMIID.NameId)
+			}
+			publicIPInfoMap[mockName] = append(infoList[:idx], infoList[idx+1:]...)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("%s publicip does not exist!!", publicIPIID.NameId)
+}
+
+func (publicIPHandler *MockPublicIPHandler) AssociatePublicIP(publicIPIID irs.IID, vmIID irs.IID) (irs.PublicIPInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called AssociatePublicIP()!")
+
+	mockName := publicIPHandler.MockName
+
+	publicIPMapLock.Lock()
+	defer publicIPMapLock.Unlock()
+
+	publicIPHandler.releaseTerminatedVMs()
+
+	info, err := publicIPHandler.findPublicIP(publicIPIID)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.PublicIPInfo{}, err
+	}
+	if info.Status == irs.PublicIPAssociated {
+		return irs.PublicIPInfo{}, fmt.Errorf("%s publicip is already associated with %s vm!!", publicIPIID.NameId, info.AssociatedVMIID.NameId)
+	}
+	for _, other := range publicIPInfoMap[mockName] {
+		if other.Status == irs.PublicIPAssociated && other.AssociatedVMIID.NameId == vmIID.NameId {
+			return irs.PublicIPInfo{}, fmt.Errorf("%s vm already has %s publicip!!", vmIID.NameId, other.IId.NameId)
+		}
+	}
+
+	vmMapLock.Lock()
+	defer vmMapLock.Unlock()
+
+	vmInfo := findMockVM(mockName, vmIID)
+	if vmInfo == nil {
+		return irs.PublicIPInfo{}, fmt.Errorf("%s vm does not exist!!", vmIID.NameId)
+	}
+
+	// the reserved IP replaces the ephemeral IP of the VM
+	setPrimaryPublicIP(vmInfo, info.PublicIP)
+	info.Status = irs.PublicIPAssociated
+	info.AssociatedVMIID = vmInfo.IId
+
+	return *info, nil
+}
+
+func (publicIPHandler *MockPublicIPHandler) DisassociatePublicIP(publicIPIID irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DisassociatePublicIP()!")
+
+	publicIPMapLock.Lock()
+	defer publicIPMapLock.Unlock()
+
+	publicIPHandler.releaseTerminatedVMs()
+
+	info, err := publicIPHandler.findPublicIP(publicIPIID)
+	if err != nil {
+		cblogger.Error(err)
+		return false, err
+	}
+	if info.Status != irs.PublicIPAssociated {
+		return false, fmt.Errorf("%s publicip is not associated with any vm!!", publicIPIID.NameId)
+	}
+
+	vmMapLock.Lock()
+	defer vmMapLock.Unlock()
+
+	if vmInfo := findMockVM(publicIPHandler.MockName, info.AssociatedVMIID); vmInfo != nil {
+		setPrimaryPublicIP(vmInfo, mockEphemeralPublicIP)
+	}
+	info.Status = irs.PublicIPAvailable
+	info.AssociatedVMIID = irs.IID{}
+
+	return true, nil
+}
+
+// caller must hold publicIPMapLock.
+func (publicIPHandler *MockPublicIPHandler) findPublicIP(publicIPIID irs.IID) (*irs.PublicIPInfo, error) {
+	for _, info := range publicIPInfoMap[publicIPHandler.MockName] {
+		if info.IId.NameId == publicIPIID.NameId {
+			return info, nil
+		}
+	}
+	return nil, fmt.Errorf("%s publicip does not exist!!", publicIPIID.NameId)
+}
+
+// a reserved IP becomes Available when the VM is terminated like CSPs.
+// caller must hold publicIPMapLock.
+func (publicIPHandler *MockPublicIPHandler) releaseTerminatedVMs() {
+	vmMapLock.RLock()
+	defer vmMapLock.RUnlock()
+
+	for _, info := range publicIPInfoMap[publicIPHandler.MockName] {
+		if info.Status != irs.PublicIPAssociated {
+			continue
+		}
+		if findMockVM(publicIPHandler.MockName, info.AssociatedVMIID) == nil {
+			info.Status = irs.PublicIPAvailable
+			info.AssociatedVMIID = irs.IID{}
+		}
+	}
+}
+
+// caller must hold vmMapLock.
+func findMockVM(mockName string, vmIID irs.IID) *irs.VMInfo {
+	for _, info := range vmInfoMap[mockName] {
+		if info.IId.NameId == vmIID.NameId {
+			return info
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Mock Driver.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+import (
+	"fmt"
+
+	cblog "github.com/cloud-barista/cb-log"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// the same regions of the VMSpec mock data, shared by all MockNames
+var mockRegionZoneInfoList = []irs.RegionZoneInfo{
+	{Name: "mock-region01", DisplayName: "Mock Region 01", Status: irs.RegionZoneAvailable,
+		ZoneList: []irs.ZoneInfo{
+			{Name: "mock-region01-a", DisplayName: "Mock Zone 01-a", Status: irs.RegionZoneAvailable},
+			{Name: "mock-region01-b", DisplayName: "Mock Zone 01-b", Status: irs.RegionZoneAvailable},
+		}},
+	{Name: "mock-region02", DisplayName: "Mock Region 02", Status: irs.RegionZoneAvailable,
+		ZoneList: []irs.ZoneInfo{
+			{Name: "mock-region02-a", DisplayName: "Mock Zone 02-a", Status: irs.RegionZoneAvailable},
+			{Name: "mock-region02-b", DisplayName: "Mock Zone 02-b", Status: irs.RegionZoneUnavailable},
+		}},
+}
+
+type MockRegionZoneHandler struct {
+	MockName string
+	Region   idrv.RegionInfo
+}
+
+func (regionZoneHandler *MockRegionZoneHandler) ListRegionZone() ([]*irs.RegionZoneInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListRegionZone()!")
+
+	var infoList []*irs.RegionZoneInfo
+	for _, info := range mockRegionZoneInfoList {
+		clone := cloneRegionZoneInfo(info)
+		infoList = append(infoList, &clone)
+	}
+	return infoList, nil
+}
+
+func (regionZoneHandler *MockRegionZoneHandler) GetRegionZone(Name string) (irs.RegionZoneInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetRegionZone()!")
+
+	for _, info := range mockRegionZoneInfoList {
+		if info.Name == Name {
+			return cloneRegionZoneInfo(info), nil
+		}
+	}
+	return irs.RegionZoneInfo{}, fmt.Errorf("%s region does not exist!!", Name)
+}
+
+func (regionZoneHandler *MockRegionZoneHandler) ListZone() ([]*irs.ZoneInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListZone()!")
+
+	info, err := regionZoneHandler.GetRegionZone(regionZoneHandler.Region.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	var zoneInfoList []*irs.ZoneInfo
+	for i := range info.ZoneList {
+		zoneInfoList = append(zoneInfoList, &info.ZoneList[i])
+	}
+	return zoneInfoList, nil
+}
+
+func cloneRegionZoneInfo(info irs.RegionZoneInfo) irs.RegionZoneInfo {
+	clone := info
+	clone.ZoneList = append([]irs.ZoneInfo{}, info.ZoneList...)
+	clone.KeyValueList = append([]irs.KeyValue{}, info.KeyValueList...)
+	return clone
+}
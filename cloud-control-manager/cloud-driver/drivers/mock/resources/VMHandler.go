@@ -0,0 +1,423 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Mock Driver.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	cblog "github.com/cloud-barista/cb-log"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+var vmInfoMap map[string][]*irs.VMInfo
+var vmStatusMap map[string]map[string]irs.VMStatus // MockName => VM NameId => VMStatus
+var vmIPv6SeqMap map[string]int                    // MockName => last host sequence of VM IPv6 addresses
+
+// the handlers of the same MockName can be called concurrently, so guard the maps.
+var vmMapLock = new(sync.RWMutex)
+
+type MockVMHandler struct {
+	MockName string
+}
+
+func init() {
+	vmInfoMap = make(map[string][]*irs.VMInfo)
+	vmStatusMap = make(map[string]map[string]irs.VMStatus)
+	vmIPv6SeqMap = make(map[string]int)
+}
+
+// (1) create vmInfo object
+// (2) create secondary NICs
+// (3) insert vmInfo into global Map
+func (vmHandler *MockVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called StartVM()!")
+
+	mockName := vmHandler.MockName
+	vmReqInfo.IId.SystemId = vmReqInfo.IId.NameId
+
+	lifecycleType := irs.OnDemand
+	var interruptionState irs.VMInterruptionState
+	switch vmReqInfo.PurchaseOption.PurchaseType {
+	case "", irs.OnDemand:
+	case irs.Spot, irs.Preemptible:
+		lifecycleType = vmReqInfo.PurchaseOption.PurchaseType
+		interruptionState = irs.NotInterrupted
+	default:
+		return irs.VMInfo{}, fmt.Errorf("%s purchase type is not supported!!", vmReqInfo.PurchaseOption.PurchaseType)
+	}
+
+	vmMapLock.Lock()
+	defer vmMapLock.Unlock()
+
+	for _, info := range vmInfoMap[mockName] {
+		if info.IId.NameId == vmReqInfo.IId.NameId {
+			return irs.VMInfo{}, fmt.Errorf("%s vm already exists!!", vmReqInfo.IId.NameId)
+		}
+	}
+
+	// (1) create vmInfo object
+	vmInfo := irs.VMInfo{
+		IId:               vmReqInfo.IId,
+		StartTime:         time.Now(),
+		Region:            irs.RegionInfo{Region: "mock-region", Zone: "mock-zone"},
+		ImageIId:          vmReqInfo.ImageIID,
+		VMSpecName:        vmReqInfo.VMSpecName,
+		VpcIID:            vmReqInfo.VpcIID,
+		SubnetIID:         vmReqInfo.SubnetIID,
+		SecurityGroupIIds: vmReqInfo.SecurityGroupIIDs,
+		KeyPairIId:        vmReqInfo.KeyPairIID,
+		VMUserId:          "cb-user",
+		VMUserPasswd:      vmReqInfo.VMUserPasswd,
+		NetworkInterface:  "mock-nic0",
+		PublicIP:          "0.0.0.0",
+		PrivateIP:         "0.0.0.0",
+		VMBootDisk:        "/dev/sda1",
+		LifecycleType:     lifecycleType,
+		InterruptionState: interruptionState,
+	}
+	// a VM in a dual-stack subnet gets a global IPv6 address, used as both private and public.
+	if ipv6 := vmHandler.allocIPv6(vmReqInfo.VpcIID, vmReqInfo.SubnetIID); ipv6 != "" {
+		vmInfo.PrivateIPv6 = ipv6
+		vmInfo.PublicIPv6 = ipv6
+	}
+
+	// a fixed private IP of the primary NIC is checked like secondary NICs
+	if vmReqInfo.PrivateIP != "" {
+		primaryNIC, err := vmHandler.createNIC(vmReqInfo.VpcIID, irs.NetworkInterfaceReqInfo{SubnetIID: vmReqInfo.SubnetIID, PrivateIP: vmReqInfo.PrivateIP}, nil)
+		if err != nil {
+			cblogger.Error(err)
+			return irs.VMInfo{}, err
+		}
+		vmInfo.PrivateIP = primaryNIC.PrivateIP
+	}
+
+	// (2) create secondary NICs, the primary NIC comes first
+	vmInfo.NetworkInterfaceList = []irs.NetworkInterfaceInfo{{
+		IId:               irs.IID{NameId: vmInfo.NetworkInterface, SystemId: vmInfo.NetworkInterface},
+		SubnetIID:         vmInfo.SubnetIID,
+		SecurityGroupIIds: vmInfo.SecurityGroupIIds,
+		PrivateIP:         vmInfo.PrivateIP,
+		PublicIP:          vmInfo.PublicIP,
+	}}
+	for idx, nicReqInfo := range vmReqInfo.NetworkInterfaceList {
+		nicInfo, err := vmHandler.createNIC(vmReqInfo.VpcIID, nicReqInfo, vmInfo.NetworkInterfaceList)
+		if err != nil {
+			cblogger.Error(err)
+			return irs.VMInfo{}, err
+		}
+		nicName := fmt.Sprintf("mock-nic%d", idx+1)
+		nicInfo.IId = irs.IID{NameId: nicName, SystemId: nicName}
+		if len(nicInfo.SecurityGroupIIds) == 0 {
+			nicInfo.SecurityGroupIIds = vmInfo.SecurityGroupIIds
+		}
+		vmInfo.NetworkInterfaceList = append(vmInfo.NetworkInterfaceList, nicInfo)
+	}
+
+	// (3) insert vmInfo into global Map
+	vmInfoMap[mockName] = append(vmInfoMap[mockName], &vmInfo)
+	if _, ok := vmStatusMap[mockName]; !ok {
+		vmStatusMap[mockName] = make(map[string]irs.VMStatus)
+	}
+	vmStatusMap[mockName][vmInfo.IId.NameId] = irs.Running
+
+	return vmInfo, nil
+}
+
+func (vmHandler *MockVMHandler) SuspendVM(vmIID irs.IID) (irs.VMStatus, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called SuspendVM()!")
+
+	return vmHandler.changeStatus(vmIID, irs.Running, irs.Suspended)
+}
+
+func (vmHandler *MockVMHandler) ResumeVM(vmIID irs.IID) (irs.VMStatus, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ResumeVM()!")
+
+	return vmHandler.changeStatus(vmIID, irs.Suspended, irs.Running)
+}
+
+func (vmHandler *MockVMHandler) RebootVM(vmIID irs.IID) (irs.VMStatus, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called RebootVM()!")
+
+	return vmHandler.changeStatus(vmIID, irs.Running, irs.Running)
+}
+
+func (vmHandler *MockVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called TerminateVM()!")
+
+	mockName := vmHandler.MockName
+
+	vmMapLock.Lock()
+	defer vmMapLock.Unlock()
+
+	infoList := vmInfoMap[mockName]
+	for idx, info := range infoList {
+		if info.IId.NameId == vmIID.NameId {
+			vmInfoMap[mockName] = append(infoList[:idx], infoList[idx+1:]...)
+			delete(vmStatusMap[mockName], vmIID.NameId)
+			return irs.Terminated, nil
+		}
+	}
+	return irs.Failed, fmt.Errorf("%s vm does not exist!!", vmIID.NameId)
+}
+
+func (vmHandler *MockVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListVMStatus()!")
+
+	mockName := vmHandler.MockName
+
+	vmMapLock.RLock()
+	defer vmMapLock.RUnlock()
+
+	var statusList []*irs.VMStatusInfo
+	for _, info := range vmInfoMap[mockName] {
+		statusInfo := irs.VMStatusInfo{IId: info.IId, VmStatus: vmStatusMap[mockName][info.IId.NameId]}
+		statusList = append(statusList, &statusInfo)
+	}
+	return statusList, nil
+}
+
+func (vmHandler *MockVMHandler) GetVMStatus(vmIID irs.IID) (irs.VMStatus, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetVMStatus()!")
+
+	mockName := vmHandler.MockName
+
+	vmMapLock.RLock()
+	defer vmMapLock.RUnlock()
+
+	status, ok := vmStatusMap[mockName][vmIID.NameId]
+	if !ok {
+		return irs.NotExist, fmt.Errorf("%s vm does not exist!!", vmIID.NameId)
+	}
+	return status, nil
+}
+
+func (vmHandler *MockVMHandler) ListVM() ([]*irs.VMInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListVM()!")
+
+	mockName := vmHandler.MockName
+
+	vmMapLock.RLock()
+	defer vmMapLock.RUnlock()
+
+	infoList, ok := vmInfoMap[mockName]
+	if !ok {
+		return []*irs.VMInfo{}, nil
+	}
+	// cloning list of VM
+	resultList := make([]*irs.VMInfo, len(infoList))
+	for idx, info := range infoList {
+		clone := *info
+		clone.NetworkInterfaceList = append([]irs.NetworkInterfaceInfo{}, info.NetworkInterfaceList...)
+		resultList[idx] = &clone
+	}
+	return resultList, nil
+}
+
+func (vmHandler *MockVMHandler) GetVM(vmIID irs.IID) (irs.VMInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetVM()!")
+
+	infoList, err := vmHandler.ListVM()
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VMInfo{}, err
+	}
+
+	for _, info := range infoList {
+		if info.IId.NameId == vmIID.NameId {
+			return *info, nil
+		}
+	}
+
+	return irs.VMInfo{}, fmt.Errorf("%s vm does not exist!!", vmIID.NameId)
+}
+
+func (vmHandler *MockVMHandler) GetVMConsoleOutput(vmIID irs.IID) (string, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetVMConsoleOutput()!")
+
+	info, err := vmHandler.GetVM(vmIID)
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+
+	// fake boot messages of the mock VM
+	output := fmt.Sprintf("[    0.000000] Linux version 5.4.0-mock (%s)\n", info.IId.NameId)
+	output += fmt.Sprintf("[    1.000000] %s login:\n", info.IId.NameId)
+	return output, nil
+}
+
+func (vmHandler *MockVMHandler) changeStatus(vmIID irs.IID, from irs.VMStatus, to irs.VMStatus) (irs.VMStatus, error) {
+	mockName := vmHandler.MockName
+
+	vmMapLock.Lock()
+	defer vmMapLock.Unlock()
+
+	status, ok := vmStatusMap[mockName][vmIID.NameId]
+	if !ok {
+		return irs.NotExist, fmt.Errorf("%s vm does not exist!!", vmIID.NameId)
+	}
+	if status != from {
+		return status, fmt.Errorf("%s vm is %s, not %s!!", vmIID.NameId, status, from)
+	}
+
+	vmStatusMap[mockName][vmIID.NameId] = to
+	return to, nil
+}
+
+// SimulateVMInterruption changes the interruption state of a Spot/Preemptible mock VM
+// to test the recovery logic of users.
+//  - InterruptionNoticed: VM keeps running.
+//  - Interrupted: VM is reclaimed and becomes Terminated like a reclaimed Spot VM of AWS.
+func SimulateVMInterruption(mockName string, vmIID irs.IID, state irs.VMInterruptionState) error {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called SimulateVMInterruption()!")
+
+	vmMapLock.Lock()
+	defer vmMapLock.Unlock()
+
+	for _, info := range vmInfoMap[mockName] {
+		if info.IId.NameId != vmIID.NameId {
+			continue
+		}
+		if info.LifecycleType != irs.Spot && info.LifecycleType != irs.Preemptible {
+			return fmt.Errorf("%s vm is %s, it can not be interrupted!!", vmIID.NameId, info.LifecycleType)
+		}
+
+		info.InterruptionState = state
+		if state == irs.Interrupted {
+			vmStatusMap[mockName][vmIID.NameId] = irs.Terminated
+		}
+		return nil
+	}
+
+	return fmt.Errorf("%s vm does not exist!!", vmIID.NameId)
+}
+
+// the next host address of the subnet IPv6 CIDR, "" if the subnet is not dual-stack.
+// caller must hold vmMapLock.
+func (vmHandler *MockVMHandler) allocIPv6(vpcIID irs.IID, subnetIID irs.IID) string {
+	vpcMapLock.RLock()
+	defer vpcMapLock.RUnlock()
+
+	vpcHandler := MockVPCHandler{MockName: vmHandler.MockName}
+	vpcInfo := vpcHandler.findVPC(vpcIID)
+	if vpcInfo == nil {
+		return ""
+	}
+	subnetInfo := findSubnet(vpcInfo, subnetIID)
+	if subnetInfo == nil || subnetInfo.IPv6_CIDR == "" {
+		return ""
+	}
+	_, subnetNet, err := net.ParseCIDR(subnetInfo.IPv6_CIDR)
+	if err != nil {
+		return ""
+	}
+
+	seq := vmIPv6SeqMap[vmHandler.MockName] + 1
+	vmIPv6SeqMap[vmHandler.MockName] = seq
+	ip := make(net.IP, net.IPv6len)
+	copy(ip, subnetNet.IP.To16())
+	binary.BigEndian.PutUint64(ip[8:], uint64(seq))
+	return ip.String()
+}
+
+// NIC in a subnet of the VM's VPC with a fixed or the first free private IP.
+// newNICList is NICs of the VM in creation, not yet in vmInfoMap.
+// caller must hold vmMapLock.
+func (vmHandler *MockVMHandler) createNIC(vpcIID irs.IID, nicReqInfo irs.NetworkInterfaceReqInfo, newNICList []irs.NetworkInterfaceInfo) (irs.NetworkInterfaceInfo, error) {
+	vpcMapLock.RLock()
+	vpcHandler := MockVPCHandler{MockName: vmHandler.MockName}
+	var subnetInfo *irs.SubnetInfo
+	if vpcInfo := vpcHandler.findVPC(vpcIID); vpcInfo != nil {
+		if found := findSubnet(vpcInfo, nicReqInfo.SubnetIID); found != nil {
+			clone := *found
+			subnetInfo = &clone
+		}
+	}
+	vpcMapLock.RUnlock()
+	if subnetInfo == nil {
+		return irs.NetworkInterfaceInfo{}, fmt.Errorf("%s subnet does not exist in %s vpc!!", nicReqInfo.SubnetIID.NameId, vpcIID.NameId)
+	}
+	_, subnetNet, err := net.ParseCIDR(subnetInfo.IPv4_CIDR)
+	if err != nil {
+		return irs.NetworkInterfaceInfo{}, err
+	}
+
+	usedIPs := map[string]bool{}
+	for _, info := range vmInfoMap[vmHandler.MockName] {
+		for _, nicInfo := range info.NetworkInterfaceList {
+			usedIPs[nicInfo.PrivateIP] = true
+		}
+	}
+	for _, nicInfo := range newNICList {
+		usedIPs[nicInfo.PrivateIP] = true
+	}
+
+	privateIP := nicReqInfo.PrivateIP
+	if privateIP != "" {
+		ip := net.ParseIP(privateIP)
+		if ip == nil || ip.To4() == nil || !subnetNet.Contains(ip) {
+			return irs.NetworkInterfaceInfo{}, fmt.Errorf("%s is not an IPv4 address of %s subnet(%s)!!", privateIP, subnetInfo.IId.NameId, subnetInfo.IPv4_CIDR)
+		}
+		if usedIPs[privateIP] {
+			return irs.NetworkInterfaceInfo{}, fmt.Errorf("%s is already in use!!", privateIP)
+		}
+	} else {
+		// first free host address, except the network and broadcast addresses
+		base := binary.BigEndian.Uint32(subnetNet.IP.To4())
+		ones, bits := subnetNet.Mask.Size()
+		size := uint32(1) << uint(bits-ones)
+		for seq := uint32(1); seq+1 < size; seq++ {
+			ip := make(net.IP, net.IPv4len)
+			binary.BigEndian.PutUint32(ip, base+seq)
+			if !usedIPs[ip.String()] {
+				privateIP = ip.String()
+				break
+			}
+		}
+		if privateIP == "" {
+			return irs.NetworkInterfaceInfo{}, fmt.Errorf("%s subnet has no free IP address!!", subnetInfo.IId.NameId)
+		}
+	}
+
+	nicInfo := irs.NetworkInterfaceInfo{
+		SubnetIID:         subnetInfo.IId,
+		SecurityGroupIIds: nicReqInfo.SecurityGroupIIDs,
+		PrivateIP:         privateIP,
+	}
+	if nicReqInfo.PublicIP {
+		nicInfo.PublicIP = mockEphemeralPublicIP
+	}
+	return nicInfo, nil
+}
+
+// the public IP of the VM is the public IP of the primary NIC.
+// caller must hold vmMapLock.
+func setPrimaryPublicIP(vmInfo *irs.VMInfo, publicIP string) {
+	vmInfo.PublicIP = publicIP
+	if len(vmInfo.NetworkInterfaceList) > 0 {
+		vmInfo.NetworkInterfaceList[0].PublicIP = publicIP
+	}
+}
@@ -39,10 +39,17 @@ func prepare(mockName string) {
 	}
 
 	PrepareInfoList = []*irs.VMSpecInfo{
-		{"mock-region01", "mock-vmspec-01", irs.VCpuInfo{"4", "2.7"}, "32768", []irs.GpuInfo{ {"2", "NVIDIA", "V100", "16384MB"} }, nil},
-		{"mock-region02", "mock-vmspec-02", irs.VCpuInfo{"4", "3.2"}, "32768", []irs.GpuInfo{ {"1", "NVIDIA", "V100", "16384MB"} }, nil},
-		{"mock-region02", "mock-vmspec-03", irs.VCpuInfo{"8", "2.7"}, "62464", nil, nil},
-		{"mock-region01", "mock-vmspec-04", irs.VCpuInfo{"8", "2.7"}, "1024", nil, nil},
+		{Region: "mock-region01", Name: "mock-vmspec-01", VCpu: irs.VCpuInfo{Count: "4", Clock: "2.7"}, Mem: "32768",
+			Gpu: []irs.GpuInfo{ {Count: "2", Mfr: "NVIDIA", Model: "V100", Mem: "16384MB"} }},
+		{Region: "mock-region02", Name: "mock-vmspec-02", VCpu: irs.VCpuInfo{Count: "4", Clock: "3.2"}, Mem: "32768",
+			Gpu: []irs.GpuInfo{ {Count: "1", Mfr: "NVIDIA", Model: "V100", Mem: "16384MB"} }},
+		{Region: "mock-region02", Name: "mock-vmspec-03", VCpu: irs.VCpuInfo{Count: "8", Clock: "2.7"}, Mem: "62464"},
+		{Region: "mock-region01", Name: "mock-vmspec-04", VCpu: irs.VCpuInfo{Count: "8", Clock: "2.7"}, Mem: "1024"},
+	}
+	for _, info := range PrepareInfoList {
+		if err := irs.NormalizeVMSpecInfo(info); err != nil {
+			cblogger.Error(err)
+		}
 	}
 	vmSpecInfoMap[mockName]=PrepareInfoList
 
@@ -0,0 +1,668 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Mock Driver.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	cblog "github.com/cloud-barista/cb-log"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// key of the route propagated into a VPC by an accepted peering
+const mockPeeringRouteKey string = "PeeringRoute"
+
+// route tables of a mock VPC like AWS:
+// public subnets use {vpc}-main-rt with the default route to the Internet Gateway,
+// private subnets use {vpc}-private-rt with the default route to the NAT Gateway if exists.
+const (
+	mockMainRouteTableSuffix    string = "-main-rt"
+	mockPrivateRouteTableSuffix string = "-private-rt"
+	mockDefaultRouteCIDR        string = "0.0.0.0/0"
+)
+
+var vpcInfoMap map[string][]*irs.VPCInfo
+var vpcPeeringInfoMap map[string][]*irs.VPCPeeringInfo
+var natGatewaySeqMap map[string]int // MockName => last sequence of NAT Gateway IPs
+var vpcIPv6SeqMap map[string]int    // MockName => last sequence of auto-assigned VPC IPv6 CIDRs
+
+// guards both vpcInfoMap and vpcPeeringInfoMap.
+var vpcMapLock = new(sync.RWMutex)
+
+type MockVPCHandler struct {
+	MockName string
+}
+
+func init() {
+	vpcInfoMap = make(map[string][]*irs.VPCInfo)
+	vpcPeeringInfoMap = make(map[string][]*irs.VPCPeeringInfo)
+	natGatewaySeqMap = make(map[string]int)
+	vpcIPv6SeqMap = make(map[string]int)
+}
+
+// (1) create vpcInfo object, dual-stack if IPv6_CIDR is given
+// (2) insert vpcInfo into global Map
+func (vpcHandler *MockVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called CreateVPC()!")
+
+	mockName := vpcHandler.MockName
+	vpcReqInfo.IId.SystemId = vpcReqInfo.IId.NameId
+
+	vpcMapLock.Lock()
+	defer vpcMapLock.Unlock()
+
+	if vpcHandler.findVPC(vpcReqInfo.IId) != nil {
+		return irs.VPCInfo{}, fmt.Errorf("%s vpc already exists!!", vpcReqInfo.IId.NameId)
+	}
+
+	// (1) create vpcInfo object, dual-stack if IPv6_CIDR is given
+	ipv6CIDR, err := vpcHandler.getVPCIPv6CIDR(vpcReqInfo.IPv6_CIDR)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+	subnetInfoList := make([]irs.SubnetInfo, len(vpcReqInfo.SubnetInfoList))
+	for idx, subnetInfo := range vpcReqInfo.SubnetInfoList {
+		subnetInfo.IId.SystemId = subnetInfo.IId.NameId
+		subnetInfo.IPv6_CIDR, err = getSubnetIPv6CIDR(ipv6CIDR, subnetInfo, subnetInfoList[:idx])
+		if err != nil {
+			return irs.VPCInfo{}, err
+		}
+		subnetInfoList[idx] = subnetInfo
+	}
+	vpcInfo := irs.VPCInfo{
+		IId:            vpcReqInfo.IId,
+		IPv4_CIDR:      vpcReqInfo.IPv4_CIDR,
+		IPv6_CIDR:      ipv6CIDR,
+		SubnetInfoList: subnetInfoList,
+		RouteTableInfoList: []irs.RouteTableInfo{
+			{
+				IId:       irs.IID{NameId: vpcReqInfo.IId.NameId + mockMainRouteTableSuffix, SystemId: vpcReqInfo.IId.NameId + mockMainRouteTableSuffix},
+				RouteList: []irs.RouteInfo{{DestinationCIDR: mockDefaultRouteCIDR, TargetType: irs.RouteInternetGateway}},
+			},
+		},
+	}
+	for _, subnetInfo := range subnetInfoList {
+		routeTable := routeTableOfSubnetType(&vpcInfo, subnetInfo.Private)
+		routeTable.SubnetIIDs = append(routeTable.SubnetIIDs, subnetInfo.IId)
+	}
+
+	// (2) insert vpcInfo into global Map
+	vpcInfoMap[mockName] = append(vpcInfoMap[mockName], &vpcInfo)
+
+	return cloneVPCInfo(&vpcInfo), nil
+}
+
+func (vpcHandler *MockVPCHandler) ListVPC() ([]*irs.VPCInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListVPC()!")
+
+	vpcMapLock.RLock()
+	defer vpcMapLock.RUnlock()
+
+	infoList := vpcInfoMap[vpcHandler.MockName]
+	// cloning list of VPC
+	resultList := make([]*irs.VPCInfo, len(infoList))
+	for idx, info := range infoList {
+		clone := cloneVPCInfo(info)
+		resultList[idx] = &clone
+	}
+	return resultList, nil
+}
+
+func (vpcHandler *MockVPCHandler) GetVPC(vpcIID irs.IID) (irs.VPCInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetVPC()!")
+
+	vpcMapLock.RLock()
+	defer vpcMapLock.RUnlock()
+
+	info := vpcHandler.findVPC(vpcIID)
+	if info == nil {
+		return irs.VPCInfo{}, fmt.Errorf("%s vpc does not exist!!", vpcIID.NameId)
+	}
+	return cloneVPCInfo(info), nil
+}
+
+func (vpcHandler *MockVPCHandler) DeleteVPC(vpcIID irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DeleteVPC()!")
+
+	mockName := vpcHandler.MockName
+
+	vpcMapLock.Lock()
+	defer vpcMapLock.Unlock()
+
+	for _, peering := range vpcPeeringInfoMap[mockName] {
+		if peering.RequesterVPCIID.NameId == vpcIID.NameId || peering.AccepterVPCIID.NameId == vpcIID.NameId {
+			return false, fmt.Errorf("%s vpc is used by %s vpc peering!!", vpcIID.NameId, peering.IId.NameId)
+		}
+	}
+
+	infoList := vpcInfoMap[mockName]
+	for idx, info := range infoList {
+		if info.IId.NameId == vpcIID.NameId {
+			vpcInfoMap[mockName] = append(infoList[:idx], infoList[idx+1:]...)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("%s vpc does not exist!!", vpcIID.NameId)
+}
+
+func (vpcHandler *MockVPCHandler) AddSubnet(vpcIID irs.IID, subnetInfo irs.SubnetInfo) (irs.VPCInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called AddSubnet()!")
+
+	vpcMapLock.Lock()
+	defer vpcMapLock.Unlock()
+
+	info := vpcHandler.findVPC(vpcIID)
+	if info == nil {
+		return irs.VPCInfo{}, fmt.Errorf("%s vpc does not exist!!", vpcIID.NameId)
+	}
+	for _, subnet := range info.SubnetInfoList {
+		if subnet.IId.NameId == subnetInfo.IId.NameId {
+			return irs.VPCInfo{}, fmt.Errorf("%s subnet already exists!!", subnetInfo.IId.NameId)
+		}
+	}
+
+	subnetInfo.IId.SystemId = subnetInfo.IId.NameId
+	ipv6CIDR, err := getSubnetIPv6CIDR(info.IPv6_CIDR, subnetInfo, info.SubnetInfoList)
+	if err != nil {
+		return irs.VPCInfo{}, err
+	}
+	subnetInfo.IPv6_CIDR = ipv6CIDR
+	info.SubnetInfoList = append(info.SubnetInfoList, subnetInfo)
+	routeTable := routeTableOfSubnetType(info, subnetInfo.Private)
+	routeTable.SubnetIIDs = append(routeTable.SubnetIIDs, subnetInfo.IId)
+	return cloneVPCInfo(info), nil
+}
+
+func (vpcHandler *MockVPCHandler) RemoveSubnet(vpcIID irs.IID, subnetIID irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called RemoveSubnet()!")
+
+	vpcMapLock.Lock()
+	defer vpcMapLock.Unlock()
+
+	info := vpcHandler.findVPC(vpcIID)
+	if info == nil {
+		return false, fmt.Errorf("%s vpc does not exist!!", vpcIID.NameId)
+	}
+	for _, natInfo := range info.NATGatewayInfoList {
+		if natInfo.SubnetIID.NameId == subnetIID.NameId {
+			return false, fmt.Errorf("%s subnet has %s nat gateway!!", subnetIID.NameId, natInfo.IId.NameId)
+		}
+	}
+	for idx, subnet := range info.SubnetInfoList {
+		if subnet.IId.NameId == subnetIID.NameId {
+			info.SubnetInfoList = append(info.SubnetInfoList[:idx], info.SubnetInfoList[idx+1:]...)
+			if routeTable := routeTableOfSubnet(info, subnetIID); routeTable != nil {
+				routeTable.SubnetIIDs = removeIID(routeTable.SubnetIIDs, subnetIID)
+			}
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("%s subnet does not exist!!", subnetIID.NameId)
+}
+
+//------ NAT Gateway and Route
+
+// (1) create natGatewayInfo object with a new IP of TEST-NET-2(198.51.100.0/24)
+// (2) route the private subnets to the NAT Gateway
+func (vpcHandler *MockVPCHandler) AddNATGateway(vpcIID irs.IID, natReqInfo irs.NATGatewayReqInfo) (irs.VPCInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called AddNATGateway()!")
+
+	mockName := vpcHandler.MockName
+	natReqInfo.IId.SystemId = natReqInfo.IId.NameId
+
+	vpcMapLock.Lock()
+	defer vpcMapLock.Unlock()
+
+	info := vpcHandler.findVPC(vpcIID)
+	if info == nil {
+		return irs.VPCInfo{}, fmt.Errorf("%s vpc does not exist!!", vpcIID.NameId)
+	}
+	if len(info.NATGatewayInfoList) > 0 {
+		return irs.VPCInfo{}, fmt.Errorf("%s vpc already has %s nat gateway!!", vpcIID.NameId, info.NATGatewayInfoList[0].IId.NameId)
+	}
+	subnetInfo := findSubnet(info, natReqInfo.SubnetIID)
+	if subnetInfo == nil {
+		return irs.VPCInfo{}, fmt.Errorf("%s subnet does not exist!!", natReqInfo.SubnetIID.NameId)
+	}
+	if subnetInfo.Private {
+		return irs.VPCInfo{}, fmt.Errorf("%s subnet is private, nat gateway needs a public subnet!!", natReqInfo.SubnetIID.NameId)
+	}
+
+	seq := natGatewaySeqMap[mockName] + 1
+	if seq > 254 {
+		return irs.VPCInfo{}, fmt.Errorf("no more nat gateway ip can be allocated!!")
+	}
+	natGatewaySeqMap[mockName] = seq
+
+	// (1) create natGatewayInfo object
+	natInfo := irs.NATGatewayInfo{
+		IId:       natReqInfo.IId,
+		SubnetIID: subnetInfo.IId,
+		PublicIP:  fmt.Sprintf("198.51.100.%d", seq),
+	}
+	info.NATGatewayInfoList = append(info.NATGatewayInfoList, natInfo)
+
+	// (2) route the private subnets to the NAT Gateway
+	routeTable := routeTableOfSubnetType(info, true)
+	routeTable.RouteList = append(routeTable.RouteList, irs.RouteInfo{DestinationCIDR: mockDefaultRouteCIDR, TargetType: irs.RouteNATGateway, TargetIID: natInfo.IId})
+
+	return cloneVPCInfo(info), nil
+}
+
+func (vpcHandler *MockVPCHandler) RemoveNATGateway(vpcIID irs.IID, natGatewayIID irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called RemoveNATGateway()!")
+
+	vpcMapLock.Lock()
+	defer vpcMapLock.Unlock()
+
+	info := vpcHandler.findVPC(vpcIID)
+	if info == nil {
+		return false, fmt.Errorf("%s vpc does not exist!!", vpcIID.NameId)
+	}
+	for idx, natInfo := range info.NATGatewayInfoList {
+		if natInfo.IId.NameId != natGatewayIID.NameId {
+			continue
+		}
+		// remove the routes to the NAT Gateway
+		for rtIdx := range info.RouteTableInfoList {
+			routeTable := &info.RouteTableInfoList[rtIdx]
+			routeList := []irs.RouteInfo{}
+			for _, route := range routeTable.RouteList {
+				if route.TargetType == irs.RouteNATGateway && route.TargetIID.NameId == natGatewayIID.NameId {
+					continue
+				}
+				routeList = append(routeList, route)
+			}
+			routeTable.RouteList = routeList
+		}
+		info.NATGatewayInfoList = append(info.NATGatewayInfoList[:idx], info.NATGatewayInfoList[idx+1:]...)
+		return true, nil
+	}
+	return false, fmt.Errorf("%s nat gateway does not exist!!", natGatewayIID.NameId)
+}
+
+func (vpcHandler *MockVPCHandler) AddRoute(vpcIID irs.IID, subnetIID irs.IID, routeInfo irs.RouteInfo) (irs.VPCInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called AddRoute()!")
+
+	if _, _, err := net.ParseCIDR(routeInfo.DestinationCIDR); err != nil {
+		return irs.VPCInfo{}, fmt.Errorf("%s is not a valid CIDR!!", routeInfo.DestinationCIDR)
+	}
+
+	vpcMapLock.Lock()
+	defer vpcMapLock.Unlock()
+
+	info := vpcHandler.findVPC(vpcIID)
+	if info == nil {
+		return irs.VPCInfo{}, fmt.Errorf("%s vpc does not exist!!", vpcIID.NameId)
+	}
+	routeTable := routeTableOfSubnet(info, subnetIID)
+	if routeTable == nil {
+		return irs.VPCInfo{}, fmt.Errorf("%s subnet does not exist!!", subnetIID.NameId)
+	}
+	for _, route := range routeTable.RouteList {
+		if route.DestinationCIDR == routeInfo.DestinationCIDR {
+			return irs.VPCInfo{}, fmt.Errorf("route to %s already exists in %s route table!!", routeInfo.DestinationCIDR, routeTable.IId.NameId)
+		}
+	}
+
+	switch routeInfo.TargetType {
+	case irs.RouteInternetGateway:
+		routeInfo.TargetIID = irs.IID{}
+	case irs.RouteNATGateway:
+		found := false
+		for _, natInfo := range info.NATGatewayInfoList {
+			if natInfo.IId.NameId == routeInfo.TargetIID.NameId {
+				routeInfo.TargetIID = natInfo.IId
+				found = true
+			}
+		}
+		if !found {
+			return irs.VPCInfo{}, fmt.Errorf("%s nat gateway does not exist!!", routeInfo.TargetIID.NameId)
+		}
+	case irs.RouteVPCPeering:
+		peeringInfo := vpcHandler.findVPCPeering(routeInfo.TargetIID)
+		if peeringInfo == nil || peeringInfo.Status != irs.VPCPeeringActive {
+			return irs.VPCInfo{}, fmt.Errorf("%s vpc peering is not active!!", routeInfo.TargetIID.NameId)
+		}
+		if peeringInfo.RequesterVPCIID.NameId != vpcIID.NameId && peeringInfo.AccepterVPCIID.NameId != vpcIID.NameId {
+			return irs.VPCInfo{}, fmt.Errorf("%s vpc peering is not connected to %s vpc!!", routeInfo.TargetIID.NameId, vpcIID.NameId)
+		}
+		routeInfo.TargetIID = peeringInfo.IId
+	default:
+		return irs.VPCInfo{}, fmt.Errorf("%s is not a valid route target type!!", routeInfo.TargetType)
+	}
+
+	routeTable.RouteList = append(routeTable.RouteList, routeInfo)
+	return cloneVPCInfo(info), nil
+}
+
+func (vpcHandler *MockVPCHandler) RemoveRoute(vpcIID irs.IID, subnetIID irs.IID, routeInfo irs.RouteInfo) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called RemoveRoute()!")
+
+	vpcMapLock.Lock()
+	defer vpcMapLock.Unlock()
+
+	info := vpcHandler.findVPC(vpcIID)
+	if info == nil {
+		return false, fmt.Errorf("%s vpc does not exist!!", vpcIID.NameId)
+	}
+	routeTable := routeTableOfSubnet(info, subnetIID)
+	if routeTable == nil {
+		return false, fmt.Errorf("%s subnet does not exist!!", subnetIID.NameId)
+	}
+	for idx, route := range routeTable.RouteList {
+		if route.DestinationCIDR == routeInfo.DestinationCIDR {
+			routeTable.RouteList = append(routeTable.RouteList[:idx], routeTable.RouteList[idx+1:]...)
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("route to %s does not exist in %s route table!!", routeInfo.DestinationCIDR, routeTable.IId.NameId)
+}
+
+//------ VPC Peering
+
+func (vpcHandler *MockVPCHandler) RequestVPCPeering(peeringReqInfo irs.VPCPeeringReqInfo) (irs.VPCPeeringInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called RequestVPCPeering()!")
+
+	mockName := vpcHandler.MockName
+	peeringReqInfo.IId.SystemId = peeringReqInfo.IId.NameId
+
+	vpcMapLock.Lock()
+	defer vpcMapLock.Unlock()
+
+	if vpcHandler.findVPCPeering(peeringReqInfo.IId) != nil {
+		return irs.VPCPeeringInfo{}, fmt.Errorf("%s vpc peering already exists!!", peeringReqInfo.IId.NameId)
+	}
+	requester := vpcHandler.findVPC(peeringReqInfo.RequesterVPCIID)
+	if requester == nil {
+		return irs.VPCPeeringInfo{}, fmt.Errorf("%s vpc does not exist!!", peeringReqInfo.RequesterVPCIID.NameId)
+	}
+	accepter := vpcHandler.findVPC(peeringReqInfo.AccepterVPCIID)
+	if accepter == nil {
+		return irs.VPCPeeringInfo{}, fmt.Errorf("%s vpc does not exist!!", peeringReqInfo.AccepterVPCIID.NameId)
+	}
+
+	peeringInfo := irs.VPCPeeringInfo{
+		IId:             peeringReqInfo.IId,
+		RequesterVPCIID: requester.IId,
+		AccepterVPCIID:  accepter.IId,
+		Status:          irs.VPCPeeringPending,
+	}
+	vpcPeeringInfoMap[mockName] = append(vpcPeeringInfoMap[mockName], &peeringInfo)
+
+	return peeringInfo, nil
+}
+
+// an accepted peering adds a route to the peer VPC CIDR into both VPCs.
+func (vpcHandler *MockVPCHandler) AcceptVPCPeering(peeringIID irs.IID) (irs.VPCPeeringInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called AcceptVPCPeering()!")
+
+	vpcMapLock.Lock()
+	defer vpcMapLock.Unlock()
+
+	peeringInfo := vpcHandler.findVPCPeering(peeringIID)
+	if peeringInfo == nil {
+		return irs.VPCPeeringInfo{}, fmt.Errorf("%s vpc peering does not exist!!", peeringIID.NameId)
+	}
+	if peeringInfo.Status != irs.VPCPeeringPending {
+		return irs.VPCPeeringInfo{}, fmt.Errorf("%s vpc peering is %s, not Pending!!", peeringIID.NameId, peeringInfo.Status)
+	}
+
+	requester := vpcHandler.findVPC(peeringInfo.RequesterVPCIID)
+	accepter := vpcHandler.findVPC(peeringInfo.AccepterVPCIID)
+	if requester == nil || accepter == nil {
+		peeringInfo.Status = irs.VPCPeeringFailed
+		return irs.VPCPeeringInfo{}, fmt.Errorf("%s vpc peering has a deleted vpc!!", peeringIID.NameId)
+	}
+
+	requester.KeyValueList = append(requester.KeyValueList, irs.KeyValue{Key: mockPeeringRouteKey, Value: peeringRoute(accepter.IPv4_CIDR, peeringInfo.IId.NameId)})
+	accepter.KeyValueList = append(accepter.KeyValueList, irs.KeyValue{Key: mockPeeringRouteKey, Value: peeringRoute(requester.IPv4_CIDR, peeringInfo.IId.NameId)})
+	peeringInfo.Status = irs.VPCPeeringActive
+
+	return *peeringInfo, nil
+}
+
+func (vpcHandler *MockVPCHandler) ListVPCPeering() ([]*irs.VPCPeeringInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ListVPCPeering()!")
+
+	vpcMapLock.RLock()
+	defer vpcMapLock.RUnlock()
+
+	infoList := vpcPeeringInfoMap[vpcHandler.MockName]
+	// cloning list of VPC Peering
+	resultList := make([]*irs.VPCPeeringInfo, len(infoList))
+	for idx, info := range infoList {
+		clone := *info
+		resultList[idx] = &clone
+	}
+	return resultList, nil
+}
+
+func (vpcHandler *MockVPCHandler) GetVPCPeering(peeringIID irs.IID) (irs.VPCPeeringInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called GetVPCPeering()!")
+
+	vpcMapLock.RLock()
+	defer vpcMapLock.RUnlock()
+
+	peeringInfo := vpcHandler.findVPCPeering(peeringIID)
+	if peeringInfo == nil {
+		return irs.VPCPeeringInfo{}, fmt.Errorf("%s vpc peering does not exist!!", peeringIID.NameId)
+	}
+	return *peeringInfo, nil
+}
+
+func (vpcHandler *MockVPCHandler) DeleteVPCPeering(peeringIID irs.IID) (bool, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called DeleteVPCPeering()!")
+
+	mockName := vpcHandler.MockName
+
+	vpcMapLock.Lock()
+	defer vpcMapLock.Unlock()
+
+	infoList := vpcPeeringInfoMap[mockName]
+	for idx, info := range infoList {
+		if info.IId.NameId != peeringIID.NameId {
+			continue
+		}
+		// remove the propagated routes
+		for _, vpcIID := range []irs.IID{info.RequesterVPCIID, info.AccepterVPCIID} {
+			vpcInfo := vpcHandler.findVPC(vpcIID)
+			if vpcInfo == nil {
+				continue
+			}
+			keyValueList := []irs.KeyValue{}
+			for _, kv := range vpcInfo.KeyValueList {
+				if kv.Key == mockPeeringRouteKey && hasPeeringRoute(kv.Value, info.IId.NameId) {
+					continue
+				}
+				keyValueList = append(keyValueList, kv)
+			}
+			vpcInfo.KeyValueList = keyValueList
+		}
+		vpcPeeringInfoMap[mockName] = append(infoList[:idx], infoList[idx+1:]...)
+		return true, nil
+	}
+	return false, fmt.Errorf("%s vpc peering does not exist!!", peeringIID.NameId)
+}
+
+// caller must hold vpcMapLock.
+func (vpcHandler *MockVPCHandler) findVPC(vpcIID irs.IID) *irs.VPCInfo {
+	for _, info := range vpcInfoMap[vpcHandler.MockName] {
+		if info.IId.NameId == vpcIID.NameId {
+			return info
+		}
+	}
+	return nil
+}
+
+// caller must hold vpcMapLock.
+func (vpcHandler *MockVPCHandler) findVPCPeering(peeringIID irs.IID) *irs.VPCPeeringInfo {
+	for _, info := range vpcPeeringInfoMap[vpcHandler.MockName] {
+		if info.IId.NameId == peeringIID.NameId {
+			return info
+		}
+	}
+	return nil
+}
+
+// ex) "10.1.0.0/16 via peering-01"
+func peeringRoute(peerCIDR string, peeringName string) string {
+	return peerCIDR + " via " + peeringName
+}
+
+func hasPeeringRoute(route string, peeringName string) bool {
+	return strings.HasSuffix(route, " via "+peeringName)
+}
+
+func findSubnet(info *irs.VPCInfo, subnetIID irs.IID) *irs.SubnetInfo {
+	for idx := range info.SubnetInfoList {
+		if info.SubnetInfoList[idx].IId.NameId == subnetIID.NameId {
+			return &info.SubnetInfoList[idx]
+		}
+	}
+	return nil
+}
+
+// route table of public or private subnets, the private route table is created on demand.
+func routeTableOfSubnetType(info *irs.VPCInfo, private bool) *irs.RouteTableInfo {
+	name := info.IId.NameId + mockMainRouteTableSuffix
+	if private {
+		name = info.IId.NameId + mockPrivateRouteTableSuffix
+	}
+	for idx := range info.RouteTableInfoList {
+		if info.RouteTableInfoList[idx].IId.NameId == name {
+			return &info.RouteTableInfoList[idx]
+		}
+	}
+	info.RouteTableInfoList = append(info.RouteTableInfoList, irs.RouteTableInfo{IId: irs.IID{NameId: name, SystemId: name}})
+	return &info.RouteTableInfoList[len(info.RouteTableInfoList)-1]
+}
+
+func routeTableOfSubnet(info *irs.VPCInfo, subnetIID irs.IID) *irs.RouteTableInfo {
+	subnetInfo := findSubnet(info, subnetIID)
+	if subnetInfo == nil {
+		return nil
+	}
+	return routeTableOfSubnetType(info, subnetInfo.Private)
+}
+
+// "auto" is assigned from the documentation range(2001:db8::/32) like CSP provided /56 CIDRs.
+// caller must hold vpcMapLock.
+func (vpcHandler *MockVPCHandler) getVPCIPv6CIDR(reqIPv6CIDR string) (string, error) {
+	if reqIPv6CIDR == "" {
+		return "", nil
+	}
+	if reqIPv6CIDR == irs.IPv6AutoCIDR {
+		seq := vpcIPv6SeqMap[vpcHandler.MockName] + 1
+		if seq > 0xffff {
+			return "", fmt.Errorf("no more ipv6 cidr!!")
+		}
+		vpcIPv6SeqMap[vpcHandler.MockName] = seq
+		return fmt.Sprintf("2001:db8:%x::/56", seq), nil
+	}
+	ip, ipNet, err := net.ParseCIDR(reqIPv6CIDR)
+	if err != nil || ip.To4() != nil {
+		return "", fmt.Errorf("%s is not an ipv6 cidr!!", reqIPv6CIDR)
+	}
+	if prefixLen, _ := ipNet.Mask.Size(); prefixLen > 64 {
+		return "", fmt.Errorf("%s is smaller than /64!!", reqIPv6CIDR)
+	}
+	return ipNet.String(), nil
+}
+
+// Subnet of a dual-stack VPC uses the given /64 or the first free /64 of the VPC IPv6 CIDR.
+func getSubnetIPv6CIDR(vpcIPv6CIDR string, subnetInfo irs.SubnetInfo, usedSubnetList []irs.SubnetInfo) (string, error) {
+	if vpcIPv6CIDR == "" {
+		if subnetInfo.IPv6_CIDR != "" {
+			return "", fmt.Errorf("%s subnet has ipv6 cidr, but vpc is not dual-stack!!", subnetInfo.IId.NameId)
+		}
+		return "", nil
+	}
+	_, vpcNet, _ := net.ParseCIDR(vpcIPv6CIDR)
+	isUsed := func(cidr string) bool {
+		for _, used := range usedSubnetList {
+			if used.IPv6_CIDR == cidr {
+				return true
+			}
+		}
+		return false
+	}
+
+	if subnetInfo.IPv6_CIDR != "" && subnetInfo.IPv6_CIDR != irs.IPv6AutoCIDR {
+		ip, subnetNet, err := net.ParseCIDR(subnetInfo.IPv6_CIDR)
+		if err != nil || ip.To4() != nil {
+			return "", fmt.Errorf("%s is not an ipv6 cidr!!", subnetInfo.IPv6_CIDR)
+		}
+		if prefixLen, _ := subnetNet.Mask.Size(); prefixLen != 64 || !vpcNet.Contains(subnetNet.IP) {
+			return "", fmt.Errorf("%s is not a /64 of vpc ipv6 cidr %s!!", subnetInfo.IPv6_CIDR, vpcIPv6CIDR)
+		}
+		if isUsed(subnetNet.String()) {
+			return "", fmt.Errorf("%s ipv6 cidr is already used!!", subnetNet.String())
+		}
+		return subnetNet.String(), nil
+	}
+
+	// the upper 64 bits are the network address of a /64
+	vpcPrefixLen, _ := vpcNet.Mask.Size()
+	base := binary.BigEndian.Uint64(vpcNet.IP.To16()[:8])
+	for i := uint64(0); i < uint64(1)<<uint(64-vpcPrefixLen); i++ {
+		ip := make(net.IP, net.IPv6len)
+		binary.BigEndian.PutUint64(ip[:8], base+i)
+		candidate := (&net.IPNet{IP: ip, Mask: net.CIDRMask(64, 128)}).String()
+		if !isUsed(candidate) {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("no free /64 in vpc ipv6 cidr %s!!", vpcIPv6CIDR)
+}
+
+func removeIID(iidList []irs.IID, iid irs.IID) []irs.IID {
+	result := []irs.IID{}
+	for _, one := range iidList {
+		if one.NameId != iid.NameId {
+			result = append(result, one)
+		}
+	}
+	return result
+}
+
+func cloneVPCInfo(info *irs.VPCInfo) irs.VPCInfo {
+	clone := *info
+	clone.SubnetInfoList = append([]irs.SubnetInfo{}, info.SubnetInfoList...)
+	clone.NATGatewayInfoList = append([]irs.NATGatewayInfo{}, info.NATGatewayInfoList...)
+	clone.RouteTableInfoList = make([]irs.RouteTableInfo, len(info.RouteTableInfoList))
+	for idx, routeTable := range info.RouteTableInfoList {
+		routeTable.SubnetIIDs = append([]irs.IID{}, routeTable.SubnetIIDs...)
+		routeTable.RouteList = append([]irs.RouteInfo{}, routeTable.RouteList...)
+		clone.RouteTableInfoList[idx] = routeTable
+	}
+	clone.KeyValueList = append([]irs.KeyValue{}, info.KeyValueList...)
+	return clone
+}
@@ -0,0 +1,155 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+)
+
+var bucketHandler irs.BucketHandler
+
+func init() {
+	cred := idrv.CredentialInfo{
+		MockName: "MockDriver-Bucket-01",
+	}
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: cred,
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	bucketHandler, _ = cloudConn.CreateBucketHandler()
+}
+
+func TestBucketCreateList(t *testing.T) {
+	bucketInfo, err := bucketHandler.CreateBucket(irs.BucketReqInfo{IId: irs.IID{NameId: "mock-bucket-01"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if bucketInfo.IId.SystemId == "" || bucketInfo.CreatedTime.IsZero() {
+		t.Errorf("created bucket has wrong values: %#v", bucketInfo)
+	}
+
+	if _, err := bucketHandler.CreateBucket(irs.BucketReqInfo{IId: irs.IID{NameId: "mock-bucket-01"}}); err == nil {
+		t.Error("duplicated bucket should fail")
+	}
+	if _, err := bucketHandler.CreateBucket(irs.BucketReqInfo{IId: irs.IID{NameId: "Mock_Bucket"}}); err == nil {
+		t.Error("invalid bucket name should fail")
+	}
+
+	list, err := bucketHandler.ListBucket()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(list) != 1 {
+		t.Errorf("number of buckets is %d, not 1", len(list))
+	}
+}
+
+func TestObjectPutGetList(t *testing.T) {
+	bucketIID := irs.IID{NameId: "mock-bucket-01"}
+	data := []byte("hello cb-spider object storage")
+
+	// size -1: unknown size stream
+	objectInfo, err := bucketHandler.PutObject(bucketIID, "dir/hello.txt", strings.NewReader(string(data)), -1, "text/plain")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if objectInfo.Size != int64(len(data)) || objectInfo.ETag == "" {
+		t.Errorf("put object has wrong values: %#v", objectInfo)
+	}
+	if _, err := bucketHandler.PutObject(bucketIID, "bad.txt", bytes.NewReader(data), 3, ""); err == nil {
+		t.Error("put object with a wrong size should fail")
+	}
+	if _, err := bucketHandler.PutObject(bucketIID, "other.txt", bytes.NewReader(data), int64(len(data)), ""); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	reader, info, err := bucketHandler.GetObject(bucketIID, "dir/hello.txt")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	got, err := ioutil.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !bytes.Equal(got, data) || info.ContentType != "text/plain" {
+		t.Errorf("get object has wrong values: %q, %#v", got, info)
+	}
+
+	list, err := bucketHandler.ListObject(bucketIID, "dir/")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(list) != 1 || list[0].Name != "dir/hello.txt" {
+		t.Errorf("list with prefix has wrong values: %#v", list)
+	}
+
+	if _, _, err := bucketHandler.GetObject(bucketIID, "none.txt"); err == nil {
+		t.Error("get of a missing object should fail")
+	}
+}
+
+func TestPresignedURL(t *testing.T) {
+	bucketIID := irs.IID{NameId: "mock-bucket-01"}
+
+	url, err := bucketHandler.GetPresignedURL(bucketIID, "dir/hello.txt", irs.PresignedGet, time.Hour)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !strings.Contains(url, "mock-bucket-01") || !strings.Contains(url, "dir/hello.txt") {
+		t.Errorf("presigned url has wrong value: %s", url)
+	}
+	if _, err := bucketHandler.GetPresignedURL(bucketIID, "none.txt", irs.PresignedGet, time.Hour); err == nil {
+		t.Error("GET url of a missing object should fail")
+	}
+	if _, err := bucketHandler.GetPresignedURL(bucketIID, "new.txt", irs.PresignedPut, time.Hour); err != nil {
+		t.Error(err.Error())
+	}
+	if _, err := bucketHandler.GetPresignedURL(bucketIID, "new.txt", irs.PresignedURLMethod("POST"), time.Hour); err == nil {
+		t.Error("POST url should fail")
+	}
+}
+
+func TestBucketDelete(t *testing.T) {
+	bucketIID := irs.IID{NameId: "mock-bucket-01"}
+
+	if _, err := bucketHandler.DeleteBucket(bucketIID); err == nil {
+		t.Error("delete of a non-empty bucket should fail")
+	}
+
+	list, err := bucketHandler.ListObject(bucketIID, "")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, object := range list {
+		if _, err := bucketHandler.DeleteObject(bucketIID, object.Name); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+
+	result, err := bucketHandler.DeleteBucket(bucketIID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !result {
+		t.Error("delete bucket returned false")
+	}
+	if _, err := bucketHandler.GetBucket(bucketIID); err == nil {
+		t.Error("deleted bucket still exists")
+	}
+}
@@ -0,0 +1,52 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package mocktest
+
+import (
+	"errors"
+
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+
+	"testing"
+)
+
+func TestDriverCapability(t *testing.T) {
+	capability := mockdrv.MockDriver{}.GetDriverCapability()
+	if capability.SecurityHandler {
+		t.Error("The mock driver does not have SecurityHandler, but SecurityHandler is true.")
+	}
+	if !capability.SUBNET_ADD_REMOVE || !capability.VM_SUSPEND_RESUME || !capability.VM_REBOOT {
+		t.Errorf("The mock driver supports AddSubnet, SuspendVM and RebootVM: %+v", capability)
+	}
+
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: idrv.CredentialInfo{MockName: "MockDriver-Capability-01"},
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	handler, err := cloudConn.CreateSecurityHandler()
+	if err == nil || handler != nil {
+		t.Error("CreateSecurityHandler() of the mock driver should return an error.")
+	}
+}
+
+func TestNotSupportedError(t *testing.T) {
+	var err error = &idrv.NotSupportedError{Driver: "mock-config01", Feature: "SecurityHandler"}
+	if !idrv.IsNotSupported(err) {
+		t.Errorf("IsNotSupported(%v) should be true.", err)
+	}
+	if err.Error() != "mock-config01: SecurityHandler is not supported by the driver!" {
+		t.Errorf("The error message is wrong: %s", err.Error())
+	}
+
+	if idrv.IsNotSupported(errors.New(err.Error())) {
+		t.Error("IsNotSupported() of an untyped error should be false.")
+	}
+}
@@ -0,0 +1,55 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"testing"
+)
+
+func TestConnectionInfo(t *testing.T) {
+	crdKeyValueList := []irs.KeyValue{{Key: "MockName", Value: "MockDriver-ConnInfo-01"}, {Key: "Extra", Value: "extra-value"}}
+	rgnKeyValueList := []irs.KeyValue{{Key: "Region", Value: "mock-region01"}}
+
+	driver := mockdrv.MockDriver{}
+	connInfo, err := driver.GetConnectionInfo(crdKeyValueList, rgnKeyValueList)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if connInfo.CredentialInfo.MockName != "MockDriver-ConnInfo-01" {
+		t.Errorf("MockName is not translated: %s", connInfo.CredentialInfo.MockName)
+	}
+	if connInfo.RegionInfo.Region != "mock-region01" || connInfo.RegionInfo.Zone != "" {
+		t.Errorf("Region or Zone is wrong: %s, %s", connInfo.RegionInfo.Region, connInfo.RegionInfo.Zone)
+	}
+
+	// the keys without their own field are passed in the key-value list.
+	if len(connInfo.CredentialInfo.KeyValueList) != 2 || connInfo.CredentialInfo.KeyValueList[1].Value != "extra-value" {
+		t.Errorf("KeyValueList of the credential is wrong: %v", connInfo.CredentialInfo.KeyValueList)
+	}
+
+	regionKey, zoneKey := driver.GetRegionZoneKeyName()
+	if regionKey != "Region" || zoneKey != "" {
+		t.Errorf("Region and Zone key names are wrong: %s, %s", regionKey, zoneKey)
+	}
+}
+
+func TestDriverInterfaceVersion(t *testing.T) {
+	version := idrv.GetInterfaceVersion(mockdrv.MockDriver{}.GetDriverVersion())
+	if version != idrv.InterfaceVersion {
+		t.Errorf("The interface version of the driver is not %s. It is %q.", idrv.InterfaceVersion, version)
+	}
+
+	if version := idrv.GetInterfaceVersion("MOCK DRIVER Version 1.0"); version != "" {
+		t.Errorf("A driver version without the interface version should return \"\". It is %q.", version)
+	}
+}
@@ -0,0 +1,128 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"testing"
+)
+
+var dnsHandler irs.DNSHandler
+var dnsVPCHandler irs.VPCHandler
+
+func init() {
+	cred := idrv.CredentialInfo{
+		MockName: "MockDriver-DNS-01",
+	}
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: cred,
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	dnsHandler, _ = cloudConn.CreateDNSHandler()
+	dnsVPCHandler, _ = cloudConn.CreateVPCHandler()
+}
+
+func TestDNSZoneCreateList(t *testing.T) {
+	_, err := dnsVPCHandler.CreateVPC(irs.VPCReqInfo{
+		IId:            irs.IID{NameId: "mock-dns-vpc-01"},
+		IPv4_CIDR:      "10.50.0.0/16",
+		SubnetInfoList: []irs.SubnetInfo{{IId: irs.IID{NameId: "mock-dns-subnet-01"}, IPv4_CIDR: "10.50.1.0/24"}},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	zoneInfo, err := dnsHandler.CreateZone(irs.DNSZoneReqInfo{
+		IId:        irs.IID{NameId: "mock-dns-zone-01"},
+		DomainName: "cb-spider.internal",
+		VpcIID:     irs.IID{NameId: "mock-dns-vpc-01"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if zoneInfo.VpcIID.NameId != "mock-dns-vpc-01" || len(zoneInfo.RecordList) != 0 {
+		t.Errorf("created zone has wrong values: %#v", zoneInfo)
+	}
+
+	if _, err := dnsHandler.CreateZone(irs.DNSZoneReqInfo{IId: irs.IID{NameId: "mock-dns-zone-01"}, DomainName: "other.internal", VpcIID: irs.IID{NameId: "mock-dns-vpc-01"}}); err == nil {
+		t.Error("duplicated zone should fail")
+	}
+	if _, err := dnsHandler.CreateZone(irs.DNSZoneReqInfo{IId: irs.IID{NameId: "mock-dns-zone-02"}, DomainName: "cb-spider.internal", VpcIID: irs.IID{NameId: "mock-dns-vpc-01"}}); err == nil {
+		t.Error("duplicated domain in a VPC should fail")
+	}
+	if _, err := dnsHandler.CreateZone(irs.DNSZoneReqInfo{IId: irs.IID{NameId: "mock-dns-zone-02"}, DomainName: "other.internal", VpcIID: irs.IID{NameId: "mock-dns-vpc-99"}}); err == nil {
+		t.Error("zone with a wrong VPC should fail")
+	}
+
+	list, err := dnsHandler.ListZone()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(list) != 1 {
+		t.Errorf("number of zones is %d, not 1", len(list))
+	}
+}
+
+func TestDNSRecord(t *testing.T) {
+	zoneIID := irs.IID{NameId: "mock-dns-zone-01"}
+
+	zoneInfo, err := dnsHandler.AddRecord(zoneIID, irs.DNSRecordInfo{Name: "web-01", Type: irs.DNSRecordA, Values: []string{"10.50.1.10"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(zoneInfo.RecordList) != 1 || zoneInfo.RecordList[0].TTL != irs.DNSDefaultTTL {
+		t.Errorf("added record has wrong values: %#v", zoneInfo.RecordList)
+	}
+	if _, err := dnsHandler.AddRecord(zoneIID, irs.DNSRecordInfo{Name: "web", Type: irs.DNSRecordCNAME, Values: []string{"web-01.cb-spider.internal"}}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if _, err := dnsHandler.AddRecord(zoneIID, irs.DNSRecordInfo{Name: "web-01", Type: irs.DNSRecordA, Values: []string{"10.50.1.11"}}); err == nil {
+		t.Error("duplicated record should fail")
+	}
+	if _, err := dnsHandler.AddRecord(zoneIID, irs.DNSRecordInfo{Name: "web", Type: irs.DNSRecordA, Values: []string{"10.50.1.11"}}); err == nil {
+		t.Error("A record with the same name of a CNAME record should fail")
+	}
+	if _, err := dnsHandler.AddRecord(zoneIID, irs.DNSRecordInfo{Name: "db-01", Type: irs.DNSRecordA, Values: []string{"db-01"}}); err == nil {
+		t.Error("A record with a non IPv4 value should fail")
+	}
+
+	zoneInfo, err = dnsHandler.UpdateRecord(zoneIID, irs.DNSRecordInfo{Name: "web-01", Type: irs.DNSRecordA, TTL: 60, Values: []string{"10.50.1.20"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if zoneInfo.RecordList[0].TTL != 60 || zoneInfo.RecordList[0].Values[0] != "10.50.1.20" {
+		t.Errorf("updated record has wrong values: %#v", zoneInfo.RecordList[0])
+	}
+	if _, err := dnsHandler.UpdateRecord(zoneIID, irs.DNSRecordInfo{Name: "db-01", Type: irs.DNSRecordA, Values: []string{"10.50.1.30"}}); err == nil {
+		t.Error("updating a record which does not exist should fail")
+	}
+
+	if _, err := dnsHandler.RemoveRecord(zoneIID, "web", irs.DNSRecordCNAME); err != nil {
+		t.Fatal(err.Error())
+	}
+	zoneInfo, err = dnsHandler.GetZone(zoneIID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(zoneInfo.RecordList) != 1 {
+		t.Errorf("number of records is %d, not 1", len(zoneInfo.RecordList))
+	}
+
+	if _, err := dnsHandler.DeleteZone(zoneIID); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := dnsHandler.GetZone(zoneIID); err == nil {
+		t.Error("deleted zone should not exist")
+	}
+}
@@ -0,0 +1,122 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"testing"
+)
+
+var ipv6VPCHandler irs.VPCHandler
+var ipv6VMHandler irs.VMHandler
+
+func init() {
+	cred := idrv.CredentialInfo{
+		MockName: "MockDriver-IPv6-01",
+	}
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: cred,
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	ipv6VPCHandler, _ = cloudConn.CreateVPCHandler()
+	ipv6VMHandler, _ = cloudConn.CreateVMHandler()
+}
+
+func TestIPv6Capability(t *testing.T) {
+	capInfo := (&mockdrv.MockDriver{}).GetDriverCapability()
+	if !capInfo.VPC_IPv6_CIDR {
+		t.Error("mock driver should support IPv6 VPC CIDR")
+	}
+}
+
+func TestIPv6DualStackVPC(t *testing.T) {
+	vpcInfo, err := ipv6VPCHandler.CreateVPC(irs.VPCReqInfo{
+		IId:       irs.IID{NameId: "mock-ipv6-vpc-01"},
+		IPv4_CIDR: "10.20.0.0/16",
+		IPv6_CIDR: irs.IPv6AutoCIDR,
+		SubnetInfoList: []irs.SubnetInfo{
+			{IId: irs.IID{NameId: "mock-ipv6-subnet-01"}, IPv4_CIDR: "10.20.1.0/24"},
+			{IId: irs.IID{NameId: "mock-ipv6-subnet-02"}, IPv4_CIDR: "10.20.2.0/24"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if vpcInfo.IPv6_CIDR != "2001:db8:1::/56" {
+		t.Errorf("auto IPv6 CIDR: got %s", vpcInfo.IPv6_CIDR)
+	}
+	if vpcInfo.SubnetInfoList[0].IPv6_CIDR != "2001:db8:1::/64" || vpcInfo.SubnetInfoList[1].IPv6_CIDR != "2001:db8:1:1::/64" {
+		t.Errorf("subnet IPv6 CIDRs should be sequential /64s: %v", vpcInfo.SubnetInfoList)
+	}
+
+	vpcInfo, err = ipv6VPCHandler.AddSubnet(vpcInfo.IId, irs.SubnetInfo{IId: irs.IID{NameId: "mock-ipv6-subnet-03"}, IPv4_CIDR: "10.20.3.0/24", IPv6_CIDR: "2001:db8:1:ff::/64"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if vpcInfo.SubnetInfoList[2].IPv6_CIDR != "2001:db8:1:ff::/64" {
+		t.Errorf("given subnet IPv6 CIDR: got %s", vpcInfo.SubnetInfoList[2].IPv6_CIDR)
+	}
+
+	if _, err := ipv6VPCHandler.AddSubnet(vpcInfo.IId, irs.SubnetInfo{IId: irs.IID{NameId: "mock-ipv6-subnet-04"}, IPv4_CIDR: "10.20.4.0/24", IPv6_CIDR: "2001:db8:1:ff::/64"}); err == nil {
+		t.Error("duplicated subnet IPv6 CIDR should fail")
+	}
+	if _, err := ipv6VPCHandler.AddSubnet(vpcInfo.IId, irs.SubnetInfo{IId: irs.IID{NameId: "mock-ipv6-subnet-04"}, IPv4_CIDR: "10.20.4.0/24", IPv6_CIDR: "2001:db8:2::/64"}); err == nil {
+		t.Error("subnet IPv6 CIDR out of the VPC should fail")
+	}
+}
+
+func TestIPv6SingleStackVPC(t *testing.T) {
+	vpcInfo, err := ipv6VPCHandler.CreateVPC(irs.VPCReqInfo{
+		IId:            irs.IID{NameId: "mock-ipv4-vpc-01"},
+		IPv4_CIDR:      "10.30.0.0/16",
+		SubnetInfoList: []irs.SubnetInfo{{IId: irs.IID{NameId: "mock-ipv4-subnet-01"}, IPv4_CIDR: "10.30.1.0/24"}},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if vpcInfo.IPv6_CIDR != "" || vpcInfo.SubnetInfoList[0].IPv6_CIDR != "" {
+		t.Errorf("IPv4 only VPC should have no IPv6 CIDR: %v", vpcInfo)
+	}
+	if _, err := ipv6VPCHandler.AddSubnet(vpcInfo.IId, irs.SubnetInfo{IId: irs.IID{NameId: "mock-ipv4-subnet-02"}, IPv4_CIDR: "10.30.2.0/24", IPv6_CIDR: irs.IPv6AutoCIDR}); err == nil {
+		t.Error("IPv6 subnet in an IPv4 only VPC should fail")
+	}
+	if _, err := ipv6VPCHandler.CreateVPC(irs.VPCReqInfo{IId: irs.IID{NameId: "mock-ipv6-vpc-02"}, IPv4_CIDR: "10.40.0.0/16", IPv6_CIDR: "10.40.0.0/16"}); err == nil {
+		t.Error("IPv4 CIDR as IPv6_CIDR should fail")
+	}
+}
+
+func TestIPv6VM(t *testing.T) {
+	vmInfo, err := ipv6VMHandler.StartVM(irs.VMReqInfo{
+		IId:       irs.IID{NameId: "mock-ipv6-vm-01"},
+		VpcIID:    irs.IID{NameId: "mock-ipv6-vpc-01"},
+		SubnetIID: irs.IID{NameId: "mock-ipv6-subnet-02"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if vmInfo.PrivateIPv6 != "2001:db8:1:1::1" || vmInfo.PublicIPv6 != vmInfo.PrivateIPv6 {
+		t.Errorf("VM IPv6 address: got private %s, public %s", vmInfo.PrivateIPv6, vmInfo.PublicIPv6)
+	}
+
+	vmInfo, err = ipv6VMHandler.StartVM(irs.VMReqInfo{
+		IId:       irs.IID{NameId: "mock-ipv4-vm-01"},
+		VpcIID:    irs.IID{NameId: "mock-ipv4-vpc-01"},
+		SubnetIID: irs.IID{NameId: "mock-ipv4-subnet-01"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if vmInfo.PrivateIPv6 != "" || vmInfo.PublicIPv6 != "" {
+		t.Errorf("VM in an IPv4 only subnet should have no IPv6 address: %v", vmInfo)
+	}
+}
@@ -0,0 +1,137 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"testing"
+)
+
+var nicVPCHandler irs.VPCHandler
+var nicVMHandler irs.VMHandler
+
+func init() {
+	cred := idrv.CredentialInfo{
+		MockName: "MockDriver-NIC-01",
+	}
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: cred,
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	nicVPCHandler, _ = cloudConn.CreateVPCHandler()
+	nicVMHandler, _ = cloudConn.CreateVMHandler()
+}
+
+func TestMultiNICStartVM(t *testing.T) {
+	if !(&mockdrv.MockDriver{}).GetDriverCapability().VM_MULTI_NIC {
+		t.Error("mock driver should support multiple network interfaces")
+	}
+
+	_, err := nicVPCHandler.CreateVPC(irs.VPCReqInfo{
+		IId:       irs.IID{NameId: "mock-nic-vpc-01"},
+		IPv4_CIDR: "10.60.0.0/16",
+		SubnetInfoList: []irs.SubnetInfo{
+			{IId: irs.IID{NameId: "mock-nic-subnet-01"}, IPv4_CIDR: "10.60.1.0/24"},
+			{IId: irs.IID{NameId: "mock-nic-subnet-02"}, IPv4_CIDR: "10.60.2.0/24"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	vmInfo, err := nicVMHandler.StartVM(irs.VMReqInfo{
+		IId:               irs.IID{NameId: "mock-nic-vm-01"},
+		VpcIID:            irs.IID{NameId: "mock-nic-vpc-01"},
+		SubnetIID:         irs.IID{NameId: "mock-nic-subnet-01"},
+		SecurityGroupIIDs: []irs.IID{{NameId: "mock-nic-sg-01"}},
+		NetworkInterfaceList: []irs.NetworkInterfaceReqInfo{
+			{SubnetIID: irs.IID{NameId: "mock-nic-subnet-02"}, PrivateIP: "10.60.2.10"},
+			{SubnetIID: irs.IID{NameId: "mock-nic-subnet-02"}, SecurityGroupIIDs: []irs.IID{{NameId: "mock-nic-sg-02"}}, PublicIP: true},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	nicList := vmInfo.NetworkInterfaceList
+	if len(nicList) != 3 {
+		t.Fatalf("number of NICs is %d, not 3", len(nicList))
+	}
+	if nicList[0].SubnetIID.NameId != "mock-nic-subnet-01" {
+		t.Errorf("the primary NIC should come first: %#v", nicList[0])
+	}
+	if nicList[1].PrivateIP != "10.60.2.10" || nicList[1].SecurityGroupIIds[0].NameId != "mock-nic-sg-01" || nicList[1].PublicIP != "" {
+		t.Errorf("NIC with a fixed IP has wrong values: %#v", nicList[1])
+	}
+	if nicList[2].PrivateIP != "10.60.2.1" || nicList[2].SecurityGroupIIds[0].NameId != "mock-nic-sg-02" || nicList[2].PublicIP == "" {
+		t.Errorf("NIC with an allocated IP has wrong values: %#v", nicList[2])
+	}
+
+	getInfo, err := nicVMHandler.GetVM(vmInfo.IId)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(getInfo.NetworkInterfaceList) != 3 {
+		t.Errorf("GetVM should report all NICs: %#v", getInfo.NetworkInterfaceList)
+	}
+}
+
+func TestMultiNICStartVMFail(t *testing.T) {
+	reqInfo := irs.VMReqInfo{
+		IId:       irs.IID{NameId: "mock-nic-vm-02"},
+		VpcIID:    irs.IID{NameId: "mock-nic-vpc-01"},
+		SubnetIID: irs.IID{NameId: "mock-nic-subnet-01"},
+	}
+
+	reqInfo.NetworkInterfaceList = []irs.NetworkInterfaceReqInfo{{SubnetIID: irs.IID{NameId: "mock-nic-subnet-99"}}}
+	if _, err := nicVMHandler.StartVM(reqInfo); err == nil {
+		t.Error("NIC in a wrong subnet should fail")
+	}
+	reqInfo.NetworkInterfaceList = []irs.NetworkInterfaceReqInfo{{SubnetIID: irs.IID{NameId: "mock-nic-subnet-02"}, PrivateIP: "10.60.1.10"}}
+	if _, err := nicVMHandler.StartVM(reqInfo); err == nil {
+		t.Error("NIC with an IP out of the subnet should fail")
+	}
+	reqInfo.NetworkInterfaceList = []irs.NetworkInterfaceReqInfo{{SubnetIID: irs.IID{NameId: "mock-nic-subnet-02"}, PrivateIP: "10.60.2.10"}}
+	if _, err := nicVMHandler.StartVM(reqInfo); err == nil {
+		t.Error("NIC with an IP in use should fail")
+	}
+
+	if _, err := nicVMHandler.GetVM(reqInfo.IId); err == nil {
+		t.Error("failed VM should not exist")
+	}
+}
+
+func TestFixedPrivateIPStartVM(t *testing.T) {
+	reqInfo := irs.VMReqInfo{
+		IId:       irs.IID{NameId: "mock-nic-vm-03"},
+		VpcIID:    irs.IID{NameId: "mock-nic-vpc-01"},
+		SubnetIID: irs.IID{NameId: "mock-nic-subnet-01"},
+		PrivateIP: "10.60.1.20",
+	}
+	vmInfo, err := nicVMHandler.StartVM(reqInfo)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if vmInfo.PrivateIP != "10.60.1.20" || vmInfo.NetworkInterfaceList[0].PrivateIP != "10.60.1.20" {
+		t.Errorf("fixed private IP is not assigned: %s", vmInfo.PrivateIP)
+	}
+
+	reqInfo.IId = irs.IID{NameId: "mock-nic-vm-04"}
+	if _, err := nicVMHandler.StartVM(reqInfo); err == nil {
+		t.Error("private IP in use should fail")
+	}
+	reqInfo.PrivateIP = "10.60.2.20"
+	if _, err := nicVMHandler.StartVM(reqInfo); err == nil {
+		t.Error("private IP out of the subnet should fail")
+	}
+}
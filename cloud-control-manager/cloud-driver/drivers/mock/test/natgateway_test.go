@@ -0,0 +1,144 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"testing"
+)
+
+var natVPCHandler irs.VPCHandler
+
+func init() {
+	cred := idrv.CredentialInfo{
+		MockName: "MockDriver-NATGateway-01",
+	}
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: cred,
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	natVPCHandler, _ = cloudConn.CreateVPCHandler()
+}
+
+func findRouteTable(vpcInfo irs.VPCInfo, name string) *irs.RouteTableInfo {
+	for idx := range vpcInfo.RouteTableInfoList {
+		if vpcInfo.RouteTableInfoList[idx].IId.NameId == name {
+			return &vpcInfo.RouteTableInfoList[idx]
+		}
+	}
+	return nil
+}
+
+func TestNATGatewayPrivateSubnet(t *testing.T) {
+	vpcInfo, err := natVPCHandler.CreateVPC(irs.VPCReqInfo{
+		IId:       irs.IID{NameId: "mock-nat-vpc-01"},
+		IPv4_CIDR: "10.10.0.0/16",
+		SubnetInfoList: []irs.SubnetInfo{
+			{IId: irs.IID{NameId: "mock-nat-public-01"}, IPv4_CIDR: "10.10.1.0/24"},
+			{IId: irs.IID{NameId: "mock-nat-private-01"}, IPv4_CIDR: "10.10.2.0/24", Private: true},
+		},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	mainRT := findRouteTable(vpcInfo, "mock-nat-vpc-01-main-rt")
+	privateRT := findRouteTable(vpcInfo, "mock-nat-vpc-01-private-rt")
+	if mainRT == nil || privateRT == nil {
+		t.Fatalf("route tables are not created: %v", vpcInfo.RouteTableInfoList)
+	}
+	if len(mainRT.RouteList) != 1 || mainRT.RouteList[0].TargetType != irs.RouteInternetGateway {
+		t.Errorf("main route table should have the default route to the Internet Gateway: %v", mainRT.RouteList)
+	}
+	if len(privateRT.RouteList) != 0 {
+		t.Errorf("private route table should have no route before NAT Gateway: %v", privateRT.RouteList)
+	}
+	if len(privateRT.SubnetIIDs) != 1 || privateRT.SubnetIIDs[0].NameId != "mock-nat-private-01" {
+		t.Errorf("private subnet is not associated with the private route table: %v", privateRT.SubnetIIDs)
+	}
+}
+
+func TestNATGatewayAddRemove(t *testing.T) {
+	vpcIID := irs.IID{NameId: "mock-nat-vpc-01"}
+	natIID := irs.IID{NameId: "mock-nat-01"}
+
+	if _, err := natVPCHandler.AddNATGateway(vpcIID, irs.NATGatewayReqInfo{IId: natIID, SubnetIID: irs.IID{NameId: "mock-nat-private-01"}}); err == nil {
+		t.Error("NAT Gateway in a private subnet should fail")
+	}
+
+	vpcInfo, err := natVPCHandler.AddNATGateway(vpcIID, irs.NATGatewayReqInfo{IId: natIID, SubnetIID: irs.IID{NameId: "mock-nat-public-01"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(vpcInfo.NATGatewayInfoList) != 1 || vpcInfo.NATGatewayInfoList[0].PublicIP == "" {
+		t.Errorf("NAT Gateway is not created: %v", vpcInfo.NATGatewayInfoList)
+	}
+	privateRT := findRouteTable(vpcInfo, "mock-nat-vpc-01-private-rt")
+	if len(privateRT.RouteList) != 1 || privateRT.RouteList[0].TargetIID.NameId != natIID.NameId {
+		t.Errorf("private route table should have the default route to the NAT Gateway: %v", privateRT.RouteList)
+	}
+
+	if _, err := natVPCHandler.AddNATGateway(vpcIID, irs.NATGatewayReqInfo{IId: irs.IID{NameId: "mock-nat-02"}, SubnetIID: irs.IID{NameId: "mock-nat-public-01"}}); err == nil {
+		t.Error("second NAT Gateway should fail")
+	}
+	if _, err := natVPCHandler.RemoveSubnet(vpcIID, irs.IID{NameId: "mock-nat-public-01"}); err == nil {
+		t.Error("removing the subnet of a NAT Gateway should fail")
+	}
+
+	if _, err := natVPCHandler.RemoveNATGateway(vpcIID, natIID); err != nil {
+		t.Fatal(err.Error())
+	}
+	vpcInfo, err = natVPCHandler.GetVPC(vpcIID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(vpcInfo.NATGatewayInfoList) != 0 {
+		t.Errorf("NAT Gateway is not removed: %v", vpcInfo.NATGatewayInfoList)
+	}
+	if privateRT := findRouteTable(vpcInfo, "mock-nat-vpc-01-private-rt"); len(privateRT.RouteList) != 0 {
+		t.Errorf("route to the removed NAT Gateway remains: %v", privateRT.RouteList)
+	}
+}
+
+func TestRouteAddRemove(t *testing.T) {
+	vpcIID := irs.IID{NameId: "mock-nat-vpc-01"}
+	subnetIID := irs.IID{NameId: "mock-nat-public-01"}
+	routeInfo := irs.RouteInfo{DestinationCIDR: "192.168.0.0/16", TargetType: irs.RouteInternetGateway}
+
+	vpcInfo, err := natVPCHandler.AddRoute(vpcIID, subnetIID, routeInfo)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if mainRT := findRouteTable(vpcInfo, "mock-nat-vpc-01-main-rt"); len(mainRT.RouteList) != 2 {
+		t.Errorf("route is not added: %v", mainRT.RouteList)
+	}
+	if _, err := natVPCHandler.AddRoute(vpcIID, subnetIID, routeInfo); err == nil {
+		t.Error("duplicated route should fail")
+	}
+	if _, err := natVPCHandler.AddRoute(vpcIID, subnetIID, irs.RouteInfo{DestinationCIDR: "172.16.0.0/12", TargetType: irs.RouteNATGateway, TargetIID: irs.IID{NameId: "mock-nat-none"}}); err == nil {
+		t.Error("route to a nonexistent NAT Gateway should fail")
+	}
+	if _, err := natVPCHandler.AddRoute(vpcIID, subnetIID, irs.RouteInfo{DestinationCIDR: "10.300.0.0/16", TargetType: irs.RouteInternetGateway}); err == nil {
+		t.Error("invalid CIDR should fail")
+	}
+
+	if _, err := natVPCHandler.RemoveRoute(vpcIID, subnetIID, routeInfo); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := natVPCHandler.RemoveRoute(vpcIID, subnetIID, routeInfo); err == nil {
+		t.Error("removing a nonexistent route should fail")
+	}
+	if _, err := natVPCHandler.DeleteVPC(vpcIID); err != nil {
+		t.Fatal(err.Error())
+	}
+}
@@ -0,0 +1,144 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"testing"
+)
+
+var nlbHandler irs.NLBHandler
+var nlbVMHandler irs.VMHandler
+
+func init() {
+	cred := idrv.CredentialInfo{
+		MockName: "MockDriver-NLB-01",
+	}
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: cred,
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	nlbHandler, _ = cloudConn.CreateNLBHandler()
+	nlbVMHandler, _ = cloudConn.CreateVMHandler()
+}
+
+func TestNLBCreateList(t *testing.T) {
+	for _, name := range []string{"mock-nlb-vm-01", "mock-nlb-vm-02"} {
+		if _, err := nlbVMHandler.StartVM(irs.VMReqInfo{IId: irs.IID{NameId: name}}); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+
+	reqInfo := irs.NLBReqInfo{
+		IId:      irs.IID{NameId: "mock-nlb-01"},
+		VpcIID:   irs.IID{NameId: "mock-vpc-01"},
+		Listener: irs.ListenerInfo{Protocol: "TCP", Port: "80"},
+		VMGroup: irs.NLBVMGroupInfo{Protocol: "TCP", Port: "8080",
+			VMs: []irs.IID{{NameId: "mock-nlb-vm-01"}}},
+		HealthChecker: irs.HealthCheckerInfo{Protocol: "TCP", Port: "8080", Interval: 10, Timeout: 10, Threshold: 3},
+	}
+	info, err := nlbHandler.CreateNLB(reqInfo)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if info.Type != "PUBLIC" || info.Listener.IP == "" {
+		t.Errorf("created NLB has wrong values: %#v", info)
+	}
+	if _, err := nlbHandler.CreateNLB(reqInfo); err == nil {
+		t.Error("duplicated NLB should fail")
+	}
+
+	reqInfo.IId.NameId = "mock-nlb-bad"
+	reqInfo.VMGroup.VMs = []irs.IID{{NameId: "not-exist-vm"}}
+	if _, err := nlbHandler.CreateNLB(reqInfo); err == nil {
+		t.Error("NLB with a not existing VM should fail")
+	}
+
+	list, err := nlbHandler.ListNLB()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(list) != 1 {
+		t.Errorf("number of NLBs is %d, not 1", len(list))
+	}
+}
+
+func TestNLBVMGroup(t *testing.T) {
+	nlbIID := irs.IID{NameId: "mock-nlb-01"}
+
+	vmGroup, err := nlbHandler.AddVMs(nlbIID, []irs.IID{{NameId: "mock-nlb-vm-02"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(vmGroup.VMs) != 2 {
+		t.Errorf("number of VMs is %d, not 2", len(vmGroup.VMs))
+	}
+	if _, err := nlbHandler.AddVMs(nlbIID, []irs.IID{{NameId: "mock-nlb-vm-02"}}); err == nil {
+		t.Error("adding a VM twice should fail")
+	}
+
+	if _, err := nlbVMHandler.SuspendVM(irs.IID{NameId: "mock-nlb-vm-02"}); err != nil {
+		t.Fatal(err.Error())
+	}
+	healthInfo, err := nlbHandler.GetVMGroupHealthInfo(nlbIID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, vm := range healthInfo.AllVMs {
+		expected := irs.Healthy
+		if vm.VMIID.NameId == "mock-nlb-vm-02" {
+			expected = irs.Unhealthy
+		}
+		if vm.HealthState != expected {
+			t.Errorf("%s is %s, not %s", vm.VMIID.NameId, vm.HealthState, expected)
+		}
+	}
+
+	if _, err := nlbHandler.RemoveVMs(nlbIID, []irs.IID{{NameId: "mock-nlb-vm-02"}}); err != nil {
+		t.Fatal(err.Error())
+	}
+	info, err := nlbHandler.GetNLB(nlbIID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(info.VMGroup.VMs) != 1 || info.VMGroup.VMs[0].NameId != "mock-nlb-vm-01" {
+		t.Errorf("VMs after removing: %v", info.VMGroup.VMs)
+	}
+}
+
+func TestNLBChangeDelete(t *testing.T) {
+	nlbIID := irs.IID{NameId: "mock-nlb-01"}
+
+	listener, err := nlbHandler.ChangeListener(nlbIID, irs.ListenerInfo{Protocol: "TCP", Port: "8000"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if listener.Port != "8000" || listener.IP == "" {
+		t.Errorf("changed listener has wrong values: %#v", listener)
+	}
+
+	if _, err := nlbHandler.ChangeHealthCheckerInfo(nlbIID, irs.HealthCheckerInfo{Protocol: "HTTP", Port: "80", Interval: 5}); err != nil {
+		t.Fatal(err.Error())
+	}
+	info, _ := nlbHandler.GetNLB(nlbIID)
+	if info.HealthChecker.Protocol != "HTTP" {
+		t.Errorf("health checker protocol is %s, not HTTP", info.HealthChecker.Protocol)
+	}
+
+	if ok, err := nlbHandler.DeleteNLB(nlbIID); !ok || err != nil {
+		t.Fatalf("DeleteNLB: %v, %v", ok, err)
+	}
+	if _, err := nlbHandler.GetNLB(nlbIID); err == nil {
+		t.Error("deleted NLB should not exist")
+	}
+}
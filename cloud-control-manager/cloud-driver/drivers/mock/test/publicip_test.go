@@ -0,0 +1,125 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"testing"
+)
+
+var publicIPHandler irs.PublicIPHandler
+var publicIPVMHandler irs.VMHandler
+
+func init() {
+	cred := idrv.CredentialInfo{
+		MockName: "MockDriver-PublicIP-01",
+	}
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: cred,
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	publicIPHandler, _ = cloudConn.CreatePublicIPHandler()
+	publicIPVMHandler, _ = cloudConn.CreateVMHandler()
+}
+
+func TestPublicIPAllocateList(t *testing.T) {
+	for _, name := range []string{"mock-publicip-01", "mock-publicip-02"} {
+		info, err := publicIPHandler.AllocatePublicIP(irs.PublicIPReqInfo{IId: irs.IID{NameId: name}})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		if info.PublicIP == "" || info.Status != irs.PublicIPAvailable {
+			t.Errorf("allocated PublicIP has wrong values: %#v", info)
+		}
+	}
+	if _, err := publicIPHandler.AllocatePublicIP(irs.PublicIPReqInfo{IId: irs.IID{NameId: "mock-publicip-01"}}); err == nil {
+		t.Error("duplicated PublicIP should fail")
+	}
+
+	list, err := publicIPHandler.ListPublicIP()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(list) != 2 {
+		t.Errorf("number of PublicIPs is %d, not 2", len(list))
+	}
+}
+
+func TestPublicIPAssociate(t *testing.T) {
+	publicIPIID := irs.IID{NameId: "mock-publicip-01"}
+	vmIID := irs.IID{NameId: "mock-publicip-vm-01"}
+
+	if _, err := publicIPVMHandler.StartVM(irs.VMReqInfo{IId: vmIID}); err != nil {
+		t.Fatal(err.Error())
+	}
+	info, err := publicIPHandler.AssociatePublicIP(publicIPIID, vmIID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if info.Status != irs.PublicIPAssociated || info.AssociatedVMIID.NameId != vmIID.NameId {
+		t.Errorf("associated PublicIP has wrong values: %#v", info)
+	}
+	if _, err := publicIPHandler.AssociatePublicIP(irs.IID{NameId: "mock-publicip-02"}, vmIID); err == nil {
+		t.Error("associating a second PublicIP with a VM should fail")
+	}
+	if _, err := publicIPHandler.ReleasePublicIP(publicIPIID); err == nil {
+		t.Error("releasing an associated PublicIP should fail")
+	}
+
+	// the reserved IP survives suspend/resume
+	publicIPVMHandler.SuspendVM(vmIID)
+	publicIPVMHandler.ResumeVM(vmIID)
+	vmInfo, err := publicIPVMHandler.GetVM(vmIID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if vmInfo.PublicIP != info.PublicIP {
+		t.Errorf("VM PublicIP is %s, not %s", vmInfo.PublicIP, info.PublicIP)
+	}
+
+	if ok, err := publicIPHandler.DisassociatePublicIP(publicIPIID); !ok || err != nil {
+		t.Fatalf("DisassociatePublicIP: %v, %v", ok, err)
+	}
+	vmInfo, _ = publicIPVMHandler.GetVM(vmIID)
+	if vmInfo.PublicIP == info.PublicIP {
+		t.Error("VM still has the disassociated PublicIP")
+	}
+}
+
+func TestPublicIPTerminatedVMRelease(t *testing.T) {
+	publicIPIID := irs.IID{NameId: "mock-publicip-02"}
+	vmIID := irs.IID{NameId: "mock-publicip-vm-01"}
+
+	if _, err := publicIPHandler.AssociatePublicIP(publicIPIID, vmIID); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := publicIPVMHandler.TerminateVM(vmIID); err != nil {
+		t.Fatal(err.Error())
+	}
+	info, err := publicIPHandler.GetPublicIP(publicIPIID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if info.Status != irs.PublicIPAvailable {
+		t.Errorf("PublicIP of terminated VM is %s, not Available", info.Status)
+	}
+
+	for _, name := range []string{"mock-publicip-01", "mock-publicip-02"} {
+		if ok, err := publicIPHandler.ReleasePublicIP(irs.IID{NameId: name}); !ok || err != nil {
+			t.Fatalf("ReleasePublicIP: %v, %v", ok, err)
+		}
+	}
+	if _, err := publicIPHandler.GetPublicIP(publicIPIID); err == nil {
+		t.Error("released PublicIP should not exist")
+	}
+}
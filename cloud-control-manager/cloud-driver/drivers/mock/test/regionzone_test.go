@@ -0,0 +1,74 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"testing"
+)
+
+var regionZoneHandler irs.RegionZoneHandler
+
+func init() {
+	cred := idrv.CredentialInfo{
+		MockName: "MockDriver-RegionZone-01",
+	}
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: cred,
+		RegionInfo:     idrv.RegionInfo{Region: "mock-region02"},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	regionZoneHandler, _ = cloudConn.CreateRegionZoneHandler()
+}
+
+func TestRegionZoneListGet(t *testing.T) {
+	infoList, err := regionZoneHandler.ListRegionZone()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(infoList) != 2 {
+		t.Errorf("The number of Regions is not %d. It is %d.", 2, len(infoList))
+	}
+
+	info, err := regionZoneHandler.GetRegionZone("mock-region01")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(info.ZoneList) != 2 || info.ZoneList[0].Name != "mock-region01-a" {
+		t.Errorf("Zones of mock-region01 are wrong: %v", info.ZoneList)
+	}
+
+	// the returned info is a copy
+	info.ZoneList[0].Status = irs.RegionZoneUnavailable
+	info, _ = regionZoneHandler.GetRegionZone("mock-region01")
+	if info.ZoneList[0].Status != irs.RegionZoneAvailable {
+		t.Error("The mock data is changed by the returned info.")
+	}
+
+	if _, err := regionZoneHandler.GetRegionZone("mock-region99"); err == nil {
+		t.Error("A region which does not exist should return an error.")
+	}
+}
+
+func TestRegionZoneListZone(t *testing.T) {
+	// zones of the region of the connection: mock-region02
+	zoneList, err := regionZoneHandler.ListZone()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(zoneList) != 2 {
+		t.Fatalf("The number of Zones is not %d. It is %d.", 2, len(zoneList))
+	}
+	if zoneList[1].Name != "mock-region02-b" || zoneList[1].Status != irs.RegionZoneUnavailable {
+		t.Errorf("The Zone status is wrong: %v", zoneList[1])
+	}
+}
@@ -0,0 +1,129 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.09.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	mkrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock/resources"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"testing"
+)
+
+var vmHandler irs.VMHandler
+
+func init() {
+	cred := idrv.CredentialInfo{
+		MockName: "MockDriver-VM-01",
+	}
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: cred,
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	vmHandler, _ = cloudConn.CreateVMHandler()
+}
+
+func TestVMPurchaseOption(t *testing.T) {
+	onDemand, err := vmHandler.StartVM(irs.VMReqInfo{IId: irs.IID{NameId: "mock-vm-ondemand"}})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if onDemand.LifecycleType != irs.OnDemand {
+		t.Errorf("LifecycleType is %s, not %s", onDemand.LifecycleType, irs.OnDemand)
+	}
+
+	spotReq := irs.VMReqInfo{
+		IId:            irs.IID{NameId: "mock-vm-spot"},
+		PurchaseOption: irs.VMPurchaseOption{PurchaseType: irs.Spot, MaxPrice: "0.05"},
+	}
+	spot, err := vmHandler.StartVM(spotReq)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if spot.LifecycleType != irs.Spot {
+		t.Errorf("LifecycleType is %s, not %s", spot.LifecycleType, irs.Spot)
+	}
+	if spot.InterruptionState != irs.NotInterrupted {
+		t.Errorf("InterruptionState is %s, not %s", spot.InterruptionState, irs.NotInterrupted)
+	}
+
+	_, err = vmHandler.StartVM(irs.VMReqInfo{
+		IId:            irs.IID{NameId: "mock-vm-unknown"},
+		PurchaseOption: irs.VMPurchaseOption{PurchaseType: "Reserved"},
+	})
+	if err == nil {
+		t.Error("StartVM with an unknown purchase type should fail")
+	}
+}
+
+func TestVMInterruption(t *testing.T) {
+	mockName := "MockDriver-VM-01"
+
+	if err := mkrs.SimulateVMInterruption(mockName, irs.IID{NameId: "mock-vm-ondemand"}, irs.Interrupted); err == nil {
+		t.Error("OnDemand VM should not be interrupted")
+	}
+
+	spotIID := irs.IID{NameId: "mock-vm-spot"}
+	if err := mkrs.SimulateVMInterruption(mockName, spotIID, irs.InterruptionNoticed); err != nil {
+		t.Fatal(err.Error())
+	}
+	status, err := vmHandler.GetVMStatus(spotIID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if status != irs.Running {
+		t.Errorf("VM Status is %s, not %s", status, irs.Running)
+	}
+
+	if err := mkrs.SimulateVMInterruption(mockName, spotIID, irs.Interrupted); err != nil {
+		t.Fatal(err.Error())
+	}
+	info, err := vmHandler.GetVM(spotIID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if info.InterruptionState != irs.Interrupted {
+		t.Errorf("InterruptionState is %s, not %s", info.InterruptionState, irs.Interrupted)
+	}
+	status, _ = vmHandler.GetVMStatus(spotIID)
+	if status != irs.Terminated {
+		t.Errorf("VM Status is %s, not %s", status, irs.Terminated)
+	}
+
+	// clean up
+	for _, name := range []string{"mock-vm-ondemand", "mock-vm-spot"} {
+		if _, err := vmHandler.TerminateVM(irs.IID{NameId: name}); err != nil {
+			t.Error(err.Error())
+		}
+	}
+}
+
+func TestVMConsoleOutput(t *testing.T) {
+	vmIID := irs.IID{NameId: "mock-vm-console"}
+	if _, err := vmHandler.StartVM(irs.VMReqInfo{IId: vmIID}); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	output, err := vmHandler.GetVMConsoleOutput(vmIID)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if output == "" {
+		t.Error("The console output is empty.")
+	}
+
+	if _, err := vmHandler.TerminateVM(vmIID); err != nil {
+		t.Error(err.Error())
+	}
+	if _, err := vmHandler.GetVMConsoleOutput(vmIID); err == nil {
+		t.Error("GetVMConsoleOutput of a terminated VM should fail")
+	}
+}
@@ -55,3 +55,37 @@ func regionTest(t *testing.T, mockRegion string) {
                 //fmt.Printf("\n\t%#v\n", info)
         }
 }
+
+func TestVMSpecNormalizedFields(t *testing.T) {
+        info, err := vmSpecHandler.GetVMSpec("mock-region01", "mock-vmspec-01")
+        if err != nil {
+                t.Error(err.Error())
+        }
+
+        if info.MemSizeMiB != 32768 {
+                t.Errorf("MemSizeMiB is not %d. It is %d.", 32768, info.MemSizeMiB)
+        }
+        if info.VCpu.CountNum != 4 || info.VCpu.ClockGHz != 2.7 {
+                t.Errorf("VCpu is not {4, 2.7}. It is {%d, %v}.", info.VCpu.CountNum, info.VCpu.ClockGHz)
+        }
+        if len(info.Gpu) != 1 || info.Gpu[0].CountNum != 2 || info.Gpu[0].MemSizeMiB != 16384 {
+                t.Errorf("Gpu is not normalised: %#v", info.Gpu)
+        }
+}
+
+func TestParseMemSizeMiB(t *testing.T) {
+        cases := map[string]int64{"1024": 1024, "16384MB": 16384, "16GB": 16384, "0.5 GiB": 512, "N/A": 0, "": 0}
+        for str, want := range cases {
+                got, err := irs.ParseMemSizeMiB(str)
+                if err != nil {
+                        t.Error(err.Error())
+                }
+                if got != want {
+                        t.Errorf("ParseMemSizeMiB(%s) is not %d. It is %d.", str, want, got)
+                }
+        }
+
+        if _, err := irs.ParseMemSizeMiB("4 bananas"); err == nil {
+                t.Errorf("ParseMemSizeMiB(4 bananas) should return an error.")
+        }
+}
@@ -0,0 +1,144 @@
+// Mock Driver Test of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package mocktest
+
+import (
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+
+	"testing"
+)
+
+var vpcPeeringHandler irs.VPCHandler
+
+func init() {
+	cred := idrv.CredentialInfo{
+		MockName: "MockDriver-VPCPeering-01",
+	}
+	connInfo := idrv.ConnectionInfo{
+		CredentialInfo: cred,
+		RegionInfo:     idrv.RegionInfo{},
+	}
+	cloudConn, _ := (&mockdrv.MockDriver{}).ConnectCloud(connInfo)
+	vpcPeeringHandler, _ = cloudConn.CreateVPCHandler()
+}
+
+func countPeeringRoutes(t *testing.T, vpcName string) int {
+	vpcInfo, err := vpcPeeringHandler.GetVPC(irs.IID{NameId: vpcName})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	count := 0
+	for _, kv := range vpcInfo.KeyValueList {
+		if kv.Key == "PeeringRoute" {
+			count++
+		}
+	}
+	return count
+}
+
+func TestVPCCreateList(t *testing.T) {
+	vpcReqInfoList := []irs.VPCReqInfo{
+		{
+			IId:            irs.IID{NameId: "mock-peering-vpc-01"},
+			IPv4_CIDR:      "10.1.0.0/16",
+			SubnetInfoList: []irs.SubnetInfo{{IId: irs.IID{NameId: "mock-peering-subnet-01"}, IPv4_CIDR: "10.1.1.0/24"}},
+		},
+		{
+			IId:            irs.IID{NameId: "mock-peering-vpc-02"},
+			IPv4_CIDR:      "10.2.0.0/16",
+			SubnetInfoList: []irs.SubnetInfo{{IId: irs.IID{NameId: "mock-peering-subnet-02"}, IPv4_CIDR: "10.2.1.0/24"}},
+		},
+	}
+	for _, vpcReqInfo := range vpcReqInfoList {
+		if _, err := vpcPeeringHandler.CreateVPC(vpcReqInfo); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	if _, err := vpcPeeringHandler.CreateVPC(vpcReqInfoList[0]); err == nil {
+		t.Error("duplicated VPC should fail")
+	}
+
+	list, err := vpcPeeringHandler.ListVPC()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(list) != 2 {
+		t.Errorf("number of VPCs is %d, not 2", len(list))
+	}
+}
+
+func TestVPCPeeringRequestAccept(t *testing.T) {
+	peeringIID := irs.IID{NameId: "mock-peering-01"}
+	info, err := vpcPeeringHandler.RequestVPCPeering(irs.VPCPeeringReqInfo{
+		IId:             peeringIID,
+		RequesterVPCIID: irs.IID{NameId: "mock-peering-vpc-01"},
+		AccepterVPCIID:  irs.IID{NameId: "mock-peering-vpc-02"},
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if info.Status != irs.VPCPeeringPending {
+		t.Errorf("requested VPC Peering is %s, not Pending", info.Status)
+	}
+	if countPeeringRoutes(t, "mock-peering-vpc-01") != 0 {
+		t.Error("routes are propagated before the peering is accepted")
+	}
+
+	info, err = vpcPeeringHandler.AcceptVPCPeering(peeringIID)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if info.Status != irs.VPCPeeringActive {
+		t.Errorf("accepted VPC Peering is %s, not Active", info.Status)
+	}
+	if _, err := vpcPeeringHandler.AcceptVPCPeering(peeringIID); err == nil {
+		t.Error("accepting an active VPC Peering should fail")
+	}
+	for _, vpcName := range []string{"mock-peering-vpc-01", "mock-peering-vpc-02"} {
+		if count := countPeeringRoutes(t, vpcName); count != 1 {
+			t.Errorf("%s has %d peering routes, not 1", vpcName, count)
+		}
+	}
+
+	if _, err := vpcPeeringHandler.DeleteVPC(irs.IID{NameId: "mock-peering-vpc-01"}); err == nil {
+		t.Error("deleting a peered VPC should fail")
+	}
+}
+
+func TestVPCPeeringDelete(t *testing.T) {
+	peeringIID := irs.IID{NameId: "mock-peering-01"}
+
+	list, err := vpcPeeringHandler.ListVPCPeering()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(list) != 1 {
+		t.Errorf("number of VPC Peerings is %d, not 1", len(list))
+	}
+
+	if ok, err := vpcPeeringHandler.DeleteVPCPeering(peeringIID); !ok || err != nil {
+		t.Fatalf("DeleteVPCPeering: %v, %v", ok, err)
+	}
+	for _, vpcName := range []string{"mock-peering-vpc-01", "mock-peering-vpc-02"} {
+		if count := countPeeringRoutes(t, vpcName); count != 0 {
+			t.Errorf("%s still has %d peering routes", vpcName, count)
+		}
+	}
+	if _, err := vpcPeeringHandler.GetVPCPeering(peeringIID); err == nil {
+		t.Error("deleted VPC Peering should not exist")
+	}
+
+	for _, vpcName := range []string{"mock-peering-vpc-01", "mock-peering-vpc-02"} {
+		if ok, err := vpcPeeringHandler.DeleteVPC(irs.IID{NameId: vpcName}); !ok || err != nil {
+			t.Fatalf("DeleteVPC: %v, %v", ok, err)
+		}
+	}
+}
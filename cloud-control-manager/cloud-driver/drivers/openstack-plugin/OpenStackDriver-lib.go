@@ -15,6 +15,7 @@ import (
 	oscon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/openstack/connect"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	"github.com/rackspace/gophercloud"
 	"github.com/rackspace/gophercloud/openstack"
 	"github.com/sirupsen/logrus"
@@ -30,12 +31,15 @@ func init() {
 type OpenStackDriver struct{}
 
 func (OpenStackDriver) GetDriverVersion() string {
-	return "OPENSTACK DRIVER Version 1.0"
+	return idrv.DriverVersion("OPENSTACK DRIVER Version 1.0")
 }
 
 func (OpenStackDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	var drvCapabilityInfo idrv.DriverCapabilityInfo
 
+	drvCapabilityInfo.FIXED_SUBNET_CIDR = true
+	drvCapabilityInfo.VPC_CIDR = false
+
 	drvCapabilityInfo.ImageHandler = true
 	drvCapabilityInfo.VPCHandler = true
 	drvCapabilityInfo.SecurityHandler = true
@@ -44,10 +48,65 @@ func (OpenStackDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	drvCapabilityInfo.PublicIPHandler = false
 	drvCapabilityInfo.VMHandler = true
 	drvCapabilityInfo.VMSpecHandler = true
+	drvCapabilityInfo.NLBHandler = false
+	drvCapabilityInfo.DNSHandler = false
+	drvCapabilityInfo.BucketHandler = true
+	drvCapabilityInfo.RegionZoneHandler = true
+
+	drvCapabilityInfo.VPC_IPv6_CIDR = false
+	drvCapabilityInfo.SECURITY_IPv6_RULE = true
+	// false: a secondary NIC needs a port of its subnet, created before the server and deleted after it,
+	// but the VMHandler attaches only the network of the VPC. a follow-up of VM_MULTI_NIC, see CHANGELOG.md.
+	drvCapabilityInfo.VM_MULTI_NIC = false
+	drvCapabilityInfo.SUBNET_ADD_REMOVE = true
+	drvCapabilityInfo.VM_SUSPEND_RESUME = true
+	drvCapabilityInfo.VM_REBOOT = true
 
 	return drvCapabilityInfo
 }
 
+// key names of the region and the zone in the key-value list of the region.
+func (OpenStackDriver) GetRegionZoneKeyName() (string, string) {
+	return "Region", ""
+}
+
+// translates the key-value lists of the credential and the region into ConnectionInfo.
+func (driver OpenStackDriver) GetConnectionInfo(credentialKeyValueList []irs.KeyValue, regionKeyValueList []irs.KeyValue) (idrv.ConnectionInfo, error) {
+	regionKey, _ := driver.GetRegionZoneKeyName()
+
+	connectionInfo := idrv.ConnectionInfo{
+		CredentialInfo: idrv.CredentialInfo{
+			IdentityEndpoint: idrv.GetKeyValue(credentialKeyValueList, "IdentityEndpoint"),
+			Username:         idrv.GetKeyValue(credentialKeyValueList, "Username"),
+			Password:         idrv.GetKeyValue(credentialKeyValueList, "Password"),
+			DomainName:       idrv.GetKeyValue(credentialKeyValueList, "DomainName"),
+			ProjectID:        idrv.GetKeyValue(credentialKeyValueList, "ProjectID"),
+			KeyValueList:     credentialKeyValueList,
+		},
+		RegionInfo: idrv.RegionInfo{
+			Region:       idrv.GetKeyValue(regionKeyValueList, regionKey),
+			KeyValueList: regionKeyValueList,
+		},
+	}
+	return connectionInfo, nil
+}
+
+// keys of the credential and the region read by GetConnectionInfo().
+func (OpenStackDriver) GetConnectionSchema() idrv.ConnectionSchema {
+	return idrv.ConnectionSchema{
+		Credential: []idrv.KeySchema{
+			{Key: "IdentityEndpoint", Description: "Keystone endpoint, ex) http://192.168.0.10:5000/v3", Required: true},
+			{Key: "Username", Description: "User name", Required: true},
+			{Key: "Password", Description: "User password", Required: true, Secret: true},
+			{Key: "DomainName", Description: "ex) default", Required: true},
+			{Key: "ProjectID", Description: "Project ID", Required: true},
+		},
+		Region: []idrv.KeySchema{
+			{Key: "Region", Description: "ex) RegionOne", Required: true},
+		},
+	}
+}
+
 /* org
 func (OpenStackDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo) (icon.CloudConnection, error) {
 	// 1. get info of credential and region for Test A Cloud from connectionInfo.
@@ -86,8 +145,19 @@ func (driver *OpenStackDriver) ConnectCloud(connectionInfo idrv.ConnectionInfo)
 	if err != nil {
 		cblogger.Error(err)
 	}
+	ObjectStorageClient, err := getObjectStorageClient(connectionInfo)
+	if err != nil {
+		cblogger.Error(err)
+	}
 
-	iConn := oscon.OpenStackCloudConnection{connectionInfo.RegionInfo, Client, ImageClient, NetworkClient, VolumeClient}
+	iConn := oscon.OpenStackCloudConnection{
+		Region:              connectionInfo.RegionInfo,
+		Client:              Client,
+		ImageClient:         ImageClient,
+		NetworkClient:       NetworkClient,
+		VolumeClient:        VolumeClient,
+		ObjectStorageClient: ObjectStorageClient,
+	}
 
 	return &iConn, nil // return type: (icon.CloudConnection, error)
 }
@@ -220,4 +290,25 @@ func getVolumeClient(connInfo idrv.ConnectionInfo) (*gophercloud.ServiceClient,
 	return client, err
 }
 
+func getObjectStorageClient(connInfo idrv.ConnectionInfo) (*gophercloud.ServiceClient, error) {
+	authOpts := gophercloud.AuthOptions{
+		IdentityEndpoint: connInfo.CredentialInfo.IdentityEndpoint,
+		Username:         connInfo.CredentialInfo.Username,
+		Password:         connInfo.CredentialInfo.Password,
+		DomainName:       connInfo.CredentialInfo.DomainName,
+		TenantID:         connInfo.CredentialInfo.ProjectID,
+	}
+	provider, err := openstack.AuthenticatedClient(authOpts)
+	if err != nil {
+		return nil, err
+	}
+	client, err := openstack.NewObjectStorageV1(provider, gophercloud.EndpointOpts{
+		Region: connInfo.RegionInfo.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return client, err
+}
+
 var CloudDriver OpenStackDriver
@@ -11,6 +11,7 @@
 package connect
 
 import (
+	"errors"
 	cblog "github.com/cloud-barista/cb-log"
 	osrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/openstack/resources"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
@@ -28,11 +29,12 @@ func init() {
 
 // modified by powerkim, 2019.07.29
 type OpenStackCloudConnection struct {
-	Region        idrv.RegionInfo
-	Client        *gophercloud.ServiceClient
-	ImageClient   *gophercloud.ServiceClient
-	NetworkClient *gophercloud.ServiceClient
-	VolumeClient  *gophercloud.ServiceClient
+	Region              idrv.RegionInfo
+	Client              *gophercloud.ServiceClient
+	ImageClient         *gophercloud.ServiceClient
+	NetworkClient       *gophercloud.ServiceClient
+	VolumeClient        *gophercloud.ServiceClient
+	ObjectStorageClient *gophercloud.ServiceClient
 }
 
 func (cloudConn *OpenStackCloudConnection) CreateImageHandler() (irs.ImageHandler, error) {
@@ -89,6 +91,33 @@ func (cloudConn *OpenStackCloudConnection) CreateVMSpecHandler() (irs.VMSpecHand
 	return &vmSpecHandler, nil
 }
 
+func (cloudConn *OpenStackCloudConnection) CreatePublicIPHandler() (irs.PublicIPHandler, error) {
+	cblogger.Error("OpenStack Cloud Driver: called CreatePublicIPHandler(), but not supported!")
+	return nil, errors.New("OpenStack Cloud Driver: CreatePublicIPHandler() is not supported!")
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateNLBHandler() (irs.NLBHandler, error) {
+	cblogger.Error("OpenStack Cloud Driver: called CreateNLBHandler(), but not supported!")
+	return nil, errors.New("OpenStack Cloud Driver: CreateNLBHandler() is not supported!")
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateDNSHandler() (irs.DNSHandler, error) {
+	cblogger.Error("OpenStack Cloud Driver: called CreateDNSHandler(), but not supported!")
+	return nil, errors.New("OpenStack Cloud Driver: CreateDNSHandler() is not supported!")
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateBucketHandler() (irs.BucketHandler, error) {
+	cblogger.Info("OpenStack Cloud Driver: called CreateBucketHandler()!")
+	bucketHandler := osrs.OpenStackBucketHandler{cloudConn.ObjectStorageClient}
+	return &bucketHandler, nil
+}
+
+func (cloudConn *OpenStackCloudConnection) CreateRegionZoneHandler() (irs.RegionZoneHandler, error) {
+	cblogger.Info("OpenStack Cloud Driver: called CreateRegionZoneHandler()!")
+	regionZoneHandler := osrs.OpenStackRegionZoneHandler{cloudConn.Region, cloudConn.Client}
+	return &regionZoneHandler, nil
+}
+
 func (cloudConn *OpenStackCloudConnection) IsConnected() (bool, error) {
 	return true, nil
 }
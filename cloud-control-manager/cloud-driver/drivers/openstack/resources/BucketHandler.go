@@ -0,0 +1,223 @@
+package resources
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/objectstorage/v1/accounts"
+	"github.com/rackspace/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/rackspace/gophercloud/openstack/objectstorage/v1/objects"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// Bucket => Swift Container
+type OpenStackBucketHandler struct {
+	Client *gophercloud.ServiceClient
+}
+
+func (bucketHandler *OpenStackBucketHandler) CreateBucket(bucketReqInfo irs.BucketReqInfo) (irs.BucketInfo, error) {
+	// Swift는 같은 이름의 Container를 다시 생성해도 성공하므로 먼저 확인
+	_, err := containers.Get(bucketHandler.Client, bucketReqInfo.IId.NameId).Extract()
+	if err == nil {
+		return irs.BucketInfo{}, errors.New(fmt.Sprintf("Container with name %s already exist", bucketReqInfo.IId.NameId))
+	}
+
+	_, err = containers.Create(bucketHandler.Client, bucketReqInfo.IId.NameId, nil).Extract()
+	if err != nil {
+		return irs.BucketInfo{}, err
+	}
+	return bucketHandler.GetBucket(bucketReqInfo.IId)
+}
+
+func (bucketHandler *OpenStackBucketHandler) ListBucket() ([]*irs.BucketInfo, error) {
+	// Container 목록 조회
+	var bucketList []*irs.BucketInfo
+	err := containers.List(bucketHandler.Client, containers.ListOpts{Full: true}).EachPage(func(page pagination.Page) (bool, error) {
+		containerList, err := containers.ExtractInfo(page)
+		if err != nil {
+			return false, err
+		}
+		for _, container := range containerList {
+			// 목록에는 생성 시간이 없어서 Container별로 조회
+			bucketInfo, err := bucketHandler.GetBucket(irs.IID{NameId: container.Name})
+			if err != nil {
+				return false, err
+			}
+			bucketList = append(bucketList, &bucketInfo)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bucketList, nil
+}
+
+func (bucketHandler *OpenStackBucketHandler) GetBucket(bucketIID irs.IID) (irs.BucketInfo, error) {
+	name := getContainerName(bucketIID)
+	result := containers.Get(bucketHandler.Client, name)
+	header, err := result.Extract()
+	if err != nil {
+		return irs.BucketInfo{}, err
+	}
+
+	// X-Timestamp: Container 생성 시간(Unix, 소수점 포함)
+	var createdTime time.Time
+	if timestamp, err := strconv.ParseFloat(result.Header.Get("X-Timestamp"), 64); err == nil {
+		createdTime = time.Unix(int64(timestamp), 0)
+	}
+	return irs.BucketInfo{
+		IId:         irs.IID{NameId: name, SystemId: name},
+		CreatedTime: createdTime,
+		KeyValueList: []irs.KeyValue{
+			{Key: "ObjectCount", Value: strconv.FormatInt(header.ObjectCount, 10)},
+			{Key: "BytesUsed", Value: result.Header.Get("X-Container-Bytes-Used")},
+		},
+	}, nil
+}
+
+func (bucketHandler *OpenStackBucketHandler) DeleteBucket(bucketIID irs.IID) (bool, error) {
+	// Object가 있으면 409 Conflict
+	_, err := containers.Delete(bucketHandler.Client, getContainerName(bucketIID)).Extract()
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (bucketHandler *OpenStackBucketHandler) ListObject(bucketIID irs.IID, prefix string) ([]*irs.ObjectInfo, error) {
+	var objectList []*irs.ObjectInfo
+	listOpts := objects.ListOpts{Full: true, Prefix: prefix}
+	err := objects.List(bucketHandler.Client, getContainerName(bucketIID), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		objectInfoList, err := objects.ExtractInfo(page)
+		if err != nil {
+			return false, err
+		}
+		for _, object := range objectInfoList {
+			// ex) 2020-10-19T10:31:56.123450
+			lastModified, _ := time.Parse("2006-01-02T15:04:05.999999", object.LastModified)
+			objectList = append(objectList, &irs.ObjectInfo{
+				Name:         object.Name,
+				Size:         object.Bytes,
+				LastModified: lastModified,
+				ETag:         object.Hash,
+				ContentType:  object.ContentType,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objectList, nil
+}
+
+// objects.Create()는 io.ReadSeeker를 두 번 읽으므로 직접 Stream으로 전송
+func (bucketHandler *OpenStackBucketHandler) PutObject(bucketIID irs.IID, objectName string, reader io.Reader, size int64, contentType string) (irs.ObjectInfo, error) {
+	objectURL := bucketHandler.Client.ServiceURL(getContainerName(bucketIID), objectName)
+	req, err := http.NewRequest(http.MethodPut, objectURL, reader)
+	if err != nil {
+		return irs.ObjectInfo{}, err
+	}
+	// 크기를 모르면 Chunked Transfer Encoding으로 전송
+	req.ContentLength = size
+	req.Header.Set("X-Auth-Token", bucketHandler.Client.TokenID)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := bucketHandler.Client.HTTPClient.Do(req)
+	if err != nil {
+		return irs.ObjectInfo{}, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		return irs.ObjectInfo{}, errors.New(fmt.Sprintf("failed to put the object %s: %s", objectName, resp.Status))
+	}
+	return bucketHandler.getObjectInfo(bucketIID, objectName)
+}
+
+func (bucketHandler *OpenStackBucketHandler) GetObject(bucketIID irs.IID, objectName string) (io.ReadCloser, irs.ObjectInfo, error) {
+	result := objects.Download(bucketHandler.Client, getContainerName(bucketIID), objectName, nil)
+	header, err := result.Extract()
+	if err != nil {
+		if result.Body != nil {
+			result.Body.Close()
+		}
+		return nil, irs.ObjectInfo{}, err
+	}
+	return result.Body, irs.ObjectInfo{
+		Name:         objectName,
+		Size:         header.ContentLength,
+		LastModified: header.LastModified,
+		ETag:         header.ETag,
+		ContentType:  header.ContentType,
+	}, nil
+}
+
+func (bucketHandler *OpenStackBucketHandler) DeleteObject(bucketIID irs.IID, objectName string) (bool, error) {
+	_, err := objects.Delete(bucketHandler.Client, getContainerName(bucketIID), objectName, nil).Extract()
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Temp URL, Account에 X-Account-Meta-Temp-URL-Key가 설정되어 있어야 함
+// objects.CreateTempURL()은 PUT을 지원하지 않아서 직접 서명
+func (bucketHandler *OpenStackBucketHandler) GetPresignedURL(bucketIID irs.IID, objectName string, method irs.PresignedURLMethod, expires time.Duration) (string, error) {
+	if method != irs.PresignedGet && method != irs.PresignedPut {
+		return "", errors.New(fmt.Sprintf("%s method of the temp URL is not supported", method))
+	}
+
+	accountHeader, err := accounts.Get(bucketHandler.Client, nil).Extract()
+	if err != nil {
+		return "", err
+	}
+	if accountHeader.TempURLKey == "" {
+		return "", errors.New("X-Account-Meta-Temp-URL-Key of the account is not set")
+	}
+
+	// ex) https://{swift}/v1/AUTH_{project}/{container}/{object}
+	objectURL := bucketHandler.Client.ServiceURL(getContainerName(bucketIID), objectName)
+	splitIdx := strings.Index(objectURL, "/v1/")
+	if splitIdx < 0 {
+		return "", errors.New(fmt.Sprintf("unknown object storage endpoint: %s", objectURL))
+	}
+	objectPath := objectURL[splitIdx:]
+
+	expiry := time.Now().Add(expires).Unix()
+	hash := hmac.New(sha1.New, []byte(accountHeader.TempURLKey))
+	hash.Write([]byte(fmt.Sprintf("%s\n%d\n%s", method, expiry, objectPath)))
+	return fmt.Sprintf("%s?temp_url_sig=%x&temp_url_expires=%d", objectURL, hash.Sum(nil), expiry), nil
+}
+
+func (bucketHandler *OpenStackBucketHandler) getObjectInfo(bucketIID irs.IID, objectName string) (irs.ObjectInfo, error) {
+	header, err := objects.Get(bucketHandler.Client, getContainerName(bucketIID), objectName, nil).Extract()
+	if err != nil {
+		return irs.ObjectInfo{}, err
+	}
+	return irs.ObjectInfo{
+		Name:         objectName,
+		Size:         header.ContentLength,
+		LastModified: header.LastModified,
+		ETag:         header.ETag,
+		ContentType:  header.ContentType,
+	}, nil
+}
+
+func getContainerName(bucketIID irs.IID) string {
+	if bucketIID.SystemId != "" {
+		return bucketIID.SystemId
+	}
+	return bucketIID.NameId
+}
@@ -0,0 +1,78 @@
+package resources
+
+import (
+	"errors"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	"github.com/rackspace/gophercloud"
+)
+
+// the region of the connection only, the zones are Nova availability zones.
+type OpenStackRegionZoneHandler struct {
+	Region idrv.RegionInfo
+	Client *gophercloud.ServiceClient
+}
+
+// GET /os-availability-zone response
+type availabilityZoneList struct {
+	AvailabilityZoneInfo []struct {
+		ZoneName  string `json:"zoneName"`
+		ZoneState struct {
+			Available bool `json:"available"`
+		} `json:"zoneState"`
+	} `json:"availabilityZoneInfo"`
+}
+
+func (regionZoneHandler *OpenStackRegionZoneHandler) ListRegionZone() ([]*irs.RegionZoneInfo, error) {
+	regionZoneInfo, err := regionZoneHandler.GetRegionZone(regionZoneHandler.Region.Region)
+	if err != nil {
+		return nil, err
+	}
+	return []*irs.RegionZoneInfo{&regionZoneInfo}, nil
+}
+
+func (regionZoneHandler *OpenStackRegionZoneHandler) GetRegionZone(Name string) (irs.RegionZoneInfo, error) {
+	if Name != regionZoneHandler.Region.Region {
+		return irs.RegionZoneInfo{}, errors.New("OpenStack Cloud Driver: only the region of the connection[" + regionZoneHandler.Region.Region + "] is supported!")
+	}
+
+	zoneInfoList, err := regionZoneHandler.ListZone()
+	if err != nil {
+		return irs.RegionZoneInfo{}, err
+	}
+
+	regionZoneInfo := irs.RegionZoneInfo{
+		Name:   Name,
+		Status: irs.RegionZoneAvailable,
+	}
+	for _, zoneInfo := range zoneInfoList {
+		regionZoneInfo.ZoneList = append(regionZoneInfo.ZoneList, *zoneInfo)
+	}
+	return regionZoneInfo, nil
+}
+
+func (regionZoneHandler *OpenStackRegionZoneHandler) ListZone() ([]*irs.ZoneInfo, error) {
+	var result availabilityZoneList
+	_, err := regionZoneHandler.Client.Request("GET", regionZoneHandler.Client.ServiceURL("os-availability-zone"), gophercloud.RequestOpts{
+		JSONResponse: &result,
+		OkCodes:      []int{200},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return nil, err
+	}
+
+	var zoneInfoList []*irs.ZoneInfo
+	for _, zone := range result.AvailabilityZoneInfo {
+		status := irs.RegionZoneUnavailable
+		if zone.ZoneState.Available {
+			status = irs.RegionZoneAvailable
+		}
+		zoneInfoList = append(zoneInfoList, &irs.ZoneInfo{
+			Name:   zone.ZoneName,
+			Status: status,
+		})
+	}
+	return zoneInfoList, nil
+}
@@ -55,6 +55,7 @@ func (securityHandler *OpenStackSecurityHandler) setterSeg(secGroup secgroups.Se
 		ruleInfo := irs.SecurityRuleInfo{
 			Direction:  direction,
 			IPProtocol: strings.ToLower(rule.Protocol),
+			CIDR:       rule.RemoteIPPrefix,
 		}
 
 		if strings.ToLower(rule.Protocol) == ICMP {
@@ -106,27 +107,37 @@ func (securityHandler *OpenStackSecurityHandler) CreateSecurity(securityReqInfo
 			direction = rules.DirEgress
 		}
 
+		// IPv6 CIDR이면 EtherType을 IPv6로 설정 함.
+		remoteIPPrefix := "0.0.0.0/0"
+		etherType := rules.Ether4
+		if rule.CIDR != "" {
+			remoteIPPrefix = rule.CIDR
+			if strings.Contains(rule.CIDR, ":") {
+				etherType = rules.Ether6
+			}
+		}
+
 		var createRuleOpts rules.CreateOpts
 
 		if strings.ToLower(rule.IPProtocol) == ICMP {
 			createRuleOpts = rules.CreateOpts{
 				Direction:      direction,
-				EtherType:      rules.Ether4,
+				EtherType:      etherType,
 				SecGroupID:     group.ID,
 				Protocol:       strings.ToLower(rule.IPProtocol),
-				RemoteIPPrefix: "0.0.0.0/0",
+				RemoteIPPrefix: remoteIPPrefix,
 			}
 		} else {
 			fromPort, _ := strconv.Atoi(rule.FromPort)
 			toPort, _ := strconv.Atoi(rule.ToPort)
 			createRuleOpts = rules.CreateOpts{
 				Direction:      direction,
-				EtherType:      rules.Ether4,
+				EtherType:      etherType,
 				SecGroupID:     group.ID,
 				PortRangeMin:   fromPort,
 				PortRangeMax:   toPort,
 				Protocol:       strings.ToLower(rule.IPProtocol),
-				RemoteIPPrefix: "0.0.0.0/0",
+				RemoteIPPrefix: remoteIPPrefix,
 			}
 		}
 
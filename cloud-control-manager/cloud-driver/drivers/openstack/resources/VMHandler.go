@@ -121,7 +121,7 @@ func (vmHandler *OpenStackVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInf
 		FlavorName: vmReqInfo.VMSpecName,
 		//FlavorRef: *flavorId,
 		Networks: []servers.Network{
-			{UUID: vmReqInfo.VpcIID.SystemId},
+			{UUID: vmReqInfo.VpcIID.SystemId, FixedIP: vmReqInfo.PrivateIP},
 		},
 	}
 
@@ -356,6 +356,30 @@ func (vmHandler *OpenStackVMHandler) GetVM(vmIID irs.IID) (irs.VMInfo, error) {
 	return vmInfo, nil
 }
 
+// 가상서버 콘솔 로그 조회 (os-getConsoleOutput)
+func (vmHandler *OpenStackVMHandler) GetVMConsoleOutput(vmIID irs.IID) (string, error) {
+	actionURL := vmHandler.Client.ServiceURL("servers", vmIID.SystemId, "action")
+	reqBody := map[string]interface{}{
+		"os-getConsoleOutput": map[string]interface{}{},
+	}
+
+	var result interface{}
+	_, err := vmHandler.Client.Post(actionURL, reqBody, &result, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		cblogger.Error(err)
+		return "", err
+	}
+
+	resultMap, ok := result.(map[string]interface{})
+	if !ok {
+		return "", errors.New("invalid console output response")
+	}
+	output, _ := resultMap["output"].(string)
+	return output, nil
+}
+
 func (vmHandler *OpenStackVMHandler) AssociatePublicIP(serverID string) (bool, error) {
 	// PublicIP 생성
 	//VPCHander := cloudConnection.CreateVPCHandler()
@@ -22,6 +22,9 @@ func setterVMSpec(region string, vmSpec flavors.Flavor) *irs.VMSpecInfo {
 		KeyValueList: nil,
 	}
 
+	if err := irs.NormalizeVMSpecInfo(vmSpecInfo); err != nil {
+		cblogger.Error(err)
+	}
 	return vmSpecInfo
 }
 
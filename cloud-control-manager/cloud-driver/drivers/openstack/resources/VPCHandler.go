@@ -364,3 +364,39 @@ func (VPCHandler *OpenStackVPCHandler) RemoveSubnet(vpcIID irs.IID, subnetIID ir
 	}
 	return true, nil
 }
+
+func (VPCHandler *OpenStackVPCHandler) RequestVPCPeering(peeringReqInfo irs.VPCPeeringReqInfo) (irs.VPCPeeringInfo, error) {
+	return irs.VPCPeeringInfo{}, errors.New("OpenStack Cloud Driver: RequestVPCPeering() is not supported!")
+}
+
+func (VPCHandler *OpenStackVPCHandler) AcceptVPCPeering(peeringIID irs.IID) (irs.VPCPeeringInfo, error) {
+	return irs.VPCPeeringInfo{}, errors.New("OpenStack Cloud Driver: AcceptVPCPeering() is not supported!")
+}
+
+func (VPCHandler *OpenStackVPCHandler) ListVPCPeering() ([]*irs.VPCPeeringInfo, error) {
+	return nil, errors.New("OpenStack Cloud Driver: ListVPCPeering() is not supported!")
+}
+
+func (VPCHandler *OpenStackVPCHandler) GetVPCPeering(peeringIID irs.IID) (irs.VPCPeeringInfo, error) {
+	return irs.VPCPeeringInfo{}, errors.New("OpenStack Cloud Driver: GetVPCPeering() is not supported!")
+}
+
+func (VPCHandler *OpenStackVPCHandler) DeleteVPCPeering(peeringIID irs.IID) (bool, error) {
+	return false, errors.New("OpenStack Cloud Driver: DeleteVPCPeering() is not supported!")
+}
+
+func (VPCHandler *OpenStackVPCHandler) AddNATGateway(vpcIID irs.IID, natReqInfo irs.NATGatewayReqInfo) (irs.VPCInfo, error) {
+	return irs.VPCInfo{}, errors.New("OpenStack Cloud Driver: AddNATGateway() is not supported!")
+}
+
+func (VPCHandler *OpenStackVPCHandler) RemoveNATGateway(vpcIID irs.IID, natGatewayIID irs.IID) (bool, error) {
+	return false, errors.New("OpenStack Cloud Driver: RemoveNATGateway() is not supported!")
+}
+
+func (VPCHandler *OpenStackVPCHandler) AddRoute(vpcIID irs.IID, subnetIID irs.IID, routeInfo irs.RouteInfo) (irs.VPCInfo, error) {
+	return irs.VPCInfo{}, errors.New("OpenStack Cloud Driver: AddRoute() is not supported!")
+}
+
+func (VPCHandler *OpenStackVPCHandler) RemoveRoute(vpcIID irs.IID, subnetIID irs.IID, routeInfo irs.RouteInfo) (bool, error) {
+	return false, errors.New("OpenStack Cloud Driver: RemoveRoute() is not supported!")
+}
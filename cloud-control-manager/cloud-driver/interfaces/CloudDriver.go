@@ -11,21 +11,62 @@
 package interfaces
 
 import (
+	"fmt"
+	"strings"
+
 	icon "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/connect"
+	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 )
 
+// version of these interfaces.
+// Increase it when an interface or a struct of the interfaces is changed,
+// so a driver library built with the other version is rejected before its use.
+const InterfaceVersion = "1.3"
+
+const interfaceVersionPrefix = "; Interface Version "
+
 type DriverCapabilityInfo struct {
-	FIXED_SUBNET_CIDR   bool // support: true, do not support: false
-	VPC_CIDR     bool // support: true, do not support: false
-	ImageHandler bool // support: true, do not support: false
-	VPCHandler   bool // support: true, do not support: false
+	FIXED_SUBNET_CIDR bool // Subnet CIDR given by user, support: true, do not support(assigned by CSP): false
+	VPC_CIDR          bool // VPC CIDR given by user, support: true, do not support(ignored): false
+	ImageHandler      bool // support: true, do not support: false
+	VPCHandler        bool // support: true, do not support: false
 	//VNetworkHandler bool // support: true, do not support: false
-	SecurityHandler bool // support: true, do not support: false
-	KeyPairHandler  bool // support: true, do not support: false
-	VNicHandler     bool // support: true, do not support: false
-	PublicIPHandler bool // support: true, do not support: false
-	VMHandler       bool // support: true, do not support: false
-	VMSpecHandler   bool // support: true, do not support: false
+	SecurityHandler   bool // support: true, do not support: false
+	KeyPairHandler    bool // support: true, do not support: false
+	VNicHandler       bool // support: true, do not support: false
+	PublicIPHandler   bool // support: true, do not support: false
+	VMHandler         bool // support: true, do not support: false
+	VMSpecHandler     bool // support: true, do not support: false
+	NLBHandler        bool // support: true, do not support: false
+	DNSHandler        bool // support: true, do not support: false
+	BucketHandler     bool // support: true, do not support: false
+	RegionZoneHandler bool // support: true, do not support: false
+
+	VPC_IPv6_CIDR      bool // dual-stack VPC, Subnet and VM, support: true, do not support: false
+	SECURITY_IPv6_RULE bool // IPv6 CIDR in Security Rules, support: true, do not support: false
+	VM_MULTI_NIC       bool // secondary NICs of VM, support: true, do not support: false
+	SUBNET_ADD_REMOVE  bool // AddSubnet() and RemoveSubnet() of an existing VPC, support: true, do not support: false
+	VM_SUSPEND_RESUME  bool // SuspendVM() and ResumeVM(), support: true, do not support: false
+	VM_REBOOT          bool // RebootVM(), support: true, do not support: false
+}
+
+// returned for a handler or a feature which the driver does not support,
+// ex) a false field of DriverCapabilityInfo.
+type NotSupportedError struct {
+	Driver  string // driver or connection name, "" if unknown
+	Feature string // ex) "NLBHandler", "VM_SUSPEND_RESUME"
+}
+
+func (e *NotSupportedError) Error() string {
+	if e.Driver == "" {
+		return fmt.Sprintf("%s is not supported by the driver!", e.Feature)
+	}
+	return fmt.Sprintf("%s: %s is not supported by the driver!", e.Driver, e.Feature)
+}
+
+func IsNotSupported(err error) bool {
+	_, ok := err.(*NotSupportedError)
+	return ok
 }
 
 type CredentialInfo struct {
@@ -43,15 +84,19 @@ type CredentialInfo struct {
 	AuthToken        string // Cloudit Credential
 	ClientEmail      string // GCP
 	PrivateKey       string // GCP
-	Host      	 string // Docker
+	Host             string // Docker
 	APIVersion       string // Docker
 	MockName         string // Mock
+
+	KeyValueList []irs.KeyValue // all key-value pairs of the credential, for the keys without their own field
 }
 
 type RegionInfo struct {
 	Region        string
 	Zone          string
 	ResourceGroup string // Azure RegionInfo
+
+	KeyValueList []irs.KeyValue // all key-value pairs of the region, for the keys without their own field
 }
 
 type ConnectionInfo struct {
@@ -59,10 +104,60 @@ type ConnectionInfo struct {
 	RegionInfo     RegionInfo
 }
 
+// a key of the key-value list of the credential or the region.
+type KeySchema struct {
+	Key         string
+	Description string
+	Required    bool
+	Secret      bool // should not be shown to the user
+}
+
+// keys of the credential and the region used by a driver.
+type ConnectionSchema struct {
+	Credential []KeySchema
+	Region     []KeySchema
+}
+
 type CloudDriver interface {
+	// should be made by DriverVersion(), for the interface version check.
 	GetDriverVersion() string
 	GetDriverCapability() DriverCapabilityInfo
 
+	// translates the key-value lists of the registered credential and region into ConnectionInfo,
+	// so the core does not have to know the keys of each cloud.
+	GetConnectionInfo(credentialKeyValueList []irs.KeyValue, regionKeyValueList []irs.KeyValue) (ConnectionInfo, error)
+	// keys of the credential and the region read by GetConnectionInfo(),
+	// used to validate the registered key-value lists.
+	GetConnectionSchema() ConnectionSchema
+	// key names of the region and the zone in the key-value list of the region,
+	// zoneKey is "" if the cloud does not use a zone.
+	GetRegionZoneKeyName() (regionKey string, zoneKey string)
+
 	ConnectCloud(connectionInfo ConnectionInfo) (icon.CloudConnection, error)
 	//ConnectNetworkCloud(connectionInfo ConnectionInfo) (icon.CloudConnection, error)
 }
+
+// value of the key in the key-value list, "" if the key does not exist.
+func GetKeyValue(keyValueList []irs.KeyValue, key string) string {
+	for _, kv := range keyValueList {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return ""
+}
+
+// driver version with the interface version of the build,
+// ex) "AWS DRIVER Version 1.0; Interface Version 1.3"
+func DriverVersion(version string) string {
+	return version + interfaceVersionPrefix + InterfaceVersion
+}
+
+// interface version in the result of GetDriverVersion(), "" if the driver does not have it.
+func GetInterfaceVersion(driverVersion string) string {
+	idx := strings.LastIndex(driverVersion, interfaceVersionPrefix)
+	if idx < 0 {
+		return ""
+	}
+	return driverVersion[idx+len(interfaceVersionPrefix):]
+}
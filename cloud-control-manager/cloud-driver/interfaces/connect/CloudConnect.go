@@ -21,12 +21,20 @@ type CloudConnection interface {
 	CreateSecurityHandler() (irs.SecurityHandler, error)
 	CreateKeyPairHandler() (irs.KeyPairHandler, error)
 	//CreateVNicHandler() (irs.VNicHandler, error)
-	//CreatePublicIPHandler() (irs.PublicIPHandler, error)
+	CreatePublicIPHandler() (irs.PublicIPHandler, error)
 
 	CreateVMHandler() (irs.VMHandler, error)
 
 	CreateVMSpecHandler() (irs.VMSpecHandler, error)
 
+	CreateNLBHandler() (irs.NLBHandler, error)
+
+	CreateDNSHandler() (irs.DNSHandler, error)
+
+	CreateBucketHandler() (irs.BucketHandler, error)
+
+	CreateRegionZoneHandler() (irs.RegionZoneHandler, error)
+
 	IsConnected() (bool, error)
 	Close() error
 }
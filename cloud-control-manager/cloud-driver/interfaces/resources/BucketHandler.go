@@ -0,0 +1,63 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+import (
+	"io"
+	"time"
+)
+
+// object storage bucket in the region of the connection,
+// ex) AWS S3 bucket, GCP GCS bucket, Azure Blob container, Alibaba OSS bucket, OpenStack Swift container
+type BucketReqInfo struct {
+	IId IID // {NameId, SystemId}, NameId is used as the bucket name of CSP
+
+	KeyValueList []KeyValue
+}
+
+type BucketInfo struct {
+	IId         IID // {NameId, SystemId}
+	CreatedTime time.Time
+
+	KeyValueList []KeyValue
+}
+
+type ObjectInfo struct {
+	Name         string // object key, ex) "logs/2020/10/app.log"
+	Size         int64  // bytes
+	LastModified time.Time
+	ETag         string
+	ContentType  string
+}
+
+type PresignedURLMethod string
+
+const (
+	PresignedGet PresignedURLMethod = "GET" // download
+	PresignedPut PresignedURLMethod = "PUT" // upload
+)
+
+type BucketHandler interface {
+	CreateBucket(bucketReqInfo BucketReqInfo) (BucketInfo, error)
+	ListBucket() ([]*BucketInfo, error)
+	GetBucket(bucketIID IID) (BucketInfo, error)
+	DeleteBucket(bucketIID IID) (bool, error) // only an empty bucket can be deleted
+
+	ListObject(bucketIID IID, prefix string) ([]*ObjectInfo, error)
+	// reader is streamed to CSP, size: -1 if unknown.
+	PutObject(bucketIID IID, objectName string, reader io.Reader, size int64, contentType string) (ObjectInfo, error)
+	// caller must close the returned reader.
+	GetObject(bucketIID IID, objectName string) (io.ReadCloser, ObjectInfo, error)
+	DeleteObject(bucketIID IID, objectName string) (bool, error)
+
+	// URL to GET or PUT an object without credentials until expiration.
+	GetPresignedURL(bucketIID IID, objectName string, method PresignedURLMethod, expires time.Duration) (string, error)
+}
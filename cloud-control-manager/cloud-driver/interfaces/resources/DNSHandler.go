@@ -0,0 +1,60 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+// private DNS zone resolved only in the attached VPC,
+// ex) AWS Route 53 private hosted zone, Azure Private DNS zone, GCP Cloud DNS private zone
+type DNSZoneReqInfo struct {
+	IId        IID    // {NameId, SystemId}
+	DomainName string // ex) "cb-spider.internal"
+	VpcIID     IID    // VPC which resolves the zone
+
+	KeyValueList []KeyValue
+}
+
+type DNSRecordType string
+
+const (
+	DNSRecordA     DNSRecordType = "A"
+	DNSRecordCNAME DNSRecordType = "CNAME"
+)
+
+// default TTL of a record in seconds
+const DNSDefaultTTL int64 = 300
+
+// a record is identified by {Name, Type} in a zone.
+type DNSRecordInfo struct {
+	Name   string // relative to the zone, ex) "web-01" => web-01.cb-spider.internal
+	Type   DNSRecordType
+	TTL    int64    // seconds, 0: DNSDefaultTTL
+	Values []string // IPv4 addresses for A, one domain name for CNAME
+}
+
+type DNSZoneInfo struct {
+	IId        IID // {NameId, SystemId}
+	DomainName string
+	VpcIID     IID
+
+	RecordList []DNSRecordInfo // A and CNAME records only, SOA and NS records are hidden
+
+	KeyValueList []KeyValue
+}
+
+type DNSHandler interface {
+	CreateZone(zoneReqInfo DNSZoneReqInfo) (DNSZoneInfo, error)
+	ListZone() ([]*DNSZoneInfo, error)
+	GetZone(zoneIID IID) (DNSZoneInfo, error)
+	DeleteZone(zoneIID IID) (bool, error)
+
+	AddRecord(zoneIID IID, recordInfo DNSRecordInfo) (DNSZoneInfo, error)
+	UpdateRecord(zoneIID IID, recordInfo DNSRecordInfo) (DNSZoneInfo, error)
+	RemoveRecord(zoneIID IID, recordName string, recordType DNSRecordType) (bool, error)
+}
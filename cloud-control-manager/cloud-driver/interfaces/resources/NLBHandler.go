@@ -0,0 +1,100 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+import "time"
+
+type NLBReqInfo struct {
+	IId    IID    // {NameId, SystemId}
+	VpcIID IID    // {NameId, SystemId}
+	Type   string // PUBLIC(default) | INTERNAL
+
+	Listener      ListenerInfo
+	VMGroup       NLBVMGroupInfo
+	HealthChecker HealthCheckerInfo
+}
+
+type NLBInfo struct {
+	IId    IID    // {NameId, SystemId}
+	VpcIID IID    // {NameId, SystemId}
+	Type   string // PUBLIC | INTERNAL
+
+	Listener      ListenerInfo
+	VMGroup       NLBVMGroupInfo
+	HealthChecker HealthCheckerInfo
+
+	CreatedTime  time.Time
+	KeyValueList []KeyValue
+}
+
+// frontend of NLB
+type ListenerInfo struct {
+	Protocol string // TCP | UDP
+	IP       string // Auto generated and attached
+	Port     string // 1-65535
+	DNSName  string // Optional, Auto generated and attached
+
+	KeyValueList []KeyValue
+}
+
+// backend VMs of NLB
+type NLBVMGroupInfo struct {
+	Protocol string // TCP | UDP
+	Port     string // 1-65535
+	VMs      []IID
+
+	KeyValueList []KeyValue
+}
+
+type HealthCheckerInfo struct {
+	Protocol  string // TCP | HTTP
+	Port      string // Listener Port or 1-65535
+	Interval  int    // secs, Interval time between health checks.
+	Timeout   int    // secs, Waiting time to decide an unhealthy VM when no response.
+	Threshold int    // num, The number of continuous health checks to change the VM status.
+
+	KeyValueList []KeyValue
+}
+
+type NLBHealthState string
+
+const (
+	Healthy   NLBHealthState = "Healthy"
+	Unhealthy NLBHealthState = "Unhealthy"
+	Unknown   NLBHealthState = "Unknown" // ex) health check is in progress
+)
+
+// health state of each backend VM
+type VMHealthInfo struct {
+	VMIID       IID
+	HealthState NLBHealthState
+}
+
+type HealthInfo struct {
+	AllVMs []VMHealthInfo
+}
+
+type NLBHandler interface {
+	//------ NLB Management
+	CreateNLB(nlbReqInfo NLBReqInfo) (NLBInfo, error)
+	ListNLB() ([]*NLBInfo, error)
+	GetNLB(nlbIID IID) (NLBInfo, error)
+	DeleteNLB(nlbIID IID) (bool, error)
+
+	//------ Frontend Control
+	ChangeListener(nlbIID IID, listener ListenerInfo) (ListenerInfo, error)
+
+	//------ Backend Control
+	AddVMs(nlbIID IID, vmIIDs []IID) (NLBVMGroupInfo, error)
+	RemoveVMs(nlbIID IID, vmIIDs []IID) (bool, error)
+	GetVMGroupHealthInfo(nlbIID IID) (HealthInfo, error)
+	ChangeHealthCheckerInfo(nlbIID IID, healthChecker HealthCheckerInfo) (HealthCheckerInfo, error)
+}
@@ -0,0 +1,45 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+// reserved(static) Public IP, ex) AWS Elastic IP, GCP static external IP, Azure static Public IP
+type PublicIPReqInfo struct {
+	IId IID // {NameId, SystemId}
+
+	KeyValueList []KeyValue
+}
+
+type PublicIPStatus string
+
+const (
+	PublicIPAvailable  PublicIPStatus = "Available"  // allocated, not attached
+	PublicIPAssociated PublicIPStatus = "Associated" // attached to a VM
+)
+
+type PublicIPInfo struct {
+	IId      IID // {NameId, SystemId}
+	PublicIP string
+	Status   PublicIPStatus
+
+	AssociatedVMIID IID // empty if Available
+
+	KeyValueList []KeyValue
+}
+
+type PublicIPHandler interface {
+	AllocatePublicIP(publicIPReqInfo PublicIPReqInfo) (PublicIPInfo, error)
+	ListPublicIP() ([]*PublicIPInfo, error)
+	GetPublicIP(publicIPIID IID) (PublicIPInfo, error)
+	ReleasePublicIP(publicIPIID IID) (bool, error)
+
+	AssociatePublicIP(publicIPIID IID, vmIID IID) (PublicIPInfo, error)
+	DisassociatePublicIP(publicIPIID IID) (bool, error)
+}
@@ -0,0 +1,46 @@
+// Cloud Driver Interface of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// This is Resouces interfaces of Cloud Driver.
+//
+// by CB-Spider Team, 2020.10.
+
+package resources
+
+type RegionZoneStatus string
+
+const (
+	RegionZoneAvailable    RegionZoneStatus = "Available"
+	RegionZoneUnavailable  RegionZoneStatus = "Unavailable"
+	RegionZoneNotSupported RegionZoneStatus = "StatusNotSupported" // CSP does not provide the status
+)
+
+// region of CSP, which is available with the credential of the connection,
+// ex) AWS region, Azure location, GCP region, Alibaba region, OpenStack region
+type RegionZoneInfo struct {
+	Name        string // CSP region name, ex) "us-east-2", "koreacentral"
+	DisplayName string // ex) "US East (Ohio)", "Korea Central"
+	Status      RegionZoneStatus
+	ZoneList    []ZoneInfo // empty if the CSP has no zones in the region, ex) Azure location
+
+	KeyValueList []KeyValue
+}
+
+type ZoneInfo struct {
+	Name        string // CSP zone name, ex) "us-east-2a", "asia-northeast3-a"
+	DisplayName string
+	Status      RegionZoneStatus
+
+	KeyValueList []KeyValue
+}
+
+type RegionZoneHandler interface {
+	ListRegionZone() ([]*RegionZoneInfo, error)
+	GetRegionZone(Name string) (RegionZoneInfo, error)
+
+	// zones of the region of the connection
+	ListZone() ([]*ZoneInfo, error)
+}
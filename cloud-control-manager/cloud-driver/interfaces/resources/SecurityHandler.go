@@ -24,6 +24,7 @@ type SecurityRuleInfo struct {
 	ToPort     string
 	IPProtocol string
 	Direction  string
+	CIDR       string // IPv4 or IPv6 CIDR, default: "0.0.0.0/0"
 }
 
 type SecurityInfo struct {
@@ -28,8 +28,47 @@ type VMReqInfo struct {
 
 	VMUserId     string
 	VMUserPasswd string
+
+	PurchaseOption VMPurchaseOption // default: OnDemand
+
+	PrivateIP string // optional, fixed private IP of the primary NIC in the SubnetIID CIDR
+
+	PublicIPIID IID // optional, reserved Public IP to attach at creation
+	DNSZoneIID  IID // optional, private DNS zone to register the VM NameId as an A record of the Private IP
+
+	// optional, secondary NICs. The primary NIC is always made of SubnetIID and SecurityGroupIIDs.
+	NetworkInterfaceList []NetworkInterfaceReqInfo
+}
+
+type NetworkInterfaceReqInfo struct {
+	SubnetIID         IID    // a Subnet of the VM's VPC
+	SecurityGroupIIDs []IID  // empty means the SecurityGroupIIDs of the primary NIC
+	PrivateIP         string // optional, fixed private IP in the Subnet CIDR
+	PublicIP          bool   // true: assign a public IP to this NIC
 }
 
+// GO do not support Enum. So, define like this.
+type VMPurchaseType string
+
+const (
+	OnDemand    VMPurchaseType = "OnDemand"
+	Spot        VMPurchaseType = "Spot"        // AWS, Azure, Alibaba
+	Preemptible VMPurchaseType = "Preemptible" // GCP
+)
+
+type VMPurchaseOption struct {
+	PurchaseType VMPurchaseType // "" means OnDemand
+	MaxPrice     string         // Spot only, USD per hour. ex) "0.0035", "" means up to the on-demand price
+}
+
+type VMInterruptionState string
+
+const (
+	NotInterrupted      VMInterruptionState = "NotInterrupted"
+	InterruptionNoticed VMInterruptionState = "InterruptionNoticed" // CSP gave a notice to reclaim the VM
+	Interrupted         VMInterruptionState = "Interrupted"         // VM was stopped or terminated by CSP for reclaiming
+)
+
 type VMStatusInfo struct {
 	IId      IID // {NameId, SystemId}
 	VmStatus VMStatus
@@ -81,13 +120,28 @@ type VMInfo struct {
 	PublicDNS        string
 	PrivateIP        string
 	PrivateDNS       string
+	PublicIPv6       string // in a dual-stack Subnet, globally routable
+	PrivateIPv6      string // in a dual-stack Subnet, private(ex: Azure ULA)
 
 	VMBootDisk  string // ex) /dev/sda1
 	VMBlockDisk string // ex)
 
+	LifecycleType     VMPurchaseType      // OnDemand, Spot, Preemptible
+	InterruptionState VMInterruptionState // valid only for Spot, Preemptible
+
+	NetworkInterfaceList []NetworkInterfaceInfo // all NICs, the primary NIC comes first
+
 	KeyValueList []KeyValue
 }
 
+type NetworkInterfaceInfo struct {
+	IId               IID // {NameId, SystemId}, ex) {eth1, eni-0a2b3c4d5e6f}
+	SubnetIID         IID
+	SecurityGroupIIds []IID
+	PrivateIP         string
+	PublicIP          string
+}
+
 type VMHandler interface {
 	StartVM(vmReqInfo VMReqInfo) (VMInfo, error)
 
@@ -101,4 +155,7 @@ type VMHandler interface {
 
 	ListVM() ([]*VMInfo, error)
 	GetVM(vmIID IID) (VMInfo, error)
+
+	// console(serial) output of the VM for troubleshooting boot failures
+	GetVMConsoleOutput(vmIID IID) (string, error)
 }
@@ -10,6 +10,12 @@
 
 package resources
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 type VMSpecInfo struct {
 	Region string
 	Name   string
@@ -17,12 +23,19 @@ type VMSpecInfo struct {
 	Mem    string
 	Gpu    []GpuInfo
 
+	// normalised form of Mem, always in MiB
+	MemSizeMiB int64
+
 	KeyValueList []KeyValue
 }
 
 type VCpuInfo struct {
 	Count string
 	Clock string // GHz
+
+	// normalised forms of Count and Clock
+	CountNum int
+	ClockGHz float64
 }
 
 type GpuInfo struct {
@@ -30,6 +43,10 @@ type GpuInfo struct {
 	Mfr   string
 	Model string
 	Mem   string
+
+	// normalised forms of Count and Mem(MiB)
+	CountNum   int
+	MemSizeMiB int64
 }
 
 type VMSpecHandler interface {
@@ -41,3 +58,125 @@ type VMSpecHandler interface {
 	ListOrgVMSpec(Region string) (string, error)             // return string: json format
 	GetOrgVMSpec(Region string, Name string) (string, error) // return string: json format
 }
+
+// NormalizeVMSpecInfo fills the typed fields of VMSpecInfo from the legacy string fields.
+// Drivers call it at the end of their spec mapper.
+// A typed field already set by the driver is kept and is not compared with its string field,
+// the empty Mem and VCpu.Count are filled from it.
+// The error lists the string fields that can not be parsed, their typed fields are set to 0.
+//  - Mem and Gpu.Mem: a plain number is MiB, a unit suffix(MB, MiB, GB, GiB) is converted.
+//  - VCpu.Clock: GHz
+//  - empty, "N/A" and "-1" mean unknown and are mapped to 0.
+func NormalizeVMSpecInfo(info *VMSpecInfo) error {
+	var errList []string
+
+	if info.MemSizeMiB == 0 {
+		mem, err := ParseMemSizeMiB(info.Mem)
+		if err != nil {
+			errList = append(errList, "Mem: "+err.Error())
+		}
+		info.MemSizeMiB = mem
+	} else if info.Mem == "" {
+		info.Mem = strconv.FormatInt(info.MemSizeMiB, 10)
+	}
+
+	if info.VCpu.CountNum == 0 {
+		count, err := parseCount(info.VCpu.Count)
+		if err != nil {
+			errList = append(errList, "VCpu.Count: "+err.Error())
+		}
+		info.VCpu.CountNum = count
+	} else if info.VCpu.Count == "" {
+		info.VCpu.Count = strconv.Itoa(info.VCpu.CountNum)
+	}
+
+	if info.VCpu.ClockGHz == 0 {
+		clock, err := parseClockGHz(info.VCpu.Clock)
+		if err != nil {
+			errList = append(errList, "VCpu.Clock: "+err.Error())
+		}
+		info.VCpu.ClockGHz = clock
+	}
+
+	for i := range info.Gpu {
+		gpu := &info.Gpu[i]
+		if gpu.CountNum == 0 {
+			count, err := parseCount(gpu.Count)
+			if err != nil {
+				errList = append(errList, fmt.Sprintf("Gpu[%d].Count: %s", i, err.Error()))
+			}
+			gpu.CountNum = count
+		}
+		if gpu.MemSizeMiB == 0 {
+			mem, err := ParseMemSizeMiB(gpu.Mem)
+			if err != nil {
+				errList = append(errList, fmt.Sprintf("Gpu[%d].Mem: %s", i, err.Error()))
+			}
+			gpu.MemSizeMiB = mem
+		}
+	}
+
+	if len(errList) > 0 {
+		return fmt.Errorf("VMSpec(%s) does not conform to the spec format: %s", info.Name, strings.Join(errList, ", "))
+	}
+	return nil
+}
+
+// ParseMemSizeMiB converts a memory size string into MiB.
+// ex) "1024" => 1024, "16384MB" => 16384, "16GB" => 16384, "0.5 GiB" => 512
+func ParseMemSizeMiB(mem string) (int64, error) {
+	str := strings.TrimSpace(mem)
+	if isUnknownValue(str) {
+		return 0, nil
+	}
+
+	multiplier := 1.0
+	upper := strings.ToUpper(str)
+	for _, unit := range []struct {
+		suffix string
+		mul    float64
+	}{{"GIB", 1024}, {"GB", 1024}, {"MIB", 1}, {"MB", 1}} {
+		if strings.HasSuffix(upper, unit.suffix) {
+			str = strings.TrimSpace(str[:len(str)-len(unit.suffix)])
+			multiplier = unit.mul
+			break
+		}
+	}
+
+	value, err := strconv.ParseFloat(str, 64)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("invalid memory size '%s'", mem)
+	}
+	return int64(value*multiplier + 0.5), nil
+}
+
+func parseCount(count string) (int, error) {
+	str := strings.TrimSpace(count)
+	if isUnknownValue(str) {
+		return 0, nil
+	}
+	value, err := strconv.Atoi(str)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("invalid count '%s'", count)
+	}
+	return value, nil
+}
+
+func parseClockGHz(clock string) (float64, error) {
+	str := strings.TrimSpace(clock)
+	if strings.HasSuffix(strings.ToUpper(str), "GHZ") {
+		str = strings.TrimSpace(str[:len(str)-3])
+	}
+	if isUnknownValue(str) {
+		return 0, nil
+	}
+	value, err := strconv.ParseFloat(str, 64)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("invalid clock '%s'", clock)
+	}
+	return value, nil
+}
+
+func isUnknownValue(str string) bool {
+	return str == "" || strings.EqualFold(str, "N/A") || str == "-1"
+}
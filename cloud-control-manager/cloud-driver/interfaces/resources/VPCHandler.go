@@ -12,16 +12,24 @@
 
 package resources
 
+// IPv6 CIDR of a dual-stack VPC
+//   "": IPv4 only, "auto": assigned by the CSP(AWS, Alibaba), ex) "fd00:db8:deca::/48": given by user(Azure)
+const IPv6AutoCIDR string = "auto"
+
 type VPCReqInfo struct { 
 	IId   IID       // {NameId, SystemId}
 	IPv4_CIDR string 
+	IPv6_CIDR string  // optional, "" | "auto" | IPv6 CIDR
 	SubnetInfoList []SubnetInfo 
 }
 
 type VPCInfo struct {
 	IId   IID       // {NameId, SystemId}
 	IPv4_CIDR string 
+	IPv6_CIDR string  // "" if IPv4 only
 	SubnetInfoList []SubnetInfo 
+	NATGatewayInfoList []NATGatewayInfo 
+	RouteTableInfoList []RouteTableInfo 
 
 	KeyValueList []KeyValue 
 }
@@ -29,10 +37,73 @@ type VPCInfo struct {
 type SubnetInfo struct {
 	IId   IID       // {NameId, SystemId}
 	IPv4_CIDR string 
+	IPv6_CIDR string  // in a dual-stack VPC, "": next /64 of the VPC IPv6 CIDR
+	Private bool    // false: default route to the Internet Gateway, true: no direct route to the internet
 
 	KeyValueList []KeyValue 
 }
 
+// NAT Gateway in a public subnet, the default route of all private subnets in the VPC
+type NATGatewayReqInfo struct {
+	IId       IID // {NameId, SystemId}
+	SubnetIID IID // public subnet of the NAT Gateway
+}
+
+type NATGatewayInfo struct {
+	IId       IID // {NameId, SystemId}
+	SubnetIID IID // public subnet of the NAT Gateway
+	PublicIP  string
+
+	KeyValueList []KeyValue
+}
+
+type RouteTargetType string
+
+const (
+	RouteInternetGateway RouteTargetType = "InternetGateway"
+	RouteNATGateway      RouteTargetType = "NATGateway"
+	RouteVPCPeering      RouteTargetType = "VPCPeering"
+)
+
+type RouteInfo struct {
+	DestinationCIDR string
+	TargetType      RouteTargetType
+	TargetIID       IID // NAT Gateway or VPC Peering, empty for the Internet Gateway
+}
+
+// route table and the subnets associated with it
+type RouteTableInfo struct {
+	IId        IID // {NameId, SystemId}
+	SubnetIIDs []IID
+	RouteList  []RouteInfo
+
+	KeyValueList []KeyValue
+}
+
+// peering between two VPCs in the same connection
+type VPCPeeringReqInfo struct {
+	IId             IID // {NameId, SystemId}
+	RequesterVPCIID IID // {NameId, SystemId}
+	AccepterVPCIID  IID // {NameId, SystemId}
+}
+
+type VPCPeeringStatus string
+
+const (
+	VPCPeeringPending VPCPeeringStatus = "Pending" // requested, not accepted
+	VPCPeeringActive  VPCPeeringStatus = "Active"  // accepted, routes are propagated
+	VPCPeeringFailed  VPCPeeringStatus = "Failed"  // rejected, expired or failed
+)
+
+type VPCPeeringInfo struct {
+	IId             IID // {NameId, SystemId}
+	RequesterVPCIID IID // {NameId, SystemId}
+	AccepterVPCIID  IID // {NameId, SystemId}
+	Status          VPCPeeringStatus
+
+	KeyValueList []KeyValue
+}
+
 type VPCHandler interface {
 	CreateVPC(vpcReqInfo VPCReqInfo) (VPCInfo, error)
 	ListVPC() ([]*VPCInfo, error)
@@ -41,4 +112,21 @@ type VPCHandler interface {
 
 	AddSubnet(vpcIID IID, subnetInfo SubnetInfo) (VPCInfo, error)
 	RemoveSubnet(vpcIID IID, subnetIID IID) (bool, error)
+
+	//------ NAT Gateway and Route
+	// create a NAT Gateway in a public subnet and route the private subnets to it.
+	AddNATGateway(vpcIID IID, natReqInfo NATGatewayReqInfo) (VPCInfo, error)
+	RemoveNATGateway(vpcIID IID, natGatewayIID IID) (bool, error)
+	// add or remove a custom route in the route table of the subnet.
+	AddRoute(vpcIID IID, subnetIID IID, routeInfo RouteInfo) (VPCInfo, error)
+	RemoveRoute(vpcIID IID, subnetIID IID, routeInfo RouteInfo) (bool, error)
+
+	//------ VPC Peering
+	RequestVPCPeering(peeringReqInfo VPCPeeringReqInfo) (VPCPeeringInfo, error)
+	// accept the peering and add the routes to the peer VPC CIDR into all subnets of both VPCs.
+	AcceptVPCPeering(peeringIID IID) (VPCPeeringInfo, error)
+	ListVPCPeering() ([]*VPCPeeringInfo, error)
+	GetVPCPeering(peeringIID IID) (VPCPeeringInfo, error)
+	// remove the propagated routes and delete the peering.
+	DeleteVPCPeering(peeringIID IID) (bool, error)
 }
@@ -263,6 +263,50 @@ defer iidRWLock.rwMutex.Unlock()
         return result, nil
 }
 
+// all IIDs of all resource types of the connection.
+func (iidRWLock *IIDRWLOCK)ListAllIID(connectionName string) ([]*IIDInfo, error) {
+	cblog.Info("call ListAllIID()")
+
+	if connectionName == "" {
+		return nil, fmt.Errorf("ConnectionName is empty!")
+	}
+
+iidRWLock.rwMutex.RLock()
+defer iidRWLock.rwMutex.RUnlock()
+	iIDInfoList, err := listAllInfo(connectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	// escape: "%2F" => "/"
+	for i, iidInfo := range iIDInfoList {
+		iIDInfoList[i].IId.NameId = strings.ReplaceAll(iidInfo.IId.NameId, "%2F", "/")
+	}
+
+	return iIDInfoList, nil
+}
+
+// delete all IIDs of the connection, and return the number of deleted IIDs.
+// only the IIDs are deleted, not the resources of the CSP.
+func (iidRWLock *IIDRWLOCK)DeleteAllIID(connectionName string) (int, error) {
+	cblog.Info("call DeleteAllIID()")
+
+	if connectionName == "" {
+		return 0, fmt.Errorf("ConnectionName is empty!")
+	}
+
+iidRWLock.rwMutex.Lock()
+defer iidRWLock.rwMutex.Unlock()
+
+	count, err := deleteAllInfo(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return count, err
+	}
+
+	return count, nil
+}
+
 //----------------
 
 func checkParams(connectionName string, resourceType string, iId *resources.IID) error {
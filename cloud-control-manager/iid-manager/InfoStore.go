@@ -181,3 +181,54 @@ func deleteInfo(connectionName string, resourceType string, nameId string) (bool
         return false, fmt.Errorf("[" + connectionName + ":" + resourceType +  ":" + nameId + "] does not exist!")
 }
 
+// 1. get key-value list of all resource types
+// 2. create IIDInfo List & return
+func listAllInfo(connectionName string) ([]*IIDInfo, error) {
+        // ex) /resource-info-spaces/iids/aws-seoul-config/VM/powerkim_vm_01 [i-0bc7123b7e5cbf79d]
+
+        key := "/resource-info-spaces/iids/" + connectionName
+        keyValueList, err := store.GetList(key, true)
+        if err != nil {
+                return nil, err
+        }
+
+        iidInfoList := []*IIDInfo{}
+        for _, kv := range keyValueList {
+		// keyValueList should have ~/connectionName/... or ~/connectionName-01/...,
+		// so we have to check the sameness of connectionName.
+		if utils.GetNodeValue(kv.Key, 3) != connectionName {
+			continue
+		}
+                iidInfo := &IIDInfo{connectionName, utils.GetNodeValue(kv.Key, 4), resources.IID{utils.GetNodeValue(kv.Key, 5), kv.Value} }
+                iidInfoList = append(iidInfoList, iidInfo)
+        }
+
+        return iidInfoList, nil
+}
+
+// delete all keys of the connection.
+func deleteAllInfo(connectionName string) (int, error) {
+        // ex) /resource-info-spaces/iids/aws-seoul-config/VM/powerkim_vm_01 [i-0bc7123b7e5cbf79d]
+
+        key := "/resource-info-spaces/iids/" + connectionName
+        keyValueList, err := store.GetList(key, true)
+        if err != nil {
+                return 0, err
+        }
+
+        count := 0
+        for _, kv := range keyValueList {
+		// keyValueList should have ~/connectionName/... or ~/connectionName-01/...,
+		// so we have to check the sameness of connectionName.
+		if utils.GetNodeValue(kv.Key, 3) != connectionName {
+			continue
+		}
+		err = store.Delete(kv.Key)
+		if err != nil {
+			return count, err
+		}
+		count++
+        }
+
+        return count, nil
+}
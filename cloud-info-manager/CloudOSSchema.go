@@ -0,0 +1,156 @@
+// Credential and Region Schema of CloudOS.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package cloudos
+
+import (
+	"fmt"
+	"strings"
+
+	icbs "github.com/cloud-barista/cb-store/interfaces"
+)
+
+type KeySchema struct {
+	Key         string `json:"Key"`
+	Description string `json:"Description"`
+	Required    bool   `json:"Required"`
+	Secret      bool   `json:"Secret"`
+}
+
+type CloudOSSchema struct {
+	CloudOS    string      `json:"CloudOS"`
+	Credential []KeySchema `json:"Credential"`
+	Region     []KeySchema `json:"Region"`
+}
+
+// the schema is owned by the driver of the CloudOS,
+// so the cloud-control-manager, which loads the drivers, sets the getter.
+type CloudOSSchemaGetter func(cloudOSName string) (*CloudOSSchema, error)
+
+var schemaGetter CloudOSSchemaGetter
+
+func SetCloudOSSchemaGetter(getter CloudOSSchemaGetter) {
+	schemaGetter = getter
+}
+
+func GetCloudOSSchema(cloudOSName string) (*CloudOSSchema, error) {
+	cblog.Info("call GetCloudOSSchema()")
+
+	if schemaGetter == nil {
+		return nil, fmt.Errorf("%s: no CloudOS schema getter is set!", cloudOSName)
+	}
+	schema, err := schemaGetter(strings.ToUpper(cloudOSName))
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	return schema, nil
+}
+
+// unknown, duplicated and missing required keys are rejected.
+func ValidateCredentialKeyValue(providerName string, keyValueInfoList []icbs.KeyValue) error {
+	schema, err := getSchemaForValidation(providerName)
+	if err != nil || schema == nil {
+		return err
+	}
+	return validateKeyValue(schema.CloudOS, "Credential", schema.Credential, keyValueInfoList)
+}
+
+// unknown, duplicated and missing required keys are rejected.
+func ValidateRegionKeyValue(providerName string, keyValueInfoList []icbs.KeyValue) error {
+	schema, err := getSchemaForValidation(providerName)
+	if err != nil || schema == nil {
+		return err
+	}
+	return validateKeyValue(schema.CloudOS, "Region", schema.Region, keyValueInfoList)
+}
+
+// only missing required keys are rejected,
+// for the connection with the info registered before the schema.
+func CheckRequiredKeyValue(schema *CloudOSSchema, crdKeyValueList []icbs.KeyValue, rgnKeyValueList []icbs.KeyValue) error {
+	err := checkRequiredKey(schema.CloudOS, "Credential", schema.Credential, crdKeyValueList)
+	if err != nil {
+		return err
+	}
+	return checkRequiredKey(schema.CloudOS, "Region", schema.Region, rgnKeyValueList)
+}
+
+//----------------
+
+// nil schema for a provider which is not in conf/cloudos.yaml, it is not validated.
+// a listed CloudOS without its schema is an error, not a skipped validation.
+func getSchemaForValidation(providerName string) (*CloudOSSchema, error) {
+	if !isCloudOS(providerName) {
+		cblog.Warnf("%s: not a listed CloudOS, so the key/values are not validated.", providerName)
+		return nil, nil
+	}
+
+	schema, err := GetCloudOSSchema(providerName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: can not validate the key/values without the CloudOS schema: %v", providerName, err)
+	}
+	return schema, nil
+}
+
+func isCloudOS(providerName string) bool {
+	for _, cloudOS := range ListCloudOS() {
+		if strings.EqualFold(cloudOS, providerName) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateKeyValue(cloudOS string, kind string, keySchemaList []KeySchema, keyValueInfoList []icbs.KeyValue) error {
+	keySchemaMap := map[string]KeySchema{}
+	for _, keySchema := range keySchemaList {
+		keySchemaMap[keySchema.Key] = keySchema
+	}
+
+	keyMap := map[string]bool{}
+	for _, kv := range keyValueInfoList {
+		if _, ok := keySchemaMap[kv.Key]; !ok {
+			return fmt.Errorf("%s is not a %s key of %s! valid keys: %s", kv.Key, kind, cloudOS, keyNames(keySchemaList))
+		}
+		if keyMap[kv.Key] {
+			return fmt.Errorf("%s key %s is duplicated!", kind, kv.Key)
+		}
+		keyMap[kv.Key] = true
+	}
+
+	return checkRequiredKey(cloudOS, kind, keySchemaList, keyValueInfoList)
+}
+
+func checkRequiredKey(cloudOS string, kind string, keySchemaList []KeySchema, keyValueInfoList []icbs.KeyValue) error {
+	valueMap := map[string]string{}
+	for _, kv := range keyValueInfoList {
+		valueMap[kv.Key] = kv.Value
+	}
+
+	for _, keySchema := range keySchemaList {
+		if !keySchema.Required {
+			continue
+		}
+		value, ok := valueMap[keySchema.Key]
+		if !ok {
+			return fmt.Errorf("%s key %s is required for %s! (%s)", kind, keySchema.Key, cloudOS, keySchema.Description)
+		}
+		if value == "" {
+			return fmt.Errorf("%s key %s of %s is empty!", kind, keySchema.Key, cloudOS)
+		}
+	}
+	return nil
+}
+
+func keyNames(keySchemaList []KeySchema) string {
+	names := []string{}
+	for _, keySchema := range keySchemaList {
+		names = append(names, keySchema.Key)
+	}
+	return strings.Join(names, ", ")
+}
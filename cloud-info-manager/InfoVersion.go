@@ -0,0 +1,167 @@
+// Version of Cloud Info for the optimistic concurrency.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package cloudos
+
+import (
+	"fmt"
+	"strconv"
+
+	cbstore "github.com/cloud-barista/cb-store"
+	icbs "github.com/cloud-barista/cb-store/interfaces"
+	"github.com/cloud-barista/cb-store/utils"
+)
+
+// kinds of Cloud Info
+const (
+	DriverKind           string = "drivers"
+	CredentialKind       string = "credentials"
+	RegionKind           string = "regions"
+	ConnectionConfigKind string = "connection-configs"
+)
+
+// format
+// /cloud-info-spaces/versions/{Kind}/<Name> [Version]
+// ex) /cloud-info-spaces/versions/credentials/aws_credential01 [3]
+//
+// The version is kept after the deletion, so a re-registered info does not reuse an old version.
+// An info registered before the versioning has the version 1.
+const versionSpace string = "/cloud-info-spaces/versions/"
+
+var versionStore icbs.Store
+
+func init() {
+	versionStore = cbstore.GetStore()
+}
+
+// returned when the version of an update request is not the current version.
+type VersionConflictError struct {
+	Kind           string
+	Name           string
+	Version        int
+	CurrentVersion int
+}
+
+func (e *VersionConflictError) Error() string {
+	return fmt.Sprintf("%s(%s) was changed by another request: Version=%d, current Version=%d",
+		e.Kind, e.Name, e.Version, e.CurrentVersion)
+}
+
+func IsVersionConflict(err error) bool {
+	_, ok := err.(*VersionConflictError)
+	return ok
+}
+
+func GetInfoVersion(kind string, name string) (int, error) {
+	kv, err := versionStore.Get(versionSpace + kind + "/" + name)
+	if err != nil {
+		return 0, err
+	}
+	if kv == nil || kv.Value == "" {
+		return 1, nil
+	}
+	return strconv.Atoi(kv.Value)
+}
+
+// Name => Version
+type InfoVersionMap map[string]int
+
+func (versionMap InfoVersionMap) Version(name string) int {
+	if version, ok := versionMap[name]; ok {
+		return version
+	}
+	return 1
+}
+
+// Name => Version of all infos of the kind, for the list APIs.
+func ListInfoVersion(kind string) (InfoVersionMap, error) {
+	keyValueList, err := versionStore.GetList(versionSpace+kind, true)
+	if err != nil {
+		return nil, err
+	}
+
+	versionMap := InfoVersionMap{}
+	for _, kv := range keyValueList {
+		version, err := strconv.Atoi(kv.Value)
+		if err != nil {
+			return nil, err
+		}
+		// /cloud-info-spaces/versions/{Kind}/<Name>
+		versionMap[utils.GetNodeValue(kv.Key, 4)] = version
+	}
+	return versionMap, nil
+}
+
+// the version of a new registration.
+// a registration over an existing info is also a change.
+func NextInfoVersion(kind string, name string) (int, error) {
+	kv, err := versionStore.Get(versionSpace + kind + "/" + name)
+	if err != nil {
+		return 0, err
+	}
+	version := 1
+	if kv != nil && kv.Value != "" {
+		current, err := strconv.Atoi(kv.Value)
+		if err != nil {
+			return 0, err
+		}
+		version = current + 1
+	}
+
+	err = versionStore.Put(versionSpace+kind+"/"+name, strconv.Itoa(version))
+	if err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+// compare the version of an update request with the current version.
+// The caller should hold the lock of the kind from CheckInfoVersion() to NextInfoVersion(),
+// and the deletion of the kind should hold the same lock.
+func CheckInfoVersion(kind string, name string, version int) error {
+	if version <= 0 {
+		return fmt.Errorf("Version is required for the update of %s(%s)!", kind, name)
+	}
+
+	current, err := GetInfoVersion(kind, name)
+	if err != nil {
+		return err
+	}
+	if version != current {
+		return &VersionConflictError{Kind: kind, Name: name, Version: version, CurrentVersion: current}
+	}
+	return nil
+}
+
+// the key/values of the patch are added or replaced, and the others are kept.
+func MergeKeyValueList(keyValueInfoList []icbs.KeyValue, patchList []icbs.KeyValue) []icbs.KeyValue {
+	mergedList := []icbs.KeyValue{}
+	for _, kv := range keyValueInfoList {
+		for _, patch := range patchList {
+			if patch.Key == kv.Key {
+				kv.Value = patch.Value
+			}
+		}
+		mergedList = append(mergedList, kv)
+	}
+	for _, patch := range patchList {
+		if !hasKey(keyValueInfoList, patch.Key) {
+			mergedList = append(mergedList, patch)
+		}
+	}
+	return mergedList
+}
+
+func hasKey(keyValueInfoList []icbs.KeyValue, key string) bool {
+	for _, kv := range keyValueInfoList {
+		if kv.Key == key {
+			return true
+		}
+	}
+	return false
+}
@@ -10,7 +10,10 @@ package connectionconfiginfomanager
 
 import (
 	"fmt"
+	"strings"
+	"sync"
 
+	im "github.com/cloud-barista/cb-spider/cloud-info-manager"
 	"github.com/cloud-barista/cb-store/config"
 	"github.com/sirupsen/logrus"
 )
@@ -21,6 +24,9 @@ func init() {
 	cblog = config.Cblogger
 }
 
+// locks the creation and the update, for the version check.
+var configLock = new(sync.Mutex)
+
 //====================================================================
 type ConnectionConfigInfo struct {
 	ConfigName     string // ex) "config01"
@@ -28,6 +34,7 @@ type ConnectionConfigInfo struct {
 	DriverName     string // ex) "AWS-Test-Driver-V0.5"
 	CredentialName string // ex) "credential01"
 	RegionName     string // ex) "region01"
+	Version        int    // version for the update, see UpdateConnectionConfig()
 }
 
 //====================================================================
@@ -50,6 +57,9 @@ func CreateConnectionConfig(configName string, providerName string, driverName s
 
 	}
 
+	configLock.Lock()
+	defer configLock.Unlock()
+
 	cblog.Debug("insert metainfo into store")
 
 	err = insertInfo(configName, providerName, driverName, credentialName, regionName)
@@ -58,10 +68,94 @@ func CreateConnectionConfig(configName string, providerName string, driverName s
 		return nil, err
 	}
 
-	cncInfo := &ConnectionConfigInfo{configName, providerName, driverName, credentialName, regionName}
+	version, err := im.NextInfoVersion(im.ConnectionConfigKind, configName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	cncInfo := &ConnectionConfigInfo{configName, providerName, driverName, credentialName, regionName, version}
 	return cncInfo, nil
 }
 
+// 1. check params
+// 2. check the version and the ProviderName
+// 3. replace the config in cb-store
+// The ProviderName can not be changed.
+// The caller should check the driver, credential and region of the new config.
+// For a patch, get the config and merge the patch with MergeConnectionConfig().
+func UpdateConnectionConfig(configInfo ConnectionConfigInfo) (*ConnectionConfigInfo, error) {
+	cblog.Info("call UpdateConnectionConfig()")
+
+	cblog.Debug("check params")
+	err := checkParams(configInfo.ConfigName, configInfo.ProviderName, configInfo.DriverName,
+		configInfo.CredentialName, configInfo.RegionName)
+	if err != nil {
+		return nil, err
+	}
+
+	configLock.Lock()
+	defer configLock.Unlock()
+
+	oldInfo, err := getInfo(configInfo.ConfigName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return updateConnectionConfig(oldInfo, configInfo)
+}
+
+// the non-empty names of the patch are replaced, and the Version of the patch is used.
+func MergeConnectionConfig(configInfo ConnectionConfigInfo, patchInfo ConnectionConfigInfo) ConnectionConfigInfo {
+	if patchInfo.ProviderName != "" {
+		configInfo.ProviderName = patchInfo.ProviderName
+	}
+	if patchInfo.DriverName != "" {
+		configInfo.DriverName = patchInfo.DriverName
+	}
+	if patchInfo.CredentialName != "" {
+		configInfo.CredentialName = patchInfo.CredentialName
+	}
+	if patchInfo.RegionName != "" {
+		configInfo.RegionName = patchInfo.RegionName
+	}
+	configInfo.Version = patchInfo.Version
+	return configInfo
+}
+
+// the caller should hold the configLock.
+func updateConnectionConfig(oldInfo *ConnectionConfigInfo, configInfo ConnectionConfigInfo) (*ConnectionConfigInfo, error) {
+	configName := configInfo.ConfigName
+	if !strings.EqualFold(oldInfo.ProviderName, configInfo.ProviderName) {
+		return nil, fmt.Errorf("ProviderName of ConnectionConfig(%s) can not be changed: %s => %s",
+			configName, oldInfo.ProviderName, configInfo.ProviderName)
+	}
+
+	err := im.CheckInfoVersion(im.ConnectionConfigKind, configName, configInfo.Version)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	cblog.Debug("replace metainfo in store")
+
+	err = updateInfo(oldInfo, configInfo.DriverName, configInfo.CredentialName, configInfo.RegionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	version, err := im.NextInfoVersion(im.ConnectionConfigKind, configName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &ConnectionConfigInfo{configName, oldInfo.ProviderName, configInfo.DriverName,
+		configInfo.CredentialName, configInfo.RegionName, version}, nil
+}
+
 func ListConnectionConfig() ([]*ConnectionConfigInfo, error) {
 	cblog.Info("call ListConnectionConfig()")
 
@@ -98,6 +192,9 @@ func DeleteConnectionConfig(configName string) (bool, error) {
 		return false, fmt.Errorf("ConnectionName is empty!")
 	}
 
+	configLock.Lock()
+	defer configLock.Unlock()
+
 	result, err := deleteInfo(configName)
 	if err != nil {
 		cblog.Error(err)
@@ -0,0 +1,82 @@
+// Test of Cloud ConnectionConfig Info. Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2019.09.
+
+package connectionconfiginfomanager
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	icbs "github.com/cloud-barista/cb-store/interfaces"
+)
+
+// a store which fails to put any key.
+type failPutStore struct {
+	icbs.Store
+}
+
+func (s *failPutStore) Put(key string, value string) error {
+	return fmt.Errorf("failed to put %s", key)
+}
+
+func TestUpdateConnectionConfigPutFailure(t *testing.T) {
+	configName := "update-test-" + strconv.FormatInt(time.Now().UnixNano(), 36)
+	cncInfo, err := CreateConnectionConfig(configName, "AWS", "aws-driver01", "aws-credential01", "aws-region01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer DeleteConnectionConfig(configName)
+
+	newInfo := *cncInfo
+	newInfo.RegionName = "aws-region02"
+
+	realStore := store
+	store = &failPutStore{Store: realStore}
+	_, err = UpdateConnectionConfig(newInfo)
+	store = realStore
+	if err == nil {
+		t.Fatal("UpdateConnectionConfig() should fail.")
+	}
+
+	// the old config and the version are kept.
+	gotInfo, err := GetConnectionConfig(configName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *gotInfo != *cncInfo {
+		t.Errorf("ConnectionConfig = %+v, expected: %+v", *gotInfo, *cncInfo)
+	}
+
+	// the update succeeds with the same version, and only the new config exists.
+	_, err = UpdateConnectionConfig(newInfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotInfo, err = GetConnectionConfig(configName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotInfo.RegionName != "aws-region02" || gotInfo.Version != cncInfo.Version+1 {
+		t.Errorf("ConnectionConfig = %+v", *gotInfo)
+	}
+	configInfoList, err := ListConnectionConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	count := 0
+	for _, configInfo := range configInfoList {
+		if configInfo.ConfigName == configName {
+			count++
+		}
+	}
+	if count != 1 {
+		t.Errorf("%d ConnectionConfigs of %s exist.", count, configName)
+	}
+}
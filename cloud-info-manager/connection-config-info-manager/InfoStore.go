@@ -12,6 +12,7 @@ package connectionconfiginfomanager
 import (
 	"fmt"
 
+	im "github.com/cloud-barista/cb-spider/cloud-info-manager"
 	"github.com/cloud-barista/cb-store/utils"
 	"github.com/cloud-barista/cb-store"
 	icbs "github.com/cloud-barista/cb-store/interfaces"
@@ -56,6 +57,32 @@ func insertInfo(configName string, providerName string, driverName string, crede
 	return nil
 }
 
+// the names are in the key, so the new key is put before the old key is deleted,
+// and the old key is kept if the put fails.
+func updateInfo(oldInfo *ConnectionConfigInfo, driverName string, credentialName string, regionName string) error {
+	oldKey := "/cloud-info-spaces/connection-configs/" + oldInfo.ConfigName + "/" + oldInfo.ProviderName + "/" +
+		oldInfo.DriverName + "/" + oldInfo.CredentialName + "/" + oldInfo.RegionName
+	key := "/cloud-info-spaces/connection-configs/" + oldInfo.ConfigName + "/" + oldInfo.ProviderName + "/" +
+		driverName + "/" + credentialName + "/" + regionName
+	if key == oldKey {
+		return nil
+	}
+
+	err := insertInfo(oldInfo.ConfigName, oldInfo.ProviderName, driverName, credentialName, regionName)
+	if err != nil {
+		return err
+	}
+	err = store.Delete(oldKey)
+	if err != nil {
+		// rollback
+		if err2 := store.Delete(key); err2 != nil {
+			return fmt.Errorf(err.Error() + ", " + err2.Error())
+		}
+		return err
+	}
+	return nil
+}
+
 // 1. get key-value list
 // 2. create ConnectionConfigInfo List & return
 func listInfo() ([]*ConnectionConfigInfo, error) {
@@ -67,11 +94,16 @@ func listInfo() ([]*ConnectionConfigInfo, error) {
                 return nil, err
         }
 
+	versionMap, err := im.ListInfoVersion(im.ConnectionConfigKind)
+	if err != nil {
+		return nil, err
+	}
+
         configInfoList := make([]*ConnectionConfigInfo, len(keyValueList))
         for count, kv := range keyValueList {
                 cncInfo := &ConnectionConfigInfo{utils.GetNodeValue(kv.Key, 3), utils.GetNodeValue(kv.Key, 4),
 						utils.GetNodeValue(kv.Key, 5), utils.GetNodeValue(kv.Key, 6), 
-						utils.GetNodeValue(kv.Key, 7),
+						utils.GetNodeValue(kv.Key, 7), versionMap.Version(utils.GetNodeValue(kv.Key, 3)),
 						}
                 configInfoList[count] = cncInfo
         }
@@ -100,9 +132,13 @@ func getInfo(configName string) (*ConnectionConfigInfo, error) {
                 // keyValueList should have ~/driverName/... or ~/driverName-01/...,
                 // so we have to check the sameness of driverName.
                 if utils.GetNodeValue(kv.Key, 3) == configName {
+			version, err := im.GetInfoVersion(im.ConnectionConfigKind, configName)
+			if err != nil {
+				return nil, err
+			}
 			cncInfo := &ConnectionConfigInfo{utils.GetNodeValue(kv.Key, 3), utils.GetNodeValue(kv.Key, 4),
 				utils.GetNodeValue(kv.Key, 5), utils.GetNodeValue(kv.Key, 6),
-				utils.GetNodeValue(kv.Key, 7),
+				utils.GetNodeValue(kv.Key, 7), version,
 				}
 			return cncInfo, nil
 		} // end of if
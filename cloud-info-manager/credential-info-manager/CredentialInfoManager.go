@@ -10,16 +10,12 @@ package credentialinfomanager
 
 import (
 	"fmt"
+	"strings"
 
+	im "github.com/cloud-barista/cb-spider/cloud-info-manager"
 	"github.com/cloud-barista/cb-store/config"
 	icbs "github.com/cloud-barista/cb-store/interfaces"
 	"github.com/sirupsen/logrus"
-
-	"crypto/aes"
-	"crypto/cipher"
-	"crypto/rand"
-	"encoding/base64"
-	"io"
 )
 
 var cblog *logrus.Logger
@@ -36,6 +32,7 @@ type CredentialInfo struct {
 	//	 {ClientSecret, XXX},
 	//	 {TenantId, XXX},
 	//	 {SubscriptionId, XXX} }
+	Version int // version for the update, see UpdateCredential()
 }
 
 //====================================================================
@@ -57,7 +54,10 @@ cblog.Debug("check params")
 
 	cblog.Debug("insert metainfo into store")
 
-        err = encryptKeyValueList(keyValueInfoList)
+	rotateLock.Lock()
+	defer rotateLock.Unlock()
+
+        err = encryptKeyValueList(credentialName, keyValueInfoList)
         if err != nil {
                 return &CredentialInfo{}, err
 	}
@@ -68,10 +68,120 @@ cblog.Debug("check params")
 		return nil, err
 	}
 
-	crdInfo := &CredentialInfo{credentialName, providerName, keyValueInfoList}
+	version, err := im.NextInfoVersion(im.CredentialKind, credentialName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	crdInfo := &CredentialInfo{credentialName, providerName, keyValueInfoList, version}
 	return crdInfo, nil
 }
 
+// 1. check params
+// 2. check the version and the ProviderName
+// 3. replace the key/values in cb-store
+// The ProviderName can not be changed, because the connection configs use this credential.
+func UpdateCredential(crdInfo CredentialInfo) (*CredentialInfo, error) {
+	cblog.Info("call UpdateCredential()")
+
+	cblog.Debug("check params")
+	err := checkParams(crdInfo.CredentialName, crdInfo.ProviderName, crdInfo.KeyValueInfoList)
+	if err != nil {
+		return nil, err
+	}
+
+	rotateLock.Lock()
+	defer rotateLock.Unlock()
+
+	oldInfo, err := getInfo(crdInfo.CredentialName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return updateCredential(oldInfo, crdInfo)
+}
+
+// the key/values of the request are added or replaced, and the others are kept.
+// ProviderName can be empty.
+func PatchCredential(crdInfo CredentialInfo) (*CredentialInfo, error) {
+	cblog.Info("call PatchCredential()")
+
+	if crdInfo.CredentialName == "" {
+		return nil, fmt.Errorf("CredentialName is empty!")
+	}
+
+	rotateLock.Lock()
+	defer rotateLock.Unlock()
+
+	oldInfo, err := getInfo(crdInfo.CredentialName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	oldKeyValueInfoList := make([]icbs.KeyValue, len(oldInfo.KeyValueInfoList))
+	copy(oldKeyValueInfoList, oldInfo.KeyValueInfoList)
+	err = decryptKeyValueList(crdInfo.CredentialName, oldKeyValueInfoList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if crdInfo.ProviderName == "" {
+		crdInfo.ProviderName = oldInfo.ProviderName
+	}
+	crdInfo.KeyValueInfoList = im.MergeKeyValueList(oldKeyValueInfoList, crdInfo.KeyValueInfoList)
+
+	cblog.Debug("check params")
+	err = checkParams(crdInfo.CredentialName, crdInfo.ProviderName, crdInfo.KeyValueInfoList)
+	if err != nil {
+		return nil, err
+	}
+
+	return updateCredential(oldInfo, crdInfo)
+}
+
+// the caller should hold the rotateLock.
+func updateCredential(oldInfo *CredentialInfo, crdInfo CredentialInfo) (*CredentialInfo, error) {
+	credentialName := crdInfo.CredentialName
+	if !strings.EqualFold(oldInfo.ProviderName, crdInfo.ProviderName) {
+		return nil, fmt.Errorf("ProviderName of Credential(%s) can not be changed: %s => %s",
+			credentialName, oldInfo.ProviderName, crdInfo.ProviderName)
+	}
+
+	err := im.CheckInfoVersion(im.CredentialKind, credentialName, crdInfo.Version)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	err = encryptKeyValueList(credentialName, crdInfo.KeyValueInfoList)
+	if err != nil {
+		return nil, err
+	}
+
+	cblog.Debug("replace metainfo in store")
+
+	err = updateInfo(credentialName, oldInfo.ProviderName, oldInfo.KeyValueInfoList, crdInfo.KeyValueInfoList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	version, err := im.NextInfoVersion(im.CredentialKind, credentialName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// the secrets of the old references should be resolved again.
+	ClearSecretCache()
+
+	return &CredentialInfo{credentialName, oldInfo.ProviderName, crdInfo.KeyValueInfoList, version}, nil
+}
+
 func ListCredential() ([]*CredentialInfo, error) {
 	cblog.Info("call ListCredential()")
 
@@ -104,6 +214,7 @@ func GetCredential(credentialName string) (*CredentialInfo, error) {
 // 1. check params
 // 2. get CredentialInfo from cb-store
 // 3. decrypt CrednetialInfo
+// 4. resolve the secret references, ex) secret:env:AWS_SECRET
 func GetCredentialDecrypt(credentialName string) (*CredentialInfo, error) {
         cblog.Info("call GetCredential()")
 
@@ -117,77 +228,52 @@ func GetCredentialDecrypt(credentialName string) (*CredentialInfo, error) {
                 return nil, err
         }
 
-	err = decryptKeyValueList(crdInfo.KeyValueInfoList)	
+	err = decryptKeyValueList(credentialName, crdInfo.KeyValueInfoList)
+	if err != nil {
+		return &CredentialInfo{}, err
+	}
+	err = resolveKeyValueList(crdInfo.KeyValueInfoList)
 	if err != nil {
+		cblog.Error(err)
 		return &CredentialInfo{}, err
 	}
 	return crdInfo, nil
 }
 
-// @todo env by powerkim, 2020.06.01.
-var key = []byte("cloud-barista-cb-spider-cloud-ba") // 32 bytes
-
-func encryptKeyValueList(keyValueInfoList []icbs.KeyValue) error {
-
-        for i, kv := range keyValueInfoList {
-                encb, err := encrypt(key, []byte(kv.Value))
-                kv.Value = string(encb)
-                if err != nil {
-                        return err
-                }
-                keyValueInfoList[i] = kv
-        }
-        return  nil
-}
-
-func decryptKeyValueList(keyValueInfoList []icbs.KeyValue) error {
+// encrypt with the current master key
+func encryptKeyValueList(credentialName string, keyValueInfoList []icbs.KeyValue) error {
+	r, err := getKeyRing()
+	if err != nil {
+		return err
+	}
 
 	for i, kv := range keyValueInfoList {
-		decb, err := decrypt(key, []byte(kv.Value))
-		kv.Value = string(decb)
+		enc, err := encryptValue(r, credentialName, kv.Key, kv.Value)
 		if err != nil {
 			return err
 		}
+		kv.Value = enc
 		keyValueInfoList[i] = kv
 	}
-	return nil	
+	return nil
 }
 
-func encrypt(key, text []byte) ([]byte, error) {
-	block, err := aes.NewCipher(key)
+// decrypt with the master key of each KeyID prefix, or the legacy key
+func decryptKeyValueList(credentialName string, keyValueInfoList []icbs.KeyValue) error {
+	r, err := getKeyRing()
 	if err != nil {
-		return nil, err
+		return err
 	}
-	b := base64.StdEncoding.EncodeToString(text)
-	ciphertext := make([]byte, aes.BlockSize+len(b))
-	iv := ciphertext[:aes.BlockSize]
-	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
-		return nil, err
-	}
-	cfb := cipher.NewCFBEncrypter(block, iv)
-	cfb.XORKeyStream(ciphertext[aes.BlockSize:], []byte(b))
-	return ciphertext, nil
-}
 
-func decrypt(key, text []byte) ([]byte, error) {
-	block, err := aes.NewCipher(key)
-	if err != nil {
-		return nil, err
-	}
-	if len(text) < aes.BlockSize {
-		err := fmt.Errorf("decryption: " + "ciphertext too short")
-		cblog.Error(err)
-		return nil, err
-	}
-	iv := text[:aes.BlockSize]
-	text = text[aes.BlockSize:]
-	cfb := cipher.NewCFBDecrypter(block, iv)
-	cfb.XORKeyStream(text, text)
-	data, err := base64.StdEncoding.DecodeString(string(text))
-	if err != nil {
-		return nil, err
+	for i, kv := range keyValueInfoList {
+		plain, err := decryptValue(r, credentialName, kv.Key, kv.Value)
+		if err != nil {
+			return err
+		}
+		kv.Value = plain
+		keyValueInfoList[i] = kv
 	}
-	return data, nil
+	return nil
 }
 
 func UnRegisterCredential(credentialName string) (bool, error) {
@@ -197,6 +283,9 @@ func UnRegisterCredential(credentialName string) (bool, error) {
 		return false, fmt.Errorf("CredentialName is empty!")
 	}
 
+	rotateLock.Lock()
+	defer rotateLock.Unlock()
+
 	result, err := deleteInfo(credentialName)
 	if err != nil {
 		cblog.Error(err)
@@ -220,5 +309,6 @@ func checkParams(credentialName string, providerName string, keyValueInfoList []
 			return fmt.Errorf("Key is empty!")
 		}
 	}
-	return nil
+	// keys of the CloudOS schema, owned by the driver
+	return im.ValidateCredentialKeyValue(providerName, keyValueInfoList)
 }
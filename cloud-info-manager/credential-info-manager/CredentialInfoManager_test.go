@@ -0,0 +1,170 @@
+// Test of Cloud Credential Info. Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2019.09.
+
+package credentialinfomanager
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	im "github.com/cloud-barista/cb-spider/cloud-info-manager"
+	icbs "github.com/cloud-barista/cb-store/interfaces"
+)
+
+// a store which fails to put the keys with the suffix.
+type failPutStore struct {
+	icbs.Store
+	failKeySuffix string
+}
+
+func (s *failPutStore) Put(key string, value string) error {
+	if strings.HasSuffix(key, s.failKeySuffix) {
+		return fmt.Errorf("failed to put %s", key)
+	}
+	return s.Store.Put(key, value)
+}
+
+// use the master keys for the test, instead of the key file of the server.
+// the first key is the current key, a new key is generated if no key is given.
+func setTestKeyRing(t *testing.T, encodedKeys ...string) *keyRing {
+	t.Helper()
+	if len(encodedKeys) == 0 {
+		encodedKeys = []string{newTestKey(t)}
+	}
+	testRing := newTestKeyRing(t, encodedKeys...)
+
+	ringLock.Lock()
+	oldRing := ring
+	ring = testRing
+	ringLock.Unlock()
+	t.Cleanup(func() {
+		ringLock.Lock()
+		ring = oldRing
+		ringLock.Unlock()
+	})
+	return testRing
+}
+
+func newTestKey(t *testing.T) string {
+	t.Helper()
+	encodedKey, err := GenerateCredentialKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return encodedKey
+}
+
+func newTestKeyRing(t *testing.T, encodedKeys ...string) *keyRing {
+	t.Helper()
+	r := &keyRing{keyMap: map[string]*masterKey{}}
+	for _, encodedKey := range encodedKeys {
+		mk, err := newMasterKey(encodedKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.current == nil {
+			r.current = mk
+		}
+		r.keyMap[mk.id] = mk
+	}
+	return r
+}
+
+// the schema of the AWS driver, without the cloud-control-manager which loads the drivers.
+func setTestCloudOSSchema(t *testing.T) {
+	t.Helper()
+	im.SetCloudOSSchemaGetter(func(cloudOSName string) (*im.CloudOSSchema, error) {
+		if cloudOSName != "AWS" {
+			return nil, fmt.Errorf("%s: no schema for the test!", cloudOSName)
+		}
+		return &im.CloudOSSchema{
+			CloudOS: "AWS",
+			Credential: []im.KeySchema{
+				{Key: "ClientId", Required: true},
+				{Key: "ClientSecret", Required: true, Secret: true},
+			},
+		}, nil
+	})
+	t.Cleanup(func() { im.SetCloudOSSchemaGetter(nil) })
+}
+
+func TestUpdateCredentialPutFailure(t *testing.T) {
+	setTestKeyRing(t)
+	setTestCloudOSSchema(t)
+
+	credentialName := "update-test-" + strconv.FormatInt(time.Now().UnixNano(), 36)
+	crdInfo, err := RegisterCredential(credentialName, "AWS", []icbs.KeyValue{
+		{Key: "ClientId", Value: "id-v1"},
+		{Key: "ClientSecret", Value: "secret-v1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer UnRegisterCredential(credentialName)
+
+	// ClientId is put, and then ClientSecret fails.
+	realStore := store
+	store = &failPutStore{Store: realStore, failKeySuffix: "/ClientSecret"}
+	_, err = UpdateCredential(CredentialInfo{
+		CredentialName: credentialName,
+		ProviderName:   "AWS",
+		KeyValueInfoList: []icbs.KeyValue{
+			{Key: "ClientId", Value: "id-v2"},
+			{Key: "ClientSecret", Value: "secret-v2"},
+		},
+		Version: crdInfo.Version,
+	})
+	store = realStore
+	if err == nil {
+		t.Fatal("UpdateCredential() should fail.")
+	}
+
+	// the old values and the version are kept.
+	gotInfo, err := GetCredentialDecrypt(credentialName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{"ClientId": "id-v1", "ClientSecret": "secret-v1"}
+	if len(gotInfo.KeyValueInfoList) != len(expected) {
+		t.Errorf("KeyValueInfoList should be kept: %v", gotInfo.KeyValueInfoList)
+	}
+	for _, kv := range gotInfo.KeyValueInfoList {
+		if expected[kv.Key] != kv.Value {
+			t.Errorf("%s = %s, expected: %s", kv.Key, kv.Value, expected[kv.Key])
+		}
+	}
+	if gotInfo.Version != crdInfo.Version {
+		t.Errorf("Version = %d, expected: %d", gotInfo.Version, crdInfo.Version)
+	}
+
+	// the update succeeds with the same version.
+	_, err = UpdateCredential(CredentialInfo{
+		CredentialName: credentialName,
+		ProviderName:   "AWS",
+		KeyValueInfoList: []icbs.KeyValue{
+			{Key: "ClientId", Value: "id-v2"},
+			{Key: "ClientSecret", Value: "secret-v2"},
+		},
+		Version: crdInfo.Version,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotInfo, err = GetCredentialDecrypt(credentialName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, kv := range gotInfo.KeyValueInfoList {
+		if !strings.HasSuffix(kv.Value, "-v2") {
+			t.Errorf("%s = %s, expected: the value of v2", kv.Key, kv.Value)
+		}
+	}
+}
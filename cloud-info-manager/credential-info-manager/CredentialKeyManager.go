@@ -0,0 +1,405 @@
+// Master Key Manager for Cloud Credential Info. Manager.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package credentialinfomanager
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	icbs "github.com/cloud-barista/cb-store/interfaces"
+)
+
+// Master key settings
+//
+//	CBSPIDER_CREDENTIAL_KEY: base64 encoded 32 bytes key, the current key.
+//	CBSPIDER_CREDENTIAL_KEY_FILE: key file path, default: $CBSPIDER_ROOT/conf/credential.key
+//	  one base64 key per line, the first key is the current key and
+//	  the others are previous keys which are used only for decryption.
+//	  '#' starts a comment line.
+//	CBSPIDER_CREDENTIAL_KEY_AUTOGEN: true, generate a new key file if no key is set.
+//	  a new key can not decrypt the stored values, so the generation is refused
+//	  if any value is encrypted with a master key.
+//
+// If no key is set and the generation is not enabled, the loading fails.
+const (
+	credentialKeyEnv        string = "CBSPIDER_CREDENTIAL_KEY"
+	credentialKeyFileEnv    string = "CBSPIDER_CREDENTIAL_KEY_FILE"
+	credentialKeyAutoGenEnv string = "CBSPIDER_CREDENTIAL_KEY_AUTOGEN"
+	defaultKeyFileName      string = "conf/credential.key"
+)
+
+// stored value format: gcm:{KeyID}:{base64(nonce + sealed text)}
+const encPrefix string = "gcm:"
+
+// the hard-coded key of the AES-CFB format before the master key.
+// only used to decrypt the legacy values until they are rotated.
+var legacyKey = []byte("cloud-barista-cb-spider-cloud-ba") // 32 bytes
+
+type masterKey struct {
+	id   string // first 8 hex digits of sha256(key)
+	aead cipher.AEAD
+}
+
+type keyRing struct {
+	current *masterKey
+	keyMap  map[string]*masterKey // KeyID => key
+}
+
+var ring *keyRing
+var ringLock = new(sync.RWMutex)
+
+// locks the value rewrite of rotation against registration and update.
+var rotateLock = new(sync.Mutex)
+
+// (re)load the master keys from the env and the key file.
+// call this after changing the key file for an online rotation.
+func LoadCredentialKeys() error {
+	cblog.Info("call LoadCredentialKeys()")
+
+	newRing, err := loadKeyRing()
+	if err != nil {
+		cblog.Error(err)
+		return err
+	}
+
+	ringLock.Lock()
+	ring = newRing
+	ringLock.Unlock()
+
+	cblog.Infof("credential master key: current KeyID=%s, number of keys=%d", newRing.current.id, len(newRing.keyMap))
+	return nil
+}
+
+// the KeyID of the current master key
+func CurrentCredentialKeyID() (string, error) {
+	r, err := getKeyRing()
+	if err != nil {
+		return "", err
+	}
+	return r.current.id, nil
+}
+
+// generate a new base64 encoded 32 bytes key for the key file.
+func GenerateCredentialKey() (string, error) {
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// 1. list all credentials in cb-store
+// 2. decrypt the values which are not encrypted with the current key
+// 3. encrypt them with the current key and overwrite
+// returns the number of rotated values.
+func RotateCredentialKey() (int, error) {
+	cblog.Info("call RotateCredentialKey()")
+
+	r, err := getKeyRing()
+	if err != nil {
+		return 0, err
+	}
+
+	rotateLock.Lock()
+	defer rotateLock.Unlock()
+
+	credentialInfoList, err := listInfo()
+	if err != nil {
+		cblog.Error(err)
+		return 0, err
+	}
+
+	count := 0
+	for _, crdInfo := range credentialInfoList {
+		for _, kv := range crdInfo.KeyValueInfoList {
+			if keyIDOf(kv.Value) == r.current.id {
+				continue
+			}
+			plain, err := decryptValue(r, crdInfo.CredentialName, kv.Key, kv.Value)
+			if err != nil {
+				cblog.Error(err)
+				return count, fmt.Errorf("%s/%s: %v", crdInfo.CredentialName, kv.Key, err)
+			}
+			enc, err := encryptValue(r, crdInfo.CredentialName, kv.Key, plain)
+			if err != nil {
+				cblog.Error(err)
+				return count, err
+			}
+			err = insertInfo(crdInfo.CredentialName, crdInfo.ProviderName, []icbs.KeyValue{{Key: kv.Key, Value: enc}})
+			if err != nil {
+				cblog.Error(err)
+				return count, err
+			}
+			count++
+		}
+	}
+
+	cblog.Infof("%d credential values are rotated to KeyID=%s", count, r.current.id)
+	return count, nil
+}
+
+// number of stored values per KeyID, "legacy" for the AES-CFB values.
+// a previous key can be removed from the key file when it has no value.
+func CountCredentialKeyID() (map[string]int, error) {
+	credentialInfoList, err := listInfo()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	countMap := map[string]int{}
+	for _, crdInfo := range credentialInfoList {
+		for _, kv := range crdInfo.KeyValueInfoList {
+			keyID := keyIDOf(kv.Value)
+			if keyID == "" {
+				keyID = "legacy"
+			}
+			countMap[keyID]++
+		}
+	}
+	return countMap, nil
+}
+
+//----------------
+
+func getKeyRing() (*keyRing, error) {
+	ringLock.RLock()
+	r := ring
+	ringLock.RUnlock()
+	if r != nil {
+		return r, nil
+	}
+
+	// lazy loading at the first use
+	if err := LoadCredentialKeys(); err != nil {
+		return nil, err
+	}
+	ringLock.RLock()
+	defer ringLock.RUnlock()
+	return ring, nil
+}
+
+func keyFilePath() string {
+	if path := os.Getenv(credentialKeyFileEnv); path != "" {
+		return path
+	}
+	return filepath.Join(os.Getenv("CBSPIDER_ROOT"), defaultKeyFileName)
+}
+
+// 1. env key, as the current key
+// 2. keys of the key file
+// 3. if no key, generate the key file only if it is enabled and no value is encrypted
+func loadKeyRing() (*keyRing, error) {
+	var encodedKeys []string
+	if envKey := strings.TrimSpace(os.Getenv(credentialKeyEnv)); envKey != "" {
+		encodedKeys = append(encodedKeys, envKey)
+	}
+
+	path := keyFilePath()
+	fileKeys, err := readKeyFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	encodedKeys = append(encodedKeys, fileKeys...)
+
+	if len(encodedKeys) == 0 {
+		if !strings.EqualFold(os.Getenv(credentialKeyAutoGenEnv), "true") {
+			return nil, fmt.Errorf("no credential master key: set %s or the key file(%s), or set %s=true to generate a new key file!",
+				credentialKeyEnv, path, credentialKeyAutoGenEnv)
+		}
+		count, err := countEncryptedValue()
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			return nil, fmt.Errorf("no credential master key, but %d credential values are encrypted with a master key: set the key of them to %s or the key file(%s)!",
+				count, credentialKeyEnv, path)
+		}
+		newKey, err := createKeyFile(path)
+		if err != nil {
+			return nil, err
+		}
+		encodedKeys = append(encodedKeys, newKey)
+	}
+
+	r := &keyRing{keyMap: map[string]*masterKey{}}
+	for _, encodedKey := range encodedKeys {
+		mk, err := newMasterKey(encodedKey)
+		if err != nil {
+			return nil, err
+		}
+		if r.current == nil {
+			r.current = mk
+		}
+		r.keyMap[mk.id] = mk
+	}
+	return r, nil
+}
+
+// number of the stored values encrypted with any master key, the legacy values are not counted.
+func countEncryptedValue() (int, error) {
+	credentialInfoList, err := listInfo()
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, crdInfo := range credentialInfoList {
+		for _, kv := range crdInfo.KeyValueInfoList {
+			if keyIDOf(kv.Value) != "" {
+				count++
+			}
+		}
+	}
+	return count, nil
+}
+
+func readKeyFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var keys []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		keys = append(keys, line)
+	}
+	return keys, scanner.Err()
+}
+
+func createKeyFile(path string) (string, error) {
+	newKey, err := GenerateCredentialKey()
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return "", err
+	}
+	data := "# CB-Spider credential master keys, the first key is the current key.\n" + newKey + "\n"
+	if err := ioutil.WriteFile(path, []byte(data), 0600); err != nil {
+		return "", err
+	}
+	cblog.Warnf("no credential master key is set, so a new key file is generated by %s: %s", credentialKeyAutoGenEnv, path)
+	return newKey, nil
+}
+
+func newMasterKey(encodedKey string) (*masterKey, error) {
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("credential master key is not base64 encoded: %v", err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("credential master key must be 32 bytes, not %d bytes!", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(key)
+	return &masterKey{id: hex.EncodeToString(sum[:4]), aead: aead}, nil
+}
+
+// the stored value is bound to its credential and key name,
+// so a value can not be moved to another credential.
+func additionalData(credentialName string, key string) []byte {
+	return []byte(credentialName + "/" + key)
+}
+
+// returns "" for the legacy values
+func keyIDOf(value string) string {
+	if !strings.HasPrefix(value, encPrefix) {
+		return ""
+	}
+	fields := strings.SplitN(strings.TrimPrefix(value, encPrefix), ":", 2)
+	if len(fields) != 2 {
+		return ""
+	}
+	return fields[0]
+}
+
+func encryptValue(r *keyRing, credentialName string, key string, plain string) (string, error) {
+	nonce := make([]byte, r.current.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	sealed := r.current.aead.Seal(nonce, nonce, []byte(plain), additionalData(credentialName, key))
+	return encPrefix + r.current.id + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func decryptValue(r *keyRing, credentialName string, key string, value string) (string, error) {
+	if !strings.HasPrefix(value, encPrefix) {
+		plain, err := decryptLegacy(legacyKey, []byte(value))
+		if err != nil {
+			return "", err
+		}
+		return string(plain), nil
+	}
+
+	keyID := keyIDOf(value)
+	mk, ok := r.keyMap[keyID]
+	if !ok {
+		return "", fmt.Errorf("credential master key(KeyID=%s) is not loaded!", keyID)
+	}
+	sealed, err := base64.StdEncoding.DecodeString(value[len(encPrefix)+len(keyID)+1:])
+	if err != nil {
+		return "", err
+	}
+	nonceSize := mk.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		return "", fmt.Errorf("decryption: ciphertext too short")
+	}
+	plain, err := mk.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], additionalData(credentialName, key))
+	if err != nil {
+		return "", fmt.Errorf("decryption: %v", err)
+	}
+	return string(plain), nil
+}
+
+// AES-CFB format before the master key
+func decryptLegacy(key, text []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(text) < aes.BlockSize {
+		err := fmt.Errorf("decryption: " + "ciphertext too short")
+		cblog.Error(err)
+		return nil, err
+	}
+	iv := text[:aes.BlockSize]
+	text = text[aes.BlockSize:]
+	cfb := cipher.NewCFBDecrypter(block, iv)
+	cfb.XORKeyStream(text, text)
+	data, err := base64.StdEncoding.DecodeString(string(text))
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
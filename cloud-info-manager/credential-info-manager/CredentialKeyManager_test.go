@@ -0,0 +1,294 @@
+// Test of Master Key Manager for Cloud Credential Info. Manager.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package credentialinfomanager
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	icbs "github.com/cloud-barista/cb-store/interfaces"
+)
+
+// an in-memory store, so the test does not see the credentials of the server.
+type memStore struct {
+	kvMap map[string]string
+}
+
+func (s *memStore) InitDB() error   { return nil }
+func (s *memStore) InitData() error { return nil }
+
+func (s *memStore) Put(key string, value string) error {
+	s.kvMap[key] = value
+	return nil
+}
+
+func (s *memStore) Get(key string) (*icbs.KeyValue, error) {
+	value, ok := s.kvMap[key]
+	if !ok {
+		return nil, nil
+	}
+	return &icbs.KeyValue{Key: key, Value: value}, nil
+}
+
+func (s *memStore) GetList(key string, sortAscend bool) ([]*icbs.KeyValue, error) {
+	keyList := []string{}
+	for k := range s.kvMap {
+		if strings.HasPrefix(k, key) {
+			keyList = append(keyList, k)
+		}
+	}
+	sort.Strings(keyList)
+	kvList := []*icbs.KeyValue{}
+	for _, k := range keyList {
+		kvList = append(kvList, &icbs.KeyValue{Key: k, Value: s.kvMap[k]})
+	}
+	return kvList, nil
+}
+
+func (s *memStore) Delete(key string) error {
+	delete(s.kvMap, key)
+	return nil
+}
+
+func setMemStore(t *testing.T) {
+	realStore := store
+	store = &memStore{kvMap: map[string]string{}}
+	t.Cleanup(func() { store = realStore })
+}
+
+// AES-CFB format before the master key
+func encryptLegacy(t *testing.T, key []byte, plain string) string {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := base64.StdEncoding.EncodeToString([]byte(plain))
+	text := make([]byte, aes.BlockSize+len(b))
+	iv := text[:aes.BlockSize]
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		t.Fatal(err)
+	}
+	cipher.NewCFBEncrypter(block, iv).XORKeyStream(text[aes.BlockSize:], []byte(b))
+	return string(text)
+}
+
+func TestEncryptDecryptValue(t *testing.T) {
+	r := newTestKeyRing(t, newTestKey(t))
+	legacyValue := encryptLegacy(t, legacyKey, "legacy-secret")
+
+	enc, err := encryptValue(r, "aws-credential01", "ClientSecret", "aws-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(enc, encPrefix+r.current.id+":") {
+		t.Errorf("%s should start with %s", enc, encPrefix+r.current.id+":")
+	}
+	if strings.Contains(enc, "aws-secret") {
+		t.Errorf("%s has the plain text", enc)
+	}
+	enc2, err := encryptValue(r, "aws-credential01", "ClientSecret", "aws-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enc == enc2 {
+		t.Error("the nonce should be different for each encryption.")
+	}
+
+	testList := []struct {
+		name           string
+		credentialName string
+		key            string
+		value          string
+		expected       string
+		expectErr      bool
+	}{
+		{"round trip", "aws-credential01", "ClientSecret", enc, "aws-secret", false},
+		{"other credential", "aws-credential02", "ClientSecret", enc, "", true},
+		{"other key", "aws-credential01", "ClientId", enc, "", true},
+		{"moved credential", "aws-credential011", "ClientSecret", enc, "", true},
+		{"legacy", "aws-credential01", "ClientSecret", legacyValue, "legacy-secret", false},
+		{"legacy too short", "aws-credential01", "ClientSecret", "short", "", true},
+		{"tampered", "aws-credential01", "ClientSecret", enc[:len(enc)-4] + "AAA=", "", true},
+		{"unknown KeyID", "aws-credential01", "ClientSecret", encPrefix + "00000000:" + enc[len(encPrefix)+len(r.current.id)+1:], "", true},
+	}
+	for _, tc := range testList {
+		t.Run(tc.name, func(t *testing.T) {
+			plain, err := decryptValue(r, tc.credentialName, tc.key, tc.value)
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("decryptValue() should fail, but returns %s", plain)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if plain != tc.expected {
+				t.Errorf("decryptValue() = %s, expected: %s", plain, tc.expected)
+			}
+		})
+	}
+}
+
+func TestDecryptUnknownKeyID(t *testing.T) {
+	oldRing := newTestKeyRing(t, newTestKey(t))
+	newRing := newTestKeyRing(t, newTestKey(t))
+
+	enc, err := encryptValue(oldRing, "aws-credential01", "ClientSecret", "aws-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = decryptValue(newRing, "aws-credential01", "ClientSecret", enc)
+	if err == nil || !strings.Contains(err.Error(), oldRing.current.id) {
+		t.Errorf("the error should have the unknown KeyID(%s): %v", oldRing.current.id, err)
+	}
+}
+
+func TestRotateCredentialKey(t *testing.T) {
+	setMemStore(t)
+	oldKey, newKey := newTestKey(t), newTestKey(t)
+	oldRing := setTestKeyRing(t, oldKey)
+
+	// a value of the old key and a legacy value
+	enc, err := encryptValue(oldRing, "aws-credential01", "ClientSecret", "aws-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = insertInfo("aws-credential01", "AWS", []icbs.KeyValue{
+		{Key: "ClientId", Value: encryptLegacy(t, legacyKey, "aws-id")},
+		{Key: "ClientSecret", Value: enc},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the new key is the current key, and the old key is kept for the decryption.
+	newRing := setTestKeyRing(t, newKey, oldKey)
+	count, err := RotateCredentialKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("2 values should be rotated, but %d values are rotated.", count)
+	}
+	countMap, err := CountCredentialKeyID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(countMap) != 1 || countMap[newRing.current.id] != 2 {
+		t.Errorf("all values should be encrypted with the new key: %v", countMap)
+	}
+
+	// the old key can be removed after the rotation.
+	setTestKeyRing(t, newKey)
+	crdInfo, err := GetCredentialDecrypt("aws-credential01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{"ClientId": "aws-id", "ClientSecret": "aws-secret"}
+	for _, kv := range crdInfo.KeyValueInfoList {
+		if expected[kv.Key] != kv.Value {
+			t.Errorf("%s = %s, expected: %s", kv.Key, kv.Value, expected[kv.Key])
+		}
+	}
+
+	count, err = RotateCredentialKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Errorf("no value should be rotated again, but %d values are rotated.", count)
+	}
+}
+
+func TestLoadKeyRingAutoGen(t *testing.T) {
+	setMemStore(t)
+	keyFile := filepath.Join(t.TempDir(), "credential.key")
+	t.Setenv(credentialKeyEnv, "")
+	t.Setenv(credentialKeyFileEnv, keyFile)
+
+	t.Run("not enabled", func(t *testing.T) {
+		t.Setenv(credentialKeyAutoGenEnv, "")
+		if _, err := loadKeyRing(); err == nil {
+			t.Error("loadKeyRing() should fail without a key.")
+		}
+		if _, err := os.Stat(keyFile); !os.IsNotExist(err) {
+			t.Errorf("the key file should not be generated: %v", err)
+		}
+	})
+
+	t.Run("encrypted values exist", func(t *testing.T) {
+		t.Setenv(credentialKeyAutoGenEnv, "true")
+		enc, err := encryptValue(newTestKeyRing(t, newTestKey(t)), "aws-credential01", "ClientSecret", "aws-secret")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := insertInfo("aws-credential01", "AWS", []icbs.KeyValue{{Key: "ClientSecret", Value: enc}}); err != nil {
+			t.Fatal(err)
+		}
+		defer deleteInfo("aws-credential01")
+
+		if _, err := loadKeyRing(); err == nil {
+			t.Error("loadKeyRing() should fail, because a new key can not decrypt the values.")
+		}
+		if _, err := os.Stat(keyFile); !os.IsNotExist(err) {
+			t.Errorf("the key file should not be generated: %v", err)
+		}
+	})
+
+	t.Run("generated", func(t *testing.T) {
+		t.Setenv(credentialKeyAutoGenEnv, "true")
+		if err := insertInfo("aws-credential01", "AWS", []icbs.KeyValue{{Key: "ClientSecret", Value: encryptLegacy(t, legacyKey, "aws-secret")}}); err != nil {
+			t.Fatal(err)
+		}
+		defer deleteInfo("aws-credential01")
+
+		r, err := loadKeyRing()
+		if err != nil {
+			t.Fatal(err)
+		}
+		info, err := os.Stat(keyFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Mode().Perm() != 0600 {
+			t.Errorf("the mode of the key file is %v", info.Mode().Perm())
+		}
+
+		// the generated key is loaded from the file.
+		t.Setenv(credentialKeyAutoGenEnv, "")
+		r2, err := loadKeyRing()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.current.id != r2.current.id {
+			t.Errorf("KeyID = %s, expected: %s", r2.current.id, r.current.id)
+		}
+	})
+
+	t.Run("env key", func(t *testing.T) {
+		envKey := newTestKey(t)
+		t.Setenv(credentialKeyEnv, envKey)
+		r, err := loadKeyRing()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.current.id != newTestKeyRing(t, envKey).current.id || len(r.keyMap) != 2 {
+			t.Errorf("the env key should be the current key, and the key of the file should be kept: %v", r.keyMap)
+		}
+	})
+}
@@ -11,6 +11,7 @@ package credentialinfomanager
 
 import (
 	"fmt"
+	im "github.com/cloud-barista/cb-spider/cloud-info-manager"
 	"github.com/cloud-barista/cb-store/utils"
 	"github.com/cloud-barista/cb-store"
 	icbs "github.com/cloud-barista/cb-store/interfaces"
@@ -69,6 +70,58 @@ func insertInfo(credentialName string, providerName string, keyValueList []icbs.
 	return nil
 }
 
+// replace the key/values without deleting the info first,
+// so the old key/values are kept if the update fails.
+// 1. put the new key/values, the old values are restored if a put fails.
+// 2. delete the removed keys.
+func updateInfo(credentialName string, providerName string, oldKeyValueList []icbs.KeyValue, keyValueList []icbs.KeyValue) error {
+	format := "/cloud-info-spaces/credentials/" + credentialName + "/" + providerName
+	for i, kv := range keyValueList {
+		err := store.Put(format+"/"+kv.Key, kv.Value)
+		if err != nil {
+			if err2 := restoreInfo(format, oldKeyValueList, keyValueList[:i]); err2 != nil {
+				return fmt.Errorf(err.Error() + ", " + err2.Error())
+			}
+			return err
+		}
+	}
+
+	for _, kv := range oldKeyValueList {
+		if getValue(keyValueList, kv.Key) == nil {
+			err := store.Delete(format + "/" + kv.Key)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// rollback the put key/values to the old values.
+func restoreInfo(format string, oldKeyValueList []icbs.KeyValue, putKeyValueList []icbs.KeyValue) error {
+	for _, kv := range putKeyValueList {
+		var err error
+		if old := getValue(oldKeyValueList, kv.Key); old != nil {
+			err = store.Put(format+"/"+kv.Key, *old)
+		} else {
+			err = store.Delete(format + "/" + kv.Key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getValue(keyValueList []icbs.KeyValue, key string) *string {
+	for i := range keyValueList {
+		if keyValueList[i].Key == key {
+			return &keyValueList[i].Value
+		}
+	}
+	return nil
+}
+
 // 1. get key-value list
 // 2. create CredentialInfo List & return
 func listInfo() ([]*CredentialInfo, error) {
@@ -84,6 +137,11 @@ func listInfo() ([]*CredentialInfo, error) {
                 return nil, err
         }
 
+	versionMap, err := im.ListInfoVersion(im.CredentialKind)
+	if err != nil {
+		return nil, err
+	}
+
         var credentialInfoList []*CredentialInfo
 	var inKeyValueList []icbs.KeyValue
 	prevCredName := ""
@@ -100,7 +158,7 @@ func listInfo() ([]*CredentialInfo, error) {
 			inKeyValueList = append(inKeyValueList, keyValue)
 		} else {
 			// insert prev CredentialInfo
-			crdInfo := &CredentialInfo{prevCredName, prevProviderName, inKeyValueList}
+			crdInfo := &CredentialInfo{prevCredName, prevProviderName, inKeyValueList, versionMap.Version(prevCredName)}
 			credentialInfoList = append(credentialInfoList, crdInfo)
 
 			prevCredName = credName
@@ -114,7 +172,7 @@ func listInfo() ([]*CredentialInfo, error) {
 
 	if len(keyValueList) > 0 {
 		// insert last CredentialInfo
-		crdInfo := &CredentialInfo{prevCredName, prevProviderName, inKeyValueList}
+		crdInfo := &CredentialInfo{prevCredName, prevProviderName, inKeyValueList, versionMap.Version(prevCredName)}
 		credentialInfoList = append(credentialInfoList, crdInfo)
 	}
 
@@ -164,7 +222,11 @@ func getInfo(credentialName string) (*CredentialInfo, error) {
 		keyValue := icbs.KeyValue{utils.GetNodeValue(kv.Key, 5), kv.Value}
 		inKeyValueList = append(inKeyValueList, keyValue)
 	}
-	return &CredentialInfo{credentialName, providerName, inKeyValueList}, nil 
+	version, err := im.GetInfoVersion(im.CredentialKind, credentialName)
+	if err != nil {
+		return nil, err
+	}
+	return &CredentialInfo{credentialName, providerName, inKeyValueList, version}, nil
 }
 
 // 1. get the original Key.
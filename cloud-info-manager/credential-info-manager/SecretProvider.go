@@ -0,0 +1,384 @@
+// Secret Providers for Cloud Credential Info. Manager.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package credentialinfomanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	icbs "github.com/cloud-barista/cb-store/interfaces"
+)
+
+// A credential value can be a reference to an external secret,
+// which is resolved at GetCredentialDecrypt() time.
+// A reference must start with the "secret:" marker, the other values are used as they are,
+// ex) "env:AWS_SECRET" or "http://192.168.0.1:5000/v3" is not a reference.
+//
+//	secret:env:AWS_SECRET                                   => value of the env var
+//	secret:file:/run/secrets/aws_secret                     => content of the file
+//	secret:file:/run/secrets/gcp.json#private_key           => field of the JSON file
+//	secret:https://store.local/v1/aws#data.secret           => field of the JSON response
+//
+// The providers are disabled by default, the admin of the server enables them with the env vars:
+//
+//	CBSPIDER_SECRET_PROVIDERS:      enabled schemes, ex) env,file,https ("http" must be listed explicitly)
+//	CBSPIDER_SECRET_ENV_ALLOWLIST:  env var names, "*" at the end matches a prefix, ex) AWS_*,GCP_KEY
+//	CBSPIDER_SECRET_FILE_ALLOWLIST: directories of the secret files, ex) /run/secrets
+//	CBSPIDER_SECRET_URL_ALLOWLIST:  URL prefixes of the secret stores, ex) https://store.local/v1/
+type SecretProvider interface {
+	// ref is the reference without "secret:{scheme}:"
+	GetSecret(ref string) (string, error)
+}
+
+const secretMarker string = "secret:"
+
+const secretProvidersEnv string = "CBSPIDER_SECRET_PROVIDERS"
+const secretEnvAllowListEnv string = "CBSPIDER_SECRET_ENV_ALLOWLIST"
+const secretFileAllowListEnv string = "CBSPIDER_SECRET_FILE_ALLOWLIST"
+const secretURLAllowListEnv string = "CBSPIDER_SECRET_URL_ALLOWLIST"
+
+// Secret cache TTL in seconds, default: 300, 0: no cache
+const secretCacheTTLEnv string = "CBSPIDER_SECRET_CACHE_TTL"
+const defaultSecretCacheTTL = 300 * time.Second
+
+var secretProviderMap = map[string]SecretProvider{}
+var secretProviderLock = new(sync.RWMutex)
+
+type cachedSecret struct {
+	value     string
+	expiredAt time.Time
+}
+
+var secretCacheMap = map[string]cachedSecret{}
+var secretCacheLock = new(sync.Mutex)
+
+func init() {
+	RegisterSecretProvider("env", &EnvSecretProvider{})
+	RegisterSecretProvider("file", &FileSecretProvider{})
+	RegisterSecretProvider("http", &HTTPSecretProvider{Scheme: "http"})
+	RegisterSecretProvider("https", &HTTPSecretProvider{Scheme: "https"})
+}
+
+// register or replace the provider of the scheme.
+// the provider is used only if the scheme is in CBSPIDER_SECRET_PROVIDERS.
+func RegisterSecretProvider(scheme string, provider SecretProvider) {
+	secretProviderLock.Lock()
+	defer secretProviderLock.Unlock()
+	secretProviderMap[scheme] = provider
+}
+
+// true if the value has the "secret:" marker.
+func IsSecretReference(value string) bool {
+	return strings.HasPrefix(value, secretMarker)
+}
+
+// resolve the reference, or return the value as it is.
+func ResolveSecret(value string) (string, error) {
+	if !IsSecretReference(value) {
+		return value, nil
+	}
+
+	provider, ref, err := getSecretProvider(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve the secret reference(%s): %v", value, err)
+	}
+
+	if secret, ok := getCachedSecret(value); ok {
+		return secret, nil
+	}
+
+	secret, err := provider.GetSecret(ref)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve the secret reference(%s): %v", value, err)
+	}
+	putCachedSecret(value, secret)
+	return secret, nil
+}
+
+// drop all cached secrets, ex) after the secrets are rotated in the secret store.
+func ClearSecretCache() {
+	secretCacheLock.Lock()
+	defer secretCacheLock.Unlock()
+	secretCacheMap = map[string]cachedSecret{}
+}
+
+//---------------- env
+
+// the env vars of CB-Spider itself, ex) CBSPIDER_CREDENTIAL_KEY, can not be read.
+type EnvSecretProvider struct{}
+
+func (provider *EnvSecretProvider) GetSecret(ref string) (string, error) {
+	if strings.HasPrefix(ref, "CBSPIDER_") || !matchAllowList(secretEnvAllowListEnv, ref, matchEnvName) {
+		return "", fmt.Errorf("env %s is not allowed by %s!", ref, secretEnvAllowListEnv)
+	}
+	value, ok := os.LookupEnv(ref)
+	if !ok {
+		return "", fmt.Errorf("env %s is not set!", ref)
+	}
+	return value, nil
+}
+
+func matchEnvName(pattern string, name string) bool {
+	if strings.HasSuffix(pattern, "*") {
+		return strings.HasPrefix(name, strings.TrimSuffix(pattern, "*"))
+	}
+	return pattern == name
+}
+
+//---------------- file
+
+// reads a local file, ex) docker or k8s secret volumes, test fixtures.
+// the trailing newline is removed.
+type FileSecretProvider struct{}
+
+func (provider *FileSecretProvider) GetSecret(ref string) (string, error) {
+	path, field := splitSecretField(ref)
+	if !filepath.IsAbs(path) {
+		return "", fmt.Errorf("%s is not an absolute path!", path)
+	}
+	// the links are followed before the check, so a link can not point out of the directories.
+	realPath, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", err
+	}
+	if !matchAllowList(secretFileAllowListEnv, realPath, matchFileDir) {
+		return "", fmt.Errorf("file %s is not allowed by %s!", path, secretFileAllowListEnv)
+	}
+
+	data, err := ioutil.ReadFile(realPath)
+	if err != nil {
+		return "", err
+	}
+	if field != "" {
+		return getJSONField(data, field)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+func matchFileDir(dir string, path string) bool {
+	if !filepath.IsAbs(dir) {
+		return false
+	}
+	if realDir, err := filepath.EvalSymlinks(dir); err == nil {
+		dir = realDir
+	}
+	rel, err := filepath.Rel(filepath.Clean(dir), path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+//---------------- http
+
+// GET the URL of a secret store in CBSPIDER_SECRET_URL_ALLOWLIST.
+//
+//	CBSPIDER_SECRET_HTTP_TOKEN: sent as "Authorization: Bearer {token}", if it is set.
+type HTTPSecretProvider struct {
+	Scheme string // http | https
+}
+
+const secretHTTPTokenEnv string = "CBSPIDER_SECRET_HTTP_TOKEN"
+
+// the redirects are not followed, because the target may not be in the allowlist.
+var secretHTTPClient = &http.Client{
+	Timeout: 10 * time.Second,
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+func (provider *HTTPSecretProvider) GetSecret(ref string) (string, error) {
+	strURL, field := splitSecretField(provider.Scheme + ":" + ref)
+	reqURL, err := url.Parse(strURL)
+	if err != nil {
+		return "", err
+	}
+	if reqURL.Scheme != provider.Scheme || reqURL.Host == "" || reqURL.User != nil {
+		return "", fmt.Errorf("%s is not a valid URL of a secret store!", strURL)
+	}
+	// "/v1/../admin" => "/admin", before the check
+	if reqURL.Path != "" {
+		reqURL.Path = path.Clean(reqURL.Path)
+		reqURL.RawPath = ""
+	}
+	if !matchAllowList(secretURLAllowListEnv, reqURL.String(), matchURLPrefix) {
+		return "", fmt.Errorf("URL %s is not allowed by %s!", strURL, secretURLAllowListEnv)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, reqURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	// the token is sent only to the secret stores in the allowlist.
+	if token := os.Getenv(secretHTTPTokenEnv); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := secretHTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("secret store returned %s", resp.Status)
+	}
+	if field != "" {
+		return getJSONField(data, field)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+func matchURLPrefix(prefix string, strURL string) bool {
+	allowedURL, err := url.Parse(prefix)
+	if err != nil || allowedURL.Host == "" {
+		return false
+	}
+	reqURL, err := url.Parse(strURL)
+	if err != nil {
+		return false
+	}
+	if reqURL.Scheme != allowedURL.Scheme || reqURL.Host != allowedURL.Host {
+		return false
+	}
+	dir := strings.TrimSuffix(allowedURL.Path, "/")
+	return dir == "" || reqURL.Path == dir || strings.HasPrefix(reqURL.Path, dir+"/")
+}
+
+//----------------
+
+// resolve the secret references in the decrypted list.
+func resolveKeyValueList(keyValueInfoList []icbs.KeyValue) error {
+	for i, kv := range keyValueInfoList {
+		secret, err := ResolveSecret(kv.Value)
+		if err != nil {
+			return err
+		}
+		kv.Value = secret
+		keyValueInfoList[i] = kv
+	}
+	return nil
+}
+
+// "secret:{scheme}:{ref}" => provider of the scheme, ref
+func getSecretProvider(value string) (SecretProvider, string, error) {
+	value = strings.TrimPrefix(value, secretMarker)
+	idx := strings.Index(value, ":")
+	if idx <= 0 {
+		return nil, "", fmt.Errorf("the scheme is empty!")
+	}
+	scheme := value[:idx]
+
+	secretProviderLock.RLock()
+	provider, ok := secretProviderMap[scheme]
+	secretProviderLock.RUnlock()
+	if !ok {
+		return nil, "", fmt.Errorf("%s is not a scheme of the secret providers!", scheme)
+	}
+	if !matchAllowList(secretProvidersEnv, scheme, func(allowed string, scheme string) bool { return allowed == scheme }) {
+		return nil, "", fmt.Errorf("the %s secret provider is not enabled by %s!", scheme, secretProvidersEnv)
+	}
+	return provider, value[idx+1:], nil
+}
+
+// true if one of the comma separated items of the env var matches the value.
+// nothing is allowed if the env var is not set.
+func matchAllowList(envName string, value string, match func(item string, value string) bool) bool {
+	for _, item := range strings.Split(os.Getenv(envName), ",") {
+		item = strings.TrimSpace(item)
+		if item != "" && match(item, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func secretCacheTTL() time.Duration {
+	strTTL := os.Getenv(secretCacheTTLEnv)
+	if strTTL == "" {
+		return defaultSecretCacheTTL
+	}
+	ttl, err := strconv.Atoi(strTTL)
+	if err != nil || ttl < 0 {
+		cblog.Errorf("%s=%s is not valid, so %s is used.", secretCacheTTLEnv, strTTL, defaultSecretCacheTTL)
+		return defaultSecretCacheTTL
+	}
+	return time.Duration(ttl) * time.Second
+}
+
+func getCachedSecret(value string) (string, bool) {
+	secretCacheLock.Lock()
+	defer secretCacheLock.Unlock()
+	cached, ok := secretCacheMap[value]
+	if !ok || time.Now().After(cached.expiredAt) {
+		return "", false
+	}
+	return cached.value, true
+}
+
+func putCachedSecret(value string, secret string) {
+	ttl := secretCacheTTL()
+	if ttl == 0 {
+		return
+	}
+	secretCacheLock.Lock()
+	defer secretCacheLock.Unlock()
+	secretCacheMap[value] = cachedSecret{value: secret, expiredAt: time.Now().Add(ttl)}
+}
+
+// "path#a.b" => "path", "a.b"
+func splitSecretField(ref string) (string, string) {
+	idx := strings.LastIndex(ref, "#")
+	if idx < 0 {
+		return ref, ""
+	}
+	return ref[:idx], ref[idx+1:]
+}
+
+// the field path is dot separated, ex) data.data.secret
+func getJSONField(data []byte, field string) (string, error) {
+	var obj interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return "", err
+	}
+	for _, name := range strings.Split(field, ".") {
+		objMap, ok := obj.(map[string]interface{})
+		if !ok {
+			return "", fmt.Errorf("%s is not a field of the JSON secret!", field)
+		}
+		obj, ok = objMap[name]
+		if !ok {
+			return "", fmt.Errorf("%s is not a field of the JSON secret!", field)
+		}
+	}
+	if str, ok := obj.(string); ok {
+		return str, nil
+	}
+	// object or number, ex) a whole service account JSON
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
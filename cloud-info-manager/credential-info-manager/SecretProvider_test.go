@@ -0,0 +1,231 @@
+// Test of Secret Providers for Cloud Credential Info. Manager.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package credentialinfomanager
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+type secretTestCase struct {
+	name      string
+	value     string
+	expected  string
+	expectErr bool
+}
+
+func runSecretTestCases(t *testing.T, testList []secretTestCase) {
+	t.Helper()
+	for _, tc := range testList {
+		t.Run(tc.name, func(t *testing.T) {
+			ClearSecretCache()
+			secret, err := ResolveSecret(tc.value)
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("ResolveSecret(%s) should fail, but returns %s", tc.value, secret)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if secret != tc.expected {
+				t.Errorf("ResolveSecret(%s) = %s, expected: %s", tc.value, secret, tc.expected)
+			}
+		})
+	}
+}
+
+func TestResolveSecretLiteral(t *testing.T) {
+	t.Setenv(secretProvidersEnv, "env,file,http,https")
+	t.Setenv(secretEnvAllowListEnv, "*")
+	t.Setenv("SECRET_TEST_LITERAL", "secret-value")
+
+	// only the values with the marker are references.
+	runSecretTestCases(t, []secretTestCase{
+		{"plain value", "plain-value", "plain-value", false},
+		{"env without marker", "env:SECRET_TEST_LITERAL", "env:SECRET_TEST_LITERAL", false},
+		{"file without marker", "file:/etc/hostname", "file:/etc/hostname", false},
+		{"URL without marker", "http://192.168.0.1:5000/v3", "http://192.168.0.1:5000/v3", false},
+		{"unknown scheme", "secret:ftp://store.local/aws", "", true},
+		{"empty scheme", "secret::SECRET_TEST_LITERAL", "", true},
+		{"no scheme", "secret:SECRET_TEST_LITERAL", "", true},
+	})
+}
+
+func TestEnvSecretProvider(t *testing.T) {
+	t.Setenv(secretProvidersEnv, "env")
+	t.Setenv(secretEnvAllowListEnv, "SECRET_TEST_*, OTHER_SECRET")
+	t.Setenv("SECRET_TEST_AWS", "aws-secret")
+	t.Setenv("OTHER_SECRET", "other-secret")
+	t.Setenv("NOT_ALLOWED_SECRET", "not-allowed")
+	t.Setenv("CBSPIDER_SECRET_TEST", "spider-secret")
+
+	runSecretTestCases(t, []secretTestCase{
+		{"prefix", "secret:env:SECRET_TEST_AWS", "aws-secret", false},
+		{"name", "secret:env:OTHER_SECRET", "other-secret", false},
+		{"not set", "secret:env:SECRET_TEST_NONE", "", true},
+		{"not in allowlist", "secret:env:NOT_ALLOWED_SECRET", "", true},
+		{"env of spider", "secret:env:CBSPIDER_SECRET_TEST", "", true},
+	})
+
+	t.Run("env of spider with wildcard", func(t *testing.T) {
+		t.Setenv(secretEnvAllowListEnv, "*")
+		if _, err := ResolveSecret("secret:env:CBSPIDER_SECRET_TEST"); err == nil {
+			t.Error("the env vars of CB-Spider should not be read.")
+		}
+	})
+
+	t.Run("provider is not enabled", func(t *testing.T) {
+		t.Setenv(secretProvidersEnv, "file")
+		ClearSecretCache()
+		if _, err := ResolveSecret("secret:env:SECRET_TEST_AWS"); err == nil {
+			t.Error("the env provider should be disabled.")
+		}
+	})
+}
+
+func TestFileSecretProvider(t *testing.T) {
+	secretDir := t.TempDir()
+	otherDir := t.TempDir()
+	writeFile := func(path string, data string) {
+		if err := ioutil.WriteFile(path, []byte(data), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile(filepath.Join(secretDir, "aws_secret"), "aws-secret\n")
+	writeFile(filepath.Join(secretDir, "gcp.json"), `{"private_key": "gcp-key", "data": {"secret": "nested", "port": 443}}`)
+	writeFile(filepath.Join(otherDir, "other_secret"), "other-secret")
+	if err := os.Symlink(filepath.Join(otherDir, "other_secret"), filepath.Join(secretDir, "link")); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(secretProvidersEnv, "file")
+	t.Setenv(secretFileAllowListEnv, secretDir)
+
+	runSecretTestCases(t, []secretTestCase{
+		{"trailing newline", "secret:file:" + secretDir + "/aws_secret", "aws-secret", false},
+		{"JSON field", "secret:file:" + secretDir + "/gcp.json#private_key", "gcp-key", false},
+		{"nested JSON field", "secret:file:" + secretDir + "/gcp.json#data.secret", "nested", false},
+		{"JSON object", "secret:file:" + secretDir + "/gcp.json#data", `{"port":443,"secret":"nested"}`, false},
+		{"no JSON field", "secret:file:" + secretDir + "/gcp.json#client_email", "", true},
+		{"not a JSON file", "secret:file:" + secretDir + "/aws_secret#key", "", true},
+		{"no file", "secret:file:" + secretDir + "/none", "", true},
+		{"relative path", "secret:file:aws_secret", "", true},
+		{"not in allowlist", "secret:file:" + otherDir + "/other_secret", "", true},
+		{"dot dot", "secret:file:" + secretDir + "/../" + filepath.Base(otherDir) + "/other_secret", "", true},
+		{"link out of allowlist", "secret:file:" + secretDir + "/link", "", true},
+		{"system file", "secret:file:/etc/hostname", "", true},
+	})
+}
+
+func TestHTTPSecretProvider(t *testing.T) {
+	var authList []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authList = append(authList, r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/v1/aws":
+			w.Write([]byte(`{"data": {"secret": "aws-secret"}}`))
+		case "/v1/plain":
+			w.Write([]byte("plain-secret\n"))
+		case "/v1/redirect":
+			http.Redirect(w, r, "/admin/secret", http.StatusFound)
+		case "/admin/secret":
+			w.Write([]byte("admin-secret"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv(secretProvidersEnv, "http")
+	t.Setenv(secretURLAllowListEnv, server.URL+"/v1/")
+	t.Setenv(secretHTTPTokenEnv, "store-token")
+
+	runSecretTestCases(t, []secretTestCase{
+		{"JSON field", "secret:" + server.URL + "/v1/aws#data.secret", "aws-secret", false},
+		{"plain", "secret:" + server.URL + "/v1/plain", "plain-secret", false},
+		{"not found", "secret:" + server.URL + "/v1/none", "", true},
+		{"not in allowlist", "secret:" + server.URL + "/admin/secret", "", true},
+		{"dot dot", "secret:" + server.URL + "/v1/../admin/secret", "", true},
+		{"redirect", "secret:" + server.URL + "/v1/redirect", "", true},
+		{"other host", "secret:http://127.0.0.2:1/v1/aws", "", true},
+		{"user info", "secret:" + strings.Replace(server.URL, "://", "://user@", 1) + "/v1/plain", "", true},
+	})
+
+	// the token is sent only to the allowed URLs, and the redirect is not followed.
+	if len(authList) != 4 {
+		t.Errorf("4 requests should be sent, but %d requests are sent.", len(authList))
+	}
+	for _, auth := range authList {
+		if auth != "Bearer store-token" {
+			t.Errorf("Authorization is %s", auth)
+		}
+	}
+
+	t.Run("http is not enabled", func(t *testing.T) {
+		t.Setenv(secretProvidersEnv, "https")
+		ClearSecretCache()
+		if _, err := ResolveSecret("secret:" + server.URL + "/v1/plain"); err == nil {
+			t.Error("the http provider should be enabled explicitly.")
+		}
+	})
+}
+
+func TestSecretCache(t *testing.T) {
+	t.Setenv(secretProvidersEnv, "env")
+	t.Setenv(secretEnvAllowListEnv, "SECRET_TEST_CACHE")
+	t.Setenv("SECRET_TEST_CACHE", "v1")
+	ref := "secret:env:SECRET_TEST_CACHE"
+
+	resolve := func(expected string) {
+		t.Helper()
+		secret, err := ResolveSecret(ref)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if secret != expected {
+			t.Errorf("ResolveSecret(%s) = %s, expected: %s", ref, secret, expected)
+		}
+	}
+
+	ClearSecretCache()
+	resolve("v1")
+
+	// cached until the TTL
+	os.Setenv("SECRET_TEST_CACHE", "v2")
+	resolve("v1")
+
+	// expired
+	secretCacheLock.Lock()
+	cached := secretCacheMap[ref]
+	cached.expiredAt = time.Now().Add(-time.Second)
+	secretCacheMap[ref] = cached
+	secretCacheLock.Unlock()
+	resolve("v2")
+
+	// cleared
+	os.Setenv("SECRET_TEST_CACHE", "v3")
+	resolve("v2")
+	ClearSecretCache()
+	resolve("v3")
+
+	// no cache
+	t.Setenv(secretCacheTTLEnv, "0")
+	ClearSecretCache()
+	resolve("v3")
+	os.Setenv("SECRET_TEST_CACHE", "v4")
+	resolve("v4")
+}
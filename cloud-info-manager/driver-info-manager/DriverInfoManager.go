@@ -10,7 +10,11 @@ package driverinfomanager
 
 import (
 	"fmt"
+	"os"
+	"strings"
+	"sync"
 
+	im "github.com/cloud-barista/cb-spider/cloud-info-manager"
 	"github.com/cloud-barista/cb-store/config"
 	"github.com/sirupsen/logrus"
 )
@@ -21,11 +25,15 @@ func init() {
 	cblog = config.Cblogger
 }
 
+// locks the registration and the update, for the version check.
+var driverLock = new(sync.Mutex)
+
 //====================================================================
 type CloudDriverInfo struct {
 	DriverName        string // ex) "AWS-Test-Driver-V0.5"
 	ProviderName      string // ex) "AWS"
 	DriverLibFileName string // ex) "aws-test-driver-v0.5.so"  //Already, you need to insert "*.so" in $CB_SPIDER_ROOT/cloud-driver/libs.
+	Version           int    // version for the update, see UpdateCloudDriver()
 }
 
 //====================================================================
@@ -35,8 +43,8 @@ func RegisterCloudDriverInfo(cldInfo CloudDriverInfo) (*CloudDriverInfo, error)
 }
 
 // 1. check params
-// 2. check driver files
-// 3. insert them into cb-store
+// 2. check driver files, see checkDriverLibFile()
+// 3. insert them and the SHA-256 of the driver file into cb-store
 // You should copy the driver library into ~/libs before.
 func RegisterCloudDriver(driverName string, providerName string, driverLibFileName string) (*CloudDriverInfo, error) {
 	cblog.Info("call RegisterCloudDriver()")
@@ -49,11 +57,15 @@ func RegisterCloudDriver(driverName string, providerName string, driverLibFileNa
 	}
 
 	cblog.Debug("check the driver library file")
-	err = checkDriverLibFile(driverLibFileName)
+	libHash, err := checkDriverLibFile(driverLibFileName)
 	if err != nil {
+		cblog.Error(err)
 		return nil, err
 	}
 
+	driverLock.Lock()
+	defer driverLock.Unlock()
+
 	cblog.Debug("insert metainfo into store")
 
 	err = insertInfo(driverName, providerName, driverLibFileName)
@@ -62,10 +74,89 @@ func RegisterCloudDriver(driverName string, providerName string, driverLibFileNa
 		return nil, err
 	}
 
-	drvInfo := &CloudDriverInfo{driverName, providerName, driverLibFileName}
+	err = insertLibHash(driverName, libHash)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	version, err := im.NextInfoVersion(im.DriverKind, driverName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	drvInfo := &CloudDriverInfo{driverName, providerName, driverLibFileName, version}
 	return drvInfo, nil
 }
 
+// 1. check params
+// 2. check driver files
+// 3. check the version and the ProviderName
+// 4. replace the DriverLibFileName and its SHA-256 in cb-store
+// The ProviderName can not be changed, because the connection configs use this driver.
+// A Go plugin can not be unloaded, so a new file with the same name is used after the restart.
+func UpdateCloudDriver(cldInfo CloudDriverInfo) (*CloudDriverInfo, error) {
+	cblog.Info("call UpdateCloudDriver()")
+
+	driverName := cldInfo.DriverName
+
+	cblog.Debug("check params")
+	err := checkParams(driverName, cldInfo.ProviderName, cldInfo.DriverLibFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	cblog.Debug("check the driver library file")
+	libHash, err := checkDriverLibFile(cldInfo.DriverLibFileName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	driverLock.Lock()
+	defer driverLock.Unlock()
+
+	oldInfo, err := getInfo(driverName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if !strings.EqualFold(oldInfo.ProviderName, cldInfo.ProviderName) {
+		return nil, fmt.Errorf("ProviderName of Driver(%s) can not be changed: %s => %s",
+			driverName, oldInfo.ProviderName, cldInfo.ProviderName)
+	}
+
+	err = im.CheckInfoVersion(im.DriverKind, driverName, cldInfo.Version)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	cblog.Debug("replace metainfo in store")
+
+	// same key, so the value is overwritten.
+	err = insertInfo(driverName, oldInfo.ProviderName, cldInfo.DriverLibFileName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	err = insertLibHash(driverName, libHash)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	version, err := im.NextInfoVersion(im.DriverKind, driverName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &CloudDriverInfo{driverName, oldInfo.ProviderName, cldInfo.DriverLibFileName, version}, nil
+}
+
 func ListCloudDriver() ([]*CloudDriverInfo, error) {
 	cblog.Info("call ListCloudDriver()")
 
@@ -102,12 +193,21 @@ func UnRegisterCloudDriver(driverName string) (bool, error) {
 		return false, fmt.Errorf("DriverName is empty!")
 	}
 
+	driverLock.Lock()
+	defer driverLock.Unlock()
+
 	result, err := deleteInfo(driverName)
 	if err != nil {
 		cblog.Error(err)
 		return false, err
 	}
 
+	err = deleteLibHash(driverName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
 	return result, nil
 }
 
@@ -127,8 +227,33 @@ func checkParams(driverName string, providerName string, driverLibFileName strin
 }
 
 // 1. check to exist file
-// 2. check to be a shared library file
-func checkDriverLibFile(driverLibFileName string) error {
-	// @todo
-	return nil
+// 2. check to be a Go plugin exporting CloudDriver of the same interface version
+// 3. return the SHA-256 of the file, "" with PLUGIN_SW=OFF
+func checkDriverLibFile(driverLibFileName string) (string, error) {
+	if IsPluginOff() {
+		return "", nil
+	}
+
+	driverPath, err := GetDriverLibPath(driverLibFileName)
+	if err != nil {
+		return "", err
+	}
+
+	fileInfo, err := os.Stat(driverPath)
+	if os.IsNotExist(err) {
+		return "", fmt.Errorf("%s: the driver library file does not exist! copy it into $CBSPIDER_ROOT/cloud-driver-libs before the registration.", driverLibFileName)
+	}
+	if err != nil {
+		return "", err
+	}
+	if fileInfo.IsDir() {
+		return "", fmt.Errorf("%s: the driver library file is a directory!", driverLibFileName)
+	}
+
+	_, err = OpenDriverLib(driverLibFileName)
+	if err != nil {
+		return "", err
+	}
+
+	return getLibFileHash(driverLibFileName)
 }
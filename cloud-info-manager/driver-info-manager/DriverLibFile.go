@@ -0,0 +1,135 @@
+// Driver Library File Check of Cloud Driver Info. Manager.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package driverinfomanager
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"plugin"
+	"strings"
+
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+)
+
+// the drivers are built in the server with PLUGIN_SW=OFF,
+// so the driver library files are not used.
+func IsPluginOff() bool {
+	return strings.ToUpper(os.Getenv("PLUGIN_SW")) == "OFF"
+}
+
+// $CBSPIDER_ROOT/cloud-driver-libs/<DriverLibFileName>
+func GetDriverLibPath(driverLibFileName string) (string, error) {
+	cbspiderRoot := os.Getenv("CBSPIDER_ROOT")
+	if cbspiderRoot == "" {
+		return "", fmt.Errorf("$CBSPIDER_ROOT is not set!!")
+	}
+	if strings.Contains(driverLibFileName, "/") {
+		return "", fmt.Errorf("%s: DriverLibFileName should be a file name in $CBSPIDER_ROOT/cloud-driver-libs!", driverLibFileName)
+	}
+	return cbspiderRoot + "/cloud-driver-libs/" + driverLibFileName, nil
+}
+
+// 1. open the Go plugin
+// 2. look up the exported "CloudDriver"
+// 3. check the interface version of the driver
+// A plugin is only initialized once and cannot be closed, ref) https://golang.org/pkg/plugin/
+func OpenDriverLib(driverLibFileName string) (idrv.CloudDriver, error) {
+	driverPath, err := GetDriverLibPath(driverLibFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	plug, err := plugin.Open(driverPath)
+	if err != nil {
+		return nil, fmt.Errorf("%s: not a Go plugin built with this server: %v", driverLibFileName, err)
+	}
+
+	symbol, err := plug.Lookup("CloudDriver")
+	if err != nil {
+		return nil, fmt.Errorf("%s: the plugin does not export CloudDriver: %v", driverLibFileName, err)
+	}
+
+	cloudDriver, ok := symbol.(idrv.CloudDriver)
+	if !ok {
+		return nil, fmt.Errorf("%s: the exported CloudDriver(%T) does not implement the CloudDriver interface(v%s)!",
+			driverLibFileName, symbol, idrv.InterfaceVersion)
+	}
+
+	err = checkInterfaceVersion(driverLibFileName, cloudDriver.GetDriverVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	return cloudDriver, nil
+}
+
+// 1. compare the SHA-256 of the file with the one recorded at the registration
+// 2. skip the driver registered before the record
+func VerifyDriverLibFile(cldInfo CloudDriverInfo) error {
+	if IsPluginOff() {
+		return nil
+	}
+
+	recorded, err := getLibHash(cldInfo.DriverName)
+	if err != nil {
+		return err
+	}
+	if recorded == "" {
+		cblog.Warnf("%s: no SHA-256 of the driver library file, so it is not verified.", cldInfo.DriverName)
+		return nil
+	}
+
+	hash, err := getLibFileHash(cldInfo.DriverLibFileName)
+	if err != nil {
+		return err
+	}
+	if hash != recorded {
+		return fmt.Errorf("%s: the driver library file %s was changed after the registration! SHA-256=%s, registered SHA-256=%s, update the driver to use it.",
+			cldInfo.DriverName, cldInfo.DriverLibFileName, hash, recorded)
+	}
+	return nil
+}
+
+//----------------
+
+func checkInterfaceVersion(driverLibFileName string, driverVersion string) error {
+	version := idrv.GetInterfaceVersion(driverVersion)
+	if version == "" {
+		return fmt.Errorf("%s: the driver version(%s) does not have the interface version, it should be v%s!",
+			driverLibFileName, driverVersion, idrv.InterfaceVersion)
+	}
+	if version != idrv.InterfaceVersion {
+		return fmt.Errorf("%s: the driver is built with the interface v%s, but this server uses v%s!",
+			driverLibFileName, version, idrv.InterfaceVersion)
+	}
+	return nil
+}
+
+func getLibFileHash(driverLibFileName string) (string, error) {
+	driverPath, err := GetDriverLibPath(driverLibFileName)
+	if err != nil {
+		return "", err
+	}
+
+	file, err := os.Open(driverPath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -12,6 +12,7 @@ package driverinfomanager
 import (
 	"fmt"
 
+	im "github.com/cloud-barista/cb-spider/cloud-info-manager"
 	"github.com/cloud-barista/cb-store/utils"
 	"github.com/cloud-barista/cb-store"
 	icbs "github.com/cloud-barista/cb-store/interfaces"
@@ -63,9 +64,15 @@ func listInfo() ([]*CloudDriverInfo, error) {
                 return nil, err
         }
 
+	versionMap, err := im.ListInfoVersion(im.DriverKind)
+	if err != nil {
+		return nil, err
+	}
+
         cloudDriverInfoList := make([]*CloudDriverInfo, len(keyValueList))
         for count, kv := range keyValueList {
-                drvInfo := &CloudDriverInfo{utils.GetNodeValue(kv.Key, 3), utils.GetNodeValue(kv.Key, 4), kv.Value}
+		driverName := utils.GetNodeValue(kv.Key, 3)
+                drvInfo := &CloudDriverInfo{driverName, utils.GetNodeValue(kv.Key, 4), kv.Value, versionMap.Version(driverName)}
                 cloudDriverInfoList[count] = drvInfo
         }
 
@@ -96,7 +103,11 @@ func getInfo(driverName string) (*CloudDriverInfo, error) {
                 if utils.GetNodeValue(kv.Key, 3) == driverName {
 			providerName := utils.GetNodeValue(kv.Key, 4)
 			driverLibFileName := kv.Value
-			drvInfo := &CloudDriverInfo{driverName, providerName, driverLibFileName}
+			version, err := im.GetInfoVersion(im.DriverKind, driverName)
+			if err != nil {
+				return nil, err
+			}
+			drvInfo := &CloudDriverInfo{driverName, providerName, driverLibFileName, version}
 			return drvInfo, nil
                 }
         }
@@ -134,3 +145,43 @@ func deleteInfo(driverName string) (bool, error) {
         return false, fmt.Errorf(driverName + ": does not exist!")
 }
 
+
+// format
+// /cloud-info-spaces/driver-lib-hashes/<DriverName> [SHA-256 of DriverLibFile]
+// ex) /cloud-info-spaces/driver-lib-hashes/AWS_driver01-V0.5 [9f86d08...]
+
+// "" libHash deletes the old one, ex) registered with PLUGIN_SW=OFF
+func insertLibHash(driverName string, libHash string) error {
+	if libHash == "" {
+		return deleteLibHash(driverName)
+	}
+
+	key := "/cloud-info-spaces/driver-lib-hashes/" + driverName
+	return store.Put(key, libHash)
+}
+
+// "" if the driver does not have it, ex) registered before the record
+func getLibHash(driverName string) (string, error) {
+	key := "/cloud-info-spaces/driver-lib-hashes/" + driverName
+	kv, err := store.Get(key)
+	if err != nil {
+		return "", err
+	}
+	if kv == nil {
+		return "", nil
+	}
+	return kv.Value, nil
+}
+
+func deleteLibHash(driverName string) error {
+	libHash, err := getLibHash(driverName)
+	if err != nil {
+		return err
+	}
+	if libHash == "" {
+		return nil
+	}
+
+	key := "/cloud-info-spaces/driver-lib-hashes/" + driverName
+	return store.Delete(key)
+}
@@ -0,0 +1,186 @@
+// Cloud Image Map Info. Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package imagemapinfomanager
+
+import (
+	"fmt"
+
+	"github.com/cloud-barista/cb-store/config"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+var cblog *logrus.Logger
+
+func init() {
+	cblog = config.Cblogger
+}
+
+//====================================================================
+// ImageMapInfo maps a logical image name on a registered region to the image ID of the CSP.
+type ImageMapInfo struct {
+	ImageName  string `yaml:"ImageName"`  // ex) "ubuntu-20.04"
+	RegionName string `yaml:"RegionName"` // ex) "aws-ohio", name of the registered region
+	CSPImageId string `yaml:"CSPImageId"` // ex) "ami-0bbe28eb2173f6167"
+}
+
+// format of bulk import file
+// imagemaps:
+//   - ImageName: ubuntu-20.04
+//     RegionName: aws-ohio
+//     CSPImageId: ami-0bbe28eb2173f6167
+//   - ImageName: ubuntu-20.04
+//     RegionName: gcp-iowa
+//     CSPImageId: https://www.googleapis.com/compute/v1/projects/ubuntu-os-cloud/global/images/ubuntu-2004-focal-v20201014
+type ImageMapInfoList struct {
+	ImageMapInfoList []ImageMapInfo `yaml:"imagemaps"`
+}
+
+//====================================================================
+
+func RegisterImageMapInfo(imgMapInfo ImageMapInfo) (*ImageMapInfo, error) {
+	return RegisterImageMap(imgMapInfo.ImageName, imgMapInfo.RegionName, imgMapInfo.CSPImageId)
+}
+
+// 1. check params
+// 2. insert them into cb-store
+func RegisterImageMap(imageName string, regionName string, cspImageId string) (*ImageMapInfo, error) {
+	cblog.Info("call RegisterImageMap()")
+
+	cblog.Debug("check params")
+	err := checkParams(imageName, regionName, cspImageId)
+	if err != nil {
+		return nil, err
+	}
+
+	cblog.Debug("insert metainfo into store")
+
+	err = insertInfo(imageName, regionName, cspImageId)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	imgMapInfo := &ImageMapInfo{imageName, regionName, cspImageId}
+	return imgMapInfo, nil
+}
+
+// 1. parse YAML data
+// 2. check all params before inserting any of them
+// 3. insert them into cb-store
+func ImportImageMap(yamlData []byte) ([]*ImageMapInfo, error) {
+	cblog.Info("call ImportImageMap()")
+
+	var imgMapList ImageMapInfoList
+	err := yaml.Unmarshal(yamlData, &imgMapList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	for _, info := range imgMapList.ImageMapInfoList {
+		err := checkParams(info.ImageName, info.RegionName, info.CSPImageId)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%s: %s", info.ImageName, info.RegionName, err.Error())
+		}
+	}
+
+	var imgMapInfoList []*ImageMapInfo
+	for _, info := range imgMapList.ImageMapInfoList {
+		imgMapInfo, err := RegisterImageMapInfo(info)
+		if err != nil {
+			return imgMapInfoList, err
+		}
+		imgMapInfoList = append(imgMapInfoList, imgMapInfo)
+	}
+
+	return imgMapInfoList, nil
+}
+
+func ListImageMap() ([]*ImageMapInfo, error) {
+	cblog.Info("call ListImageMap()")
+
+	imgMapInfoList, err := listInfo("")
+	if err != nil {
+		return nil, err
+	}
+
+	return imgMapInfoList, nil
+}
+
+// list all region mappings of a logical image name
+func ListImageMapByImageName(imageName string) ([]*ImageMapInfo, error) {
+	cblog.Info("call ListImageMapByImageName()")
+
+	if imageName == "" {
+		return nil, fmt.Errorf("ImageName is empty!")
+	}
+
+	imgMapInfoList, err := listInfo(imageName)
+	if err != nil {
+		return nil, err
+	}
+
+	return imgMapInfoList, nil
+}
+
+// 1. check params
+// 2. get ImageMapInfo from cb-store
+func GetImageMap(imageName string, regionName string) (*ImageMapInfo, error) {
+	cblog.Info("call GetImageMap()")
+
+	if imageName == "" {
+		return nil, fmt.Errorf("ImageName is empty!")
+	}
+	if regionName == "" {
+		return nil, fmt.Errorf("RegionName is empty!")
+	}
+
+	imgMapInfo, err := getInfo(imageName, regionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return imgMapInfo, err
+}
+
+func UnRegisterImageMap(imageName string, regionName string) (bool, error) {
+	cblog.Info("call UnRegisterImageMap()")
+
+	if imageName == "" {
+		return false, fmt.Errorf("ImageName is empty!")
+	}
+	if regionName == "" {
+		return false, fmt.Errorf("RegionName is empty!")
+	}
+
+	result, err := deleteInfo(imageName, regionName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	return result, nil
+}
+
+//----------------
+
+func checkParams(imageName string, regionName string, cspImageId string) error {
+	if imageName == "" {
+		return fmt.Errorf("ImageName is empty!")
+	}
+	if regionName == "" {
+		return fmt.Errorf("RegionName is empty!")
+	}
+	if cspImageId == "" {
+		return fmt.Errorf("CSPImageId is empty!")
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+// ImageMapInfo <-> CB-Store Handler for Cloud Image Map Info. Manager.
+// Cloud Image Map Info. Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package imagemapinfomanager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloud-barista/cb-store"
+	icbs "github.com/cloud-barista/cb-store/interfaces"
+	"github.com/cloud-barista/cb-store/utils"
+)
+
+var store icbs.Store
+
+func init() {
+	store = cbstore.GetStore()
+}
+
+// format
+// /cloud-info-spaces/image-maps/<ImageName>/<RegionName> [CSPImageId]
+// ex)
+// /cloud-info-spaces/image-maps/ubuntu-20.04/aws-ohio [ami-0bbe28eb2173f6167]
+// /cloud-info-spaces/image-maps/ubuntu-20.04/azure-northeu [Canonical:0001-com-ubuntu-server-focal:20_04-lts:latest]
+//
+// '/' in CSPImageId(ex: GCP image URL) is stored as it is, because it is a value.
+// '/' in ImageName is escaped with "%2F" like IIDs.
+
+const imageMapKeyPrefix = "/cloud-info-spaces/image-maps/"
+
+func insertInfo(imageName string, regionName string, cspImageId string) error {
+	key := imageMapKeyPrefix + escape(imageName) + "/" + regionName
+	err := store.Put(key, cspImageId)
+	if err != nil {
+		//cblog.Error(err)
+		return err
+	}
+	return nil
+}
+
+// 1. get key-value list
+// 2. create ImageMapInfo List & return
+// imageName == "" means all images.
+func listInfo(imageName string) ([]*ImageMapInfo, error) {
+	key := strings.TrimSuffix(imageMapKeyPrefix, "/")
+	if imageName != "" {
+		key = imageMapKeyPrefix + escape(imageName)
+	}
+
+	keyValueList, err := store.GetList(key, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var imgMapInfoList []*ImageMapInfo
+	for _, kv := range keyValueList {
+		name := unescape(utils.GetNodeValue(kv.Key, 3))
+		// keyValueList can have ~/ubuntu/... and ~/ubuntu-18.04/...,
+		// so we have to check the sameness of imageName.
+		if imageName != "" && name != imageName {
+			continue
+		}
+		imgMapInfo := &ImageMapInfo{name, utils.GetNodeValue(kv.Key, 4), kv.Value}
+		imgMapInfoList = append(imgMapInfoList, imgMapInfo)
+	}
+
+	return imgMapInfoList, nil
+}
+
+// 1. get a key-value
+// 2. create ImageMapInfo & return
+func getInfo(imageName string, regionName string) (*ImageMapInfo, error) {
+	key := imageMapKeyPrefix + escape(imageName) + "/" + regionName
+	keyValue, err := store.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if keyValue == nil {
+		return nil, fmt.Errorf(imageName + ":" + regionName + ": does not exist!")
+	}
+
+	return &ImageMapInfo{imageName, regionName, keyValue.Value}, nil
+}
+
+// 1. check the key.
+// 2. delete the key.
+func deleteInfo(imageName string, regionName string) (bool, error) {
+	key := imageMapKeyPrefix + escape(imageName) + "/" + regionName
+	keyValue, err := store.Get(key)
+	if err != nil {
+		return false, err
+	}
+
+	if keyValue == nil {
+		return false, fmt.Errorf(imageName + ":" + regionName + ": does not exist!")
+	}
+
+	err = store.Delete(key)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func escape(name string) string {
+	return strings.ReplaceAll(name, "/", "%2F")
+}
+
+func unescape(name string) string {
+	return strings.ReplaceAll(name, "%2F", "/")
+}
@@ -0,0 +1,81 @@
+// Test for Cloud Image Map Info. Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package main
+
+import (
+	"fmt"
+
+	imim "github.com/cloud-barista/cb-spider/cloud-info-manager/image-map-info-manager"
+	"github.com/cloud-barista/cb-store/config"
+)
+
+func main() {
+
+	// ex)
+	// /cloud-info-spaces/image-maps/<ubuntu-20.04>/<aws-ohio> [ami-0bbe28eb2173f6167]
+
+	fmt.Println("\n============== RegisterImageMap()")
+	imgMapInfo, err := imim.RegisterImageMap("ubuntu-20.04", "aws-ohio", "ami-0bbe28eb2173f6167")
+	if err != nil {
+		config.Cblogger.Error(err)
+	}
+	fmt.Printf(" === %#v\n", imgMapInfo)
+
+	fmt.Println("\n============== ImportImageMap()")
+	yamlData := []byte(`
+imagemaps:
+  - ImageName: ubuntu-20.04
+    RegionName: gcp-iowa
+    CSPImageId: https://www.googleapis.com/compute/v1/projects/ubuntu-os-cloud/global/images/ubuntu-2004-focal-v20201014
+  - ImageName: ubuntu-18.04
+    RegionName: aws-ohio
+    CSPImageId: ami-0dd9f0e7df0f0a138
+`)
+	imgMapInfoList, err := imim.ImportImageMap(yamlData)
+	if err != nil {
+		config.Cblogger.Error(err)
+	}
+	for _, info := range imgMapInfoList {
+		fmt.Printf(" === %#v\n", info)
+	}
+
+	fmt.Println("\n============== ListImageMapByImageName()")
+	imgMapInfoList, err = imim.ListImageMapByImageName("ubuntu-20.04")
+	if err != nil {
+		config.Cblogger.Error(err)
+	}
+	for _, info := range imgMapInfoList {
+		fmt.Printf(" === %#v\n", info)
+	}
+
+	fmt.Println("\n============== GetImageMap()")
+	imgMapInfo, err = imim.GetImageMap("ubuntu-20.04", "gcp-iowa")
+	if err != nil {
+		config.Cblogger.Error(err)
+	}
+	fmt.Printf(" === %#v\n", imgMapInfo)
+
+	fmt.Println("\n============== UnRegisterImageMap()")
+	for _, info := range []imim.ImageMapInfo{{ImageName: "ubuntu-20.04", RegionName: "aws-ohio"}, {ImageName: "ubuntu-20.04", RegionName: "gcp-iowa"}, {ImageName: "ubuntu-18.04", RegionName: "aws-ohio"}} {
+		result, err := imim.UnRegisterImageMap(info.ImageName, info.RegionName)
+		if err != nil {
+			config.Cblogger.Error(err)
+		}
+		fmt.Printf(" === imim.UnRegisterImageMap %s:%s : %#v\n", info.ImageName, info.RegionName, result)
+	}
+
+	fmt.Println("\n============== ListImageMap()")
+	imgMapInfoList, err = imim.ListImageMap()
+	if err != nil {
+		config.Cblogger.Error(err)
+	}
+	for _, info := range imgMapInfoList {
+		fmt.Printf(" === %#v\n", info)
+	}
+}
@@ -11,6 +11,7 @@ package regioninfomanager
 
 import (
 	"fmt"
+	im "github.com/cloud-barista/cb-spider/cloud-info-manager"
 	"github.com/cloud-barista/cb-store/utils"
 	"github.com/cloud-barista/cb-store"
 	icbs "github.com/cloud-barista/cb-store/interfaces"
@@ -67,6 +68,58 @@ func insertInfo(regionName string, providerName string, keyValueList []icbs.KeyV
 	return nil
 }
 
+// replace the key/values without deleting the info first,
+// so the old key/values are kept if the update fails.
+// 1. put the new key/values, the old values are restored if a put fails.
+// 2. delete the removed keys.
+func updateInfo(regionName string, providerName string, oldKeyValueList []icbs.KeyValue, keyValueList []icbs.KeyValue) error {
+	format := "/cloud-info-spaces/regions/" + regionName + "/" + providerName
+	for i, kv := range keyValueList {
+		err := store.Put(format+"/"+kv.Key, kv.Value)
+		if err != nil {
+			if err2 := restoreInfo(format, oldKeyValueList, keyValueList[:i]); err2 != nil {
+				return fmt.Errorf(err.Error() + ", " + err2.Error())
+			}
+			return err
+		}
+	}
+
+	for _, kv := range oldKeyValueList {
+		if getValue(keyValueList, kv.Key) == nil {
+			err := store.Delete(format + "/" + kv.Key)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// rollback the put key/values to the old values.
+func restoreInfo(format string, oldKeyValueList []icbs.KeyValue, putKeyValueList []icbs.KeyValue) error {
+	for _, kv := range putKeyValueList {
+		var err error
+		if old := getValue(oldKeyValueList, kv.Key); old != nil {
+			err = store.Put(format+"/"+kv.Key, *old)
+		} else {
+			err = store.Delete(format + "/" + kv.Key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getValue(keyValueList []icbs.KeyValue, key string) *string {
+	for i := range keyValueList {
+		if keyValueList[i].Key == key {
+			return &keyValueList[i].Value
+		}
+	}
+	return nil
+}
+
 // 1. get key-value list
 // 2. create RegionInfo List & return
 func listInfo() ([]*RegionInfo, error) {
@@ -83,6 +136,11 @@ func listInfo() ([]*RegionInfo, error) {
                 return nil, err
         }
 
+	versionMap, err := im.ListInfoVersion(im.RegionKind)
+	if err != nil {
+		return nil, err
+	}
+
         var regionInfoList []*RegionInfo
 	var inKeyValueList []icbs.KeyValue
 	prevRegionName := ""
@@ -99,7 +157,7 @@ func listInfo() ([]*RegionInfo, error) {
 			inKeyValueList = append(inKeyValueList, keyValue)
 		} else {
 			// insert prev RegionInfo
-			rgnInfo := &RegionInfo{prevRegionName, prevProviderName, inKeyValueList}
+			rgnInfo := &RegionInfo{prevRegionName, prevProviderName, inKeyValueList, versionMap.Version(prevRegionName)}
 			regionInfoList = append(regionInfoList, rgnInfo)
 
 			prevRegionName = regionName
@@ -113,7 +171,7 @@ func listInfo() ([]*RegionInfo, error) {
 
 	if len(keyValueList) > 0 {
 		// insert last RegionInfo
-		rgnInfo := &RegionInfo{prevRegionName, prevProviderName, inKeyValueList}
+		rgnInfo := &RegionInfo{prevRegionName, prevProviderName, inKeyValueList, versionMap.Version(prevRegionName)}
 		regionInfoList = append(regionInfoList, rgnInfo)
 	}
 
@@ -164,7 +222,11 @@ func getInfo(regionName string) (*RegionInfo, error) {
                 keyValue := icbs.KeyValue{utils.GetNodeValue(kv.Key, 5), kv.Value}
                 inKeyValueList = append(inKeyValueList, keyValue)
         }
-	return &RegionInfo{regionName, providerName, inKeyValueList}, nil 
+	version, err := im.GetInfoVersion(im.RegionKind, regionName)
+	if err != nil {
+		return nil, err
+	}
+	return &RegionInfo{regionName, providerName, inKeyValueList, version}, nil
 }
 
 // 1. get the original Key.
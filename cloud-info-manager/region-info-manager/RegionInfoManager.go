@@ -10,7 +10,10 @@ package regioninfomanager
 
 import (
 	"fmt"
+	"strings"
+	"sync"
 
+	im "github.com/cloud-barista/cb-spider/cloud-info-manager"
 	"github.com/cloud-barista/cb-store/config"
 	icbs "github.com/cloud-barista/cb-store/interfaces"
 	"github.com/sirupsen/logrus"
@@ -22,12 +25,16 @@ func init() {
 	cblog = config.Cblogger
 }
 
+// locks the registration and the update, for the version check.
+var regionLock = new(sync.Mutex)
+
 //====================================================================
 type RegionInfo struct {
 	RegionName       string          // ex) "region01"
 	ProviderName     string          // ex) "GCP"
 	KeyValueInfoList []icbs.KeyValue // ex) { {region, us-east1},
 	//	 {zone, us-east1-c},
+	Version int // version for the update, see UpdateRegion()
 }
 
 //====================================================================
@@ -48,6 +55,9 @@ func RegisterRegion(regionName string, providerName string, keyValueInfoList []i
 
 	}
 
+	regionLock.Lock()
+	defer regionLock.Unlock()
+
 	cblog.Debug("insert metainfo into store")
 
 	err = insertInfo(regionName, providerName, keyValueInfoList)
@@ -56,10 +66,104 @@ func RegisterRegion(regionName string, providerName string, keyValueInfoList []i
 		return nil, err
 	}
 
-	rgnInfo := &RegionInfo{regionName, providerName, keyValueInfoList}
+	version, err := im.NextInfoVersion(im.RegionKind, regionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	rgnInfo := &RegionInfo{regionName, providerName, keyValueInfoList, version}
 	return rgnInfo, nil
 }
 
+// 1. check params
+// 2. check the version and the ProviderName
+// 3. replace the key/values in cb-store
+// The ProviderName can not be changed, because the connection configs use this region.
+func UpdateRegion(rgnInfo RegionInfo) (*RegionInfo, error) {
+	cblog.Info("call UpdateRegion()")
+
+	cblog.Debug("check params")
+	err := checkParams(rgnInfo.RegionName, rgnInfo.ProviderName, rgnInfo.KeyValueInfoList)
+	if err != nil {
+		return nil, err
+	}
+
+	regionLock.Lock()
+	defer regionLock.Unlock()
+
+	oldInfo, err := getInfo(rgnInfo.RegionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return updateRegion(oldInfo, rgnInfo)
+}
+
+// the key/values of the request are added or replaced, and the others are kept.
+// ProviderName can be empty.
+func PatchRegion(rgnInfo RegionInfo) (*RegionInfo, error) {
+	cblog.Info("call PatchRegion()")
+
+	if rgnInfo.RegionName == "" {
+		return nil, fmt.Errorf("RegionName is empty!")
+	}
+
+	regionLock.Lock()
+	defer regionLock.Unlock()
+
+	oldInfo, err := getInfo(rgnInfo.RegionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	if rgnInfo.ProviderName == "" {
+		rgnInfo.ProviderName = oldInfo.ProviderName
+	}
+	rgnInfo.KeyValueInfoList = im.MergeKeyValueList(oldInfo.KeyValueInfoList, rgnInfo.KeyValueInfoList)
+
+	cblog.Debug("check params")
+	err = checkParams(rgnInfo.RegionName, rgnInfo.ProviderName, rgnInfo.KeyValueInfoList)
+	if err != nil {
+		return nil, err
+	}
+
+	return updateRegion(oldInfo, rgnInfo)
+}
+
+// the caller should hold the regionLock.
+func updateRegion(oldInfo *RegionInfo, rgnInfo RegionInfo) (*RegionInfo, error) {
+	regionName := rgnInfo.RegionName
+	if !strings.EqualFold(oldInfo.ProviderName, rgnInfo.ProviderName) {
+		return nil, fmt.Errorf("ProviderName of Region(%s) can not be changed: %s => %s",
+			regionName, oldInfo.ProviderName, rgnInfo.ProviderName)
+	}
+
+	err := im.CheckInfoVersion(im.RegionKind, regionName, rgnInfo.Version)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	cblog.Debug("replace metainfo in store")
+
+	err = updateInfo(regionName, oldInfo.ProviderName, oldInfo.KeyValueInfoList, rgnInfo.KeyValueInfoList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	version, err := im.NextInfoVersion(im.RegionKind, regionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &RegionInfo{regionName, oldInfo.ProviderName, rgnInfo.KeyValueInfoList, version}, nil
+}
+
 func ListRegion() ([]*RegionInfo, error) {
 	cblog.Info("call ListRegion()")
 
@@ -96,6 +200,9 @@ func UnRegisterRegion(regionName string) (bool, error) {
 		return false, fmt.Errorf("RegionName is empty!")
 	}
 
+	regionLock.Lock()
+	defer regionLock.Unlock()
+
 	result, err := deleteInfo(regionName)
 	if err != nil {
 		cblog.Error(err)
@@ -119,5 +226,6 @@ func checkParams(regionName string, providerName string, keyValueInfoList []icbs
 			return fmt.Errorf("Key is empty!")
 		}
 	}
-	return nil
+	// keys of the CloudOS schema, owned by the driver
+	return im.ValidateRegionKeyValue(providerName, keyValueInfoList)
 }
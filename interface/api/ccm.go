@@ -53,6 +53,7 @@ type VPCReq struct {
 type VPCInfo struct {
 	Name           string        `yaml:"Name" json:"Name"`
 	IPv4_CIDR      string        `yaml:"IPv4_CIDR" json:"IPv4_CIDR"`
+	IPv6_CIDR      string        `yaml:"IPv6_CIDR" json:"IPv6_CIDR"`
 	SubnetInfoList *[]SubnetInfo `yaml:"SubnetInfoList" json:"SubnetInfoList"`
 }
 
@@ -60,6 +61,8 @@ type VPCInfo struct {
 type SubnetInfo struct {
 	Name      string `yaml:"Name" json:"Name"`
 	IPv4_CIDR string `yaml:"IPv4_CIDR" json:"IPv4_CIDR"`
+	IPv6_CIDR string `yaml:"IPv6_CIDR" json:"IPv6_CIDR"`
+	Private   bool   `yaml:"Private" json:"Private"`
 }
 
 // SecurityReq - Security 정보 생성 요청 구조 정의
@@ -82,6 +85,7 @@ type SecurityRuleInfo struct {
 	ToPort     string `yaml:"ToPort" json:"ToPort"`
 	IPProtocol string `yaml:"IPProtocol" json:"IPProtocol"`
 	Direction  string `yaml:"Direction" json:"Direction"`
+	CIDR       string `yaml:"CIDR" json:"CIDR"`
 }
 
 // KeyReq - Key Pair 정보 생성 요청 구조 정의
@@ -113,6 +117,202 @@ type VMInfo struct {
 
 	VMUserId     string `yaml:"VMUserId" json:"VMUserId"`
 	VMUserPasswd string `yaml:"VMUserPasswd" json:"VMUserPasswd"`
+
+	PurchaseType string `yaml:"PurchaseType" json:"PurchaseType"`
+	MaxPrice     string `yaml:"MaxPrice" json:"MaxPrice"`
+
+	PrivateIP string `yaml:"PrivateIP" json:"PrivateIP"`
+
+	PublicIPName string `yaml:"PublicIPName" json:"PublicIPName"`
+	DNSZoneName  string `yaml:"DNSZoneName" json:"DNSZoneName"`
+
+	NetworkInterfaces []NetworkInterfaceInfo `yaml:"NetworkInterfaces" json:"NetworkInterfaces"`
+}
+
+// NetworkInterfaceInfo - VM 보조 네트워크 인터페이스 정보 구조 정의
+type NetworkInterfaceInfo struct {
+	SubnetName         string   `yaml:"SubnetName" json:"SubnetName"`
+	SecurityGroupNames []string `yaml:"SecurityGroupNames" json:"SecurityGroupNames"`
+	PrivateIP          string   `yaml:"PrivateIP" json:"PrivateIP"`
+	PublicIP           bool     `yaml:"PublicIP" json:"PublicIP"`
+}
+
+// VMGroupReq - VM 그룹 생성 요청 구조 정의
+type VMGroupReq struct {
+	ConnectionName string      `yaml:"ConnectionName" json:"ConnectionName"`
+	ReqInfo        VMGroupInfo `yaml:"ReqInfo" json:"ReqInfo"`
+}
+
+// VMGroupInfo - VM 그룹 정보 구조 정의
+type VMGroupInfo struct {
+	NamePattern       string `yaml:"NamePattern" json:"NamePattern"`
+	Count             int32  `yaml:"Count" json:"Count"`
+	StartIndex        int32  `yaml:"StartIndex" json:"StartIndex"`
+	WorkerCount       int32  `yaml:"WorkerCount" json:"WorkerCount"`
+	RollbackOnFailure bool   `yaml:"RollbackOnFailure" json:"RollbackOnFailure"`
+	VMReqInfo         VMInfo `yaml:"VMReqInfo" json:"VMReqInfo"`
+}
+
+// NLBReq - NLB 정보 생성 요청 구조 정의
+type NLBReq struct {
+	ConnectionName string  `yaml:"ConnectionName" json:"ConnectionName"`
+	ReqInfo        NLBInfo `yaml:"ReqInfo" json:"ReqInfo"`
+}
+
+// NLBInfo - NLB 정보 구조 정의
+type NLBInfo struct {
+	Name          string               `yaml:"Name" json:"Name"`
+	VPCName       string               `yaml:"VPCName" json:"VPCName"`
+	Type          string               `yaml:"Type" json:"Type"`
+	Listener      NLBListenerInfo      `yaml:"Listener" json:"Listener"`
+	VMGroup       NLBVMGroupInfo       `yaml:"VMGroup" json:"VMGroup"`
+	HealthChecker NLBHealthCheckerInfo `yaml:"HealthChecker" json:"HealthChecker"`
+}
+
+// NLBListenerInfo - NLB Listener 정보 구조 정의
+type NLBListenerInfo struct {
+	Protocol string `yaml:"Protocol" json:"Protocol"`
+	Port     string `yaml:"Port" json:"Port"`
+}
+
+// NLBVMGroupInfo - NLB VM 그룹 정보 구조 정의
+type NLBVMGroupInfo struct {
+	Protocol string   `yaml:"Protocol" json:"Protocol"`
+	Port     string   `yaml:"Port" json:"Port"`
+	VMs      []string `yaml:"VMs" json:"VMs"`
+}
+
+// NLBHealthCheckerInfo - NLB HealthChecker 정보 구조 정의
+type NLBHealthCheckerInfo struct {
+	Protocol  string `yaml:"Protocol" json:"Protocol"`
+	Port      string `yaml:"Port" json:"Port"`
+	Interval  int32  `yaml:"Interval" json:"Interval"`
+	Timeout   int32  `yaml:"Timeout" json:"Timeout"`
+	Threshold int32  `yaml:"Threshold" json:"Threshold"`
+}
+
+// NLBVMsReq - NLB VM 추가/제거 요청 구조 정의
+type NLBVMsReq struct {
+	ConnectionName string     `yaml:"ConnectionName" json:"ConnectionName"`
+	Name           string     `yaml:"Name" json:"Name"`
+	ReqInfo        NLBVMsInfo `yaml:"ReqInfo" json:"ReqInfo"`
+}
+
+// NLBVMsInfo - NLB VM 목록 정보 구조 정의
+type NLBVMsInfo struct {
+	VMs []string `yaml:"VMs" json:"VMs"`
+}
+
+// NLBListenerReq - NLB Listener 변경 요청 구조 정의
+type NLBListenerReq struct {
+	ConnectionName string          `yaml:"ConnectionName" json:"ConnectionName"`
+	Name           string          `yaml:"Name" json:"Name"`
+	ReqInfo        NLBListenerInfo `yaml:"ReqInfo" json:"ReqInfo"`
+}
+
+// NLBHealthCheckerReq - NLB HealthChecker 변경 요청 구조 정의
+type NLBHealthCheckerReq struct {
+	ConnectionName string               `yaml:"ConnectionName" json:"ConnectionName"`
+	Name           string               `yaml:"Name" json:"Name"`
+	ReqInfo        NLBHealthCheckerInfo `yaml:"ReqInfo" json:"ReqInfo"`
+}
+
+// PublicIPReq - PublicIP 할당 요청 구조 정의
+type PublicIPReq struct {
+	ConnectionName string       `yaml:"ConnectionName" json:"ConnectionName"`
+	ReqInfo        PublicIPInfo `yaml:"ReqInfo" json:"ReqInfo"`
+}
+
+// PublicIPInfo - PublicIP 정보 구조 정의
+type PublicIPInfo struct {
+	Name string `yaml:"Name" json:"Name"`
+}
+
+// PublicIPAssociateReq - PublicIP 연결 요청 구조 정의
+type PublicIPAssociateReq struct {
+	ConnectionName string                `yaml:"ConnectionName" json:"ConnectionName"`
+	Name           string                `yaml:"Name" json:"Name"`
+	ReqInfo        PublicIPAssociateInfo `yaml:"ReqInfo" json:"ReqInfo"`
+}
+
+// PublicIPAssociateInfo - PublicIP 연결 정보 구조 정의
+type PublicIPAssociateInfo struct {
+	VMName string `yaml:"VMName" json:"VMName"`
+}
+
+// VPCPeeringReq - VPC Peering 요청 구조 정의
+type VPCPeeringReq struct {
+	ConnectionName string         `yaml:"ConnectionName" json:"ConnectionName"`
+	ReqInfo        VPCPeeringInfo `yaml:"ReqInfo" json:"ReqInfo"`
+}
+
+// VPCPeeringInfo - VPC Peering 정보 구조 정의
+type VPCPeeringInfo struct {
+	Name             string `yaml:"Name" json:"Name"`
+	RequesterVPCName string `yaml:"RequesterVPCName" json:"RequesterVPCName"`
+	AccepterVPCName  string `yaml:"AccepterVPCName" json:"AccepterVPCName"`
+}
+
+// DNSZoneReq - Private DNS Zone 생성 요청 구조 정의
+type DNSZoneReq struct {
+	ConnectionName string      `yaml:"ConnectionName" json:"ConnectionName"`
+	ReqInfo        DNSZoneInfo `yaml:"ReqInfo" json:"ReqInfo"`
+}
+
+// DNSZoneInfo - Private DNS Zone 정보 구조 정의
+type DNSZoneInfo struct {
+	Name       string `yaml:"Name" json:"Name"`
+	DomainName string `yaml:"DomainName" json:"DomainName"`
+	VPCName    string `yaml:"VPCName" json:"VPCName"`
+}
+
+// DNSRecordReq - Private DNS Zone 레코드 요청 구조 정의
+type DNSRecordReq struct {
+	ConnectionName string        `yaml:"ConnectionName" json:"ConnectionName"`
+	Name           string        `yaml:"Name" json:"Name"`
+	ReqInfo        DNSRecordInfo `yaml:"ReqInfo" json:"ReqInfo"`
+}
+
+// DNSRecordInfo - Private DNS Zone 레코드 정보 구조 정의
+type DNSRecordInfo struct {
+	Name   string   `yaml:"Name" json:"Name"`
+	Type   string   `yaml:"Type" json:"Type"`
+	TTL    int64    `yaml:"TTL" json:"TTL"`
+	Values []string `yaml:"Values" json:"Values"`
+}
+
+// BucketReq - Bucket 생성 요청 구조 정의
+type BucketReq struct {
+	ConnectionName string     `yaml:"ConnectionName" json:"ConnectionName"`
+	ReqInfo        BucketInfo `yaml:"ReqInfo" json:"ReqInfo"`
+}
+
+// BucketInfo - Bucket 정보 구조 정의
+type BucketInfo struct {
+	Name string `yaml:"Name" json:"Name"`
+}
+
+// ObjectReq - Object 저장 요청 구조 정의
+type ObjectReq struct {
+	ConnectionName string `yaml:"ConnectionName" json:"ConnectionName"`
+	Name           string `yaml:"Name" json:"Name"`
+	ObjectName     string `yaml:"ObjectName" json:"ObjectName"`
+	Size           int64  `yaml:"Size" json:"Size"`
+	ContentType    string `yaml:"ContentType" json:"ContentType"`
+}
+
+// PresignedURLReq - Presigned URL 요청 구조 정의
+type PresignedURLReq struct {
+	ConnectionName string           `yaml:"ConnectionName" json:"ConnectionName"`
+	Name           string           `yaml:"Name" json:"Name"`
+	ReqInfo        PresignedURLInfo `yaml:"ReqInfo" json:"ReqInfo"`
+}
+
+// PresignedURLInfo - Presigned URL 정보 구조 정의
+type PresignedURLInfo struct {
+	ObjectName string `yaml:"ObjectName" json:"ObjectName"`
+	Method     string `yaml:"Method" json:"Method"`
+	Expires    string `yaml:"Expires" json:"Expires"`
 }
 
 // SSHRUNReq - SSH 실행 요청 구조 정의
@@ -560,6 +760,81 @@ func (ccm *CCMApi) GetOrgVMSpecByParam(connectionName string, name string) (stri
 	return result, err
 }
 
+// ListRegionZone - CSP의 Region, Zone 목록
+func (ccm *CCMApi) ListRegionZone(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.ListRegionZone()
+}
+
+// ListRegionZoneByParam - CSP의 Region, Zone 목록
+func (ccm *CCMApi) ListRegionZoneByParam(connectionName string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `"}`
+	result, err := ccm.requestCCM.ListRegionZone()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// GetRegionZone - CSP의 Region, Zone 조회
+func (ccm *CCMApi) GetRegionZone(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.GetRegionZone()
+}
+
+// GetRegionZoneByParam - CSP의 Region, Zone 조회
+func (ccm *CCMApi) GetRegionZoneByParam(connectionName string, name string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Name":"` + name + `"}`
+	result, err := ccm.requestCCM.GetRegionZone()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// ListZone - Connection의 Region에 있는 Zone 목록
+func (ccm *CCMApi) ListZone(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.ListZone()
+}
+
+// ListZoneByParam - Connection의 Region에 있는 Zone 목록
+func (ccm *CCMApi) ListZoneByParam(connectionName string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `"}`
+	result, err := ccm.requestCCM.ListZone()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
 // CreateVPC - VPC 생성
 func (ccm *CCMApi) CreateVPC(doc string) (string, error) {
 	if ccm.requestCCM == nil {
@@ -1051,6 +1326,35 @@ func (ccm *CCMApi) StartVMByParam(req *VMReq) (string, error) {
 	return result, err
 }
 
+// StartVMGroup - VM 그룹 시작
+func (ccm *CCMApi) StartVMGroup(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.StartVMGroup()
+}
+
+// StartVMGroupByParam - VM 그룹 시작
+func (ccm *CCMApi) StartVMGroupByParam(req *VMGroupReq) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	ccm.requestCCM.InData = string(j)
+	result, err := ccm.requestCCM.StartVMGroup()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
 // ControlVM - VM 제어
 func (ccm *CCMApi) ControlVM(doc string) (string, error) {
 	if ccm.requestCCM == nil {
@@ -1126,6 +1430,31 @@ func (ccm *CCMApi) GetVMStatusByParam(connectionName string, name string) (strin
 	return result, err
 }
 
+// GetVMConsoleOutput - VM 콘솔 출력 조회
+func (ccm *CCMApi) GetVMConsoleOutput(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.GetVMConsoleOutput()
+}
+
+// GetVMConsoleOutputByParam - VM 콘솔 출력 조회
+func (ccm *CCMApi) GetVMConsoleOutputByParam(connectionName string, name string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Name":"` + name + `"}`
+	result, err := ccm.requestCCM.GetVMConsoleOutput()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
 // ListVM - VM 목록
 func (ccm *CCMApi) ListVM(doc string) (string, error) {
 	if ccm.requestCCM == nil {
@@ -1251,6 +1580,1216 @@ func (ccm *CCMApi) TerminateCSPVMByParam(connectionName string, id string) (stri
 	return result, err
 }
 
+// CreateNLB - NLB 생성
+func (ccm *CCMApi) CreateNLB(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.CreateNLB()
+}
+
+// CreateNLBByParam - NLB 생성
+func (ccm *CCMApi) CreateNLBByParam(req *NLBReq) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	ccm.requestCCM.InData = string(j)
+	result, err := ccm.requestCCM.CreateNLB()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// ListNLB - NLB 목록
+func (ccm *CCMApi) ListNLB(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.ListNLB()
+}
+
+// ListNLBByParam - NLB 목록
+func (ccm *CCMApi) ListNLBByParam(connectionName string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `"}`
+	result, err := ccm.requestCCM.ListNLB()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// GetNLB - NLB 조회
+func (ccm *CCMApi) GetNLB(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.GetNLB()
+}
+
+// GetNLBByParam - NLB 조회
+func (ccm *CCMApi) GetNLBByParam(connectionName string, name string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Name":"` + name + `"}`
+	result, err := ccm.requestCCM.GetNLB()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// DeleteNLB - NLB 삭제
+func (ccm *CCMApi) DeleteNLB(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.DeleteNLB()
+}
+
+// DeleteNLBByParam - NLB 삭제
+func (ccm *CCMApi) DeleteNLBByParam(connectionName string, name string, force string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Name":"` + name + `", "force":"` + force + `"}`
+	result, err := ccm.requestCCM.DeleteNLB()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// ListAllNLB - 관리 NLB 목록
+func (ccm *CCMApi) ListAllNLB(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.ListAllNLB()
+}
+
+// ListAllNLBByParam - 관리 NLB 목록
+func (ccm *CCMApi) ListAllNLBByParam(connectionName string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `"}`
+	result, err := ccm.requestCCM.ListAllNLB()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// DeleteCSPNLB - 관리 NLB 삭제
+func (ccm *CCMApi) DeleteCSPNLB(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.DeleteCSPNLB()
+}
+
+// DeleteCSPNLBByParam - 관리 NLB 삭제
+func (ccm *CCMApi) DeleteCSPNLBByParam(connectionName string, id string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Id":"` + id + `"}`
+	result, err := ccm.requestCCM.DeleteCSPNLB()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// AddNLBVMs - NLB VM 그룹에 VM 추가
+func (ccm *CCMApi) AddNLBVMs(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.AddNLBVMs()
+}
+
+// AddNLBVMsByParam - NLB VM 그룹에 VM 추가
+func (ccm *CCMApi) AddNLBVMsByParam(req *NLBVMsReq) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	ccm.requestCCM.InData = string(j)
+	result, err := ccm.requestCCM.AddNLBVMs()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// RemoveNLBVMs - NLB VM 그룹에서 VM 제거
+func (ccm *CCMApi) RemoveNLBVMs(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.RemoveNLBVMs()
+}
+
+// RemoveNLBVMsByParam - NLB VM 그룹에서 VM 제거
+func (ccm *CCMApi) RemoveNLBVMsByParam(req *NLBVMsReq) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	ccm.requestCCM.InData = string(j)
+	result, err := ccm.requestCCM.RemoveNLBVMs()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// GetNLBVMGroupHealthInfo - NLB VM 그룹 상태 조회
+func (ccm *CCMApi) GetNLBVMGroupHealthInfo(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.GetNLBVMGroupHealthInfo()
+}
+
+// GetNLBVMGroupHealthInfoByParam - NLB VM 그룹 상태 조회
+func (ccm *CCMApi) GetNLBVMGroupHealthInfoByParam(connectionName string, name string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Name":"` + name + `"}`
+	result, err := ccm.requestCCM.GetNLBVMGroupHealthInfo()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// ChangeNLBListener - NLB Listener 변경
+func (ccm *CCMApi) ChangeNLBListener(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.ChangeNLBListener()
+}
+
+// ChangeNLBListenerByParam - NLB Listener 변경
+func (ccm *CCMApi) ChangeNLBListenerByParam(req *NLBListenerReq) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	ccm.requestCCM.InData = string(j)
+	result, err := ccm.requestCCM.ChangeNLBListener()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// ChangeNLBHealthChecker - NLB HealthChecker 변경
+func (ccm *CCMApi) ChangeNLBHealthChecker(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.ChangeNLBHealthChecker()
+}
+
+// ChangeNLBHealthCheckerByParam - NLB HealthChecker 변경
+func (ccm *CCMApi) ChangeNLBHealthCheckerByParam(req *NLBHealthCheckerReq) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	ccm.requestCCM.InData = string(j)
+	result, err := ccm.requestCCM.ChangeNLBHealthChecker()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// AllocatePublicIP - PublicIP 할당
+func (ccm *CCMApi) AllocatePublicIP(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.AllocatePublicIP()
+}
+
+// AllocatePublicIPByParam - PublicIP 할당
+func (ccm *CCMApi) AllocatePublicIPByParam(req *PublicIPReq) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	ccm.requestCCM.InData = string(j)
+	result, err := ccm.requestCCM.AllocatePublicIP()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// ListPublicIP - PublicIP 목록
+func (ccm *CCMApi) ListPublicIP(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.ListPublicIP()
+}
+
+// ListPublicIPByParam - PublicIP 목록
+func (ccm *CCMApi) ListPublicIPByParam(connectionName string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `"}`
+	result, err := ccm.requestCCM.ListPublicIP()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// GetPublicIP - PublicIP 조회
+func (ccm *CCMApi) GetPublicIP(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.GetPublicIP()
+}
+
+// GetPublicIPByParam - PublicIP 조회
+func (ccm *CCMApi) GetPublicIPByParam(connectionName string, name string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Name":"` + name + `"}`
+	result, err := ccm.requestCCM.GetPublicIP()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// ReleasePublicIP - PublicIP 반납
+func (ccm *CCMApi) ReleasePublicIP(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.ReleasePublicIP()
+}
+
+// ReleasePublicIPByParam - PublicIP 반납
+func (ccm *CCMApi) ReleasePublicIPByParam(connectionName string, name string, force string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Name":"` + name + `", "force":"` + force + `"}`
+	result, err := ccm.requestCCM.ReleasePublicIP()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// ListAllPublicIP - 관리 PublicIP 목록
+func (ccm *CCMApi) ListAllPublicIP(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.ListAllPublicIP()
+}
+
+// ListAllPublicIPByParam - 관리 PublicIP 목록
+func (ccm *CCMApi) ListAllPublicIPByParam(connectionName string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `"}`
+	result, err := ccm.requestCCM.ListAllPublicIP()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// ReleaseCSPPublicIP - 관리 PublicIP 반납
+func (ccm *CCMApi) ReleaseCSPPublicIP(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.ReleaseCSPPublicIP()
+}
+
+// ReleaseCSPPublicIPByParam - 관리 PublicIP 반납
+func (ccm *CCMApi) ReleaseCSPPublicIPByParam(connectionName string, id string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Id":"` + id + `"}`
+	result, err := ccm.requestCCM.ReleaseCSPPublicIP()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// AssociatePublicIP - PublicIP를 VM에 연결
+func (ccm *CCMApi) AssociatePublicIP(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.AssociatePublicIP()
+}
+
+// AssociatePublicIPByParam - PublicIP를 VM에 연결
+func (ccm *CCMApi) AssociatePublicIPByParam(req *PublicIPAssociateReq) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	ccm.requestCCM.InData = string(j)
+	result, err := ccm.requestCCM.AssociatePublicIP()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// DisassociatePublicIP - PublicIP를 VM에서 해제
+func (ccm *CCMApi) DisassociatePublicIP(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.DisassociatePublicIP()
+}
+
+// DisassociatePublicIPByParam - PublicIP를 VM에서 해제
+func (ccm *CCMApi) DisassociatePublicIPByParam(connectionName string, name string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Name":"` + name + `"}`
+	result, err := ccm.requestCCM.DisassociatePublicIP()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// RequestVPCPeering - VPC Peering 요청
+func (ccm *CCMApi) RequestVPCPeering(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.RequestVPCPeering()
+}
+
+// RequestVPCPeeringByParam - VPC Peering 요청
+func (ccm *CCMApi) RequestVPCPeeringByParam(req *VPCPeeringReq) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	ccm.requestCCM.InData = string(j)
+	result, err := ccm.requestCCM.RequestVPCPeering()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// AcceptVPCPeering - VPC Peering 수락
+func (ccm *CCMApi) AcceptVPCPeering(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.AcceptVPCPeering()
+}
+
+// AcceptVPCPeeringByParam - VPC Peering 수락
+func (ccm *CCMApi) AcceptVPCPeeringByParam(connectionName string, name string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Name":"` + name + `"}`
+	result, err := ccm.requestCCM.AcceptVPCPeering()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// ListVPCPeering - VPC Peering 목록
+func (ccm *CCMApi) ListVPCPeering(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.ListVPCPeering()
+}
+
+// ListVPCPeeringByParam - VPC Peering 목록
+func (ccm *CCMApi) ListVPCPeeringByParam(connectionName string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `"}`
+	result, err := ccm.requestCCM.ListVPCPeering()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// GetVPCPeering - VPC Peering 조회
+func (ccm *CCMApi) GetVPCPeering(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.GetVPCPeering()
+}
+
+// GetVPCPeeringByParam - VPC Peering 조회
+func (ccm *CCMApi) GetVPCPeeringByParam(connectionName string, name string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Name":"` + name + `"}`
+	result, err := ccm.requestCCM.GetVPCPeering()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// DeleteVPCPeering - VPC Peering 삭제
+func (ccm *CCMApi) DeleteVPCPeering(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.DeleteVPCPeering()
+}
+
+// DeleteVPCPeeringByParam - VPC Peering 삭제
+func (ccm *CCMApi) DeleteVPCPeeringByParam(connectionName string, name string, force string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Name":"` + name + `", "force":"` + force + `"}`
+	result, err := ccm.requestCCM.DeleteVPCPeering()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// ListAllVPCPeering - 관리 VPC Peering 목록
+func (ccm *CCMApi) ListAllVPCPeering(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.ListAllVPCPeering()
+}
+
+// ListAllVPCPeeringByParam - 관리 VPC Peering 목록
+func (ccm *CCMApi) ListAllVPCPeeringByParam(connectionName string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `"}`
+	result, err := ccm.requestCCM.ListAllVPCPeering()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// DeleteCSPVPCPeering - 관리 VPC Peering 삭제
+func (ccm *CCMApi) DeleteCSPVPCPeering(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.DeleteCSPVPCPeering()
+}
+
+// DeleteCSPVPCPeeringByParam - 관리 VPC Peering 삭제
+func (ccm *CCMApi) DeleteCSPVPCPeeringByParam(connectionName string, id string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Id":"` + id + `"}`
+	result, err := ccm.requestCCM.DeleteCSPVPCPeering()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// CreateDNSZone - Private DNS Zone 생성
+func (ccm *CCMApi) CreateDNSZone(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.CreateDNSZone()
+}
+
+// CreateDNSZoneByParam - Private DNS Zone 생성
+func (ccm *CCMApi) CreateDNSZoneByParam(req *DNSZoneReq) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	ccm.requestCCM.InData = string(j)
+	result, err := ccm.requestCCM.CreateDNSZone()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// ListDNSZone - Private DNS Zone 목록
+func (ccm *CCMApi) ListDNSZone(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.ListDNSZone()
+}
+
+// ListDNSZoneByParam - Private DNS Zone 목록
+func (ccm *CCMApi) ListDNSZoneByParam(connectionName string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `"}`
+	result, err := ccm.requestCCM.ListDNSZone()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// GetDNSZone - Private DNS Zone 조회
+func (ccm *CCMApi) GetDNSZone(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.GetDNSZone()
+}
+
+// GetDNSZoneByParam - Private DNS Zone 조회
+func (ccm *CCMApi) GetDNSZoneByParam(connectionName string, name string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Name":"` + name + `"}`
+	result, err := ccm.requestCCM.GetDNSZone()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// DeleteDNSZone - Private DNS Zone 삭제
+func (ccm *CCMApi) DeleteDNSZone(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.DeleteDNSZone()
+}
+
+// DeleteDNSZoneByParam - Private DNS Zone 삭제
+func (ccm *CCMApi) DeleteDNSZoneByParam(connectionName string, name string, force string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Name":"` + name + `", "force":"` + force + `"}`
+	result, err := ccm.requestCCM.DeleteDNSZone()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// ListAllDNSZone - 관리 Private DNS Zone 목록
+func (ccm *CCMApi) ListAllDNSZone(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.ListAllDNSZone()
+}
+
+// ListAllDNSZoneByParam - 관리 Private DNS Zone 목록
+func (ccm *CCMApi) ListAllDNSZoneByParam(connectionName string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `"}`
+	result, err := ccm.requestCCM.ListAllDNSZone()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// DeleteCSPDNSZone - 관리 Private DNS Zone 삭제
+func (ccm *CCMApi) DeleteCSPDNSZone(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.DeleteCSPDNSZone()
+}
+
+// DeleteCSPDNSZoneByParam - 관리 Private DNS Zone 삭제
+func (ccm *CCMApi) DeleteCSPDNSZoneByParam(connectionName string, id string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Id":"` + id + `"}`
+	result, err := ccm.requestCCM.DeleteCSPDNSZone()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// AddDNSRecord - Private DNS Zone에 레코드 추가
+func (ccm *CCMApi) AddDNSRecord(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.AddDNSRecord()
+}
+
+// AddDNSRecordByParam - Private DNS Zone에 레코드 추가
+func (ccm *CCMApi) AddDNSRecordByParam(req *DNSRecordReq) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	ccm.requestCCM.InData = string(j)
+	result, err := ccm.requestCCM.AddDNSRecord()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// UpdateDNSRecord - Private DNS Zone의 레코드 변경
+func (ccm *CCMApi) UpdateDNSRecord(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.UpdateDNSRecord()
+}
+
+// UpdateDNSRecordByParam - Private DNS Zone의 레코드 변경
+func (ccm *CCMApi) UpdateDNSRecordByParam(req *DNSRecordReq) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	ccm.requestCCM.InData = string(j)
+	result, err := ccm.requestCCM.UpdateDNSRecord()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// RemoveDNSRecord - Private DNS Zone의 레코드 삭제
+func (ccm *CCMApi) RemoveDNSRecord(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.RemoveDNSRecord()
+}
+
+// RemoveDNSRecordByParam - Private DNS Zone의 레코드 삭제
+func (ccm *CCMApi) RemoveDNSRecordByParam(req *DNSRecordReq) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	ccm.requestCCM.InData = string(j)
+	result, err := ccm.requestCCM.RemoveDNSRecord()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// CreateBucket - Bucket 생성
+func (ccm *CCMApi) CreateBucket(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.CreateBucket()
+}
+
+// CreateBucketByParam - Bucket 생성
+func (ccm *CCMApi) CreateBucketByParam(req *BucketReq) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	ccm.requestCCM.InData = string(j)
+	result, err := ccm.requestCCM.CreateBucket()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// ListBucket - Bucket 목록
+func (ccm *CCMApi) ListBucket(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.ListBucket()
+}
+
+// ListBucketByParam - Bucket 목록
+func (ccm *CCMApi) ListBucketByParam(connectionName string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `"}`
+	result, err := ccm.requestCCM.ListBucket()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// GetBucket - Bucket 조회
+func (ccm *CCMApi) GetBucket(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.GetBucket()
+}
+
+// GetBucketByParam - Bucket 조회
+func (ccm *CCMApi) GetBucketByParam(connectionName string, name string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Name":"` + name + `"}`
+	result, err := ccm.requestCCM.GetBucket()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// DeleteBucket - Bucket 삭제
+func (ccm *CCMApi) DeleteBucket(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.DeleteBucket()
+}
+
+// DeleteBucketByParam - Bucket 삭제
+func (ccm *CCMApi) DeleteBucketByParam(connectionName string, name string, force string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Name":"` + name + `", "force":"` + force + `"}`
+	result, err := ccm.requestCCM.DeleteBucket()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// ListAllBucket - 관리 Bucket 목록
+func (ccm *CCMApi) ListAllBucket(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.ListAllBucket()
+}
+
+// ListAllBucketByParam - 관리 Bucket 목록
+func (ccm *CCMApi) ListAllBucketByParam(connectionName string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `"}`
+	result, err := ccm.requestCCM.ListAllBucket()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// DeleteCSPBucket - 관리 Bucket 삭제
+func (ccm *CCMApi) DeleteCSPBucket(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.DeleteCSPBucket()
+}
+
+// DeleteCSPBucketByParam - 관리 Bucket 삭제
+func (ccm *CCMApi) DeleteCSPBucketByParam(connectionName string, id string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Id":"` + id + `"}`
+	result, err := ccm.requestCCM.DeleteCSPBucket()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// ListObject - Bucket의 Object 목록
+func (ccm *CCMApi) ListObject(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.ListObject()
+}
+
+// ListObjectByParam - Bucket의 Object 목록
+func (ccm *CCMApi) ListObjectByParam(connectionName string, name string, prefix string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Name":"` + name + `", "prefix":"` + prefix + `"}`
+	result, err := ccm.requestCCM.ListObject()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// PutObject - Bucket에 Object 저장
+func (ccm *CCMApi) PutObject(doc string, reader io.Reader) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.PutObject(reader)
+}
+
+// PutObjectByParam - Bucket에 Object 저장
+func (ccm *CCMApi) PutObjectByParam(req *ObjectReq, reader io.Reader) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	ccm.requestCCM.InData = string(j)
+	result, err := ccm.requestCCM.PutObject(reader)
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// GetObject - Bucket의 Object 조회
+func (ccm *CCMApi) GetObject(doc string, writer io.Writer) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.GetObject(writer)
+}
+
+// GetObjectByParam - Bucket의 Object 조회
+func (ccm *CCMApi) GetObjectByParam(connectionName string, name string, objectName string, writer io.Writer) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Name":"` + name + `", "ObjectName":"` + objectName + `"}`
+	result, err := ccm.requestCCM.GetObject(writer)
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// DeleteObject - Bucket의 Object 삭제
+func (ccm *CCMApi) DeleteObject(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.DeleteObject()
+}
+
+// DeleteObjectByParam - Bucket의 Object 삭제
+func (ccm *CCMApi) DeleteObjectByParam(connectionName string, name string, objectName string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	ccm.requestCCM.InData = `{"ConnectionName":"` + connectionName + `", "Name":"` + name + `", "ObjectName":"` + objectName + `"}`
+	result, err := ccm.requestCCM.DeleteObject()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
+// GetPresignedURL - Object의 Presigned URL 조회
+func (ccm *CCMApi) GetPresignedURL(doc string) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	ccm.requestCCM.InData = doc
+	return ccm.requestCCM.GetPresignedURL()
+}
+
+// GetPresignedURLByParam - Object의 Presigned URL 조회
+func (ccm *CCMApi) GetPresignedURLByParam(req *PresignedURLReq) (string, error) {
+	if ccm.requestCCM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := ccm.GetInType()
+	ccm.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	ccm.requestCCM.InData = string(j)
+	result, err := ccm.requestCCM.GetPresignedURL()
+	ccm.SetInType(holdType)
+
+	return result, err
+}
+
 // SSHRun - SSH 실행
 func (ccm *CCMApi) SSHRun(doc string) (string, error) {
 	if ccm.requestSSH == nil {
@@ -36,34 +36,51 @@ type KeyValue struct {
 	Value string `yaml:"Value" json:"Value"`
 }
 
-// CloudDriverReq - Cloud Driver 정보 생성 요청 구조 정의
+// CloudDriverReq - Cloud Driver 정보 생성/변경 요청 구조 정의 (Version은 변경 요청에만 사용)
 type CloudDriverReq struct {
 	DriverName        string `yaml:"DriverName" json:"DriverName"`
 	ProviderName      string `yaml:"ProviderName" json:"ProviderName"`
 	DriverLibFileName string `yaml:"DriverLibFileName" json:"DriverLibFileName"`
+	Version           int    `yaml:"Version" json:"Version"`
 }
 
-// CredentialReq - Credential 정보 생성 요청 구조 정의
+// CredentialReq - Credential 정보 생성/변경 요청 구조 정의 (Version은 변경 요청에만 사용)
 type CredentialReq struct {
 	CredentialName   string     `yaml:"CredentialName" json:"CredentialName"`
 	ProviderName     string     `yaml:"ProviderName" json:"ProviderName"`
 	KeyValueInfoList []KeyValue `yaml:"KeyValueInfoList" json:"KeyValueInfoList"`
+	Version          int        `yaml:"Version" json:"Version"`
 }
 
-// RegionReq - Region 정보 생성 요청 구조 정의
+// RegionReq - Region 정보 생성/변경 요청 구조 정의 (Version은 변경 요청에만 사용)
 type RegionReq struct {
 	RegionName       string     `yaml:"RegionName" json:"RegionName"`
 	ProviderName     string     `yaml:"ProviderName" json:"ProviderName"`
 	KeyValueInfoList []KeyValue `yaml:"KeyValueInfoList" json:"KeyValueInfoList"`
+	Version          int        `yaml:"Version" json:"Version"`
 }
 
-// ConnectionConfigReq - Connection Config 정보 생성 요청 구조 정의
+// RegionSyncReq - CSP의 Region, Zone 목록으로 Region 동기화 요청 구조 정의
+type RegionSyncReq struct {
+	ConnectionName   string     `yaml:"ConnectionName" json:"ConnectionName"`
+	KeyValueInfoList []KeyValue `yaml:"KeyValueInfoList" json:"KeyValueInfoList"`
+}
+
+// ConnectionConfigReq - Connection Config 정보 생성/변경 요청 구조 정의 (Version은 변경 요청에만 사용)
 type ConnectionConfigReq struct {
 	ConfigName     string `yaml:"ConfigName" json:"ConfigName"`
 	ProviderName   string `yaml:"ProviderName" json:"ProviderName"`
 	DriverName     string `yaml:"DriverName" json:"DriverName"`
 	CredentialName string `yaml:"CredentialName" json:"CredentialName"`
 	RegionName     string `yaml:"RegionName" json:"RegionName"`
+	Version        int    `yaml:"Version" json:"Version"`
+}
+
+// ImageMapReq - Image Map 정보 생성 요청 구조 정의
+type ImageMapReq struct {
+	ImageName  string `yaml:"ImageName" json:"ImageName"`
+	RegionName string `yaml:"RegionName" json:"RegionName"`
+	CSPImageId string `yaml:"CSPImageId" json:"CSPImageId"`
 }
 
 // ===== [ Implementations ] =====
@@ -304,6 +321,31 @@ func (cim *CIMApi) ListCloudOS() (string, error) {
 	return cim.requestCIM.ListCloudOS()
 }
 
+// GetCloudOSSchema - Cloud OS의 Credential, Region Key 정의 조회
+func (cim *CIMApi) GetCloudOSSchema(doc string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	cim.requestCIM.InData = doc
+	return cim.requestCIM.GetCloudOSSchema()
+}
+
+// GetCloudOSSchemaByParam - Cloud OS의 Credential, Region Key 정의 조회
+func (cim *CIMApi) GetCloudOSSchemaByParam(cloudOSName string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := cim.GetInType()
+	cim.SetInType("json")
+	cim.requestCIM.InData = `{"CloudOSName":"` + cloudOSName + `"}`
+	result, err := cim.requestCIM.GetCloudOSSchema()
+	cim.SetInType(holdType)
+
+	return result, err
+}
+
 // CreateCloudDriver - Cloud Driver 생성
 func (cim *CIMApi) CreateCloudDriver(doc string) (string, error) {
 	if cim.requestCIM == nil {
@@ -367,6 +409,31 @@ func (cim *CIMApi) GetCloudDriverByParam(driverName string) (string, error) {
 	return result, err
 }
 
+// GetCloudDriverCapability - Cloud Driver가 지원하는 Handler와 기능 조회
+func (cim *CIMApi) GetCloudDriverCapability(doc string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	cim.requestCIM.InData = doc
+	return cim.requestCIM.GetCloudDriverCapability()
+}
+
+// GetCloudDriverCapabilityByParam - Cloud Driver가 지원하는 Handler와 기능 조회
+func (cim *CIMApi) GetCloudDriverCapabilityByParam(driverName string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := cim.GetInType()
+	cim.SetInType("json")
+	cim.requestCIM.InData = `{"DriverName":"` + driverName + `"}`
+	result, err := cim.requestCIM.GetCloudDriverCapability()
+	cim.SetInType(holdType)
+
+	return result, err
+}
+
 // DeleteCloudDriver - Cloud Driver 삭제
 func (cim *CIMApi) DeleteCloudDriver(doc string) (string, error) {
 	if cim.requestCIM == nil {
@@ -378,20 +445,49 @@ func (cim *CIMApi) DeleteCloudDriver(doc string) (string, error) {
 }
 
 // DeleteCloudDriverByParam - Cloud Driver 삭제
-func (cim *CIMApi) DeleteCloudDriverByParam(driverName string) (string, error) {
+func (cim *CIMApi) DeleteCloudDriverByParam(driverName string, cascade string, orphan string) (string, error) {
 	if cim.requestCIM == nil {
 		return "", errors.New("The Open() function must be called")
 	}
 
 	holdType, _ := cim.GetInType()
 	cim.SetInType("json")
-	cim.requestCIM.InData = `{"DriverName":"` + driverName + `"}`
+	cim.requestCIM.InData = `{"DriverName":"` + driverName + `", "cascade":"` + cascade + `", "orphan":"` + orphan + `"}`
 	result, err := cim.requestCIM.DeleteCloudDriver()
 	cim.SetInType(holdType)
 
 	return result, err
 }
 
+// UpdateCloudDriver - Cloud Driver 변경
+func (cim *CIMApi) UpdateCloudDriver(doc string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	cim.requestCIM.InData = doc
+	return cim.requestCIM.UpdateCloudDriver()
+}
+
+// UpdateCloudDriverByParam - Cloud Driver 변경
+func (cim *CIMApi) UpdateCloudDriverByParam(req *CloudDriverReq) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := cim.GetInType()
+	cim.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	cim.requestCIM.InData = string(j)
+	result, err := cim.requestCIM.UpdateCloudDriver()
+	cim.SetInType(holdType)
+
+	return result, err
+}
+
 // CreateCredential - Credential 생성
 func (cim *CIMApi) CreateCredential(doc string) (string, error) {
 	if cim.requestCIM == nil {
@@ -466,20 +562,112 @@ func (cim *CIMApi) DeleteCredential(doc string) (string, error) {
 }
 
 // DeleteCredentialByParam - Credential 삭제
-func (cim *CIMApi) DeleteCredentialByParam(credentialName string) (string, error) {
+func (cim *CIMApi) DeleteCredentialByParam(credentialName string, cascade string, orphan string) (string, error) {
 	if cim.requestCIM == nil {
 		return "", errors.New("The Open() function must be called")
 	}
 
 	holdType, _ := cim.GetInType()
 	cim.SetInType("json")
-	cim.requestCIM.InData = `{"CredentialName":"` + credentialName + `"}`
+	cim.requestCIM.InData = `{"CredentialName":"` + credentialName + `", "cascade":"` + cascade + `", "orphan":"` + orphan + `"}`
 	result, err := cim.requestCIM.DeleteCredential()
 	cim.SetInType(holdType)
 
 	return result, err
 }
 
+// RotateCredentialKey - Credential Master Key 교체
+func (cim *CIMApi) RotateCredentialKey() (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	return cim.requestCIM.RotateCredentialKey()
+}
+
+// VerifyCredential - Credential 검증
+func (cim *CIMApi) VerifyCredential(doc string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	cim.requestCIM.InData = doc
+	return cim.requestCIM.VerifyCredential()
+}
+
+// VerifyCredentialByParam - Credential 검증
+func (cim *CIMApi) VerifyCredentialByParam(credentialName string, regionName string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := cim.GetInType()
+	cim.SetInType("json")
+	cim.requestCIM.InData = `{"CredentialName":"` + credentialName + `", "RegionName":"` + regionName + `"}`
+	result, err := cim.requestCIM.VerifyCredential()
+	cim.SetInType(holdType)
+
+	return result, err
+}
+
+// UpdateCredential - Credential 변경
+func (cim *CIMApi) UpdateCredential(doc string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	cim.requestCIM.InData = doc
+	return cim.requestCIM.UpdateCredential()
+}
+
+// UpdateCredentialByParam - Credential 변경
+func (cim *CIMApi) UpdateCredentialByParam(req *CredentialReq) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := cim.GetInType()
+	cim.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	cim.requestCIM.InData = string(j)
+	result, err := cim.requestCIM.UpdateCredential()
+	cim.SetInType(holdType)
+
+	return result, err
+}
+
+// PatchCredential - Credential 부분 변경
+func (cim *CIMApi) PatchCredential(doc string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	cim.requestCIM.InData = doc
+	return cim.requestCIM.PatchCredential()
+}
+
+// PatchCredentialByParam - Credential 부분 변경
+func (cim *CIMApi) PatchCredentialByParam(req *CredentialReq) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := cim.GetInType()
+	cim.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	cim.requestCIM.InData = string(j)
+	result, err := cim.requestCIM.PatchCredential()
+	cim.SetInType(holdType)
+
+	return result, err
+}
+
 // CreateRegion - Region 생성
 func (cim *CIMApi) CreateRegion(doc string) (string, error) {
 	if cim.requestCIM == nil {
@@ -554,20 +742,107 @@ func (cim *CIMApi) DeleteRegion(doc string) (string, error) {
 }
 
 // DeleteRegionByParam - Region 삭제
-func (cim *CIMApi) DeleteRegionByParam(regionName string) (string, error) {
+func (cim *CIMApi) DeleteRegionByParam(regionName string, cascade string, orphan string) (string, error) {
 	if cim.requestCIM == nil {
 		return "", errors.New("The Open() function must be called")
 	}
 
 	holdType, _ := cim.GetInType()
 	cim.SetInType("json")
-	cim.requestCIM.InData = `{"RegionName":"` + regionName + `"}`
+	cim.requestCIM.InData = `{"RegionName":"` + regionName + `", "cascade":"` + cascade + `", "orphan":"` + orphan + `"}`
 	result, err := cim.requestCIM.DeleteRegion()
 	cim.SetInType(holdType)
 
 	return result, err
 }
 
+// UpdateRegion - Region 변경
+func (cim *CIMApi) UpdateRegion(doc string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	cim.requestCIM.InData = doc
+	return cim.requestCIM.UpdateRegion()
+}
+
+// UpdateRegionByParam - Region 변경
+func (cim *CIMApi) UpdateRegionByParam(req *RegionReq) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := cim.GetInType()
+	cim.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	cim.requestCIM.InData = string(j)
+	result, err := cim.requestCIM.UpdateRegion()
+	cim.SetInType(holdType)
+
+	return result, err
+}
+
+// PatchRegion - Region 부분 변경
+func (cim *CIMApi) PatchRegion(doc string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	cim.requestCIM.InData = doc
+	return cim.requestCIM.PatchRegion()
+}
+
+// PatchRegionByParam - Region 부분 변경
+func (cim *CIMApi) PatchRegionByParam(req *RegionReq) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := cim.GetInType()
+	cim.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	cim.requestCIM.InData = string(j)
+	result, err := cim.requestCIM.PatchRegion()
+	cim.SetInType(holdType)
+
+	return result, err
+}
+
+// SyncRegion - CSP의 Region, Zone 목록으로 Region 등록 또는 변경
+func (cim *CIMApi) SyncRegion(doc string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	cim.requestCIM.InData = doc
+	return cim.requestCIM.SyncRegion()
+}
+
+// SyncRegionByParam - CSP의 Region, Zone 목록으로 Region 등록 또는 변경
+func (cim *CIMApi) SyncRegionByParam(req *RegionSyncReq) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := cim.GetInType()
+	cim.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	cim.requestCIM.InData = string(j)
+	result, err := cim.requestCIM.SyncRegion()
+	cim.SetInType(holdType)
+
+	return result, err
+}
+
 // CreateConnectionConfig - Connection Config 생성
 func (cim *CIMApi) CreateConnectionConfig(doc string) (string, error) {
 	if cim.requestCIM == nil {
@@ -642,20 +917,242 @@ func (cim *CIMApi) DeleteConnectionConfig(doc string) (string, error) {
 }
 
 // DeleteConnectionConfigByParam - Connection Config 삭제
-func (cim *CIMApi) DeleteConnectionConfigByParam(configName string) (string, error) {
+func (cim *CIMApi) DeleteConnectionConfigByParam(configName string, cascade string, orphan string) (string, error) {
 	if cim.requestCIM == nil {
 		return "", errors.New("The Open() function must be called")
 	}
 
 	holdType, _ := cim.GetInType()
 	cim.SetInType("json")
-	cim.requestCIM.InData = `{"ConfigName":"` + configName + `"}`
+	cim.requestCIM.InData = `{"ConfigName":"` + configName + `", "cascade":"` + cascade + `", "orphan":"` + orphan + `"}`
 	result, err := cim.requestCIM.DeleteConnectionConfig()
 	cim.SetInType(holdType)
 
 	return result, err
 }
 
+// ListConnectionConfigDependent - Connection Config로 생성된 자원의 IID 목록
+func (cim *CIMApi) ListConnectionConfigDependent(doc string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	cim.requestCIM.InData = doc
+	return cim.requestCIM.ListConnectionConfigDependent()
+}
+
+// ListConnectionConfigDependentByParam - Connection Config로 생성된 자원의 IID 목록
+func (cim *CIMApi) ListConnectionConfigDependentByParam(configName string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := cim.GetInType()
+	cim.SetInType("json")
+	cim.requestCIM.InData = `{"ConfigName":"` + configName + `"}`
+	result, err := cim.requestCIM.ListConnectionConfigDependent()
+	cim.SetInType(holdType)
+
+	return result, err
+}
+
+// GetConnectionConfigCapability - Connection Config의 Driver가 지원하는 Handler와 기능 조회
+func (cim *CIMApi) GetConnectionConfigCapability(doc string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	cim.requestCIM.InData = doc
+	return cim.requestCIM.GetConnectionConfigCapability()
+}
+
+// GetConnectionConfigCapabilityByParam - Connection Config의 Driver가 지원하는 Handler와 기능 조회
+func (cim *CIMApi) GetConnectionConfigCapabilityByParam(configName string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := cim.GetInType()
+	cim.SetInType("json")
+	cim.requestCIM.InData = `{"ConfigName":"` + configName + `"}`
+	result, err := cim.requestCIM.GetConnectionConfigCapability()
+	cim.SetInType(holdType)
+
+	return result, err
+}
+
+// UpdateConnectionConfig - Connection Config 변경
+func (cim *CIMApi) UpdateConnectionConfig(doc string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	cim.requestCIM.InData = doc
+	return cim.requestCIM.UpdateConnectionConfig()
+}
+
+// UpdateConnectionConfigByParam - Connection Config 변경
+func (cim *CIMApi) UpdateConnectionConfigByParam(req *ConnectionConfigReq) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := cim.GetInType()
+	cim.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	cim.requestCIM.InData = string(j)
+	result, err := cim.requestCIM.UpdateConnectionConfig()
+	cim.SetInType(holdType)
+
+	return result, err
+}
+
+// PatchConnectionConfig - Connection Config 부분 변경
+func (cim *CIMApi) PatchConnectionConfig(doc string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	cim.requestCIM.InData = doc
+	return cim.requestCIM.PatchConnectionConfig()
+}
+
+// PatchConnectionConfigByParam - Connection Config 부분 변경
+func (cim *CIMApi) PatchConnectionConfigByParam(req *ConnectionConfigReq) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := cim.GetInType()
+	cim.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	cim.requestCIM.InData = string(j)
+	result, err := cim.requestCIM.PatchConnectionConfig()
+	cim.SetInType(holdType)
+
+	return result, err
+}
+
+// CreateImageMap - Image Map 생성
+func (cim *CIMApi) CreateImageMap(doc string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	cim.requestCIM.InData = doc
+	return cim.requestCIM.CreateImageMap()
+}
+
+// CreateImageMapByParam - Image Map 생성
+func (cim *CIMApi) CreateImageMapByParam(req *ImageMapReq) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := cim.GetInType()
+	cim.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	cim.requestCIM.InData = string(j)
+	result, err := cim.requestCIM.CreateImageMap()
+	cim.SetInType(holdType)
+
+	return result, err
+}
+
+// ImportImageMap - YAML 문서로 Image Map 일괄 생성
+func (cim *CIMApi) ImportImageMap(yamlDoc string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	cim.requestCIM.InData = yamlDoc
+	return cim.requestCIM.ImportImageMap()
+}
+
+// ListImageMap - Image Map 목록
+func (cim *CIMApi) ListImageMap() (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	cim.requestCIM.InData = ""
+	return cim.requestCIM.ListImageMap()
+}
+
+// ListImageMapByParam - 지정한 Image 이름의 Image Map 목록
+func (cim *CIMApi) ListImageMapByParam(imageName string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := cim.GetInType()
+	cim.SetInType("json")
+	cim.requestCIM.InData = `{"ImageName":"` + imageName + `"}`
+	result, err := cim.requestCIM.ListImageMap()
+	cim.SetInType(holdType)
+
+	return result, err
+}
+
+// GetImageMap - Image Map 조회
+func (cim *CIMApi) GetImageMap(doc string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	cim.requestCIM.InData = doc
+	return cim.requestCIM.GetImageMap()
+}
+
+// GetImageMapByParam - Image Map 조회
+func (cim *CIMApi) GetImageMapByParam(imageName string, regionName string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := cim.GetInType()
+	cim.SetInType("json")
+	cim.requestCIM.InData = `{"ImageName":"` + imageName + `", "RegionName":"` + regionName + `"}`
+	result, err := cim.requestCIM.GetImageMap()
+	cim.SetInType(holdType)
+
+	return result, err
+}
+
+// DeleteImageMap - Image Map 삭제
+func (cim *CIMApi) DeleteImageMap(doc string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	cim.requestCIM.InData = doc
+	return cim.requestCIM.DeleteImageMap()
+}
+
+// DeleteImageMapByParam - Image Map 삭제
+func (cim *CIMApi) DeleteImageMapByParam(imageName string, regionName string) (string, error) {
+	if cim.requestCIM == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := cim.GetInType()
+	cim.SetInType("json")
+	cim.requestCIM.InData = `{"ImageName":"` + imageName + `", "RegionName":"` + regionName + `"}`
+	result, err := cim.requestCIM.DeleteImageMap()
+	cim.SetInType(holdType)
+
+	return result, err
+}
+
 // ===== [ Private Functions ] =====
 
 // ===== [ Public Functions ] =====
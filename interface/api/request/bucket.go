@@ -0,0 +1,374 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	gc "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/common"
+	pb "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/stub/cbspider"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// objectChunkSize - PutObject 스트림으로 전송하는 데이터 단위
+const objectChunkSize = 64 * 1024
+
+// ===== [ Types ] =====
+
+// ===== [ Implementations ] =====
+
+// CreateBucket - Bucket 생성
+func (r *CCMRequest) CreateBucket() (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.BucketCreateRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err2 := r.Client.CreateBucket(ctx, &item)
+	if err2 != nil {
+		return "", err2
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp.Item)
+}
+
+// ListBucket - Bucket 목록
+func (r *CCMRequest) ListBucket() (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.BucketAllQryRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err2 := r.Client.ListBucket(ctx, &item)
+	if err2 != nil {
+		return "", err2
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp)
+}
+
+// GetBucket - Bucket 조회
+func (r *CCMRequest) GetBucket() (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.BucketQryRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err2 := r.Client.GetBucket(ctx, &item)
+	if err2 != nil {
+		return "", err2
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp.Item)
+}
+
+// DeleteBucket - Bucket 삭제
+func (r *CCMRequest) DeleteBucket() (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.BucketQryRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err2 := r.Client.DeleteBucket(ctx, &item)
+	if err2 != nil {
+		return "", err2
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp)
+}
+
+// ListAllBucket - 관리 Bucket 목록
+func (r *CCMRequest) ListAllBucket() (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.BucketAllQryRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err2 := r.Client.ListAllBucket(ctx, &item)
+	if err2 != nil {
+		return "", err2
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp)
+}
+
+// DeleteCSPBucket - 관리 Bucket 삭제
+func (r *CCMRequest) DeleteCSPBucket() (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.CSPBucketQryRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err2 := r.Client.DeleteCSPBucket(ctx, &item)
+	if err2 != nil {
+		return "", err2
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp)
+}
+
+// ListObject - Bucket의 Object 목록
+func (r *CCMRequest) ListObject() (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.ObjectListRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err2 := r.Client.ListObject(ctx, &item)
+	if err2 != nil {
+		return "", err2
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp)
+}
+
+// PutObject - Bucket에 Object 저장
+// 입력데이터로 Object 정보를 받고, reader의 데이터를 나누어 스트림으로 전송
+func (r *CCMRequest) PutObject(reader io.Reader) (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+	if reader == nil {
+		return "", errors.New("object data reader required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.ObjectPutRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청, 전송 시간은 Object 크기에 따라 다르므로 Timeout은 적용하지 않음
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := r.Client.PutObject(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	// 첫 메시지로 Object 정보 전송
+	err = stream.Send(&item)
+	if err != nil {
+		return "", err
+	}
+
+	buf := make([]byte, objectChunkSize)
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			if sendErr := stream.Send(&pb.ObjectPutRequest{Data: buf[:n]}); sendErr != nil {
+				return "", sendErr
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	resp, err2 := stream.CloseAndRecv()
+	if err2 != nil {
+		return "", err2
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp.Item)
+}
+
+// GetObject - Bucket의 Object 조회
+// 스트림으로 수신한 데이터를 writer에 쓰고, Object 정보를 결과로 반환
+func (r *CCMRequest) GetObject(writer io.Writer) (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+	if writer == nil {
+		return "", errors.New("object data writer required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.ObjectQryRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청, 전송 시간은 Object 크기에 따라 다르므로 Timeout은 적용하지 않음
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := r.Client.GetObject(ctx, &item)
+	if err != nil {
+		return "", err
+	}
+
+	var info *pb.ObjectInfo
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		if resp.Item != nil {
+			info = resp.Item
+		}
+		if len(resp.Data) > 0 {
+			if _, err := writer.Write(resp.Data); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &info)
+}
+
+// DeleteObject - Bucket의 Object 삭제
+func (r *CCMRequest) DeleteObject() (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.ObjectQryRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err2 := r.Client.DeleteObject(ctx, &item)
+	if err2 != nil {
+		return "", err2
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp)
+}
+
+// GetPresignedURL - Object의 Presigned URL 조회
+func (r *CCMRequest) GetPresignedURL() (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.PresignedURLRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err2 := r.Client.GetPresignedURL(ctx, &item)
+	if err2 != nil {
+		return "", err2
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp)
+}
+
+// ===== [ Private Functions ] =====
+
+// ===== [ Public Functions ] =====
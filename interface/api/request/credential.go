@@ -3,6 +3,9 @@ package request
 import (
 	"context"
 	"errors"
+	"os"
+
+	"google.golang.org/grpc/metadata"
 
 	gc "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/common"
 	pb "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/stub/cbspider"
@@ -111,6 +114,105 @@ func (r *CIMRequest) DeleteCredential() (string, error) {
 	return gc.ConvertToOutput(r.OutType, &resp)
 }
 
+// RotateCredentialKey - Credential Master Key 교체
+// 서버의 CBSPIDER_ADMIN_TOKEN 과 같은 토큰을 CBSPIDER_ADMIN_TOKEN 환경변수로 설정해야 함
+func (r *CIMRequest) RotateCredentialKey() (string, error) {
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+	ctx = metadata.AppendToOutgoingContext(ctx, "spider-admin-token", os.Getenv("CBSPIDER_ADMIN_TOKEN"))
+
+	resp, err := r.Client.RotateCredentialKey(ctx, &pb.Empty{})
+
+	if err != nil {
+		return "", err
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp)
+}
+
+// VerifyCredential - Credential 검증
+func (r *CIMRequest) VerifyCredential() (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.CredentialVerifyRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err2 := r.Client.VerifyCredential(ctx, &item)
+	if err2 != nil {
+		return "", err2
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp)
+}
+
+// UpdateCredential - Credential 변경
+func (r *CIMRequest) UpdateCredential() (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.CredentialInfo
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err2 := r.Client.UpdateCredential(ctx, &pb.CredentialInfoRequest{Item: &item})
+	if err2 != nil {
+		return "", err2
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp.Item)
+}
+
+// PatchCredential - Credential 부분 변경
+func (r *CIMRequest) PatchCredential() (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.CredentialInfo
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err2 := r.Client.PatchCredential(ctx, &pb.CredentialInfoRequest{Item: &item})
+	if err2 != nil {
+		return "", err2
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp.Item)
+}
+
 // ===== [ Private Functions ] =====
 
 // ===== [ Public Functions ] =====
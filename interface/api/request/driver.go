@@ -84,6 +84,33 @@ func (r *CIMRequest) GetCloudDriver() (string, error) {
 	return gc.ConvertToOutput(r.OutType, &resp.Item)
 }
 
+// GetCloudDriverCapability - Cloud Driver가 지원하는 Handler와 기능 조회
+func (r *CIMRequest) GetCloudDriverCapability() (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.CloudDriverQryRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err2 := r.Client.GetCloudDriverCapability(ctx, &item)
+	if err2 != nil {
+		return "", err2
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp.Item)
+}
+
 // DeleteCloudDriver - Cloud Driver 삭제
 func (r *CIMRequest) DeleteCloudDriver() (string, error) {
 	// 입력데이터 검사
@@ -111,6 +138,33 @@ func (r *CIMRequest) DeleteCloudDriver() (string, error) {
 	return gc.ConvertToOutput(r.OutType, &resp)
 }
 
+// UpdateCloudDriver - Cloud Driver 변경
+func (r *CIMRequest) UpdateCloudDriver() (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.CloudDriverInfo
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err2 := r.Client.UpdateCloudDriver(ctx, &pb.CloudDriverInfoRequest{Item: &item})
+	if err2 != nil {
+		return "", err2
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp.Item)
+}
+
 // ===== [ Private Functions ] =====
 
 // ===== [ Public Functions ] =====
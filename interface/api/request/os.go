@@ -2,6 +2,7 @@ package request
 
 import (
 	"context"
+	"errors"
 
 	gc "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/common"
 	pb "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/stub/cbspider"
@@ -29,6 +30,33 @@ func (r *CIMRequest) ListCloudOS() (string, error) {
 	return gc.ConvertToOutput(r.OutType, &resp)
 }
 
+// GetCloudOSSchema - Cloud OS의 Credential, Region Key 정의 조회
+func (r *CIMRequest) GetCloudOSSchema() (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.CloudOSQryRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err2 := r.Client.GetCloudOSSchema(ctx, &item)
+	if err2 != nil {
+		return "", err2
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp.Item)
+}
+
 // ===== [ Private Functions ] =====
 
 // ===== [ Public Functions ] =====
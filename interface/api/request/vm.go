@@ -41,6 +41,33 @@ func (r *CCMRequest) StartVM() (string, error) {
 	return gc.ConvertToOutput(r.OutType, &resp.Item)
 }
 
+// StartVMGroup - VM 그룹 시작
+func (r *CCMRequest) StartVMGroup() (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.VMGroupCreateRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err2 := r.Client.StartVMGroup(ctx, &item)
+	if err2 != nil {
+		return "", err2
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp.Item)
+}
+
 // ControlVM - VM 제어
 func (r *CCMRequest) ControlVM() (string, error) {
 	// 입력데이터 검사
@@ -122,6 +149,33 @@ func (r *CCMRequest) GetVMStatus() (string, error) {
 	return gc.ConvertToOutput(r.OutType, &resp)
 }
 
+// GetVMConsoleOutput - VM 콘솔 출력 조회
+func (r *CCMRequest) GetVMConsoleOutput() (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.VMQryRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err2 := r.Client.GetVMConsoleOutput(ctx, &item)
+	if err2 != nil {
+		return "", err2
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp)
+}
+
 // ListVM - VM 목록
 func (r *CCMRequest) ListVM() (string, error) {
 	// 입력데이터 검사
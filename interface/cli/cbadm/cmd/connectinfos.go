@@ -30,6 +30,10 @@ func NewConnectInfosCmd() *cobra.Command {
 	connectionCmd.AddCommand(NewConnectInfosListCmd())
 	connectionCmd.AddCommand(NewCConnectInfosGetCmd())
 	connectionCmd.AddCommand(NewConnectInfosDeleteCmd())
+	connectionCmd.AddCommand(NewConnectInfosDependentsCmd())
+	connectionCmd.AddCommand(NewConnectInfosCapabilityCmd())
+	connectionCmd.AddCommand(NewConnectInfosUpdateCmd())
+	connectionCmd.AddCommand(NewConnectInfosPatchCmd())
 
 	return connectionCmd
 }
@@ -100,6 +104,54 @@ func NewCConnectInfosGetCmd() *cobra.Command {
 	return getCmd
 }
 
+// NewConnectInfosDependentsCmd - Connection Config로 생성된 자원 조회 기능을 수행하는 Cobra Command 생성
+func NewConnectInfosDependentsCmd() *cobra.Command {
+
+	dependentsCmd := &cobra.Command{
+		Use:   "dependents",
+		Short: "This is dependents command for connect infos config",
+		Long:  "This is dependents command for connect infos config",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if configName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--name parameter value : ", configName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	dependentsCmd.PersistentFlags().StringVarP(&configName, "name", "n", "", "config name")
+
+	return dependentsCmd
+}
+
+// NewConnectInfosCapabilityCmd - Connection Config의 Driver가 지원하는 Handler와 기능 조회를 수행하는 Cobra Command 생성
+func NewConnectInfosCapabilityCmd() *cobra.Command {
+
+	capabilityCmd := &cobra.Command{
+		Use:   "capability",
+		Short: "This is capability command for connect infos config",
+		Long:  "This is capability command for connect infos config",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if configName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--name parameter value : ", configName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	capabilityCmd.PersistentFlags().StringVarP(&configName, "name", "n", "", "config name")
+
+	return capabilityCmd
+}
+
 // NewConnectInfosDeleteCmd - 연결설정 삭제 기능을 수행하는 Cobra Command 생성
 func NewConnectInfosDeleteCmd() *cobra.Command {
 
@@ -114,12 +166,70 @@ func NewConnectInfosDeleteCmd() *cobra.Command {
 				return
 			}
 			logger.Debug("--name parameter value : ", configName)
+			logger.Debug("--cascade parameter value : ", cascade)
+			logger.Debug("--orphan parameter value : ", orphan)
 
 			SetupAndRun(cmd, args)
 		},
 	}
 
 	deleteCmd.PersistentFlags().StringVarP(&configName, "name", "n", "", "config name")
+	deleteCmd.PersistentFlags().StringVarP(&cascade, "cascade", "", "false", "cascade flg (true/false)")
+	deleteCmd.PersistentFlags().StringVarP(&orphan, "orphan", "", "false", "orphan flg (true/false), delete the connection configs with IIDs by cascade")
 
 	return deleteCmd
 }
+
+// NewConnectInfosUpdateCmd - 연결설정 변경 기능을 수행하는 Cobra Command 생성
+func NewConnectInfosUpdateCmd() *cobra.Command {
+
+	updateCmd := &cobra.Command{
+		Use:   "update",
+		Short: "This is update command for connect infos config",
+		Long:  "This is update command for connect infos config",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	updateCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	updateCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return updateCmd
+}
+
+// NewConnectInfosPatchCmd - 연결설정 부분 변경 기능을 수행하는 Cobra Command 생성
+func NewConnectInfosPatchCmd() *cobra.Command {
+
+	patchCmd := &cobra.Command{
+		Use:   "patch",
+		Short: "This is patch command for connect infos config",
+		Long:  "This is patch command for connect infos config",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	patchCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	patchCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return patchCmd
+}
@@ -30,6 +30,10 @@ func NewCredentialCmd() *cobra.Command {
 	credentialCmd.AddCommand(NewCredentialListCmd())
 	credentialCmd.AddCommand(NewCredentialGetCmd())
 	credentialCmd.AddCommand(NewCredentialDeleteCmd())
+	credentialCmd.AddCommand(NewCredentialUpdateCmd())
+	credentialCmd.AddCommand(NewCredentialPatchCmd())
+	credentialCmd.AddCommand(NewCredentialRotateKeyCmd())
+	credentialCmd.AddCommand(NewCredentialVerifyCmd())
 
 	return credentialCmd
 }
@@ -114,12 +118,115 @@ func NewCredentialDeleteCmd() *cobra.Command {
 				return
 			}
 			logger.Debug("--name parameter value : ", credentialName)
+			logger.Debug("--cascade parameter value : ", cascade)
+			logger.Debug("--orphan parameter value : ", orphan)
 
 			SetupAndRun(cmd, args)
 		},
 	}
 
 	deleteCmd.PersistentFlags().StringVarP(&credentialName, "name", "n", "", "crendential name")
+	deleteCmd.PersistentFlags().StringVarP(&cascade, "cascade", "", "false", "cascade flg (true/false)")
+	deleteCmd.PersistentFlags().StringVarP(&orphan, "orphan", "", "false", "orphan flg (true/false), delete the connection configs with IIDs by cascade")
 
 	return deleteCmd
 }
+
+// NewCredentialRotateKeyCmd - Credential Master Key 교체 기능을 수행하는 Cobra Command 생성
+func NewCredentialRotateKeyCmd() *cobra.Command {
+
+	rotateKeyCmd := &cobra.Command{
+		Use:   "rotatekey",
+		Short: "This is rotate key command for credential",
+		Long:  "This is rotate key command for credential",
+		Run: func(cmd *cobra.Command, args []string) {
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	return rotateKeyCmd
+}
+
+// NewCredentialVerifyCmd - Credential 검증 기능을 수행하는 Cobra Command 생성
+func NewCredentialVerifyCmd() *cobra.Command {
+
+	verifyCmd := &cobra.Command{
+		Use:   "verify",
+		Short: "This is verify command for credential",
+		Long:  "This is verify command for credential",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if credentialName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			if regionName == "" {
+				logger.Error("failed to validate --region parameter")
+				return
+			}
+			logger.Debug("--name parameter value : ", credentialName)
+			logger.Debug("--region parameter value : ", regionName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	verifyCmd.PersistentFlags().StringVarP(&credentialName, "name", "n", "", "crendential name")
+	verifyCmd.PersistentFlags().StringVarP(&regionName, "region", "r", "", "region name")
+
+	return verifyCmd
+}
+
+// NewCredentialUpdateCmd -Credential 변경 기능을 수행하는 Cobra Command 생성
+func NewCredentialUpdateCmd() *cobra.Command {
+
+	updateCmd := &cobra.Command{
+		Use:   "update",
+		Short: "This is update command for credential",
+		Long:  "This is update command for credential",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	updateCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	updateCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return updateCmd
+}
+
+// NewCredentialPatchCmd -Credential 부분 변경 기능을 수행하는 Cobra Command 생성
+func NewCredentialPatchCmd() *cobra.Command {
+
+	patchCmd := &cobra.Command{
+		Use:   "patch",
+		Short: "This is patch command for credential",
+		Long:  "This is patch command for credential",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	patchCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	patchCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return patchCmd
+}
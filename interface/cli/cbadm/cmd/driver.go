@@ -29,7 +29,9 @@ func NewDriverCmd() *cobra.Command {
 	driverCmd.AddCommand(NewDriverCreateCmd())
 	driverCmd.AddCommand(NewDriverListCmd())
 	driverCmd.AddCommand(NewDriverGetCmd())
+	driverCmd.AddCommand(NewDriverCapabilityCmd())
 	driverCmd.AddCommand(NewDriverDeleteCmd())
+	driverCmd.AddCommand(NewDriverUpdateCmd())
 
 	return driverCmd
 }
@@ -100,6 +102,30 @@ func NewDriverGetCmd() *cobra.Command {
 	return getCmd
 }
 
+// NewDriverCapabilityCmd - Cloud Driver가 지원하는 Handler와 기능 조회를 수행하는 Cobra Command 생성
+func NewDriverCapabilityCmd() *cobra.Command {
+
+	capabilityCmd := &cobra.Command{
+		Use:   "capability",
+		Short: "This is capability command for cloud driver",
+		Long:  "This is capability command for cloud driver",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if driverName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--name parameter value : ", driverName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	capabilityCmd.PersistentFlags().StringVarP(&driverName, "name", "n", "", "driver name")
+
+	return capabilityCmd
+}
+
 // NewDriverDeleteCmd - Cloud Driver 삭제 기능을 수행하는 Cobra Command 생성
 func NewDriverDeleteCmd() *cobra.Command {
 
@@ -114,12 +140,43 @@ func NewDriverDeleteCmd() *cobra.Command {
 				return
 			}
 			logger.Debug("--name parameter value : ", driverName)
+			logger.Debug("--cascade parameter value : ", cascade)
+			logger.Debug("--orphan parameter value : ", orphan)
 
 			SetupAndRun(cmd, args)
 		},
 	}
 
 	deleteCmd.PersistentFlags().StringVarP(&driverName, "name", "n", "", "driver name")
+	deleteCmd.PersistentFlags().StringVarP(&cascade, "cascade", "", "false", "cascade flg (true/false)")
+	deleteCmd.PersistentFlags().StringVarP(&orphan, "orphan", "", "false", "orphan flg (true/false), delete the connection configs with IIDs by cascade")
 
 	return deleteCmd
 }
+
+// NewDriverUpdateCmd - Cloud Driver 변경 기능을 수행하는 Cobra Command 생성
+func NewDriverUpdateCmd() *cobra.Command {
+
+	updateCmd := &cobra.Command{
+		Use:   "update",
+		Short: "This is update command for cloud driver",
+		Long:  "This is update command for cloud driver",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	updateCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	updateCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return updateCmd
+}
@@ -92,8 +92,12 @@ func SetupAndRun(cmd *cobra.Command, args []string) {
 			result, err = cim.ListCloudDriver()
 		case "get":
 			result, err = cim.GetCloudDriverByParam(driverName)
+		case "capability":
+			result, err = cim.GetCloudDriverCapabilityByParam(driverName)
 		case "delete":
-			result, err = cim.DeleteCloudDriverByParam(driverName)
+			result, err = cim.DeleteCloudDriverByParam(driverName, cascade, orphan)
+		case "update":
+			result, err = cim.UpdateCloudDriver(inData)
 		}
 	case "credential":
 		switch cmd.Name() {
@@ -104,7 +108,15 @@ func SetupAndRun(cmd *cobra.Command, args []string) {
 		case "get":
 			result, err = cim.GetCredentialByParam(credentialName)
 		case "delete":
-			result, err = cim.DeleteCredentialByParam(credentialName)
+			result, err = cim.DeleteCredentialByParam(credentialName, cascade, orphan)
+		case "update":
+			result, err = cim.UpdateCredential(inData)
+		case "patch":
+			result, err = cim.PatchCredential(inData)
+		case "rotatekey":
+			result, err = cim.RotateCredentialKey()
+		case "verify":
+			result, err = cim.VerifyCredentialByParam(credentialName, regionName)
 		}
 	case "region":
 		switch cmd.Name() {
@@ -115,7 +127,17 @@ func SetupAndRun(cmd *cobra.Command, args []string) {
 		case "get":
 			result, err = cim.GetRegionByParam(regionName)
 		case "delete":
-			result, err = cim.DeleteRegionByParam(regionName)
+			result, err = cim.DeleteRegionByParam(regionName, cascade, orphan)
+		case "update":
+			result, err = cim.UpdateRegion(inData)
+		case "patch":
+			result, err = cim.PatchRegion(inData)
+		case "sync":
+			if inData != "" {
+				result, err = cim.SyncRegion(inData)
+			} else {
+				result, err = cim.SyncRegionByParam(&api.RegionSyncReq{ConnectionName: configName})
+			}
 		}
 	case "connect-infos":
 		switch cmd.Name() {
@@ -126,7 +148,15 @@ func SetupAndRun(cmd *cobra.Command, args []string) {
 		case "get":
 			result, err = proc.GetConnectInfos(cim, configName)
 		case "delete":
-			result, err = cim.DeleteConnectionConfigByParam(configName)
+			result, err = cim.DeleteConnectionConfigByParam(configName, cascade, orphan)
+		case "dependents":
+			result, err = cim.ListConnectionConfigDependentByParam(configName)
+		case "capability":
+			result, err = cim.GetConnectionConfigCapabilityByParam(configName)
+		case "update":
+			result, err = cim.UpdateConnectionConfig(inData)
+		case "patch":
+			result, err = cim.PatchConnectionConfig(inData)
 		}
 	}
 
@@ -21,6 +21,8 @@ var (
 	credentialName string
 	regionName     string
 	configName     string
+	cascade        string
+	orphan         string
 
 	parser config.Parser
 	cim    *api.CIMApi
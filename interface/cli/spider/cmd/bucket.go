@@ -0,0 +1,390 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// ===== [ Types ] =====
+
+// ===== [ Implementations ] =====
+
+// ===== [ Private Functions ] =====
+
+// ===== [ Public Functions ] =====
+
+// NewBucketCmd - Bucket 관리 기능을 수행하는 Cobra Command 생성
+func NewBucketCmd() *cobra.Command {
+
+	bucketCmd := &cobra.Command{
+		Use:   "bucket",
+		Short: "This is a manageable command for bucket",
+		Long:  "This is a manageable command for bucket",
+	}
+
+	//  Adds the commands for application.
+	bucketCmd.AddCommand(NewBucketCreateCmd())
+	bucketCmd.AddCommand(NewBucketListCmd())
+	bucketCmd.AddCommand(NewBucketGetCmd())
+	bucketCmd.AddCommand(NewBucketDeleteCmd())
+	bucketCmd.AddCommand(NewBucketListAllCmd())
+	bucketCmd.AddCommand(NewBucketDeleteCSPCmd())
+	bucketCmd.AddCommand(NewObjectListCmd())
+	bucketCmd.AddCommand(NewObjectPutCmd())
+	bucketCmd.AddCommand(NewObjectGetCmd())
+	bucketCmd.AddCommand(NewObjectDeleteCmd())
+	bucketCmd.AddCommand(NewPresignedURLCmd())
+
+	return bucketCmd
+}
+
+// NewBucketCreateCmd - Bucket 생성 기능을 수행하는 Cobra Command 생성
+func NewBucketCreateCmd() *cobra.Command {
+
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "This is create command for bucket",
+		Long:  "This is create command for bucket",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	createCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	createCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return createCmd
+}
+
+// NewBucketListCmd - Bucket 목록 기능을 수행하는 Cobra Command 생성
+func NewBucketListCmd() *cobra.Command {
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "This is list command for bucket",
+		Long:  "This is list command for bucket",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	listCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+
+	return listCmd
+}
+
+// NewBucketGetCmd - Bucket 조회 기능을 수행하는 Cobra Command 생성
+func NewBucketGetCmd() *cobra.Command {
+
+	getCmd := &cobra.Command{
+		Use:   "get",
+		Short: "This is get command for bucket",
+		Long:  "This is get command for bucket",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if bucketName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--name parameter value : ", bucketName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	getCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	getCmd.PersistentFlags().StringVarP(&bucketName, "name", "n", "", "bucket name")
+
+	return getCmd
+}
+
+// NewBucketDeleteCmd - Bucket 삭제 기능을 수행하는 Cobra Command 생성
+func NewBucketDeleteCmd() *cobra.Command {
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete",
+		Short: "This is delete command for bucket",
+		Long:  "This is delete command for bucket",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if bucketName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--name parameter value : ", bucketName)
+			logger.Debug("--force parameter value : ", force)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	deleteCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	deleteCmd.PersistentFlags().StringVarP(&bucketName, "name", "n", "", "bucket name")
+	deleteCmd.PersistentFlags().StringVarP(&force, "force", "", "false", "force flg (true/false)")
+
+	return deleteCmd
+}
+
+// NewBucketListAllCmd - 관리 Bucket 목록 기능을 수행하는 Cobra Command 생성
+func NewBucketListAllCmd() *cobra.Command {
+
+	listAllCmd := &cobra.Command{
+		Use:   "listall",
+		Short: "This is list all command for bucket",
+		Long:  "This is list all command for bucket",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	listAllCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+
+	return listAllCmd
+}
+
+// NewBucketDeleteCSPCmd - 관리 Bucket 삭제 기능을 수행하는 Cobra Command 생성
+func NewBucketDeleteCSPCmd() *cobra.Command {
+
+	deleteCSPCmd := &cobra.Command{
+		Use:   "deletecsp",
+		Short: "This is delete csp command for bucket",
+		Long:  "This is delete csp command for bucket",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if cspID == "" {
+				logger.Error("failed to validate --id parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--id parameter value : ", cspID)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	deleteCSPCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	deleteCSPCmd.PersistentFlags().StringVarP(&cspID, "id", "", "", "csp id")
+
+	return deleteCSPCmd
+}
+
+// NewObjectListCmd - Bucket의 Object 목록 기능을 수행하는 Cobra Command 생성
+func NewObjectListCmd() *cobra.Command {
+
+	listObjectCmd := &cobra.Command{
+		Use:   "listobject",
+		Short: "This is list object command for bucket",
+		Long:  "This is list object command for bucket",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if bucketName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--name parameter value : ", bucketName)
+			logger.Debug("--prefix parameter value : ", prefix)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	listObjectCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	listObjectCmd.PersistentFlags().StringVarP(&bucketName, "name", "n", "", "bucket name")
+	listObjectCmd.PersistentFlags().StringVarP(&prefix, "prefix", "", "", "object name prefix")
+
+	return listObjectCmd
+}
+
+// NewObjectPutCmd - Bucket에 Object 저장 기능을 수행하는 Cobra Command 생성
+func NewObjectPutCmd() *cobra.Command {
+
+	putObjectCmd := &cobra.Command{
+		Use:   "putobject",
+		Short: "This is put object command for bucket",
+		Long:  "This is put object command for bucket",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if bucketName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			if objectName == "" {
+				logger.Error("failed to validate --object parameter")
+				return
+			}
+			if objectFile == "" {
+				logger.Error("failed to validate --file parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--name parameter value : ", bucketName)
+			logger.Debug("--object parameter value : ", objectName)
+			logger.Debug("--file parameter value : ", objectFile)
+			logger.Debug("--content-type parameter value : ", contentType)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	putObjectCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	putObjectCmd.PersistentFlags().StringVarP(&bucketName, "name", "n", "", "bucket name")
+	putObjectCmd.PersistentFlags().StringVarP(&objectName, "object", "", "", "object name")
+	putObjectCmd.PersistentFlags().StringVarP(&objectFile, "file", "", "", "file path to upload")
+	putObjectCmd.PersistentFlags().StringVarP(&contentType, "content-type", "", "", "content type of the object")
+
+	return putObjectCmd
+}
+
+// NewObjectGetCmd - Bucket의 Object 조회 기능을 수행하는 Cobra Command 생성
+func NewObjectGetCmd() *cobra.Command {
+
+	getObjectCmd := &cobra.Command{
+		Use:   "getobject",
+		Short: "This is get object command for bucket",
+		Long:  "This is get object command for bucket",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if bucketName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			if objectName == "" {
+				logger.Error("failed to validate --object parameter")
+				return
+			}
+			if objectFile == "" {
+				logger.Error("failed to validate --file parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--name parameter value : ", bucketName)
+			logger.Debug("--object parameter value : ", objectName)
+			logger.Debug("--file parameter value : ", objectFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	getObjectCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	getObjectCmd.PersistentFlags().StringVarP(&bucketName, "name", "n", "", "bucket name")
+	getObjectCmd.PersistentFlags().StringVarP(&objectName, "object", "", "", "object name")
+	getObjectCmd.PersistentFlags().StringVarP(&objectFile, "file", "", "", "file path to download")
+
+	return getObjectCmd
+}
+
+// NewObjectDeleteCmd - Bucket의 Object 삭제 기능을 수행하는 Cobra Command 생성
+func NewObjectDeleteCmd() *cobra.Command {
+
+	deleteObjectCmd := &cobra.Command{
+		Use:   "deleteobject",
+		Short: "This is delete object command for bucket",
+		Long:  "This is delete object command for bucket",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if bucketName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			if objectName == "" {
+				logger.Error("failed to validate --object parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--name parameter value : ", bucketName)
+			logger.Debug("--object parameter value : ", objectName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	deleteObjectCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	deleteObjectCmd.PersistentFlags().StringVarP(&bucketName, "name", "n", "", "bucket name")
+	deleteObjectCmd.PersistentFlags().StringVarP(&objectName, "object", "", "", "object name")
+
+	return deleteObjectCmd
+}
+
+// NewPresignedURLCmd - Object의 Presigned URL 조회 기능을 수행하는 Cobra Command 생성
+func NewPresignedURLCmd() *cobra.Command {
+
+	presignedURLCmd := &cobra.Command{
+		Use:   "presignedurl",
+		Short: "This is presigned url command for bucket",
+		Long:  "This is presigned url command for bucket",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	presignedURLCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	presignedURLCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return presignedURLCmd
+}
@@ -30,6 +30,10 @@ func NewConnectionCmd() *cobra.Command {
 	connectionCmd.AddCommand(NewConnectionListCmd())
 	connectionCmd.AddCommand(NewConnectionGetCmd())
 	connectionCmd.AddCommand(NewConnectionDeleteCmd())
+	connectionCmd.AddCommand(NewConnectionDependentsCmd())
+	connectionCmd.AddCommand(NewConnectionCapabilityCmd())
+	connectionCmd.AddCommand(NewConnectionUpdateCmd())
+	connectionCmd.AddCommand(NewConnectionPatchCmd())
 
 	return connectionCmd
 }
@@ -100,6 +104,54 @@ func NewConnectionGetCmd() *cobra.Command {
 	return getCmd
 }
 
+// NewConnectionDependentsCmd - Connection Config로 생성된 자원 조회 기능을 수행하는 Cobra Command 생성
+func NewConnectionDependentsCmd() *cobra.Command {
+
+	dependentsCmd := &cobra.Command{
+		Use:   "dependents",
+		Short: "This is dependents command for connection config",
+		Long:  "This is dependents command for connection config",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if configName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--name parameter value : ", configName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	dependentsCmd.PersistentFlags().StringVarP(&configName, "name", "n", "", "config name")
+
+	return dependentsCmd
+}
+
+// NewConnectionCapabilityCmd - Connection Config의 Driver가 지원하는 Handler와 기능 조회를 수행하는 Cobra Command 생성
+func NewConnectionCapabilityCmd() *cobra.Command {
+
+	capabilityCmd := &cobra.Command{
+		Use:   "capability",
+		Short: "This is capability command for connection config",
+		Long:  "This is capability command for connection config",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if configName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--name parameter value : ", configName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	capabilityCmd.PersistentFlags().StringVarP(&configName, "name", "n", "", "config name")
+
+	return capabilityCmd
+}
+
 // NewConnectionDeleteCmd - Connection Config 삭제 기능을 수행하는 Cobra Command 생성
 func NewConnectionDeleteCmd() *cobra.Command {
 
@@ -114,12 +166,70 @@ func NewConnectionDeleteCmd() *cobra.Command {
 				return
 			}
 			logger.Debug("--name parameter value : ", configName)
+			logger.Debug("--cascade parameter value : ", cascade)
+			logger.Debug("--orphan parameter value : ", orphan)
 
 			SetupAndRun(cmd, args)
 		},
 	}
 
 	deleteCmd.PersistentFlags().StringVarP(&configName, "name", "n", "", "config name")
+	deleteCmd.PersistentFlags().StringVarP(&cascade, "cascade", "", "false", "cascade flg (true/false)")
+	deleteCmd.PersistentFlags().StringVarP(&orphan, "orphan", "", "false", "orphan flg (true/false), delete the connection configs with IIDs by cascade")
 
 	return deleteCmd
 }
+
+// NewConnectionUpdateCmd - Connection Config 변경 기능을 수행하는 Cobra Command 생성
+func NewConnectionUpdateCmd() *cobra.Command {
+
+	updateCmd := &cobra.Command{
+		Use:   "update",
+		Short: "This is update command for connection config",
+		Long:  "This is update command for connection config",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	updateCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	updateCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return updateCmd
+}
+
+// NewConnectionPatchCmd - Connection Config 부분 변경 기능을 수행하는 Cobra Command 생성
+func NewConnectionPatchCmd() *cobra.Command {
+
+	patchCmd := &cobra.Command{
+		Use:   "patch",
+		Short: "This is patch command for connection config",
+		Long:  "This is patch command for connection config",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	patchCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	patchCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return patchCmd
+}
@@ -0,0 +1,288 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// ===== [ Types ] =====
+
+// ===== [ Implementations ] =====
+
+// ===== [ Private Functions ] =====
+
+// ===== [ Public Functions ] =====
+
+// NewDNSCmd - Private DNS Zone 관리 기능을 수행하는 Cobra Command 생성
+func NewDNSCmd() *cobra.Command {
+
+	dnsCmd := &cobra.Command{
+		Use:   "dns",
+		Short: "This is a manageable command for dns",
+		Long:  "This is a manageable command for dns",
+	}
+
+	//  Adds the commands for application.
+	dnsCmd.AddCommand(NewDNSZoneCreateCmd())
+	dnsCmd.AddCommand(NewDNSZoneListCmd())
+	dnsCmd.AddCommand(NewDNSZoneGetCmd())
+	dnsCmd.AddCommand(NewDNSZoneDeleteCmd())
+	dnsCmd.AddCommand(NewDNSZoneListAllCmd())
+	dnsCmd.AddCommand(NewDNSZoneDeleteCSPCmd())
+	dnsCmd.AddCommand(NewDNSRecordAddCmd())
+	dnsCmd.AddCommand(NewDNSRecordUpdateCmd())
+	dnsCmd.AddCommand(NewDNSRecordRemoveCmd())
+
+	return dnsCmd
+}
+
+// NewDNSZoneCreateCmd - Private DNS Zone 생성 기능을 수행하는 Cobra Command 생성
+func NewDNSZoneCreateCmd() *cobra.Command {
+
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "This is create command for dns",
+		Long:  "This is create command for dns",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	createCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	createCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return createCmd
+}
+
+// NewDNSZoneListCmd - Private DNS Zone 목록 기능을 수행하는 Cobra Command 생성
+func NewDNSZoneListCmd() *cobra.Command {
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "This is list command for dns",
+		Long:  "This is list command for dns",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	listCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+
+	return listCmd
+}
+
+// NewDNSZoneGetCmd - Private DNS Zone 조회 기능을 수행하는 Cobra Command 생성
+func NewDNSZoneGetCmd() *cobra.Command {
+
+	getCmd := &cobra.Command{
+		Use:   "get",
+		Short: "This is get command for dns",
+		Long:  "This is get command for dns",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if dnsZoneName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--name parameter value : ", dnsZoneName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	getCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	getCmd.PersistentFlags().StringVarP(&dnsZoneName, "name", "n", "", "dns zone name")
+
+	return getCmd
+}
+
+// NewDNSZoneDeleteCmd - Private DNS Zone 삭제 기능을 수행하는 Cobra Command 생성
+func NewDNSZoneDeleteCmd() *cobra.Command {
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete",
+		Short: "This is delete command for dns",
+		Long:  "This is delete command for dns",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if dnsZoneName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--name parameter value : ", dnsZoneName)
+			logger.Debug("--force parameter value : ", force)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	deleteCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	deleteCmd.PersistentFlags().StringVarP(&dnsZoneName, "name", "n", "", "dns zone name")
+	deleteCmd.PersistentFlags().StringVarP(&force, "force", "", "false", "force flg (true/false)")
+
+	return deleteCmd
+}
+
+// NewDNSZoneListAllCmd - 관리 Private DNS Zone 목록 기능을 수행하는 Cobra Command 생성
+func NewDNSZoneListAllCmd() *cobra.Command {
+
+	listAllCmd := &cobra.Command{
+		Use:   "listall",
+		Short: "This is list all command for dns",
+		Long:  "This is list all command for dns",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	listAllCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+
+	return listAllCmd
+}
+
+// NewDNSZoneDeleteCSPCmd - 관리 Private DNS Zone 삭제 기능을 수행하는 Cobra Command 생성
+func NewDNSZoneDeleteCSPCmd() *cobra.Command {
+
+	deleteCSPCmd := &cobra.Command{
+		Use:   "deletecsp",
+		Short: "This is delete csp command for dns",
+		Long:  "This is delete csp command for dns",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if cspID == "" {
+				logger.Error("failed to validate --id parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--id parameter value : ", cspID)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	deleteCSPCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	deleteCSPCmd.PersistentFlags().StringVarP(&cspID, "id", "", "", "csp id")
+
+	return deleteCSPCmd
+}
+
+// NewDNSRecordAddCmd - Private DNS Zone 레코드 추가 기능을 수행하는 Cobra Command 생성
+func NewDNSRecordAddCmd() *cobra.Command {
+
+	addRecordCmd := &cobra.Command{
+		Use:   "addrecord",
+		Short: "This is add record command for dns",
+		Long:  "This is add record command for dns",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	addRecordCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	addRecordCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return addRecordCmd
+}
+
+// NewDNSRecordUpdateCmd - Private DNS Zone 레코드 변경 기능을 수행하는 Cobra Command 생성
+func NewDNSRecordUpdateCmd() *cobra.Command {
+
+	updateRecordCmd := &cobra.Command{
+		Use:   "updaterecord",
+		Short: "This is update record command for dns",
+		Long:  "This is update record command for dns",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	updateRecordCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	updateRecordCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return updateRecordCmd
+}
+
+// NewDNSRecordRemoveCmd - Private DNS Zone 레코드 삭제 기능을 수행하는 Cobra Command 생성
+func NewDNSRecordRemoveCmd() *cobra.Command {
+
+	removeRecordCmd := &cobra.Command{
+		Use:   "removerecord",
+		Short: "This is remove record command for dns",
+		Long:  "This is remove record command for dns",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	removeRecordCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	removeRecordCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return removeRecordCmd
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"io/ioutil"
+	"os"
 
 	"github.com/spf13/cobra"
 
@@ -32,6 +33,40 @@ func readInDataFromFile() {
 	}
 }
 
+// putObjectFromFile - 파일의 데이터를 Object로 저장
+func putObjectFromFile(ccm *api.CCMApi) (string, error) {
+	file, err := os.Open(objectFile)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	req := &api.ObjectReq{
+		ConnectionName: connectionName,
+		Name:           bucketName,
+		ObjectName:     objectName,
+		Size:           fileInfo.Size(),
+		ContentType:    contentType,
+	}
+	return ccm.PutObjectByParam(req, file)
+}
+
+// getObjectToFile - Object의 데이터를 파일로 저장
+func getObjectToFile(ccm *api.CCMApi) (string, error) {
+	file, err := os.Create(objectFile)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	return ccm.GetObjectByParam(connectionName, bucketName, objectName, file)
+}
+
 // ===== [ Public Functions ] =====
 
 // SetupAndRun - SPIDER GRPC CLI 구동
@@ -118,6 +153,8 @@ func SetupAndRun(cmd *cobra.Command, args []string) {
 		switch cmd.Name() {
 		case "list":
 			result, err = cim.ListCloudOS()
+		case "schema":
+			result, err = cim.GetCloudOSSchemaByParam(cloudOSName)
 		}
 	case "driver":
 		switch cmd.Name() {
@@ -127,8 +164,12 @@ func SetupAndRun(cmd *cobra.Command, args []string) {
 			result, err = cim.ListCloudDriver()
 		case "get":
 			result, err = cim.GetCloudDriverByParam(driverName)
+		case "capability":
+			result, err = cim.GetCloudDriverCapabilityByParam(driverName)
 		case "delete":
-			result, err = cim.DeleteCloudDriverByParam(driverName)
+			result, err = cim.DeleteCloudDriverByParam(driverName, cascade, orphan)
+		case "update":
+			result, err = cim.UpdateCloudDriver(inData)
 		}
 	case "credential":
 		switch cmd.Name() {
@@ -139,7 +180,15 @@ func SetupAndRun(cmd *cobra.Command, args []string) {
 		case "get":
 			result, err = cim.GetCredentialByParam(credentialName)
 		case "delete":
-			result, err = cim.DeleteCredentialByParam(credentialName)
+			result, err = cim.DeleteCredentialByParam(credentialName, cascade, orphan)
+		case "update":
+			result, err = cim.UpdateCredential(inData)
+		case "patch":
+			result, err = cim.PatchCredential(inData)
+		case "rotatekey":
+			result, err = cim.RotateCredentialKey()
+		case "verify":
+			result, err = cim.VerifyCredentialByParam(credentialName, regionName)
 		}
 	case "region":
 		switch cmd.Name() {
@@ -150,7 +199,17 @@ func SetupAndRun(cmd *cobra.Command, args []string) {
 		case "get":
 			result, err = cim.GetRegionByParam(regionName)
 		case "delete":
-			result, err = cim.DeleteRegionByParam(regionName)
+			result, err = cim.DeleteRegionByParam(regionName, cascade, orphan)
+		case "update":
+			result, err = cim.UpdateRegion(inData)
+		case "patch":
+			result, err = cim.PatchRegion(inData)
+		case "sync":
+			if inData != "" {
+				result, err = cim.SyncRegion(inData)
+			} else {
+				result, err = cim.SyncRegionByParam(&api.RegionSyncReq{ConnectionName: connectionName})
+			}
 		}
 	case "connection":
 		switch cmd.Name() {
@@ -161,7 +220,15 @@ func SetupAndRun(cmd *cobra.Command, args []string) {
 		case "get":
 			result, err = cim.GetConnectionConfigByParam(configName)
 		case "delete":
-			result, err = cim.DeleteConnectionConfigByParam(configName)
+			result, err = cim.DeleteConnectionConfigByParam(configName, cascade, orphan)
+		case "dependents":
+			result, err = cim.ListConnectionConfigDependentByParam(configName)
+		case "capability":
+			result, err = cim.GetConnectionConfigCapabilityByParam(configName)
+		case "update":
+			result, err = cim.UpdateConnectionConfig(inData)
+		case "patch":
+			result, err = cim.PatchConnectionConfig(inData)
 		}
 	case "image":
 		switch cmd.Name() {
@@ -185,6 +252,15 @@ func SetupAndRun(cmd *cobra.Command, args []string) {
 		case "getorg":
 			result, err = ccm.GetOrgVMSpecByParam(connectionName, specName)
 		}
+	case "regionzone":
+		switch cmd.Name() {
+		case "list":
+			result, err = ccm.ListRegionZoneByParam(connectionName)
+		case "get":
+			result, err = ccm.GetRegionZoneByParam(connectionName, regionName)
+		case "listzone":
+			result, err = ccm.ListZoneByParam(connectionName)
+		}
 	case "vpc":
 		switch cmd.Name() {
 		case "create":
@@ -200,6 +276,23 @@ func SetupAndRun(cmd *cobra.Command, args []string) {
 		case "deletecsp":
 			result, err = ccm.DeleteCSPVPCByParam(connectionName, cspID)
 		}
+	case "vpcpeering":
+		switch cmd.Name() {
+		case "request":
+			result, err = ccm.RequestVPCPeering(inData)
+		case "accept":
+			result, err = ccm.AcceptVPCPeeringByParam(connectionName, vpcPeeringName)
+		case "list":
+			result, err = ccm.ListVPCPeeringByParam(connectionName)
+		case "get":
+			result, err = ccm.GetVPCPeeringByParam(connectionName, vpcPeeringName)
+		case "delete":
+			result, err = ccm.DeleteVPCPeeringByParam(connectionName, vpcPeeringName, force)
+		case "listall":
+			result, err = ccm.ListAllVPCPeeringByParam(connectionName)
+		case "deletecsp":
+			result, err = ccm.DeleteCSPVPCPeeringByParam(connectionName, cspID)
+		}
 	case "security":
 		switch cmd.Name() {
 		case "create":
@@ -244,6 +337,8 @@ func SetupAndRun(cmd *cobra.Command, args []string) {
 			result, err = ccm.ListVMByParam(connectionName)
 		case "get":
 			result, err = ccm.GetVMByParam(connectionName, vmName)
+		case "console":
+			result, err = ccm.GetVMConsoleOutputByParam(connectionName, vmName)
 		case "terminate":
 			result, err = ccm.TerminateVMByParam(connectionName, vmName, force)
 		case "listall":
@@ -251,6 +346,96 @@ func SetupAndRun(cmd *cobra.Command, args []string) {
 		case "terminatecsp":
 			result, err = ccm.TerminateCSPVMByParam(connectionName, cspID)
 		}
+	case "nlb":
+		switch cmd.Name() {
+		case "create":
+			result, err = ccm.CreateNLB(inData)
+		case "list":
+			result, err = ccm.ListNLBByParam(connectionName)
+		case "get":
+			result, err = ccm.GetNLBByParam(connectionName, nlbName)
+		case "delete":
+			result, err = ccm.DeleteNLBByParam(connectionName, nlbName, force)
+		case "listall":
+			result, err = ccm.ListAllNLBByParam(connectionName)
+		case "deletecsp":
+			result, err = ccm.DeleteCSPNLBByParam(connectionName, cspID)
+		case "addvms":
+			result, err = ccm.AddNLBVMs(inData)
+		case "removevms":
+			result, err = ccm.RemoveNLBVMs(inData)
+		case "health":
+			result, err = ccm.GetNLBVMGroupHealthInfoByParam(connectionName, nlbName)
+		case "changelistener":
+			result, err = ccm.ChangeNLBListener(inData)
+		case "changehealthchecker":
+			result, err = ccm.ChangeNLBHealthChecker(inData)
+		}
+	case "publicip":
+		switch cmd.Name() {
+		case "allocate":
+			result, err = ccm.AllocatePublicIP(inData)
+		case "list":
+			result, err = ccm.ListPublicIPByParam(connectionName)
+		case "get":
+			result, err = ccm.GetPublicIPByParam(connectionName, publicIPName)
+		case "release":
+			result, err = ccm.ReleasePublicIPByParam(connectionName, publicIPName, force)
+		case "listall":
+			result, err = ccm.ListAllPublicIPByParam(connectionName)
+		case "releasecsp":
+			result, err = ccm.ReleaseCSPPublicIPByParam(connectionName, cspID)
+		case "associate":
+			result, err = ccm.AssociatePublicIP(inData)
+		case "disassociate":
+			result, err = ccm.DisassociatePublicIPByParam(connectionName, publicIPName)
+		}
+	case "dns":
+		switch cmd.Name() {
+		case "create":
+			result, err = ccm.CreateDNSZone(inData)
+		case "list":
+			result, err = ccm.ListDNSZoneByParam(connectionName)
+		case "get":
+			result, err = ccm.GetDNSZoneByParam(connectionName, dnsZoneName)
+		case "delete":
+			result, err = ccm.DeleteDNSZoneByParam(connectionName, dnsZoneName, force)
+		case "listall":
+			result, err = ccm.ListAllDNSZoneByParam(connectionName)
+		case "deletecsp":
+			result, err = ccm.DeleteCSPDNSZoneByParam(connectionName, cspID)
+		case "addrecord":
+			result, err = ccm.AddDNSRecord(inData)
+		case "updaterecord":
+			result, err = ccm.UpdateDNSRecord(inData)
+		case "removerecord":
+			result, err = ccm.RemoveDNSRecord(inData)
+		}
+	case "bucket":
+		switch cmd.Name() {
+		case "create":
+			result, err = ccm.CreateBucket(inData)
+		case "list":
+			result, err = ccm.ListBucketByParam(connectionName)
+		case "get":
+			result, err = ccm.GetBucketByParam(connectionName, bucketName)
+		case "delete":
+			result, err = ccm.DeleteBucketByParam(connectionName, bucketName, force)
+		case "listall":
+			result, err = ccm.ListAllBucketByParam(connectionName)
+		case "deletecsp":
+			result, err = ccm.DeleteCSPBucketByParam(connectionName, cspID)
+		case "listobject":
+			result, err = ccm.ListObjectByParam(connectionName, bucketName, prefix)
+		case "putobject":
+			result, err = putObjectFromFile(ccm)
+		case "getobject":
+			result, err = getObjectToFile(ccm)
+		case "deleteobject":
+			result, err = ccm.DeleteObjectByParam(connectionName, bucketName, objectName)
+		case "presignedurl":
+			result, err = ccm.GetPresignedURL(inData)
+		}
 	case "ssh":
 		switch cmd.Name() {
 		case "run":
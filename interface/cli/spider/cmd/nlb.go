@@ -0,0 +1,347 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// ===== [ Types ] =====
+
+// ===== [ Implementations ] =====
+
+// ===== [ Private Functions ] =====
+
+// ===== [ Public Functions ] =====
+
+// NewNLBCmd - NLB 관리 기능을 수행하는 Cobra Command 생성
+func NewNLBCmd() *cobra.Command {
+
+	nlbCmd := &cobra.Command{
+		Use:   "nlb",
+		Short: "This is a manageable command for nlb",
+		Long:  "This is a manageable command for nlb",
+	}
+
+	//  Adds the commands for application.
+	nlbCmd.AddCommand(NewNLBCreateCmd())
+	nlbCmd.AddCommand(NewNLBListCmd())
+	nlbCmd.AddCommand(NewNLBGetCmd())
+	nlbCmd.AddCommand(NewNLBDeleteCmd())
+	nlbCmd.AddCommand(NewNLBListAllCmd())
+	nlbCmd.AddCommand(NewNLBDeleteCSPCmd())
+	nlbCmd.AddCommand(NewNLBAddVMsCmd())
+	nlbCmd.AddCommand(NewNLBRemoveVMsCmd())
+	nlbCmd.AddCommand(NewNLBHealthCmd())
+	nlbCmd.AddCommand(NewNLBChangeListenerCmd())
+	nlbCmd.AddCommand(NewNLBChangeHealthCheckerCmd())
+
+	return nlbCmd
+}
+
+// NewNLBCreateCmd - NLB 생성 기능을 수행하는 Cobra Command 생성
+func NewNLBCreateCmd() *cobra.Command {
+
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "This is create command for nlb",
+		Long:  "This is create command for nlb",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	createCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	createCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return createCmd
+}
+
+// NewNLBListCmd - NLB 목록 기능을 수행하는 Cobra Command 생성
+func NewNLBListCmd() *cobra.Command {
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "This is list command for nlb",
+		Long:  "This is list command for nlb",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	listCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+
+	return listCmd
+}
+
+// NewNLBGetCmd - NLB 조회 기능을 수행하는 Cobra Command 생성
+func NewNLBGetCmd() *cobra.Command {
+
+	getCmd := &cobra.Command{
+		Use:   "get",
+		Short: "This is get command for nlb",
+		Long:  "This is get command for nlb",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if nlbName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--name parameter value : ", nlbName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	getCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	getCmd.PersistentFlags().StringVarP(&nlbName, "name", "n", "", "nlb name")
+
+	return getCmd
+}
+
+// NewNLBDeleteCmd - NLB 삭제 기능을 수행하는 Cobra Command 생성
+func NewNLBDeleteCmd() *cobra.Command {
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete",
+		Short: "This is delete command for nlb",
+		Long:  "This is delete command for nlb",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if nlbName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--name parameter value : ", nlbName)
+			logger.Debug("--force parameter value : ", force)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	deleteCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	deleteCmd.PersistentFlags().StringVarP(&nlbName, "name", "n", "", "nlb name")
+	deleteCmd.PersistentFlags().StringVarP(&force, "force", "", "false", "force flg (true/false)")
+
+	return deleteCmd
+}
+
+// NewNLBListAllCmd - 관리 NLB 목록 기능을 수행하는 Cobra Command 생성
+func NewNLBListAllCmd() *cobra.Command {
+
+	listAllCmd := &cobra.Command{
+		Use:   "listall",
+		Short: "This is list all command for nlb",
+		Long:  "This is list all command for nlb",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	listAllCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+
+	return listAllCmd
+}
+
+// NewNLBDeleteCSPCmd - 관리 NLB 삭제 기능을 수행하는 Cobra Command 생성
+func NewNLBDeleteCSPCmd() *cobra.Command {
+
+	deleteCSPCmd := &cobra.Command{
+		Use:   "deletecsp",
+		Short: "This is delete csp command for nlb",
+		Long:  "This is delete csp command for nlb",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if cspID == "" {
+				logger.Error("failed to validate --id parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--id parameter value : ", cspID)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	deleteCSPCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	deleteCSPCmd.PersistentFlags().StringVarP(&cspID, "id", "", "", "csp id")
+
+	return deleteCSPCmd
+}
+
+// NewNLBAddVMsCmd - NLB VM 추가 기능을 수행하는 Cobra Command 생성
+func NewNLBAddVMsCmd() *cobra.Command {
+
+	addVMsCmd := &cobra.Command{
+		Use:   "addvms",
+		Short: "This is add vms command for nlb",
+		Long:  "This is add vms command for nlb",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	addVMsCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	addVMsCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return addVMsCmd
+}
+
+// NewNLBRemoveVMsCmd - NLB VM 제거 기능을 수행하는 Cobra Command 생성
+func NewNLBRemoveVMsCmd() *cobra.Command {
+
+	removeVMsCmd := &cobra.Command{
+		Use:   "removevms",
+		Short: "This is remove vms command for nlb",
+		Long:  "This is remove vms command for nlb",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	removeVMsCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	removeVMsCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return removeVMsCmd
+}
+
+// NewNLBHealthCmd - NLB VM 그룹 상태 조회 기능을 수행하는 Cobra Command 생성
+func NewNLBHealthCmd() *cobra.Command {
+
+	healthCmd := &cobra.Command{
+		Use:   "health",
+		Short: "This is health command for nlb",
+		Long:  "This is health command for nlb",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if nlbName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--name parameter value : ", nlbName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	healthCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	healthCmd.PersistentFlags().StringVarP(&nlbName, "name", "n", "", "nlb name")
+
+	return healthCmd
+}
+
+// NewNLBChangeListenerCmd - NLB Listener 변경 기능을 수행하는 Cobra Command 생성
+func NewNLBChangeListenerCmd() *cobra.Command {
+
+	changeListenerCmd := &cobra.Command{
+		Use:   "changelistener",
+		Short: "This is change listener command for nlb",
+		Long:  "This is change listener command for nlb",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	changeListenerCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	changeListenerCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return changeListenerCmd
+}
+
+// NewNLBChangeHealthCheckerCmd - NLB HealthChecker 변경 기능을 수행하는 Cobra Command 생성
+func NewNLBChangeHealthCheckerCmd() *cobra.Command {
+
+	changeHealthCheckerCmd := &cobra.Command{
+		Use:   "changehealthchecker",
+		Short: "This is change health checker command for nlb",
+		Long:  "This is change health checker command for nlb",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	changeHealthCheckerCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	changeHealthCheckerCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return changeHealthCheckerCmd
+}
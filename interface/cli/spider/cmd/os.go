@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"github.com/spf13/cobra"
+
+	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
 )
 
 // ===== [ Constants and Variables ] =====
@@ -25,6 +27,7 @@ func NewOsCmd() *cobra.Command {
 
 	//  Adds the commands for application.
 	osCmd.AddCommand(NewOsListCmd())
+	osCmd.AddCommand(NewOsSchemaCmd())
 
 	return osCmd
 }
@@ -43,3 +46,27 @@ func NewOsListCmd() *cobra.Command {
 
 	return listCmd
 }
+
+// NewOsSchemaCmd - Cloud OS의 Credential, Region Key 정의 조회 기능을 수행하는 Cobra Command 생성
+func NewOsSchemaCmd() *cobra.Command {
+
+	schemaCmd := &cobra.Command{
+		Use:   "schema",
+		Short: "This is schema command for cloud os",
+		Long:  "This is schema command for cloud os",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if cloudOSName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--name parameter value : ", cloudOSName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	schemaCmd.PersistentFlags().StringVarP(&cloudOSName, "name", "n", "", "cloud os name")
+
+	return schemaCmd
+}
@@ -0,0 +1,263 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// ===== [ Types ] =====
+
+// ===== [ Implementations ] =====
+
+// ===== [ Private Functions ] =====
+
+// ===== [ Public Functions ] =====
+
+// NewPublicIPCmd - PublicIP 관리 기능을 수행하는 Cobra Command 생성
+func NewPublicIPCmd() *cobra.Command {
+
+	publicIPCmd := &cobra.Command{
+		Use:   "publicip",
+		Short: "This is a manageable command for publicip",
+		Long:  "This is a manageable command for publicip",
+	}
+
+	//  Adds the commands for application.
+	publicIPCmd.AddCommand(NewPublicIPAllocateCmd())
+	publicIPCmd.AddCommand(NewPublicIPListCmd())
+	publicIPCmd.AddCommand(NewPublicIPGetCmd())
+	publicIPCmd.AddCommand(NewPublicIPReleaseCmd())
+	publicIPCmd.AddCommand(NewPublicIPListAllCmd())
+	publicIPCmd.AddCommand(NewPublicIPReleaseCSPCmd())
+	publicIPCmd.AddCommand(NewPublicIPAssociateCmd())
+	publicIPCmd.AddCommand(NewPublicIPDisassociateCmd())
+
+	return publicIPCmd
+}
+
+// NewPublicIPAllocateCmd - PublicIP 할당 기능을 수행하는 Cobra Command 생성
+func NewPublicIPAllocateCmd() *cobra.Command {
+
+	allocateCmd := &cobra.Command{
+		Use:   "allocate",
+		Short: "This is allocate command for publicip",
+		Long:  "This is allocate command for publicip",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	allocateCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	allocateCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return allocateCmd
+}
+
+// NewPublicIPListCmd - PublicIP 목록 기능을 수행하는 Cobra Command 생성
+func NewPublicIPListCmd() *cobra.Command {
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "This is list command for publicip",
+		Long:  "This is list command for publicip",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	listCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+
+	return listCmd
+}
+
+// NewPublicIPGetCmd - PublicIP 조회 기능을 수행하는 Cobra Command 생성
+func NewPublicIPGetCmd() *cobra.Command {
+
+	getCmd := &cobra.Command{
+		Use:   "get",
+		Short: "This is get command for publicip",
+		Long:  "This is get command for publicip",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if publicIPName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--name parameter value : ", publicIPName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	getCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	getCmd.PersistentFlags().StringVarP(&publicIPName, "name", "n", "", "publicip name")
+
+	return getCmd
+}
+
+// NewPublicIPReleaseCmd - PublicIP 반납 기능을 수행하는 Cobra Command 생성
+func NewPublicIPReleaseCmd() *cobra.Command {
+
+	releaseCmd := &cobra.Command{
+		Use:   "release",
+		Short: "This is release command for publicip",
+		Long:  "This is release command for publicip",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if publicIPName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--name parameter value : ", publicIPName)
+			logger.Debug("--force parameter value : ", force)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	releaseCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	releaseCmd.PersistentFlags().StringVarP(&publicIPName, "name", "n", "", "publicip name")
+	releaseCmd.PersistentFlags().StringVarP(&force, "force", "", "false", "force flg (true/false)")
+
+	return releaseCmd
+}
+
+// NewPublicIPListAllCmd - 관리 PublicIP 목록 기능을 수행하는 Cobra Command 생성
+func NewPublicIPListAllCmd() *cobra.Command {
+
+	listAllCmd := &cobra.Command{
+		Use:   "listall",
+		Short: "This is list all command for publicip",
+		Long:  "This is list all command for publicip",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	listAllCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+
+	return listAllCmd
+}
+
+// NewPublicIPReleaseCSPCmd - 관리 PublicIP 반납 기능을 수행하는 Cobra Command 생성
+func NewPublicIPReleaseCSPCmd() *cobra.Command {
+
+	releaseCSPCmd := &cobra.Command{
+		Use:   "releasecsp",
+		Short: "This is release csp command for publicip",
+		Long:  "This is release csp command for publicip",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if cspID == "" {
+				logger.Error("failed to validate --id parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--id parameter value : ", cspID)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	releaseCSPCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	releaseCSPCmd.PersistentFlags().StringVarP(&cspID, "id", "", "", "csp id")
+
+	return releaseCSPCmd
+}
+
+// NewPublicIPAssociateCmd - PublicIP VM 연결 기능을 수행하는 Cobra Command 생성
+func NewPublicIPAssociateCmd() *cobra.Command {
+
+	associateCmd := &cobra.Command{
+		Use:   "associate",
+		Short: "This is associate command for publicip",
+		Long:  "This is associate command for publicip",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	associateCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	associateCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return associateCmd
+}
+
+// NewPublicIPDisassociateCmd - PublicIP VM 연결 해제 기능을 수행하는 Cobra Command 생성
+func NewPublicIPDisassociateCmd() *cobra.Command {
+
+	disassociateCmd := &cobra.Command{
+		Use:   "disassociate",
+		Short: "This is disassociate command for publicip",
+		Long:  "This is disassociate command for publicip",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if publicIPName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--name parameter value : ", publicIPName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	disassociateCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	disassociateCmd.PersistentFlags().StringVarP(&publicIPName, "name", "n", "", "publicip name")
+
+	return disassociateCmd
+}
@@ -30,6 +30,9 @@ func NewRegionCmd() *cobra.Command {
 	regionCmd.AddCommand(NewRegionListCmd())
 	regionCmd.AddCommand(NewRegionGetCmd())
 	regionCmd.AddCommand(NewRegionDeleteCmd())
+	regionCmd.AddCommand(NewRegionUpdateCmd())
+	regionCmd.AddCommand(NewRegionPatchCmd())
+	regionCmd.AddCommand(NewRegionSyncCmd())
 
 	return regionCmd
 }
@@ -114,12 +117,99 @@ func NewRegionDeleteCmd() *cobra.Command {
 				return
 			}
 			logger.Debug("--name parameter value : ", regionName)
+			logger.Debug("--cascade parameter value : ", cascade)
+			logger.Debug("--orphan parameter value : ", orphan)
 
 			SetupAndRun(cmd, args)
 		},
 	}
 
 	deleteCmd.PersistentFlags().StringVarP(&regionName, "name", "n", "", "region name")
+	deleteCmd.PersistentFlags().StringVarP(&cascade, "cascade", "", "false", "cascade flg (true/false)")
+	deleteCmd.PersistentFlags().StringVarP(&orphan, "orphan", "", "false", "orphan flg (true/false), delete the connection configs with IIDs by cascade")
 
 	return deleteCmd
 }
+
+// NewRegionUpdateCmd - Region 변경 기능을 수행하는 Cobra Command 생성
+func NewRegionUpdateCmd() *cobra.Command {
+
+	updateCmd := &cobra.Command{
+		Use:   "update",
+		Short: "This is update command for region",
+		Long:  "This is update command for region",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	updateCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	updateCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return updateCmd
+}
+
+// NewRegionPatchCmd - Region 부분 변경 기능을 수행하는 Cobra Command 생성
+func NewRegionPatchCmd() *cobra.Command {
+
+	patchCmd := &cobra.Command{
+		Use:   "patch",
+		Short: "This is patch command for region",
+		Long:  "This is patch command for region",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	patchCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	patchCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return patchCmd
+}
+
+// NewRegionSyncCmd - CSP의 Region, Zone 목록으로 Region 동기화 기능을 수행하는 Cobra Command 생성
+func NewRegionSyncCmd() *cobra.Command {
+
+	syncCmd := &cobra.Command{
+		Use:   "sync",
+		Short: "This is sync command for region",
+		Long:  "This is sync command for region",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" && connectionName == "" {
+				logger.Error("failed to validate --indata or --cname parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+			logger.Debug("--cname parameter value : ", connectionName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	syncCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	syncCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+	syncCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+
+	return syncCmd
+}
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// ===== [ Types ] =====
+
+// ===== [ Implementations ] =====
+
+// ===== [ Private Functions ] =====
+
+// ===== [ Public Functions ] =====
+
+// NewRegionZoneCmd - CSP의 Region, Zone 조회 기능을 수행하는 Cobra Command 생성
+func NewRegionZoneCmd() *cobra.Command {
+
+	regionZoneCmd := &cobra.Command{
+		Use:   "regionzone",
+		Short: "This is a manageable command for region zone",
+		Long:  "This is a manageable command for region zone",
+	}
+
+	//  Adds the commands for application.
+	regionZoneCmd.AddCommand(NewRegionZoneListCmd())
+	regionZoneCmd.AddCommand(NewRegionZoneGetCmd())
+	regionZoneCmd.AddCommand(NewRegionZoneListZoneCmd())
+
+	return regionZoneCmd
+}
+
+// NewRegionZoneListCmd - CSP의 Region, Zone 목록 기능을 수행하는 Cobra Command 생성
+func NewRegionZoneListCmd() *cobra.Command {
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "This is list command for region zone",
+		Long:  "This is list command for region zone",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	listCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+
+	return listCmd
+}
+
+// NewRegionZoneGetCmd - CSP의 Region, Zone 조회 기능을 수행하는 Cobra Command 생성
+func NewRegionZoneGetCmd() *cobra.Command {
+
+	getCmd := &cobra.Command{
+		Use:   "get",
+		Short: "This is get command for region zone",
+		Long:  "This is get command for region zone",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if regionName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--name parameter value : ", regionName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	getCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	getCmd.PersistentFlags().StringVarP(&regionName, "name", "n", "", "csp region name")
+
+	return getCmd
+}
+
+// NewRegionZoneListZoneCmd - Connection의 Region에 있는 Zone 목록 기능을 수행하는 Cobra Command 생성
+func NewRegionZoneListZoneCmd() *cobra.Command {
+
+	listZoneCmd := &cobra.Command{
+		Use:   "listzone",
+		Short: "This is zone list command for region zone",
+		Long:  "This is zone list command for region zone",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	listZoneCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+
+	return listZoneCmd
+}
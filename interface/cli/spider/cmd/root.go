@@ -15,10 +15,13 @@ var (
 	inType     string
 	outType    string
 
+	cloudOSName    string
 	driverName     string
 	credentialName string
 	regionName     string
 	configName     string
+	cascade        string
+	orphan         string
 
 	connectionName string
 	imageName      string
@@ -27,6 +30,15 @@ var (
 	securityName   string
 	keypairName    string
 	vmName         string
+	nlbName        string
+	publicIPName   string
+	vpcPeeringName string
+	dnsZoneName    string
+	bucketName     string
+	objectName     string
+	objectFile     string
+	contentType    string
+	prefix         string
 	action         string
 	cspID          string
 	force          string
@@ -68,10 +80,16 @@ func NewRootCmd() *cobra.Command {
 
 	rootCmd.AddCommand(NewImageCmd())
 	rootCmd.AddCommand(NewVMSpecCmd())
+	rootCmd.AddCommand(NewRegionZoneCmd())
 	rootCmd.AddCommand(NewVPCCmd())
+	rootCmd.AddCommand(NewVPCPeeringCmd())
 	rootCmd.AddCommand(NewSecurityCmd())
 	rootCmd.AddCommand(NewKeyPairCmd())
 	rootCmd.AddCommand(NewVMCmd())
+	rootCmd.AddCommand(NewNLBCmd())
+	rootCmd.AddCommand(NewPublicIPCmd())
+	rootCmd.AddCommand(NewDNSCmd())
+	rootCmd.AddCommand(NewBucketCmd())
 
 	rootCmd.AddCommand(NewSSHCmd())
 
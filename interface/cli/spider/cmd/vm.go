@@ -32,6 +32,7 @@ func NewVMCmd() *cobra.Command {
 	vmCmd.AddCommand(NewVMGetStatusCmd())
 	vmCmd.AddCommand(NewVMListCmd())
 	vmCmd.AddCommand(NewVMGetCmd())
+	vmCmd.AddCommand(NewVMConsoleCmd())
 	vmCmd.AddCommand(NewVMTerminateCmd())
 	vmCmd.AddCommand(NewVMListAllCmd())
 	vmCmd.AddCommand(NewVMTerminateCSPCmd())
@@ -210,6 +211,36 @@ func NewVMGetCmd() *cobra.Command {
 	return getCmd
 }
 
+// NewVMConsoleCmd - VM 콘솔 출력 조회 기능을 수행하는 Cobra Command 생성
+func NewVMConsoleCmd() *cobra.Command {
+
+	consoleCmd := &cobra.Command{
+		Use:   "console",
+		Short: "This is console output command for vm",
+		Long:  "This is console output command for vm",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if vmName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--name parameter value : ", vmName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	consoleCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	consoleCmd.PersistentFlags().StringVarP(&vmName, "name", "n", "", "vm name")
+
+	return consoleCmd
+}
+
 // NewVMTerminateCmd - VM 삭제 기능을 수행하는 Cobra Command 생성
 func NewVMTerminateCmd() *cobra.Command {
 
@@ -0,0 +1,235 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// ===== [ Types ] =====
+
+// ===== [ Implementations ] =====
+
+// ===== [ Private Functions ] =====
+
+// ===== [ Public Functions ] =====
+
+// NewVPCPeeringCmd - VPC Peering 관리 기능을 수행하는 Cobra Command 생성
+func NewVPCPeeringCmd() *cobra.Command {
+
+	vpcPeeringCmd := &cobra.Command{
+		Use:   "vpcpeering",
+		Short: "This is a manageable command for vpcpeering",
+		Long:  "This is a manageable command for vpcpeering",
+	}
+
+	//  Adds the commands for application.
+	vpcPeeringCmd.AddCommand(NewVPCPeeringRequestCmd())
+	vpcPeeringCmd.AddCommand(NewVPCPeeringAcceptCmd())
+	vpcPeeringCmd.AddCommand(NewVPCPeeringListCmd())
+	vpcPeeringCmd.AddCommand(NewVPCPeeringGetCmd())
+	vpcPeeringCmd.AddCommand(NewVPCPeeringDeleteCmd())
+	vpcPeeringCmd.AddCommand(NewVPCPeeringListAllCmd())
+	vpcPeeringCmd.AddCommand(NewVPCPeeringDeleteCSPCmd())
+
+	return vpcPeeringCmd
+}
+
+// NewVPCPeeringRequestCmd - VPC Peering 요청 기능을 수행하는 Cobra Command 생성
+func NewVPCPeeringRequestCmd() *cobra.Command {
+
+	requestCmd := &cobra.Command{
+		Use:   "request",
+		Short: "This is request command for vpcpeering",
+		Long:  "This is request command for vpcpeering",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			readInDataFromFile()
+			if inData == "" {
+				logger.Error("failed to validate --indata parameter")
+				return
+			}
+			logger.Debug("--indata parameter value : \n", inData)
+			logger.Debug("--infile parameter value : ", inFile)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	requestCmd.PersistentFlags().StringVarP(&inData, "indata", "d", "", "input string data")
+	requestCmd.PersistentFlags().StringVarP(&inFile, "infile", "f", "", "input file path")
+
+	return requestCmd
+}
+
+// NewVPCPeeringAcceptCmd - VPC Peering 수락 기능을 수행하는 Cobra Command 생성
+func NewVPCPeeringAcceptCmd() *cobra.Command {
+
+	acceptCmd := &cobra.Command{
+		Use:   "accept",
+		Short: "This is accept command for vpcpeering",
+		Long:  "This is accept command for vpcpeering",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if vpcPeeringName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--name parameter value : ", vpcPeeringName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	acceptCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	acceptCmd.PersistentFlags().StringVarP(&vpcPeeringName, "name", "n", "", "vpcpeering name")
+
+	return acceptCmd
+}
+
+// NewVPCPeeringListCmd - VPC Peering 목록 기능을 수행하는 Cobra Command 생성
+func NewVPCPeeringListCmd() *cobra.Command {
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "This is list command for vpcpeering",
+		Long:  "This is list command for vpcpeering",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	listCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+
+	return listCmd
+}
+
+// NewVPCPeeringGetCmd - VPC Peering 조회 기능을 수행하는 Cobra Command 생성
+func NewVPCPeeringGetCmd() *cobra.Command {
+
+	getCmd := &cobra.Command{
+		Use:   "get",
+		Short: "This is get command for vpcpeering",
+		Long:  "This is get command for vpcpeering",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if vpcPeeringName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--name parameter value : ", vpcPeeringName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	getCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	getCmd.PersistentFlags().StringVarP(&vpcPeeringName, "name", "n", "", "vpcpeering name")
+
+	return getCmd
+}
+
+// NewVPCPeeringDeleteCmd - VPC Peering 삭제 기능을 수행하는 Cobra Command 생성
+func NewVPCPeeringDeleteCmd() *cobra.Command {
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete",
+		Short: "This is delete command for vpcpeering",
+		Long:  "This is delete command for vpcpeering",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if vpcPeeringName == "" {
+				logger.Error("failed to validate --name parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--name parameter value : ", vpcPeeringName)
+			logger.Debug("--force parameter value : ", force)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	deleteCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	deleteCmd.PersistentFlags().StringVarP(&vpcPeeringName, "name", "n", "", "vpcpeering name")
+	deleteCmd.PersistentFlags().StringVarP(&force, "force", "", "false", "force flg (true/false)")
+
+	return deleteCmd
+}
+
+// NewVPCPeeringListAllCmd - 관리 VPC Peering 목록 기능을 수행하는 Cobra Command 생성
+func NewVPCPeeringListAllCmd() *cobra.Command {
+
+	listAllCmd := &cobra.Command{
+		Use:   "listall",
+		Short: "This is list all command for vpcpeering",
+		Long:  "This is list all command for vpcpeering",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	listAllCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+
+	return listAllCmd
+}
+
+// NewVPCPeeringDeleteCSPCmd - 관리 VPC Peering 삭제 기능을 수행하는 Cobra Command 생성
+func NewVPCPeeringDeleteCSPCmd() *cobra.Command {
+
+	deleteCSPCmd := &cobra.Command{
+		Use:   "deletecsp",
+		Short: "This is delete csp command for vpcpeering",
+		Long:  "This is delete csp command for vpcpeering",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := logger.NewLogger()
+			if connectionName == "" {
+				logger.Error("failed to validate --cname parameter")
+				return
+			}
+			if cspID == "" {
+				logger.Error("failed to validate --id parameter")
+				return
+			}
+			logger.Debug("--cname parameter value : ", connectionName)
+			logger.Debug("--id parameter value : ", cspID)
+
+			SetupAndRun(cmd, args)
+		},
+	}
+
+	deleteCSPCmd.PersistentFlags().StringVarP(&connectionName, "cname", "", "", "connection name")
+	deleteCSPCmd.PersistentFlags().StringVarP(&cspID, "id", "", "", "csp id")
+
+	return deleteCSPCmd
+}
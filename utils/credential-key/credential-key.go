@@ -0,0 +1,76 @@
+// Credential Master Key Tool of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.10.
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
+)
+
+// Offline rotation(the spider server is stopped):
+//  1. genkey: generate a new key
+//  2. put the new key on the first line of the key file, keep the previous keys
+//  3. rotate: re-encrypt all credentials with the new key
+//  4. status: check no value is left for the previous keys, then remove them
+//
+// Online rotation(the spider server is running with CBSPIDER_ADMIN_TOKEN):
+//
+//	POST /spider/credentialkey/rotate with the Spider-Admin-Token header after step 2, instead of step 3.
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		return
+	}
+
+	switch os.Args[1] {
+	case "genkey":
+		key, err := cim.GenerateCredentialKey()
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		fmt.Println(key)
+	case "rotate":
+		count, err := cim.RotateCredentialKey()
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		keyID, _ := cim.CurrentCredentialKeyID()
+		fmt.Printf("%d credential values are rotated to KeyID=%s\n", count, keyID)
+	case "status":
+		keyID, err := cim.CurrentCredentialKeyID()
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		countMap, err := cim.CountCredentialKeyID()
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		fmt.Printf("current KeyID: %s\n", keyID)
+		for id, count := range countMap {
+			fmt.Printf("  KeyID=%s: %d values\n", id, count)
+		}
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Println("================================================================")
+	fmt.Println("    Usage: credential_key.sh genkey | rotate | status")
+	fmt.Println("      genkey: print a new master key")
+	fmt.Println("      rotate: re-encrypt all credentials with the current key")
+	fmt.Println("      status: print the number of values per KeyID")
+	fmt.Println("================================================================")
+}
@@ -0,0 +1 @@
+go run $CBSPIDER_ROOT/utils/credential-key/credential-key.go $1
@@ -59,7 +59,7 @@ func InsertDriverInfos() {
 			driverName := strings.ReplaceAll(file, ".so", "")
 			strs := strings.Split(file, "-")
 			cloudos := strings.ToUpper(strs[0])
-			cloudDriverInfo := dim.CloudDriverInfo{driverName, cloudos, file}
+			cloudDriverInfo := dim.CloudDriverInfo{driverName, cloudos, file, 0}
 
 			_, err := dim.RegisterCloudDriverInfo(cloudDriverInfo)
 	                if err != nil {
@@ -12,12 +12,12 @@ import (
         "github.com/sirupsen/logrus"
         "github.com/cloud-barista/cb-store/config"
 
-	icbs "github.com/cloud-barista/cb-store/interfaces"
+	// sets the getter of the CloudOS schema, owned by the drivers, for the key validation.
+	_ "github.com/cloud-barista/cb-spider/cloud-control-manager"
 	cim "github.com/cloud-barista/cb-spider/cloud-info-manager"
 	rim "github.com/cloud-barista/cb-spider/cloud-info-manager/region-info-manager"
+	rl "github.com/cloud-barista/cb-spider/utils/import-info/region-loader"
 
-	"encoding/json"
-	"io/ioutil"
 	"os"
 	"strings"
 	"fmt"
@@ -57,7 +57,7 @@ func InsertRegionInfos(resourceGroup string) ([]rim.RegionInfo) {
 	// Set Environment Value of Project Root Path
 	rootPath := os.Getenv("CBSPIDER_ROOT")
         if rootPath == "" {
-                Cblogger.Error("$CBSPIDER_ROOT is not set!!")
+                cblog.Error("$CBSPIDER_ROOT is not set!!")
                 os.Exit(1)
         }
 	regionInfoList := []rim.RegionInfo{}
@@ -74,21 +74,10 @@ func InsertRegionInfos(resourceGroup string) ([]rim.RegionInfo) {
 		}
 		defer regionFile.Close()
 
-		var oneRegionInfoList []rim.RegionInfo 
-		switch cloudos {
-			case "AWS", "CLOUDIT", "OPENSTACK", "DOCKER":
-				oneRegionInfoList = awsLoader(cloudos, regionFile)
-			case "AZURE":
-				oneRegionInfoList = azureLoader(cloudos, regionFile, resourceGroup)
-			case "GCP":
-				oneRegionInfoList = gcpLoader(cloudos, regionFile)
-			case "ALIBABA":
-				oneRegionInfoList = alibabaLoader(cloudos, regionFile)
-			case "CLOUDTWIN":
-			default:
-				errmsg := cloudos + " is not a valid ProviderName!!"
-				cblog.Error(errmsg)
-				//return nil, fmt.Errorf(errmsg)
+		oneRegionInfoList, err := rl.LoadRegionInfoList(cloudos, regionFile, resourceGroup)
+		if err != nil {
+			cblog.Error(err)
+			continue
 		}
 
 		regionInfoList = append(regionInfoList, oneRegionInfoList...)
@@ -104,104 +93,3 @@ func InsertRegionInfos(resourceGroup string) ([]rim.RegionInfo) {
 
 	return regionInfoList
 }
-
-
-// for AWS, Cloudit, OpenStack, Docker
-func awsLoader(cloudos string, regionFile *os.File) []rim.RegionInfo {
-
-	type OrgRegions struct {
-		Regions [] struct { 
-			RegionName string 	`json:"RegionName"`
-		}
-	}
-
-	byteValue, _ := ioutil.ReadAll(regionFile)
-
-	var orgRegions OrgRegions
-	json.Unmarshal(byteValue, &orgRegions)
-
-	regionInfoList := []rim.RegionInfo{}
-	for _, region := range orgRegions.Regions {
-		keyValueList := []icbs.KeyValue{ {"Region", region.RegionName} }
-		regionInfo := rim.RegionInfo{strings.ToLower(cloudos) + "-" + region.RegionName, 
-						strings.ToUpper(cloudos), keyValueList}
-		regionInfoList = append(regionInfoList, regionInfo)
-	}
-
-	return regionInfoList
-}
-
-func azureLoader(cloudos string, regionFile *os.File, resourceGroup string) []rim.RegionInfo {
-
-
-	type Regions struct {
-		Name string        `json:"name"`
-	}
-
-        byteValue, _ := ioutil.ReadAll(regionFile)
-
-        var orgRegions []Regions
-        json.Unmarshal(byteValue, &orgRegions)
-
-        regionInfoList := []rim.RegionInfo{}
-        for _, region := range orgRegions {
-
-                keyValueList := []icbs.KeyValue{ {"location", region.Name}, {"ResourceGroup", resourceGroup} }
-                regionInfo := rim.RegionInfo{strings.ToLower(cloudos) + "-" + region.Name,
-                                                strings.ToUpper(cloudos), keyValueList}
-                regionInfoList = append(regionInfoList, regionInfo)
-        }
-
-        return regionInfoList
-}
-
-func alibabaLoader(cloudos string, regionFile *os.File) []rim.RegionInfo {
-
-        type OrgRegions struct {
-                Regions struct {
-			Region [] struct {
-				RegionId string       `json:"RegionId"`
-			}
-                }
-        }
-
-        byteValue, _ := ioutil.ReadAll(regionFile)
-
-        var orgRegions OrgRegions
-        json.Unmarshal(byteValue, &orgRegions)
-
-        regionInfoList := []rim.RegionInfo{}
-        for _, region := range orgRegions.Regions.Region {
-                keyValueList := []icbs.KeyValue{ {"Region", region.RegionId} , {"Zone", region.RegionId + "a"}}
-                regionInfo := rim.RegionInfo{strings.ToLower(cloudos) + "-" + region.RegionId,
-                                                strings.ToUpper(cloudos), keyValueList}
-                regionInfoList = append(regionInfoList, regionInfo)
-        }
-
-        return regionInfoList
-}
-
-func gcpLoader(cloudos string, regionFile *os.File) []rim.RegionInfo {
-
-        type Regions struct {
-                Name string        `json:"name"`
-        }
-
-        byteValue, _ := ioutil.ReadAll(regionFile)
-
-        var orgRegions []Regions
-        json.Unmarshal(byteValue, &orgRegions)
-
-        regionInfoList := []rim.RegionInfo{}
-        for _, region := range orgRegions {
-
-		runes := []rune(region.Name)
-                keyValueList := []icbs.KeyValue{ {"Region", string(runes[0:len(region.Name)-2])}, {"Zone", region.Name} }
-                regionInfo := rim.RegionInfo{strings.ToLower(cloudos) + "-" + region.Name,
-                                                strings.ToUpper(cloudos), keyValueList}
-                regionInfoList = append(regionInfoList, regionInfo)
-        }
-
-        return regionInfoList
-}
-
@@ -0,0 +1,168 @@
+// Region Loader of the Import Tool of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.05.
+
+package regionloader
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	rim "github.com/cloud-barista/cb-spider/cloud-info-manager/region-info-manager"
+	icbs "github.com/cloud-barista/cb-store/interfaces"
+)
+
+// RegionInfo list of the cloudos from its region list file,
+// $CBSPIDER_ROOT/utils/import-info/region-list/{cloudos}-regions-list.json
+func LoadRegionInfoList(cloudos string, regionFile io.Reader, resourceGroup string) ([]rim.RegionInfo, error) {
+	switch cloudos {
+	case "AWS":
+		return awsLoader(cloudos, regionFile, true)
+	case "CLOUDIT", "OPENSTACK", "DOCKER":
+		return awsLoader(cloudos, regionFile, false)
+	case "AZURE":
+		return azureLoader(cloudos, regionFile, resourceGroup)
+	case "GCP":
+		return gcpLoader(cloudos, regionFile)
+	case "ALIBABA":
+		return alibabaLoader(cloudos, regionFile)
+	case "CLOUDTWIN":
+		return []rim.RegionInfo{}, nil
+	default:
+		return nil, fmt.Errorf(cloudos + " is not a valid ProviderName!!")
+	}
+}
+
+// for AWS, Cloudit, OpenStack, Docker
+// the first zone(Region+"a") is set for AWS, it is used to create a VPC and to list VM specs.
+func awsLoader(cloudos string, regionFile io.Reader, withZone bool) ([]rim.RegionInfo, error) {
+
+	type OrgRegions struct {
+		Regions []struct {
+			RegionName string `json:"RegionName"`
+		}
+	}
+
+	byteValue, err := ioutil.ReadAll(regionFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var orgRegions OrgRegions
+	err = json.Unmarshal(byteValue, &orgRegions)
+	if err != nil {
+		return nil, err
+	}
+
+	regionInfoList := []rim.RegionInfo{}
+	for _, region := range orgRegions.Regions {
+		keyValueList := []icbs.KeyValue{{"Region", region.RegionName}}
+		if withZone {
+			keyValueList = append(keyValueList, icbs.KeyValue{"Zone", region.RegionName + "a"})
+		}
+		regionInfo := rim.RegionInfo{strings.ToLower(cloudos) + "-" + region.RegionName,
+			strings.ToUpper(cloudos), keyValueList, 0}
+		regionInfoList = append(regionInfoList, regionInfo)
+	}
+
+	return regionInfoList, nil
+}
+
+func azureLoader(cloudos string, regionFile io.Reader, resourceGroup string) ([]rim.RegionInfo, error) {
+
+	type Regions struct {
+		Name string `json:"name"`
+	}
+
+	byteValue, err := ioutil.ReadAll(regionFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var orgRegions []Regions
+	err = json.Unmarshal(byteValue, &orgRegions)
+	if err != nil {
+		return nil, err
+	}
+
+	regionInfoList := []rim.RegionInfo{}
+	for _, region := range orgRegions {
+
+		keyValueList := []icbs.KeyValue{{"location", region.Name}, {"ResourceGroup", resourceGroup}}
+		regionInfo := rim.RegionInfo{strings.ToLower(cloudos) + "-" + region.Name,
+			strings.ToUpper(cloudos), keyValueList, 0}
+		regionInfoList = append(regionInfoList, regionInfo)
+	}
+
+	return regionInfoList, nil
+}
+
+func alibabaLoader(cloudos string, regionFile io.Reader) ([]rim.RegionInfo, error) {
+
+	type OrgRegions struct {
+		Regions struct {
+			Region []struct {
+				RegionId string `json:"RegionId"`
+			}
+		}
+	}
+
+	byteValue, err := ioutil.ReadAll(regionFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var orgRegions OrgRegions
+	err = json.Unmarshal(byteValue, &orgRegions)
+	if err != nil {
+		return nil, err
+	}
+
+	regionInfoList := []rim.RegionInfo{}
+	for _, region := range orgRegions.Regions.Region {
+		keyValueList := []icbs.KeyValue{{"Region", region.RegionId}, {"Zone", region.RegionId + "a"}}
+		regionInfo := rim.RegionInfo{strings.ToLower(cloudos) + "-" + region.RegionId,
+			strings.ToUpper(cloudos), keyValueList, 0}
+		regionInfoList = append(regionInfoList, regionInfo)
+	}
+
+	return regionInfoList, nil
+}
+
+func gcpLoader(cloudos string, regionFile io.Reader) ([]rim.RegionInfo, error) {
+
+	type Regions struct {
+		Name string `json:"name"`
+	}
+
+	byteValue, err := ioutil.ReadAll(regionFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var orgRegions []Regions
+	err = json.Unmarshal(byteValue, &orgRegions)
+	if err != nil {
+		return nil, err
+	}
+
+	regionInfoList := []rim.RegionInfo{}
+	for _, region := range orgRegions {
+
+		// zone name, ex) asia-northeast3-a => region name, asia-northeast3
+		runes := []rune(region.Name)
+		keyValueList := []icbs.KeyValue{{"Region", string(runes[0 : len(region.Name)-2])}, {"Zone", region.Name}}
+		regionInfo := rim.RegionInfo{strings.ToLower(cloudos) + "-" + region.Name,
+			strings.ToUpper(cloudos), keyValueList, 0}
+		regionInfoList = append(regionInfoList, regionInfo)
+	}
+
+	return regionInfoList, nil
+}
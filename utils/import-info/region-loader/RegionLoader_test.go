@@ -0,0 +1,58 @@
+// Test of Region Loader of the Import Tool of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2020.05.
+
+package regionloader
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	// sets the getter of the CloudOS schema, owned by the drivers.
+	_ "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	im "github.com/cloud-barista/cb-spider/cloud-info-manager"
+)
+
+// the key-value lists of all region list files should pass the schema of the drivers,
+// the import tool registers them with the same validation.
+func TestLoadedRegionKeyValueBySchema(t *testing.T) {
+	rootPath := os.Getenv("CBSPIDER_ROOT")
+	if rootPath == "" {
+		t.Skip("$CBSPIDER_ROOT is not set!!")
+	}
+	os.Setenv("PLUGIN_SW", "OFF") // the schema of the static drivers
+
+	loaded := 0
+	for _, cloudos := range im.ListCloudOS() {
+		regionFile, err := os.Open(rootPath + "/utils/import-info/region-list/" + strings.ToLower(cloudos) + "-regions-list.json")
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		regionInfoList, err := LoadRegionInfoList(cloudos, regionFile, "test-resource-group")
+		regionFile.Close()
+		if err != nil {
+			t.Fatalf("%s: %v", cloudos, err)
+		}
+		if len(regionInfoList) == 0 {
+			t.Errorf("%s: no region is loaded.", cloudos)
+		}
+		for _, regionInfo := range regionInfoList {
+			err := im.ValidateRegionKeyValue(regionInfo.ProviderName, regionInfo.KeyValueInfoList)
+			if err != nil {
+				t.Errorf("%s: %v", regionInfo.RegionName, err)
+			}
+		}
+		loaded++
+	}
+	if loaded == 0 {
+		t.Fatal("no region list file is loaded.")
+	}
+}